@@ -6,6 +6,7 @@ import (
 
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -50,6 +51,7 @@ type HeadFetcher interface {
 	HeadGenesisValidatorRoot() [32]byte
 	HeadETH1Data() *ethpb.Eth1Data
 	ProtoArrayStore() *protoarray.Store
+	ForkChoiceGetter() forkchoice.Getter
 	ChainHeads() ([][32]byte, []types.Slot)
 }
 
@@ -221,6 +223,11 @@ func (s *Service) ProtoArrayStore() *protoarray.Store {
 	return s.cfg.ForkChoiceStore.Store()
 }
 
+// ForkChoiceGetter returns the fork choice store as a read only view, including the validator votes.
+func (s *Service) ForkChoiceGetter() forkchoice.Getter {
+	return s.cfg.ForkChoiceStore
+}
+
 // GenesisTime returns the genesis time of beacon chain.
 func (s *Service) GenesisTime() time.Time {
 	return s.genesisTime
@@ -13,6 +13,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -118,7 +119,12 @@ func (s *Service) processAttestationsRoutine(subscribedToStateEvents chan<- stru
 		select {
 		case <-s.ctx.Done():
 			return
-		case <-st.C():
+		case slot := <-st.C():
+			// The proposer boost only applies to the slot in which the boosted block was received.
+			if err := s.cfg.ForkChoiceStore.ResetBoostedProposerRoot(s.ctx, slot); err != nil {
+				log.WithError(err).Error("Could not reset boosted proposer root in fork choice")
+			}
+			s.processPendingAttesterSlashings(s.ctx)
 			// Continue when there's no fork choice attestation, there's nothing to process and update head.
 			// This covers the condition when the node is still initial syncing to the head of the chain.
 			if s.cfg.AttPool.ForkchoiceAttestationCount() == 0 {
@@ -169,3 +175,28 @@ func (s *Service) processAttestations(ctx context.Context) {
 		}
 	}
 }
+
+// This feeds the validators of the pending attester slashings in the pool to fork choice, so that their
+// votes stop being counted before the slashings are included in a block.
+func (s *Service) processPendingAttesterSlashings(ctx context.Context) {
+	s.headLock.RLock()
+	if !s.hasHeadState() {
+		s.headLock.RUnlock()
+		return
+	}
+	slashings := s.cfg.SlashingPool.PendingAttesterSlashings(ctx, s.head.state, true /* no limit */)
+	s.headLock.RUnlock()
+
+	s.insertSlashingsToForkChoiceStore(ctx, slashings)
+}
+
+// This feeds the equivocating validators of attester slashings to fork choice store.
+func (s *Service) insertSlashingsToForkChoiceStore(ctx context.Context, slashings []*ethpb.AttesterSlashing) {
+	for _, slashing := range slashings {
+		if slashing == nil || slashing.Attestation_1 == nil || slashing.Attestation_2 == nil {
+			continue
+		}
+		indices := sliceutil.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices)
+		s.cfg.ForkChoiceStore.InsertEquivocatingIndices(ctx, indices)
+	}
+}
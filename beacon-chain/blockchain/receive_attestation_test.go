@@ -11,6 +11,7 @@ import (
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
@@ -120,3 +121,35 @@ func TestProcessAttestations_Ok(t *testing.T) {
 	require.Equal(t, 0, len(service.cfg.AttPool.ForkchoiceAttestations()))
 	require.LogsDoNotContain(t, hook, "Could not process attestation for fork choice")
 }
+
+func TestProcessPendingAttesterSlashings(t *testing.T) {
+	ctx := context.Background()
+	fc := protoarray.New(0, 0, [32]byte{})
+	root := [32]byte{'a'}
+	require.NoError(t, fc.ProcessBlock(ctx, 1, root, params.BeaconConfig().ZeroHash, [32]byte{}, 0, 0))
+	fc.ProcessAttestation(ctx, []uint64{0, 1, 2}, root, 0)
+	balances := []uint64{10, 10, 10}
+	_, err := fc.Head(ctx, 0, root, balances, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(30), fc.Node(root).Weight())
+
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	slashing := &ethpb.AttesterSlashing{
+		Attestation_1: &ethpb.IndexedAttestation{AttestingIndices: []uint64{0, 1}},
+		Attestation_2: &ethpb.IndexedAttestation{AttestingIndices: []uint64{1, 2}},
+	}
+	service := &Service{
+		cfg: &Config{
+			ForkChoiceStore: fc,
+			SlashingPool:    &slashings.PoolMock{PendingAttSlashings: []*ethpb.AttesterSlashing{slashing}},
+		},
+		head: &head{state: st},
+	}
+	service.processPendingAttesterSlashings(ctx)
+
+	// Only the vote of the validator which attested to both attestations is removed.
+	_, err = fc.Head(ctx, 0, root, balances, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), fc.Node(root).Weight())
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/interfaces"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/timeutils"
@@ -37,7 +38,7 @@ func (s *Service) ReceiveBlock(ctx context.Context, block interfaces.SignedBeaco
 	blockCopy := block.Copy()
 
 	// Apply state transition on the new block.
-	if err := s.onBlock(ctx, blockCopy, blockRoot); err != nil {
+	if err := s.onBlock(ctx, blockCopy, blockRoot, receivedTime); err != nil {
 		err := errors.Wrap(err, "could not process block")
 		traceutil.AnnotateError(span, err)
 		return err
@@ -65,6 +66,13 @@ func (s *Service) ReceiveBlock(ctx context.Context, block interfaces.SignedBeaco
 		return err
 	}
 
+	// Feed the signed block header to the slasher if enabled.
+	if featureconfig.Get().EnableSlasher && s.cfg.SlasherBlockHeadersFeed != nil {
+		if err := s.sendBlockHeaderToSlasher(blockCopy); err != nil {
+			log.WithError(err).Error("Could not send block header to slasher")
+		}
+	}
+
 	// Have we been finalizing? Should we start saving hot states to db?
 	if err := s.checkSaveHotStateDB(ctx); err != nil {
 		return err
@@ -174,3 +182,22 @@ func (s *Service) checkSaveHotStateDB(ctx context.Context) error {
 
 	return s.cfg.StateGen.DisableSaveHotStateToDB(ctx)
 }
+
+// Sends the signed header of a block to the slasher for double proposal detection.
+func (s *Service) sendBlockHeaderToSlasher(block interfaces.SignedBeaconBlock) error {
+	bodyRoot, err := block.Block().Body().HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	s.cfg.SlasherBlockHeadersFeed.Send(&ethpb.SignedBeaconBlockHeader{
+		Header: &ethpb.BeaconBlockHeader{
+			Slot:          block.Block().Slot(),
+			ProposerIndex: block.Block().ProposerIndex(),
+			ParentRoot:    block.Block().ParentRoot(),
+			StateRoot:     block.Block().StateRoot(),
+			BodyRoot:      bodyRoot[:],
+		},
+		Signature: block.Signature(),
+	})
+	return nil
+}
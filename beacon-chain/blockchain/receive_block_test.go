@@ -17,6 +17,8 @@ import (
 	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
 	"github.com/prysmaticlabs/prysm/proto/interfaces"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
@@ -157,6 +159,50 @@ func TestService_ReceiveBlock(t *testing.T) {
 	}
 }
 
+func TestService_ReceiveBlock_SlasherFeed(t *testing.T) {
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{EnableSlasher: true})
+	defer resetCfg()
+	ctx := context.Background()
+	genesis, keys := testutil.DeterministicGenesisState(t, 64)
+	b, err := testutil.GenerateFullBlock(genesis, keys, testutil.DefaultBlockGenConfig(), 1)
+	require.NoError(t, err)
+	beaconDB := testDB.SetupDB(t)
+	genesisBlockRoot := bytesutil.ToBytes32(nil)
+	require.NoError(t, beaconDB.SaveState(ctx, genesis, genesisBlockRoot))
+	cfg := &Config{
+		BeaconDB: beaconDB,
+		ForkChoiceStore: protoarray.New(
+			0, // justifiedEpoch
+			0, // finalizedEpoch
+			genesisBlockRoot,
+		),
+		AttPool:                 attestations.NewPool(),
+		ExitPool:                voluntaryexits.NewPool(),
+		StateNotifier:           &blockchainTesting.MockStateNotifier{RecordEvents: true},
+		StateGen:                stategen.New(beaconDB),
+		SlasherBlockHeadersFeed: new(event.Feed),
+	}
+	s, err := NewService(ctx, cfg)
+	require.NoError(t, err)
+	require.NoError(t, s.saveGenesisData(ctx, genesis))
+	headersChan := make(chan *ethpb.SignedBeaconBlockHeader, 1)
+	sub := cfg.SlasherBlockHeadersFeed.Subscribe(headersChan)
+	defer sub.Unsubscribe()
+
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, s.ReceiveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b), root))
+	select {
+	case header := <-headersChan:
+		headerRoot, err := header.Header.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, root, headerRoot)
+		assert.DeepEqual(t, b.Signature, header.Signature)
+	default:
+		t.Fatal("Block header was not sent to the slasher feed")
+	}
+}
+
 func TestService_ReceiveBlockUpdateHead(t *testing.T) {
 	ctx := context.Background()
 	genesis, keys := testutil.DeterministicGenesisState(t, 64)
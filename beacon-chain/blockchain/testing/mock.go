@@ -17,6 +17,7 @@ import (
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
@@ -50,6 +51,7 @@ type ChainService struct {
 	opNotifier                  opfeed.Notifier
 	ValidAttestation            bool
 	ForkChoiceStore             *protoarray.Store
+	ForkChoice                  *protoarray.ForkChoice
 	VerifyBlkDescendantErr      error
 	Slot                        *types.Slot // Pointer because 0 is a useful value, so checking against it can be incorrect.
 }
@@ -316,6 +318,14 @@ func (s *ChainService) ProtoArrayStore() *protoarray.Store {
 	return s.ForkChoiceStore
 }
 
+// ForkChoiceGetter mocks the same method in the chain service.
+func (s *ChainService) ForkChoiceGetter() forkchoice.Getter {
+	if s.ForkChoice == nil {
+		return nil
+	}
+	return s.ForkChoice
+}
+
 // GenesisTime mocks the same method in the chain service.
 func (s *ChainService) GenesisTime() time.Time {
 	return s.Genesis
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -33,6 +35,17 @@ func (s *Service) VerifyWeakSubjectivityRoot(ctx context.Context) error {
 	if err := s.cfg.BeaconDB.SaveBlocks(ctx, s.getInitSyncBlocks()); err != nil {
 		return err
 	}
+	// A node started from a checkpoint sync origin has no blocks before the origin, so the
+	// weak subjectivity checkpoint is verified against the origin instead.
+	verified, err := s.verifyWeakSubjectivityOrigin(ctx, r)
+	if err != nil {
+		return err
+	}
+	if verified {
+		log.Info("Weak subjectivity check has passed")
+		s.wsVerified = true
+		return nil
+	}
 	// A node should have the weak subjectivity block in the DB.
 	if !s.cfg.BeaconDB.HasBlock(ctx, r) {
 		return fmt.Errorf("node does not have root in DB: %#x", r)
@@ -58,3 +71,33 @@ func (s *Service) VerifyWeakSubjectivityRoot(ctx context.Context) error {
 
 	return fmt.Errorf("node does not have root in db corresponding to epoch: %#x %d", r, s.cfg.WeakSubjectivityCheckpt.Epoch)
 }
+
+// verifyWeakSubjectivityOrigin verifies the weak subjectivity root against the checkpoint sync
+// origin of the node. It returns true if the root is the origin block root, and an error if the
+// weak subjectivity epoch is not after the origin while its block is not in the DB.
+func (s *Service) verifyWeakSubjectivityOrigin(ctx context.Context, r [32]byte) (bool, error) {
+	oRoot, err := s.cfg.BeaconDB.OriginBlockRoot(ctx)
+	if errors.Is(err, db.ErrNotFoundOriginBlockRoot) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if oRoot == r {
+		return true, nil
+	}
+	if s.cfg.BeaconDB.HasBlock(ctx, r) {
+		return false, nil
+	}
+	oBlock, err := s.cfg.BeaconDB.Block(ctx, oRoot)
+	if err != nil {
+		return false, err
+	}
+	if oBlock == nil || oBlock.IsNil() {
+		return false, fmt.Errorf("node does not have origin root in DB: %#x", oRoot)
+	}
+	if s.cfg.WeakSubjectivityCheckpt.Epoch <= helpers.SlotToEpoch(oBlock.Block().Slot()) {
+		return false, fmt.Errorf("weak subjectivity root %#x does not match checkpoint sync origin root %#x", r, oRoot)
+	}
+	return false, nil
+}
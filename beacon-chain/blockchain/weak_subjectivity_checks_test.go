@@ -80,3 +80,50 @@ func TestService_VerifyWeakSubjectivityRoot(t *testing.T) {
 		})
 	}
 }
+
+func TestService_VerifyWeakSubjectivityRoot_Origin(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 64
+	require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b)))
+	r, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveOriginBlockRoot(ctx, r))
+	tests := []struct {
+		name      string
+		checkpt   *ethpb.Checkpoint
+		errString string
+	}{
+		{
+			name:    "origin root passes",
+			checkpt: &ethpb.Checkpoint{Root: r[:], Epoch: 2},
+		},
+		{
+			name:      "root before origin",
+			checkpt:   &ethpb.Checkpoint{Root: bytesutil.PadTo([]byte{'a'}, 32), Epoch: 1},
+			errString: "does not match checkpoint sync origin root",
+		},
+		{
+			name:      "root after origin not in DB",
+			checkpt:   &ethpb.Checkpoint{Root: bytesutil.PadTo([]byte{'a'}, 32), Epoch: 3},
+			errString: "node does not have root in DB",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				cfg:              &Config{BeaconDB: beaconDB, WeakSubjectivityCheckpt: tt.checkpt},
+				finalizedCheckpt: &ethpb.Checkpoint{Epoch: 4},
+			}
+			err := s.VerifyWeakSubjectivityRoot(ctx)
+			if tt.errString == "" {
+				require.NoError(t, err)
+				require.Equal(t, true, s.wsVerified)
+			} else {
+				require.ErrorContains(t, tt.errString, err)
+			}
+		})
+	}
+}
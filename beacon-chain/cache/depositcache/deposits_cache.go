@@ -54,7 +54,9 @@ type DepositCache struct {
 	pendingDeposits   []*dbpb.DepositContainer
 	deposits          []*dbpb.DepositContainer
 	finalizedDeposits *FinalizedDeposits
-	depositsLock      sync.RWMutex
+	// The snapshot the cache was started from, in which case the deposits before it are unknown.
+	snapshot     *dbpb.DepositSnapshot
+	depositsLock sync.RWMutex
 }
 
 // New instantiates a new deposit cache
@@ -90,8 +92,8 @@ func (dc *DepositCache) InsertDeposit(ctx context.Context, d *ethpb.Deposit, blo
 	dc.depositsLock.Lock()
 	defer dc.depositsLock.Unlock()
 
-	if int(index) != len(dc.deposits) {
-		return errors.Errorf("wanted deposit with index %d to be inserted but received %d", len(dc.deposits), index)
+	if wanted := dc.snapshotCount() + int64(len(dc.deposits)); index != wanted {
+		return errors.Errorf("wanted deposit with index %d to be inserted but received %d", wanted, index)
 	}
 	// Keep the slice sorted on insertion in order to avoid costly sorting on retrieval.
 	heightIdx := sort.Search(len(dc.deposits), func(i int) bool { return dc.deposits[i].Index >= index })
@@ -115,6 +117,29 @@ func (dc *DepositCache) InsertDepositContainers(ctx context.Context, ctrs []*dbp
 	historicalDepositsCount.Add(float64(len(ctrs)))
 }
 
+// InsertSnapshot starts the cache from a snapshot of the finalized deposit tree, so that only the
+// deposits after the snapshot have to be inserted. This must be done before any deposit is inserted.
+func (dc *DepositCache) InsertSnapshot(ctx context.Context, snapshot *dbpb.DepositSnapshot) error {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.InsertSnapshot")
+	defer span.End()
+	dc.depositsLock.Lock()
+	defer dc.depositsLock.Unlock()
+
+	if len(dc.deposits) != 0 || dc.finalizedDeposits.MerkleTrieIndex != -1 {
+		return errors.New("cannot insert a deposit snapshot into a non empty cache")
+	}
+	depositTrie, err := trieutil.TrieFromSnapshot(snapshot, params.BeaconConfig().DepositContractTreeDepth)
+	if err != nil {
+		return errors.Wrap(err, "could not create deposit trie from snapshot")
+	}
+	dc.snapshot = snapshot
+	dc.finalizedDeposits = &FinalizedDeposits{
+		Deposits:        depositTrie,
+		MerkleTrieIndex: int64(snapshot.DepositCount) - 1,
+	}
+	return nil
+}
+
 // InsertFinalizedDeposits inserts deposits up to eth1DepositIndex (inclusive) into the finalized deposits cache.
 func (dc *DepositCache) InsertFinalizedDeposits(ctx context.Context, eth1DepositIndex int64) {
 	ctx, span := trace.StartSpan(ctx, "DepositsCache.InsertFinalizedDeposits")
@@ -181,12 +206,16 @@ func (dc *DepositCache) DepositsNumberAndRootAtHeight(ctx context.Context, block
 	dc.depositsLock.RLock()
 	defer dc.depositsLock.RUnlock()
 	heightIdx := sort.Search(len(dc.deposits), func(i int) bool { return dc.deposits[i].Eth1BlockHeight > blockHeight.Uint64() })
-	// send the deposit root of the empty trie, if eth1follow distance is greater than the time of the earliest
-	// deposit.
 	if heightIdx == 0 {
+		// The deposits of the snapshot are all made by its block.
+		if dc.snapshot != nil && blockHeight.Uint64() >= dc.snapshot.ExecutionBlockHeight {
+			return dc.snapshot.DepositCount, bytesutil.ToBytes32(dc.snapshot.DepositRoot)
+		}
+		// send the deposit root of the empty trie, if eth1follow distance is greater than the time of the earliest
+		// deposit.
 		return 0, [32]byte{}
 	}
-	return uint64(heightIdx), bytesutil.ToBytes32(dc.deposits[heightIdx-1].DepositRoot)
+	return uint64(dc.snapshotCount()) + uint64(heightIdx), bytesutil.ToBytes32(dc.deposits[heightIdx-1].DepositRoot)
 }
 
 // DepositByPubkey looks through historical deposits and finds one which contains
@@ -252,11 +281,13 @@ func (dc *DepositCache) PruneProofs(ctx context.Context, untilDepositIndex int64
 	dc.depositsLock.Lock()
 	defer dc.depositsLock.Unlock()
 
-	if untilDepositIndex >= int64(len(dc.deposits)) {
-		untilDepositIndex = int64(len(dc.deposits) - 1)
+	// Deposits are stored from the snapshot the cache was started from.
+	until := untilDepositIndex - dc.snapshotCount()
+	if until >= int64(len(dc.deposits)) {
+		until = int64(len(dc.deposits) - 1)
 	}
 
-	for i := untilDepositIndex; i >= 0; i-- {
+	for i := until; i >= 0; i-- {
 		if ctx.Err() != nil {
 			return ctx.Err()
 		}
@@ -269,3 +300,11 @@ func (dc *DepositCache) PruneProofs(ctx context.Context, untilDepositIndex int64
 
 	return nil
 }
+
+// snapshotCount returns the number of deposits before the first one of the cache.
+func (dc *DepositCache) snapshotCount() int64 {
+	if dc.snapshot == nil {
+		return 0
+	}
+	return int64(dc.snapshot.DepositCount)
+}
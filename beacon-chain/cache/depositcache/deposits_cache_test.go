@@ -792,3 +792,58 @@ func makeDepositProof() [][]byte {
 	}
 	return proof
 }
+
+func TestInsertSnapshot_ContinuesFromSnapshot(t *testing.T) {
+	ctx := context.Background()
+	dc, err := New()
+	require.NoError(t, err)
+
+	var deposits []*ethpb.Deposit
+	var leaves [][]byte
+	for i := 0; i < 5; i++ {
+		d := &ethpb.Deposit{
+			Proof: makeDepositProof(),
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+				WithdrawalCredentials: make([]byte, 32),
+				Signature:             make([]byte, 96),
+			},
+		}
+		hash, err := d.Data.HashTreeRoot()
+		require.NoError(t, err)
+		deposits = append(deposits, d)
+		leaves = append(leaves, hash[:])
+	}
+	trie, err := trieutil.GenerateTrieFromItems(leaves, params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err)
+	snapshot, err := trie.Snapshot(3)
+	require.NoError(t, err)
+	snapshot.ExecutionBlockHeight = 10
+	require.NoError(t, dc.InsertSnapshot(ctx, snapshot))
+	require.ErrorContains(t, "non empty cache", dc.InsertSnapshot(ctx, snapshot))
+
+	require.ErrorContains(t, "wanted deposit with index 3", dc.InsertDeposit(ctx, deposits[0], 9, 0, [32]byte{}))
+	require.NoError(t, dc.InsertDeposit(ctx, deposits[3], 11, 3, [32]byte{'a'}))
+	require.NoError(t, dc.InsertDeposit(ctx, deposits[4], 12, 4, [32]byte{'b'}))
+
+	count, root := dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(9))
+	assert.Equal(t, uint64(0), count)
+	assert.Equal(t, [32]byte{}, root)
+	count, root = dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(10))
+	assert.Equal(t, uint64(3), count)
+	assert.DeepEqual(t, snapshot.DepositRoot, root[:])
+	count, root = dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(11))
+	assert.Equal(t, uint64(4), count)
+	assert.Equal(t, [32]byte{'a'}, root)
+
+	assert.Equal(t, int64(2), dc.FinalizedDeposits(ctx).MerkleTrieIndex)
+	assert.Equal(t, 2, len(dc.NonFinalizedDeposits(ctx, nil)))
+	dc.InsertFinalizedDeposits(ctx, 4)
+	finalized := dc.FinalizedDeposits(ctx)
+	assert.Equal(t, int64(4), finalized.MerkleTrieIndex)
+	assert.Equal(t, trie.HashTreeRoot(), finalized.Deposits.HashTreeRoot())
+
+	require.NoError(t, dc.PruneProofs(ctx, 3))
+	assert.DeepEqual(t, [][]byte(nil), dc.deposits[0].Deposit.Proof)
+	assert.NotNil(t, dc.deposits[1].Deposit.Proof)
+}
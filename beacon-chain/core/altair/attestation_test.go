@@ -0,0 +1,144 @@
+package altair_test
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestValidatorFlag_AddHas(t *testing.T) {
+	cfg := params.BeaconConfig()
+	var flag uint8
+	assert.Equal(t, false, altair.HasValidatorFlag(flag, cfg.TimelySourceFlagIndex))
+	assert.Equal(t, false, altair.HasValidatorFlag(flag, cfg.TimelyTargetFlagIndex))
+	assert.Equal(t, false, altair.HasValidatorFlag(flag, cfg.TimelyHeadFlagIndex))
+
+	flag = altair.AddValidatorFlag(flag, cfg.TimelySourceFlagIndex)
+	assert.Equal(t, true, altair.HasValidatorFlag(flag, cfg.TimelySourceFlagIndex))
+	assert.Equal(t, false, altair.HasValidatorFlag(flag, cfg.TimelyTargetFlagIndex))
+
+	flag = altair.AddValidatorFlag(flag, cfg.TimelyHeadFlagIndex)
+	assert.Equal(t, true, altair.HasValidatorFlag(flag, cfg.TimelySourceFlagIndex))
+	assert.Equal(t, false, altair.HasValidatorFlag(flag, cfg.TimelyTargetFlagIndex))
+	assert.Equal(t, true, altair.HasValidatorFlag(flag, cfg.TimelyHeadFlagIndex))
+
+	// Adding an existing flag is a no-op.
+	assert.Equal(t, flag, altair.AddValidatorFlag(flag, cfg.TimelyHeadFlagIndex))
+}
+
+// attestationBlock returns an Altair block including an attestation of the whole committee at slot 0,
+// along with the Altair state at slot 1 the attestation is valid for.
+func attestationBlock(t *testing.T) (iface.BeaconState, interfaces.SignedBeaconBlock, []uint64) {
+	genesisState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	st, err := altair.UpgradeToAltair(context.Background(), genesisState)
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(1))
+
+	committee, err := helpers.BeaconCommitteeFromState(st, 0, 0)
+	require.NoError(t, err)
+	aggBits := bitfield.NewBitlist(uint64(len(committee)))
+	for i := range committee {
+		aggBits.SetBitAt(uint64(i), true)
+	}
+	data := &ethpb.AttestationData{
+		BeaconBlockRoot: make([]byte, 32),
+		Source:          st.CurrentJustifiedCheckpoint(),
+		Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+	}
+	d, err := helpers.Domain(st.Fork(), 0, params.BeaconConfig().DomainBeaconAttester, st.GenesisValidatorRoot())
+	require.NoError(t, err)
+	sigRoot, err := helpers.ComputeSigningRoot(data, d)
+	require.NoError(t, err)
+	sigs := make([]bls.Signature, len(committee))
+	indices := make([]uint64, len(committee))
+	for i, idx := range committee {
+		sigs[i] = privKeys[idx].Sign(sigRoot[:])
+		indices[i] = uint64(idx)
+	}
+	att := &ethpb.Attestation{
+		Data:            data,
+		AggregationBits: aggBits,
+		Signature:       bls.AggregateSignatures(sigs).Marshal(),
+	}
+	b, err := wrapper.WrappedAltairSignedBeaconBlock(&prysmv2.SignedBeaconBlockAltair{
+		Block: &prysmv2.BeaconBlockAltair{
+			Slot: 1,
+			Body: &prysmv2.BeaconBlockBodyAltair{Attestations: []*ethpb.Attestation{att}},
+		},
+	})
+	require.NoError(t, err)
+	return st, b, indices
+}
+
+func TestProcessAttestations(t *testing.T) {
+	cfg := params.BeaconConfig()
+	st, b, indices := attestationBlock(t)
+
+	// The proposer is rewarded for every newly set flag of the attesters.
+	proposerIndex, err := helpers.BeaconProposerIndex(st)
+	require.NoError(t, err)
+	rewardNumerator := uint64(0)
+	for _, idx := range indices {
+		br, err := altair.BaseReward(st, types.ValidatorIndex(idx))
+		require.NoError(t, err)
+		rewardNumerator += br * (cfg.TimelySourceWeight + cfg.TimelyTargetWeight + cfg.TimelyHeadWeight)
+	}
+	rewardDenominator := (cfg.WeightDenominator - cfg.ProposerWeight) * cfg.WeightDenominator / cfg.ProposerWeight
+	proposerBalance, err := st.BalanceAtIndex(proposerIndex)
+	require.NoError(t, err)
+
+	st, err = altair.ProcessAttestations(context.Background(), st, b)
+	require.NoError(t, err)
+
+	participation, err := st.CurrentEpochParticipation()
+	require.NoError(t, err)
+	attesters := make(map[uint64]bool, len(indices))
+	for _, idx := range indices {
+		attesters[idx] = true
+	}
+	for i, flags := range participation {
+		if attesters[uint64(i)] {
+			assert.Equal(t, true, altair.HasValidatorFlag(flags, cfg.TimelySourceFlagIndex))
+			assert.Equal(t, true, altair.HasValidatorFlag(flags, cfg.TimelyTargetFlagIndex))
+			assert.Equal(t, true, altair.HasValidatorFlag(flags, cfg.TimelyHeadFlagIndex))
+		} else {
+			assert.Equal(t, uint8(0), flags, "Unexpected participation of validator %d", i)
+		}
+	}
+	previousParticipation, err := st.PreviousEpochParticipation()
+	require.NoError(t, err)
+	assert.DeepEqual(t, make([]byte, len(previousParticipation)), previousParticipation)
+	balance, err := st.BalanceAtIndex(proposerIndex)
+	require.NoError(t, err)
+	assert.Equal(t, proposerBalance+rewardNumerator/rewardDenominator, balance)
+
+	// Including the same attestation again sets no new flag and does not reward the proposer.
+	st, err = altair.ProcessAttestations(context.Background(), st, b)
+	require.NoError(t, err)
+	balance, err = st.BalanceAtIndex(proposerIndex)
+	require.NoError(t, err)
+	assert.Equal(t, proposerBalance+rewardNumerator/rewardDenominator, balance)
+}
+
+func TestProcessAttestations_InvalidSignature(t *testing.T) {
+	st, b, _ := attestationBlock(t)
+	att := b.Block().Body().Attestations()[0]
+	att.Signature = bls.NewAggregateSignature().Marshal()
+
+	_, err := altair.ProcessAttestations(context.Background(), st, b)
+	assert.ErrorContains(t, "could not verify attestation at index 0 in block", err)
+}
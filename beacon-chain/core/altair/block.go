@@ -0,0 +1,143 @@
+package altair
+
+import (
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// ProcessSyncAggregate verifies sync committee aggregate signature signing over the previous slot block root.
+//
+// Spec code:
+//  def process_sync_aggregate(state: BeaconState, sync_aggregate: SyncAggregate) -> None:
+//    # Verify sync committee aggregate signature signing over the previous slot block root
+//    committee_pubkeys = state.current_sync_committee.pubkeys
+//    participant_pubkeys = [pubkey for pubkey, bit in zip(committee_pubkeys, sync_aggregate.sync_committee_bits) if bit]
+//    previous_slot = max(state.slot, Slot(1)) - Slot(1)
+//    domain = get_domain(state, DOMAIN_SYNC_COMMITTEE, compute_epoch_at_slot(previous_slot))
+//    signing_root = compute_signing_root(get_block_root_at_slot(state, previous_slot), domain)
+//    assert eth2_fast_aggregate_verify(participant_pubkeys, signing_root, sync_aggregate.sync_committee_signature)
+//
+//    # Compute participant and proposer rewards
+//    total_active_increments = get_total_active_balance(state) // EFFECTIVE_BALANCE_INCREMENT
+//    total_base_rewards = Gwei(get_base_reward_per_increment(state) * total_active_increments)
+//    max_participant_rewards = Gwei(total_base_rewards * SYNC_REWARD_WEIGHT // WEIGHT_DENOMINATOR // SLOTS_PER_EPOCH)
+//    participant_reward = Gwei(max_participant_rewards // SYNC_COMMITTEE_SIZE)
+//    proposer_reward = Gwei(participant_reward * PROPOSER_WEIGHT // (WEIGHT_DENOMINATOR - PROPOSER_WEIGHT))
+//
+//    # Apply participant and proposer rewards
+//    all_pubkeys = [v.pubkey for v in state.validators]
+//    committee_indices = [ValidatorIndex(all_pubkeys.index(pubkey)) for pubkey in state.current_sync_committee.pubkeys]
+//    for participant_index, participation_bit in zip(committee_indices, sync_aggregate.sync_committee_bits):
+//        if participation_bit:
+//            increase_balance(state, participant_index, participant_reward)
+//            increase_balance(state, get_beacon_proposer_index(state), proposer_reward)
+//        else:
+//            decrease_balance(state, participant_index, participant_reward)
+func ProcessSyncAggregate(state iface.BeaconState, sync *prysmv2.SyncAggregate) (iface.BeaconState, error) {
+	if sync == nil {
+		return nil, errors.New("nil sync aggregate")
+	}
+	committee, err := state.CurrentSyncCommittee()
+	if err != nil {
+		return nil, err
+	}
+	if committee == nil {
+		return nil, errors.New("nil current sync committee in state")
+	}
+	committeeIndices, err := syncCommitteeIndices(state, committee.Pubkeys)
+	if err != nil {
+		return nil, err
+	}
+	if sync.SyncCommitteeBits.Len() < uint64(len(committeeIndices)) {
+		return nil, errors.Errorf("sync committee bits length %d is less than committee size %d",
+			sync.SyncCommitteeBits.Len(), len(committeeIndices))
+	}
+
+	participantPubkeys := make([]bls.PublicKey, 0, len(committeeIndices))
+	for i, pubkey := range committee.Pubkeys {
+		if !sync.SyncCommitteeBits.BitAt(uint64(i)) {
+			continue
+		}
+		pk, err := bls.PublicKeyFromBytes(pubkey)
+		if err != nil {
+			return nil, err
+		}
+		participantPubkeys = append(participantPubkeys, pk)
+	}
+	if err := verifySyncCommitteeSig(state, participantPubkeys, sync.SyncCommitteeSignature); err != nil {
+		return nil, errors.Wrap(err, "could not verify sync committee signature")
+	}
+
+	activeBalance, err := helpers.TotalActiveBalance(state)
+	if err != nil {
+		return nil, err
+	}
+	participantReward, proposerReward := SyncRewards(activeBalance)
+
+	proposerIndex, err := helpers.BeaconProposerIndex(state)
+	if err != nil {
+		return nil, err
+	}
+	for i, index := range committeeIndices {
+		if sync.SyncCommitteeBits.BitAt(uint64(i)) {
+			if err := helpers.IncreaseBalance(state, index, participantReward); err != nil {
+				return nil, err
+			}
+			if err := helpers.IncreaseBalance(state, proposerIndex, proposerReward); err != nil {
+				return nil, err
+			}
+		} else if err := helpers.DecreaseBalance(state, index, participantReward); err != nil {
+			return nil, err
+		}
+	}
+	return state, nil
+}
+
+// syncCommitteeIndices maps the sync committee public keys to their validator indices.
+func syncCommitteeIndices(state iface.ReadOnlyBeaconState, pubkeys [][]byte) ([]types.ValidatorIndex, error) {
+	indices := make([]types.ValidatorIndex, len(pubkeys))
+	for i, pubkey := range pubkeys {
+		index, ok := state.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubkey))
+		if !ok {
+			return nil, errors.Errorf("validator index not found for pubkey %#x", bytesutil.Trunc(pubkey))
+		}
+		indices[i] = index
+	}
+	return indices, nil
+}
+
+// verifySyncCommitteeSig verifies sync committee signature `syncSig` is valid with respect to public keys `syncKeys`.
+func verifySyncCommitteeSig(state iface.ReadOnlyBeaconState, syncKeys []bls.PublicKey, syncSig []byte) error {
+	ps := helpers.PrevSlot(state.Slot())
+	d, err := helpers.Domain(state.Fork(), helpers.SlotToEpoch(ps), params.BeaconConfig().DomainSyncCommittee, state.GenesisValidatorRoot())
+	if err != nil {
+		return err
+	}
+	pbr, err := helpers.BlockRootAtSlot(state, ps)
+	if err != nil {
+		return err
+	}
+	sszBytes := &pb.SigningData{
+		ObjectRoot: pbr,
+		Domain:     d,
+	}
+	sigRoot, err := sszBytes.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	sig, err := bls.SignatureFromBytes(syncSig)
+	if err != nil {
+		return err
+	}
+	if !sig.Eth2FastAggregateVerify(syncKeys, sigRoot) {
+		return errors.New("invalid sync committee signature")
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package altair_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// syncAggregateState returns an Altair state at slot 1 whose block root at slot 0 is set, along
+// with the secret keys of its validators.
+func syncAggregateState(t *testing.T) (iface.BeaconState, []bls.SecretKey, []byte) {
+	genesisState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	st, err := altair.UpgradeToAltair(context.Background(), genesisState)
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(1))
+	root := bytesutil.PadTo([]byte("block root"), 32)
+	require.NoError(t, st.UpdateBlockRootAtIndex(0, bytesutil.ToBytes32(root)))
+	return st, privKeys, root
+}
+
+// signSyncAggregate signs the root by the sync committee members whose bits are set.
+func signSyncAggregate(t *testing.T, st iface.BeaconState, privKeys []bls.SecretKey, bits bitfield.Bitvector512, root []byte) []byte {
+	committee, err := st.CurrentSyncCommittee()
+	require.NoError(t, err)
+	d, err := helpers.Domain(st.Fork(), 0, params.BeaconConfig().DomainSyncCommittee, st.GenesisValidatorRoot())
+	require.NoError(t, err)
+	sigRoot, err := (&pb.SigningData{ObjectRoot: root, Domain: d}).HashTreeRoot()
+	require.NoError(t, err)
+	sigs := make([]bls.Signature, 0, bits.Count())
+	for i, pubkey := range committee.Pubkeys {
+		if !bits.BitAt(uint64(i)) {
+			continue
+		}
+		idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubkey))
+		require.Equal(t, true, ok)
+		sigs = append(sigs, privKeys[idx].Sign(sigRoot[:]))
+	}
+	return bls.AggregateSignatures(sigs).Marshal()
+}
+
+func TestProcessSyncAggregate(t *testing.T) {
+	st, privKeys, root := syncAggregateState(t)
+	bits := bitfield.NewBitvector512()
+	for i := uint64(0); i < bits.Len(); i += 3 {
+		bits.SetBitAt(i, true)
+	}
+	syncAggregate := &prysmv2.SyncAggregate{
+		SyncCommitteeBits:      bits,
+		SyncCommitteeSignature: signSyncAggregate(t, st, privKeys, bits, root),
+	}
+
+	// Compute the expected balances following the spec, as validators can sit several times in the committee.
+	committee, err := st.CurrentSyncCommittee()
+	require.NoError(t, err)
+	activeBalance, err := helpers.TotalActiveBalance(st)
+	require.NoError(t, err)
+	participantReward, proposerReward := altair.SyncRewards(activeBalance)
+	proposerIndex, err := helpers.BeaconProposerIndex(st)
+	require.NoError(t, err)
+	want := st.Balances()
+	for i, pubkey := range committee.Pubkeys {
+		idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubkey))
+		require.Equal(t, true, ok)
+		if bits.BitAt(uint64(i)) {
+			want[idx] += participantReward
+			want[proposerIndex] += proposerReward
+		} else {
+			want[idx] -= participantReward
+		}
+	}
+
+	st, err = altair.ProcessSyncAggregate(st, syncAggregate)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, st.Balances())
+}
+
+func TestProcessSyncAggregate_InvalidSignature(t *testing.T) {
+	st, privKeys, _ := syncAggregateState(t)
+	bits := bitfield.NewBitvector512()
+	bits.SetBitAt(0, true)
+	bits.SetBitAt(1, true)
+	// The committee signs a root which is not the block root of the previous slot.
+	syncAggregate := &prysmv2.SyncAggregate{
+		SyncCommitteeBits:      bits,
+		SyncCommitteeSignature: signSyncAggregate(t, st, privKeys, bits, bytesutil.PadTo([]byte("other root"), 32)),
+	}
+	balances := st.Balances()
+	_, err := altair.ProcessSyncAggregate(st, syncAggregate)
+	assert.ErrorContains(t, "could not verify sync committee signature", err)
+	assert.DeepEqual(t, balances, st.Balances())
+
+	_, err = altair.ProcessSyncAggregate(st, nil)
+	assert.ErrorContains(t, "nil sync aggregate", err)
+}
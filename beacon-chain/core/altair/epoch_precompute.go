@@ -0,0 +1,348 @@
+package altair
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// InitializeEpochValidators gets called at the beginning of process epoch cycle to return
+// pre computed instances of validators attesting records and total
+// balances attested in an epoch.
+func InitializeEpochValidators(ctx context.Context, st iface.BeaconState) ([]*precompute.Validator, *precompute.Balance, error) {
+	ctx, span := trace.StartSpan(ctx, "altair.InitializeEpochValidators")
+	defer span.End()
+	pValidators := make([]*precompute.Validator, st.NumValidators())
+	bal := &precompute.Balance{}
+
+	prevEpoch := helpers.PrevEpoch(st)
+	currentEpoch := helpers.CurrentEpoch(st)
+	inactivityScores, err := st.InactivityScores()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(inactivityScores) != len(pValidators) {
+		return nil, nil, errors.Errorf("inactivity scores length %d does not match validators length %d",
+			len(inactivityScores), len(pValidators))
+	}
+
+	if err := st.ReadFromEveryValidator(func(idx int, val iface.ReadOnlyValidator) error {
+		// Was validator withdrawable or slashed
+		withdrawable := prevEpoch+1 >= val.WithdrawableEpoch()
+		pVal := &precompute.Validator{
+			IsSlashed:                    val.Slashed(),
+			IsWithdrawableCurrentEpoch:   withdrawable,
+			CurrentEpochEffectiveBalance: val.EffectiveBalance(),
+			InactivityScore:              inactivityScores[idx],
+		}
+		// Validator active current epoch
+		if helpers.IsActiveValidatorUsingTrie(val, currentEpoch) {
+			pVal.IsActiveCurrentEpoch = true
+			bal.ActiveCurrentEpoch += val.EffectiveBalance()
+		}
+		// Validator active previous epoch
+		if helpers.IsActiveValidatorUsingTrie(val, prevEpoch) {
+			pVal.IsActivePrevEpoch = true
+			bal.ActivePrevEpoch += val.EffectiveBalance()
+		}
+
+		pValidators[idx] = pVal
+		return nil
+	}); err != nil {
+		return nil, nil, errors.Wrap(err, "could not initialize epoch validator")
+	}
+	return pValidators, bal, nil
+}
+
+// ProcessInactivityScores of beacon chain. This updates inactivity scores of beacon chain and
+// updates the precompute validator struct for later processing.
+//
+// Spec code:
+//  def process_inactivity_updates(state: BeaconState) -> None:
+//    # Skip the genesis epoch as score updates are based on the previous epoch participation
+//    if get_current_epoch(state) == GENESIS_EPOCH:
+//        return
+//
+//    for index in get_eligible_validator_indices(state):
+//        # Increase the inactivity score of inactive validators
+//        if index in get_unslashed_participating_indices(state, TIMELY_TARGET_FLAG_INDEX, get_previous_epoch(state)):
+//            state.inactivity_scores[index] -= min(1, state.inactivity_scores[index])
+//        else:
+//            state.inactivity_scores[index] += INACTIVITY_SCORE_BIAS
+//        # Decrease the inactivity score of all eligible validators during a leak-free epoch
+//        if not is_in_inactivity_leak(state):
+//            state.inactivity_scores[index] -= min(INACTIVITY_SCORE_RECOVERY_RATE, state.inactivity_scores[index])
+func ProcessInactivityScores(
+	ctx context.Context,
+	state iface.BeaconState,
+	vals []*precompute.Validator,
+) (iface.BeaconState, []*precompute.Validator, error) {
+	ctx, span := trace.StartSpan(ctx, "altair.ProcessInactivityScores")
+	defer span.End()
+
+	cfg := params.BeaconConfig()
+	if helpers.CurrentEpoch(state) == cfg.GenesisEpoch {
+		return state, vals, nil
+	}
+
+	inactivityScores, err := state.InactivityScores()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(inactivityScores) != len(vals) {
+		return nil, nil, errors.Errorf("inactivity scores length %d does not match validators length %d",
+			len(inactivityScores), len(vals))
+	}
+
+	inLeak := helpers.IsInInactivityLeak(helpers.PrevEpoch(state), state.FinalizedCheckpointEpoch())
+	for i, v := range vals {
+		if !precompute.EligibleForRewards(v) {
+			continue
+		}
+
+		if v.IsPrevEpochTargetAttester && !v.IsSlashed {
+			// Decrease inactivity score when validator gets target correct.
+			if v.InactivityScore > 0 {
+				v.InactivityScore--
+			}
+		} else {
+			v.InactivityScore += cfg.InactivityScoreBias
+		}
+
+		if !inLeak {
+			if v.InactivityScore < cfg.InactivityScoreRecoveryRate {
+				v.InactivityScore = 0
+			} else {
+				v.InactivityScore -= cfg.InactivityScoreRecoveryRate
+			}
+		}
+		inactivityScores[i] = v.InactivityScore
+	}
+
+	if err := state.SetInactivityScores(inactivityScores); err != nil {
+		return nil, nil, err
+	}
+	return state, vals, nil
+}
+
+// ProcessEpochParticipation processes the epoch participation in state and updates individual validator's pre computes,
+// it also tracks and updates epoch attesting balances.
+//
+// Spec code:
+//  def get_unslashed_participating_indices(state: BeaconState, flag_index: int, epoch: Epoch) -> Set[ValidatorIndex]:
+//    """
+//    Return the set of validator indices that are both active and unslashed for the given ``flag_index`` and ``epoch``.
+//    """
+//    assert epoch in (get_previous_epoch(state), get_current_epoch(state))
+//    if epoch == get_current_epoch(state):
+//        epoch_participation = state.current_epoch_participation
+//    else:
+//        epoch_participation = state.previous_epoch_participation
+//    active_validator_indices = get_active_validator_indices(state, epoch)
+//    participating_indices = [i for i in active_validator_indices if has_flag(epoch_participation[i], flag_index)]
+//    return set(filter(lambda index: not state.validators[index].slashed, participating_indices))
+func ProcessEpochParticipation(
+	ctx context.Context,
+	state iface.BeaconState,
+	bal *precompute.Balance,
+	vals []*precompute.Validator,
+) ([]*precompute.Validator, *precompute.Balance, error) {
+	ctx, span := trace.StartSpan(ctx, "altair.ProcessEpochParticipation")
+	defer span.End()
+
+	cp, err := state.CurrentEpochParticipation()
+	if err != nil {
+		return nil, nil, err
+	}
+	pp, err := state.PreviousEpochParticipation()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(cp) != len(vals) || len(pp) != len(vals) {
+		return nil, nil, errors.Errorf("participation lengths (%d, %d) do not match validators length %d",
+			len(cp), len(pp), len(vals))
+	}
+
+	cfg := params.BeaconConfig()
+	for i, v := range vals {
+		if v.IsSlashed {
+			continue
+		}
+		if v.IsActiveCurrentEpoch && HasValidatorFlag(cp[i], cfg.TimelyTargetFlagIndex) {
+			vals[i].IsCurrentEpochTargetAttester = true
+		}
+		if !v.IsActivePrevEpoch {
+			continue
+		}
+		if HasValidatorFlag(pp[i], cfg.TimelySourceFlagIndex) {
+			vals[i].IsPrevEpochAttester = true
+		}
+		if HasValidatorFlag(pp[i], cfg.TimelyTargetFlagIndex) {
+			vals[i].IsPrevEpochTargetAttester = true
+		}
+		if HasValidatorFlag(pp[i], cfg.TimelyHeadFlagIndex) {
+			vals[i].IsPrevEpochHeadAttester = true
+		}
+	}
+	bal = precompute.UpdateBalance(vals, bal)
+	return vals, bal, nil
+}
+
+// ProcessRewardsAndPenaltiesPrecompute processes the rewards and penalties of individual validator.
+// This is an optimized version by passing in precomputed validator attesting records and and total epoch balances.
+//
+// Spec code:
+//  def process_rewards_and_penalties(state: BeaconState) -> None:
+//    # No rewards are applied at the end of `GENESIS_EPOCH` because rewards are for work done in the previous epoch
+//    if get_current_epoch(state) == GENESIS_EPOCH:
+//        return
+//
+//    flag_deltas = [get_flag_index_deltas(state, flag_index) for flag_index in range(len(PARTICIPATION_FLAG_WEIGHTS))]
+//    deltas = flag_deltas + [get_inactivity_penalty_deltas(state)]
+//    for (rewards, penalties) in deltas:
+//        for index in range(len(state.validators)):
+//            increase_balance(state, ValidatorIndex(index), rewards[index])
+//            decrease_balance(state, ValidatorIndex(index), penalties[index])
+func ProcessRewardsAndPenaltiesPrecompute(
+	state iface.BeaconState,
+	bal *precompute.Balance,
+	vals []*precompute.Validator,
+) (iface.BeaconState, error) {
+	// Don't process rewards and penalties in genesis epoch.
+	if helpers.CurrentEpoch(state) == params.BeaconConfig().GenesisEpoch {
+		return state, nil
+	}
+
+	numOfVals := state.NumValidators()
+	// Guard against an out-of-bounds using validator balance precompute.
+	if len(vals) != numOfVals || len(vals) != state.BalancesLength() {
+		return state, errors.New("validator registries not the same length as state's validator registries")
+	}
+
+	balances := state.Balances()
+	inLeak := helpers.IsInInactivityLeak(helpers.PrevEpoch(state), state.FinalizedCheckpointEpoch())
+	for i := 0; i < numOfVals; i++ {
+		deltas := attestationDeltas(bal, vals[i], inLeak)
+		for _, d := range deltas {
+			balances[i] = helpers.IncreaseBalanceWithVal(balances[i], d.reward)
+			balances[i] = helpers.DecreaseBalanceWithVal(balances[i], d.penalty)
+		}
+		vals[i].AfterEpochTransitionBalance = balances[i]
+	}
+
+	if err := state.SetBalances(balances); err != nil {
+		return nil, errors.Wrap(err, "could not set validator balances")
+	}
+	return state, nil
+}
+
+// AttestationDeltas returns the source, target, head and inactivity components of the rewards and
+// penalties ProcessRewardsAndPenaltiesPrecompute applies to individual validators.
+func AttestationDeltas(state iface.ReadOnlyBeaconState, bal *precompute.Balance, vals []*precompute.Validator) ([]*precompute.AttestationDelta, error) {
+	numOfVals := state.NumValidators()
+	if len(vals) != numOfVals {
+		return nil, errors.New("validator registries not the same length as state's validator registries")
+	}
+	inLeak := helpers.IsInInactivityLeak(helpers.PrevEpoch(state), state.FinalizedCheckpointEpoch())
+	attDeltas := make([]*precompute.AttestationDelta, numOfVals)
+	for i := 0; i < numOfVals; i++ {
+		attDeltas[i] = &precompute.AttestationDelta{}
+		deltas := attestationDeltas(bal, vals[i], inLeak)
+		if len(deltas) == 0 {
+			continue
+		}
+		attDeltas[i].SourceReward, attDeltas[i].SourcePenalty = deltas[0].reward, deltas[0].penalty
+		attDeltas[i].TargetReward, attDeltas[i].TargetPenalty = deltas[1].reward, deltas[1].penalty
+		attDeltas[i].HeadReward, attDeltas[i].HeadPenalty = deltas[2].reward, deltas[2].penalty
+		attDeltas[i].InactivityPenalty = deltas[3].penalty
+	}
+	return attDeltas, nil
+}
+
+// delta is a reward and penalty pair applied to a single validator's balance.
+type delta struct {
+	reward  uint64
+	penalty uint64
+}
+
+// attestationDeltas computes the source, target, head flag deltas and the inactivity penalty delta
+// of a single validator, in the order the spec applies them.
+//
+// Spec code:
+//  def get_flag_index_deltas(state: BeaconState, flag_index: int) -> Tuple[Sequence[Gwei], Sequence[Gwei]]:
+//    """
+//    Return the deltas for a given ``flag_index`` by scanning through the participation flags.
+//    """
+//    rewards = [Gwei(0)] * len(state.validators)
+//    penalties = [Gwei(0)] * len(state.validators)
+//    previous_epoch = get_previous_epoch(state)
+//    unslashed_participating_indices = get_unslashed_participating_indices(state, flag_index, previous_epoch)
+//    weight = PARTICIPATION_FLAG_WEIGHTS[flag_index]
+//    unslashed_participating_balance = get_total_balance(state, unslashed_participating_indices)
+//    unslashed_participating_increments = unslashed_participating_balance // EFFECTIVE_BALANCE_INCREMENT
+//    active_increments = get_total_active_balance(state) // EFFECTIVE_BALANCE_INCREMENT
+//    for index in get_eligible_validator_indices(state):
+//        base_reward = get_base_reward(state, index)
+//        if index in unslashed_participating_indices:
+//            if not is_in_inactivity_leak(state):
+//                reward_numerator = base_reward * weight * unslashed_participating_increments
+//                rewards[index] += Gwei(reward_numerator // (active_increments * WEIGHT_DENOMINATOR))
+//        elif flag_index != TIMELY_HEAD_FLAG_INDEX:
+//            penalties[index] += Gwei(base_reward * weight // WEIGHT_DENOMINATOR)
+//    return rewards, penalties
+//
+//  def get_inactivity_penalty_deltas(state: BeaconState) -> Tuple[Sequence[Gwei], Sequence[Gwei]]:
+//    """
+//    Return the inactivity penalty deltas by considering timely target participation flags and inactivity scores.
+//    """
+//    rewards = [Gwei(0) for _ in range(len(state.validators))]
+//    penalties = [Gwei(0) for _ in range(len(state.validators))]
+//    previous_epoch = get_previous_epoch(state)
+//    matching_target_indices = get_unslashed_participating_indices(state, TIMELY_TARGET_FLAG_INDEX, previous_epoch)
+//    for index in get_eligible_validator_indices(state):
+//        if index not in matching_target_indices:
+//            penalty_numerator = state.validators[index].effective_balance * state.inactivity_scores[index]
+//            penalty_denominator = INACTIVITY_SCORE_BIAS * INACTIVITY_PENALTY_QUOTIENT_ALTAIR
+//            penalties[index] += Gwei(penalty_numerator // penalty_denominator)
+//    return rewards, penalties
+func attestationDeltas(bal *precompute.Balance, val *precompute.Validator, inLeak bool) []delta {
+	if !precompute.EligibleForRewards(val) {
+		return nil
+	}
+	cfg := params.BeaconConfig()
+	increment := cfg.EffectiveBalanceIncrement
+	baseReward := (val.CurrentEpochEffectiveBalance / increment) * BaseRewardPerIncrement(bal.ActiveCurrentEpoch)
+	activeIncrements := bal.ActiveCurrentEpoch / increment
+
+	flagDelta := func(participated bool, weight, participatingBalance uint64, isHead bool) delta {
+		d := delta{}
+		if participated {
+			if !inLeak {
+				rewardNumerator := baseReward * weight * (participatingBalance / increment)
+				d.reward = rewardNumerator / (activeIncrements * cfg.WeightDenominator)
+			}
+		} else if !isHead {
+			d.penalty = baseReward * weight / cfg.WeightDenominator
+		}
+		return d
+	}
+
+	deltas := []delta{
+		flagDelta(val.IsPrevEpochAttester, cfg.TimelySourceWeight, bal.PrevEpochAttested, false),
+		flagDelta(val.IsPrevEpochTargetAttester, cfg.TimelyTargetWeight, bal.PrevEpochTargetAttested, false),
+		flagDelta(val.IsPrevEpochHeadAttester, cfg.TimelyHeadWeight, bal.PrevEpochHeadAttested, true),
+	}
+
+	inactivity := delta{}
+	if !val.IsPrevEpochTargetAttester {
+		penaltyNumerator := val.CurrentEpochEffectiveBalance * val.InactivityScore
+		penaltyDenominator := cfg.InactivityScoreBias * cfg.InactivityPenaltyQuotientAltair
+		inactivity.penalty = penaltyNumerator / penaltyDenominator
+	}
+	return append(deltas, inactivity)
+}
@@ -0,0 +1,148 @@
+package altair_test
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	stateAltair "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestAttestationDeltas(t *testing.T) {
+	cfg := params.BeaconConfig()
+	numValidators := 4
+	validators := make([]*ethpb.Validator, numValidators)
+	balances := make([]uint64, numValidators)
+	for i := range validators {
+		validators[i] = &ethpb.Validator{
+			EffectiveBalance:  cfg.MaxEffectiveBalance,
+			ExitEpoch:         cfg.FarFutureEpoch,
+			WithdrawableEpoch: cfg.FarFutureEpoch,
+		}
+		balances[i] = cfg.MaxEffectiveBalance
+	}
+	allFlags := byte(1<<cfg.TimelySourceFlagIndex | 1<<cfg.TimelyTargetFlagIndex | 1<<cfg.TimelyHeadFlagIndex)
+	s, err := stateAltair.InitializeFromProto(&pb.BeaconStateAltair{
+		Slot:                       2 * cfg.SlotsPerEpoch,
+		Validators:                 validators,
+		Balances:                   balances,
+		PreviousEpochParticipation: []byte{allFlags, 1 << cfg.TimelySourceFlagIndex, 0, allFlags},
+		CurrentEpochParticipation:  make([]byte, numValidators),
+		InactivityScores:           []uint64{0, 100, 100, 0},
+		FinalizedCheckpoint:        &ethpb.Checkpoint{Root: make([]byte, 32)},
+	})
+	require.NoError(t, err)
+
+	vals, bal, err := altair.InitializeEpochValidators(context.Background(), s)
+	require.NoError(t, err)
+	vals, bal, err = altair.ProcessEpochParticipation(context.Background(), s, bal, vals)
+	require.NoError(t, err)
+
+	deltas, err := altair.AttestationDeltas(s, bal, vals)
+	require.NoError(t, err)
+	require.Equal(t, numValidators, len(deltas))
+
+	assert.NotEqual(t, uint64(0), deltas[0].SourceReward)
+	assert.NotEqual(t, uint64(0), deltas[0].TargetReward)
+	assert.NotEqual(t, uint64(0), deltas[0].HeadReward)
+	assert.Equal(t, uint64(0), deltas[0].Penalty())
+
+	assert.NotEqual(t, uint64(0), deltas[1].SourceReward)
+	assert.NotEqual(t, uint64(0), deltas[1].TargetPenalty)
+	assert.NotEqual(t, uint64(0), deltas[1].InactivityPenalty)
+	// Missing the head vote is not penalized.
+	assert.Equal(t, uint64(0), deltas[1].HeadPenalty)
+
+	assert.Equal(t, uint64(0), deltas[2].Reward())
+	assert.NotEqual(t, uint64(0), deltas[2].SourcePenalty)
+	// No inclusion delay reward post Altair.
+	for _, d := range deltas {
+		assert.Equal(t, uint64(0), d.InclusionDelayReward)
+	}
+
+	processed, err := altair.ProcessRewardsAndPenaltiesPrecompute(s, bal, vals)
+	require.NoError(t, err)
+	for i, b := range processed.Balances() {
+		assert.Equal(t, balances[i]+deltas[i].Reward()-deltas[i].Penalty(), b, "Unexpected balance of validator %d", i)
+	}
+}
+
+func TestAttestationDeltas_MismatchedLength(t *testing.T) {
+	s, err := stateAltair.InitializeFromProto(&pb.BeaconStateAltair{
+		Validators: []*ethpb.Validator{{}},
+		Balances:   []uint64{0},
+	})
+	require.NoError(t, err)
+	_, err = altair.AttestationDeltas(s, nil, nil)
+	require.ErrorContains(t, "not the same length", err)
+}
+
+func TestProcessInactivityScores(t *testing.T) {
+	cfg := params.BeaconConfig()
+	targetFlag := byte(1 << cfg.TimelyTargetFlagIndex)
+	// Validators 0 and 1 attested the target, validator 2 did not, and validator 3 is not active.
+	newState := func(epoch, finalizedEpoch types.Epoch) *stateAltair.BeaconState {
+		validators := make([]*ethpb.Validator, 4)
+		balances := make([]uint64, len(validators))
+		for i := range validators {
+			validators[i] = &ethpb.Validator{
+				EffectiveBalance:  cfg.MaxEffectiveBalance,
+				ExitEpoch:         cfg.FarFutureEpoch,
+				WithdrawableEpoch: cfg.FarFutureEpoch,
+			}
+			balances[i] = cfg.MaxEffectiveBalance
+		}
+		validators[3].ActivationEpoch = cfg.FarFutureEpoch
+		s, err := stateAltair.InitializeFromProto(&pb.BeaconStateAltair{
+			Slot:                       cfg.SlotsPerEpoch.Mul(uint64(epoch)),
+			Validators:                 validators,
+			Balances:                   balances,
+			PreviousEpochParticipation: []byte{targetFlag, targetFlag, 0, 0},
+			CurrentEpochParticipation:  make([]byte, len(validators)),
+			InactivityScores:           []uint64{20, 0, 20, 7},
+			FinalizedCheckpoint:        &ethpb.Checkpoint{Epoch: finalizedEpoch, Root: make([]byte, 32)},
+		})
+		require.NoError(t, err)
+		return s
+	}
+	processInactivityScores := func(s *stateAltair.BeaconState) []uint64 {
+		vals, bal, err := altair.InitializeEpochValidators(context.Background(), s)
+		require.NoError(t, err)
+		vals, _, err = altair.ProcessEpochParticipation(context.Background(), s, bal, vals)
+		require.NoError(t, err)
+		processed, vals, err := altair.ProcessInactivityScores(context.Background(), s, vals)
+		require.NoError(t, err)
+		scores, err := processed.InactivityScores()
+		require.NoError(t, err)
+		for i, v := range vals {
+			assert.Equal(t, scores[i], v.InactivityScore, "Precompute score of validator %d does not match state", i)
+		}
+		return scores
+	}
+
+	t.Run("genesis epoch", func(t *testing.T) {
+		assert.DeepEqual(t, []uint64{20, 0, 20, 7}, processInactivityScores(newState(0, 0)))
+	})
+	t.Run("no leak", func(t *testing.T) {
+		// Scores of target attesters decrease by one, others increase by the bias, and all
+		// eligible scores recover.
+		want := []uint64{
+			20 - 1 - cfg.InactivityScoreRecoveryRate,
+			0,
+			20 + cfg.InactivityScoreBias - cfg.InactivityScoreRecoveryRate,
+			7,
+		}
+		assert.DeepEqual(t, want, processInactivityScores(newState(2, 0)))
+	})
+	t.Run("inactivity leak", func(t *testing.T) {
+		epoch := cfg.MinEpochsToInactivityPenalty + 2
+		want := []uint64{19, 0, 20 + cfg.InactivityScoreBias, 7}
+		assert.DeepEqual(t, want, processInactivityScores(newState(types.Epoch(epoch), 0)))
+	})
+}
@@ -0,0 +1,59 @@
+package altair_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	stateAltair "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestProcessParticipationFlagUpdates_CanRotate(t *testing.T) {
+	numValidators := 64
+	validators := make([]*ethpb.Validator, numValidators)
+	for i := range validators {
+		validators[i] = &ethpb.Validator{
+			EffectiveBalance: params.BeaconConfig().MaxEffectiveBalance,
+			ExitEpoch:        params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	current := make([]byte, numValidators)
+	for i := range current {
+		current[i] = 7
+	}
+	s, err := stateAltair.InitializeFromProto(&pb.BeaconStateAltair{
+		Validators:                 validators,
+		PreviousEpochParticipation: make([]byte, numValidators),
+		CurrentEpochParticipation:  current,
+	})
+	require.NoError(t, err)
+
+	st, err := altair.ProcessParticipationFlagUpdates(s)
+	require.NoError(t, err)
+	prev, err := st.PreviousEpochParticipation()
+	require.NoError(t, err)
+	assert.DeepEqual(t, current, prev)
+	curr, err := st.CurrentEpochParticipation()
+	require.NoError(t, err)
+	assert.DeepEqual(t, make([]byte, numValidators), curr)
+}
+
+func TestProcessSyncCommitteeUpdates_NotPeriodBoundary(t *testing.T) {
+	committee := &pb.SyncCommittee{AggregatePubkey: []byte{'a'}}
+	s, err := stateAltair.InitializeFromProto(&pb.BeaconStateAltair{
+		Slot:                 params.BeaconConfig().SlotsPerEpoch,
+		CurrentSyncCommittee: committee,
+		NextSyncCommittee:    &pb.SyncCommittee{AggregatePubkey: []byte{'b'}},
+	})
+	require.NoError(t, err)
+
+	st, err := altair.ProcessSyncCommitteeUpdates(s)
+	require.NoError(t, err)
+	got, err := st.CurrentSyncCommittee()
+	require.NoError(t, err)
+	assert.DeepEqual(t, committee.AggregatePubkey, got.AggregatePubkey)
+}
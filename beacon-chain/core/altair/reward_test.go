@@ -0,0 +1,64 @@
+package altair_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	stateAltair "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestBaseRewardPerIncrement(t *testing.T) {
+	tests := []struct {
+		activeBalance uint64
+		want          uint64
+	}{
+		{activeBalance: 1, want: params.BeaconConfig().EffectiveBalanceIncrement * params.BeaconConfig().BaseRewardFactor},
+		{activeBalance: 2, want: params.BeaconConfig().EffectiveBalanceIncrement * params.BeaconConfig().BaseRewardFactor},
+		{activeBalance: 4, want: params.BeaconConfig().EffectiveBalanceIncrement * params.BeaconConfig().BaseRewardFactor / 2},
+		{activeBalance: params.BeaconConfig().MaxEffectiveBalance, want: 357771},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, altair.BaseRewardPerIncrement(tt.activeBalance))
+	}
+}
+
+func TestBaseReward(t *testing.T) {
+	numValidators := 4
+	validators := make([]*ethpb.Validator, numValidators)
+	for i := range validators {
+		validators[i] = &ethpb.Validator{
+			EffectiveBalance: params.BeaconConfig().MaxEffectiveBalance,
+			ExitEpoch:        params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	s, err := stateAltair.InitializeFromProto(&pb.BeaconStateAltair{
+		Validators: validators,
+		Balances:   make([]uint64, numValidators),
+	})
+	require.NoError(t, err)
+
+	total := uint64(numValidators) * params.BeaconConfig().MaxEffectiveBalance
+	increments := params.BeaconConfig().MaxEffectiveBalance / params.BeaconConfig().EffectiveBalanceIncrement
+	r, err := altair.BaseReward(s, 0)
+	require.NoError(t, err)
+	assert.Equal(t, increments*altair.BaseRewardPerIncrement(total), r)
+
+	_, err = altair.BaseReward(s, 100)
+	require.ErrorContains(t, "out of range", err)
+}
+
+func TestSyncRewards(t *testing.T) {
+	cfg := params.BeaconConfig()
+	activeBalance := 1024 * cfg.MaxEffectiveBalance
+	participantReward, proposerReward := altair.SyncRewards(activeBalance)
+
+	totalBaseRewards := altair.BaseRewardPerIncrement(activeBalance) * (activeBalance / cfg.EffectiveBalanceIncrement)
+	wantParticipantReward := totalBaseRewards * cfg.SyncRewardWeight / cfg.WeightDenominator / uint64(cfg.SlotsPerEpoch) / cfg.SyncCommitteeSize
+	assert.Equal(t, wantParticipantReward, participantReward)
+	assert.Equal(t, wantParticipantReward*cfg.ProposerWeight/(cfg.WeightDenominator-cfg.ProposerWeight), proposerReward)
+}
@@ -0,0 +1,250 @@
+package altair
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+const maxRandomByte = uint64(1<<8 - 1)
+
+// NextSyncCommittee returns the next sync committee for a given state.
+//
+// Spec code:
+//  def get_next_sync_committee(state: BeaconState) -> SyncCommittee:
+//    """
+//    Return the next sync committee, with possible pubkey duplicates.
+//    """
+//    indices = get_next_sync_committee_indices(state)
+//    pubkeys = [state.validators[index].pubkey for index in indices]
+//    aggregate_pubkey = eth2_aggregate_pubkeys(pubkeys)
+//    return SyncCommittee(pubkeys=pubkeys, aggregate_pubkey=aggregate_pubkey)
+func NextSyncCommittee(state iface.BeaconState) (*pb.SyncCommittee, error) {
+	indices, err := NextSyncCommitteeIndices(state)
+	if err != nil {
+		return nil, err
+	}
+	pubkeys := make([][]byte, len(indices))
+	for i, index := range indices {
+		p := state.PubkeyAtIndex(index)
+		pubkeys[i] = p[:]
+	}
+	aggregated, err := bls.AggregatePublicKeys(pubkeys)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not aggregate sync committee public keys")
+	}
+	return &pb.SyncCommittee{
+		Pubkeys:         pubkeys,
+		AggregatePubkey: aggregated.Marshal(),
+	}, nil
+}
+
+// NextSyncCommitteeIndices returns the next sync committee indices for a given state.
+//
+// Spec code:
+//  def get_next_sync_committee_indices(state: BeaconState) -> Sequence[ValidatorIndex]:
+//    """
+//    Return the sync committee indices, with possible duplicates, for the next sync committee.
+//    """
+//    epoch = Epoch(get_current_epoch(state) + 1)
+//
+//    MAX_RANDOM_BYTE = 2**8 - 1
+//    active_validator_indices = get_active_validator_indices(state, epoch)
+//    active_validator_count = uint64(len(active_validator_indices))
+//    seed = get_seed(state, epoch, DOMAIN_SYNC_COMMITTEE)
+//    i = 0
+//    sync_committee_indices: List[ValidatorIndex] = []
+//    while len(sync_committee_indices) < SYNC_COMMITTEE_SIZE:
+//        shuffled_index = compute_shuffled_index(uint64(i % active_validator_count), active_validator_count, seed)
+//        candidate_index = active_validator_indices[shuffled_index]
+//        random_byte = hash(seed + uint_to_bytes(uint64(i // 32)))[i % 32]
+//        effective_balance = state.validators[candidate_index].effective_balance
+//        if effective_balance * MAX_RANDOM_BYTE >= MAX_EFFECTIVE_BALANCE * random_byte:
+//            sync_committee_indices.append(candidate_index)
+//        i += 1
+//    return sync_committee_indices
+func NextSyncCommitteeIndices(state iface.BeaconState) ([]types.ValidatorIndex, error) {
+	epoch := helpers.NextEpoch(state)
+	indices, err := helpers.ActiveValidatorIndices(state, epoch)
+	if err != nil {
+		return nil, err
+	}
+	count := uint64(len(indices))
+	if count == 0 {
+		return nil, errors.New("no active validators to compute sync committee")
+	}
+	seed, err := helpers.Seed(state, epoch, params.BeaconConfig().DomainSyncCommittee)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := params.BeaconConfig()
+	syncCommitteeIndices := make([]types.ValidatorIndex, 0, cfg.SyncCommitteeSize)
+	var randomBytes [32]byte
+	for i := uint64(0); uint64(len(syncCommitteeIndices)) < cfg.SyncCommitteeSize; i++ {
+		sIndex, err := helpers.ComputeShuffledIndex(types.ValidatorIndex(i%count), count, seed, true /* shuffle */)
+		if err != nil {
+			return nil, err
+		}
+		if i%32 == 0 {
+			randomBytes = hashutil.Hash(append(seed[:], bytesutil.Bytes8(i/32)...))
+		}
+		cIndex := indices[sIndex]
+		v, err := state.ValidatorAtIndexReadOnly(cIndex)
+		if err != nil {
+			return nil, err
+		}
+		if v.EffectiveBalance()*maxRandomByte >= cfg.MaxEffectiveBalance*uint64(randomBytes[i%32]) {
+			syncCommitteeIndices = append(syncCommitteeIndices, cIndex)
+		}
+	}
+	return syncCommitteeIndices, nil
+}
+
+// SubnetsForSyncCommittee returns the sync committee subnets the validator at the given index
+// is a member of. The next sync committee is used when the next slot crosses into a new sync
+// committee period.
+//
+// Spec code:
+//  def compute_subnets_for_sync_committee(state: BeaconState, validator_index: ValidatorIndex) -> Set[uint64]:
+//    next_slot_epoch = compute_epoch_at_slot(Slot(state.slot + 1))
+//    if compute_sync_committee_period(get_current_epoch(state)) == compute_sync_committee_period(next_slot_epoch):
+//        sync_committee = state.current_sync_committee
+//    else:
+//        sync_committee = state.next_sync_committee
+//
+//    target_pubkey = state.validators[validator_index].pubkey
+//    sync_committee_indices = [index for index, pubkey in enumerate(sync_committee.pubkeys) if pubkey == target_pubkey]
+//    return set([
+//        uint64(index // (SYNC_COMMITTEE_SIZE // SYNC_COMMITTEE_SUBNET_COUNT))
+//        for index in sync_committee_indices
+//    ])
+func SubnetsForSyncCommittee(state iface.BeaconState, index types.ValidatorIndex) ([]uint64, error) {
+	committee, err := SyncCommitteeForNextSlot(state)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := state.ValidatorAtIndexReadOnly(index)
+	if err != nil {
+		return nil, err
+	}
+	pubkey := v.PublicKey()
+	subCommitteeSize := params.BeaconConfig().SyncCommitteeSize / params.BeaconConfig().SyncCommitteeSubnetCount
+	seen := make(map[uint64]bool)
+	subnets := make([]uint64, 0, 1)
+	for i, p := range committee.Pubkeys {
+		if bytesutil.ToBytes48(p) != pubkey {
+			continue
+		}
+		subnet := uint64(i) / subCommitteeSize
+		if !seen[subnet] {
+			seen[subnet] = true
+			subnets = append(subnets, subnet)
+		}
+	}
+	return subnets, nil
+}
+
+// SyncCommitteeForNextSlot returns the sync committee responsible for the slot following the
+// state's slot. This is the next sync committee when the next slot crosses into a new sync
+// committee period, and the current sync committee otherwise.
+func SyncCommitteeForNextSlot(state iface.BeaconState) (*pb.SyncCommittee, error) {
+	nextSlotEpoch := helpers.SlotToEpoch(state.Slot() + 1)
+	currentEpoch := helpers.CurrentEpoch(state)
+	period := params.BeaconConfig().EpochsPerSyncCommitteePeriod
+
+	var committee *pb.SyncCommittee
+	var err error
+	if currentEpoch/period == nextSlotEpoch/period {
+		committee, err = state.CurrentSyncCommittee()
+	} else {
+		committee, err = state.NextSyncCommittee()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if committee == nil {
+		return nil, errors.New("nil sync committee in state")
+	}
+	return committee, nil
+}
+
+// SyncSubCommitteePubkeys returns the public keys of the sync committee members in the given subcommittee.
+//
+// Spec code:
+//    sync_subcommittee_size = SYNC_COMMITTEE_SIZE // SYNC_COMMITTEE_SUBNET_COUNT
+//    i = subcommittee_index * sync_subcommittee_size
+//    return sync_committee.pubkeys[i:i + sync_subcommittee_size]
+func SyncSubCommitteePubkeys(committee *pb.SyncCommittee, subComIdx uint64) ([][]byte, error) {
+	if committee == nil {
+		return nil, errors.New("nil sync committee")
+	}
+	cfg := params.BeaconConfig()
+	subCommSize := cfg.SyncCommitteeSize / cfg.SyncCommitteeSubnetCount
+	i := subComIdx * subCommSize
+	endOfSubCom := i + subCommSize
+	if endOfSubCom > uint64(len(committee.Pubkeys)) {
+		return nil, errors.Errorf("subcommittee index %d out of range for committee of size %d", subComIdx, len(committee.Pubkeys))
+	}
+	return committee.Pubkeys[i:endOfSubCom], nil
+}
+
+// IsSyncCommitteeAggregator checks whether the provided signature is for a valid
+// aggregator.
+//
+// Spec code:
+//  def is_sync_committee_aggregator(signature: BLSSignature) -> bool:
+//    modulo = max(1, SYNC_COMMITTEE_SIZE // SYNC_COMMITTEE_SUBNET_COUNT // TARGET_AGGREGATORS_PER_SYNC_SUBCOMMITTEE)
+//    return bytes_to_uint64(hash(signature)[0:8]) % modulo == 0
+func IsSyncCommitteeAggregator(sig []byte) (bool, error) {
+	if len(sig) != params.BeaconConfig().BLSSignatureLength {
+		return false, errors.New("incorrect sig length")
+	}
+
+	cfg := params.BeaconConfig()
+	modulo := uint64(1)
+	if m := cfg.SyncCommitteeSize / cfg.SyncCommitteeSubnetCount / cfg.TargetAggregatorsPerSyncSubcommittee; m > 1 {
+		modulo = m
+	}
+	hashedSig := hashutil.Hash(sig)
+	return binary.LittleEndian.Uint64(hashedSig[:8])%modulo == 0, nil
+}
+
+// ValidateSyncMessageTime validates sync message to ensure that the provided slot is valid.
+// A sync message is only valid for the current slot, with a MAXIMUM_GOSSIP_CLOCK_DISPARITY
+// allowance on either side of the slot.
+func ValidateSyncMessageTime(slot types.Slot, genesisTime time.Time, clockDisparity time.Duration) error {
+	if err := helpers.ValidateSlotClock(slot, uint64(genesisTime.Unix())); err != nil {
+		return err
+	}
+	messageTime, err := helpers.SlotToTime(uint64(genesisTime.Unix()), slot)
+	if err != nil {
+		return err
+	}
+	currentSlot := helpers.SlotsSince(genesisTime)
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+
+	now := timeutils.Now()
+	lowerBounds := messageTime.Add(-clockDisparity)
+	upperBounds := messageTime.Add(slotDuration).Add(clockDisparity)
+	if now.Before(lowerBounds) || !now.Before(upperBounds) {
+		return fmt.Errorf(
+			"sync message slot %d not within allowable range of current slot %d",
+			slot,
+			currentSlot,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package altair_test
+
+import (
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	stateAltair "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+func syncCommitteeForTest(size uint64) *pb.SyncCommittee {
+	pubkeys := make([][]byte, size)
+	for i := range pubkeys {
+		pubkeys[i] = bytesutil.PadTo(bytesutil.Bytes8(uint64(i)), params.BeaconConfig().BLSPubkeyLength)
+	}
+	return &pb.SyncCommittee{Pubkeys: pubkeys, AggregatePubkey: make([]byte, params.BeaconConfig().BLSPubkeyLength)}
+}
+
+func TestSyncSubCommitteePubkeys(t *testing.T) {
+	cfg := params.BeaconConfig()
+	committee := syncCommitteeForTest(cfg.SyncCommitteeSize)
+	subCommSize := cfg.SyncCommitteeSize / cfg.SyncCommitteeSubnetCount
+
+	for i := uint64(0); i < cfg.SyncCommitteeSubnetCount; i++ {
+		pubkeys, err := altair.SyncSubCommitteePubkeys(committee, i)
+		require.NoError(t, err)
+		assert.DeepEqual(t, committee.Pubkeys[i*subCommSize:(i+1)*subCommSize], pubkeys)
+	}
+	_, err := altair.SyncSubCommitteePubkeys(committee, cfg.SyncCommitteeSubnetCount)
+	require.ErrorContains(t, "out of range", err)
+	_, err = altair.SyncSubCommitteePubkeys(nil, 0)
+	require.ErrorContains(t, "nil sync committee", err)
+}
+
+func TestSubnetsForSyncCommittee(t *testing.T) {
+	cfg := params.BeaconConfig()
+	committee := syncCommitteeForTest(cfg.SyncCommitteeSize)
+	subCommSize := cfg.SyncCommitteeSize / cfg.SyncCommitteeSubnetCount
+	// Validator 1 appears in the first and the last subcommittee.
+	committee.Pubkeys[cfg.SyncCommitteeSize-1] = committee.Pubkeys[1]
+
+	validators := make([]*ethpb.Validator, 3)
+	for i := range validators {
+		validators[i] = &ethpb.Validator{
+			PublicKey: bytesutil.PadTo(bytesutil.Bytes8(uint64(i)), params.BeaconConfig().BLSPubkeyLength),
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	validators[2].PublicKey = bytesutil.PadTo([]byte{'x', 'y', 'z'}, params.BeaconConfig().BLSPubkeyLength)
+	s, err := stateAltair.InitializeFromProto(&pb.BeaconStateAltair{
+		Validators:           validators,
+		CurrentSyncCommittee: committee,
+		NextSyncCommittee:    syncCommitteeForTest(cfg.SyncCommitteeSize),
+	})
+	require.NoError(t, err)
+
+	subnets, err := altair.SubnetsForSyncCommittee(s, 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{0, (cfg.SyncCommitteeSize - 1) / subCommSize}, subnets)
+
+	subnets, err = altair.SubnetsForSyncCommittee(s, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(subnets))
+}
+
+func TestIsSyncCommitteeAggregator(t *testing.T) {
+	_, err := altair.IsSyncCommitteeAggregator([]byte{'a'})
+	require.ErrorContains(t, "incorrect sig length", err)
+
+	// Every signature is an aggregator when the modulo is 1.
+	prevConfig := params.BeaconConfig().Copy()
+	defer params.OverrideBeaconConfig(prevConfig)
+	c := params.BeaconConfig().Copy()
+	c.TargetAggregatorsPerSyncSubcommittee = c.SyncCommitteeSize
+	params.OverrideBeaconConfig(c)
+	ok, err := altair.IsSyncCommitteeAggregator(make([]byte, params.BeaconConfig().BLSSignatureLength))
+	require.NoError(t, err)
+	assert.Equal(t, true, ok)
+}
+
+func TestValidateSyncMessageTime(t *testing.T) {
+	secondsPerSlot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	disparity := params.BeaconNetworkConfig().MaximumGossipClockDisparity
+	tests := []struct {
+		name        string
+		slot        types.Slot
+		genesisTime time.Time
+		wantedErr   string
+	}{
+		{
+			name:        "current slot",
+			slot:        10,
+			genesisTime: timeutils.Now().Add(-10 * secondsPerSlot),
+		},
+		{
+			name:        "within clock disparity of the next slot",
+			slot:        11,
+			genesisTime: timeutils.Now().Add(-11*secondsPerSlot + disparity/2),
+		},
+		{
+			name:        "previous slot",
+			slot:        9,
+			genesisTime: timeutils.Now().Add(-10*secondsPerSlot - disparity),
+			wantedErr:   "not within allowable range",
+		},
+		{
+			name:        "future slot",
+			slot:        12,
+			genesisTime: timeutils.Now().Add(-10 * secondsPerSlot),
+			wantedErr:   "not within allowable range",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := altair.ValidateSyncMessageTime(tt.slot, tt.genesisTime, disparity)
+			if tt.wantedErr != "" {
+				require.ErrorContains(t, tt.wantedErr, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
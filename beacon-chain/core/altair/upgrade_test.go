@@ -0,0 +1,89 @@
+package altair_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/version"
+)
+
+func TestUpgradeToAltair(t *testing.T) {
+	cfg := params.BeaconConfig()
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, st.SetSlot(cfg.SlotsPerEpoch+1))
+
+	// A previous epoch attestation of the first member of the committee at slot 0, included in time.
+	committee, err := helpers.BeaconCommitteeFromState(st, 0, 0)
+	require.NoError(t, err)
+	aggBits := bitfield.NewBitlist(uint64(len(committee)))
+	aggBits.SetBitAt(0, true)
+	require.NoError(t, st.AppendPreviousEpochAttestations(&pb.PendingAttestation{
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: make([]byte, 32),
+			Source:          st.PreviousJustifiedCheckpoint(),
+			Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+		},
+		AggregationBits: aggBits,
+		InclusionDelay:  cfg.MinAttestationInclusionDelay,
+	}))
+	preForkVersion := st.Fork().CurrentVersion
+
+	upgraded, err := altair.UpgradeToAltair(context.Background(), st)
+	require.NoError(t, err)
+	assert.Equal(t, version.Altair, upgraded.Version())
+	assert.Equal(t, st.Slot(), upgraded.Slot())
+	assert.Equal(t, st.GenesisTime(), upgraded.GenesisTime())
+	assert.DeepEqual(t, &pb.Fork{
+		PreviousVersion: preForkVersion,
+		CurrentVersion:  cfg.AltairForkVersion,
+		Epoch:           helpers.CurrentEpoch(st),
+	}, upgraded.Fork())
+	assert.DeepSSZEqual(t, st.Validators(), upgraded.Validators())
+	assert.DeepEqual(t, st.Balances(), upgraded.Balances())
+	assert.DeepEqual(t, st.BlockRoots(), upgraded.BlockRoots())
+	assert.DeepSSZEqual(t, st.FinalizedCheckpoint(), upgraded.FinalizedCheckpoint())
+
+	numValidators := st.NumValidators()
+	scores, err := upgraded.InactivityScores()
+	require.NoError(t, err)
+	assert.DeepEqual(t, make([]uint64, numValidators), scores)
+	currentParticipation, err := upgraded.CurrentEpochParticipation()
+	require.NoError(t, err)
+	assert.DeepEqual(t, make([]byte, numValidators), currentParticipation)
+
+	// The pending attestation is translated into the participation flags of the attester.
+	previousParticipation, err := upgraded.PreviousEpochParticipation()
+	require.NoError(t, err)
+	require.Equal(t, numValidators, len(previousParticipation))
+	for i, flags := range previousParticipation {
+		if i == int(committee[0]) {
+			assert.Equal(t, true, altair.HasValidatorFlag(flags, cfg.TimelySourceFlagIndex))
+			assert.Equal(t, true, altair.HasValidatorFlag(flags, cfg.TimelyTargetFlagIndex))
+			assert.Equal(t, true, altair.HasValidatorFlag(flags, cfg.TimelyHeadFlagIndex))
+		} else {
+			assert.Equal(t, uint8(0), flags, "Unexpected participation of validator %d", i)
+		}
+	}
+
+	// The current and next sync committees are the same at the fork.
+	currentCommittee, err := upgraded.CurrentSyncCommittee()
+	require.NoError(t, err)
+	nextCommittee, err := upgraded.NextSyncCommittee()
+	require.NoError(t, err)
+	assert.Equal(t, int(cfg.SyncCommitteeSize), len(currentCommittee.Pubkeys))
+	assert.DeepSSZEqual(t, currentCommittee, nextCommittee)
+}
+
+func TestUpgradeToAltair_NilState(t *testing.T) {
+	_, err := altair.UpgradeToAltair(context.Background(), nil)
+	assert.ErrorContains(t, "nil state", err)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/mathutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/version"
 )
 
 // sortableIndices implements the Sort interface to sort newly activated validator indices
@@ -166,6 +167,8 @@ func ProcessRegistryUpdates(state iface.BeaconState) (iface.BeaconState, error)
 //            penalty_numerator = validator.effective_balance // increment * adjusted_total_slashing_balance
 //            penalty = penalty_numerator // total_balance * increment
 //            decrease_balance(state, ValidatorIndex(index), penalty)
+//
+// Post Altair, PROPORTIONAL_SLASHING_MULTIPLIER_ALTAIR is used as the multiplier.
 func ProcessSlashings(state iface.BeaconState) (iface.BeaconState, error) {
 	currentEpoch := helpers.CurrentEpoch(state)
 	totalBalance, err := helpers.TotalActiveBalance(state)
@@ -186,7 +189,11 @@ func ProcessSlashings(state iface.BeaconState) (iface.BeaconState, error) {
 	// a callback is used here to apply the following actions  to all validators
 	// below equally.
 	increment := params.BeaconConfig().EffectiveBalanceIncrement
-	minSlashing := mathutil.Min(totalSlashing*params.BeaconConfig().ProportionalSlashingMultiplier, totalBalance)
+	multiplier := params.BeaconConfig().ProportionalSlashingMultiplier
+	if state.Version() == version.Altair {
+		multiplier = params.BeaconConfig().ProportionalSlashingMultiplierAltair
+	}
+	minSlashing := mathutil.Min(totalSlashing*multiplier, totalBalance)
 	err = state.ApplyToEveryValidator(func(idx int, val *ethpb.Validator) (bool, *ethpb.Validator, error) {
 		correctEpoch := (currentEpoch + exitLength/2) == val.WithdrawableEpoch
 		if val.Slashed && correctEpoch {
@@ -142,6 +142,70 @@ func TestAttestationDeltaPrecompute(t *testing.T) {
 	}
 }
 
+func TestAttestationDeltas_Components(t *testing.T) {
+	e := params.BeaconConfig().SlotsPerEpoch
+	validatorCount := uint64(2048)
+	base := buildState(e+2, validatorCount)
+	atts := make([]*pb.PendingAttestation, 3)
+	var emptyRoot [32]byte
+	for i := 0; i < len(atts); i++ {
+		atts[i] = &pb.PendingAttestation{
+			Data: &ethpb.AttestationData{
+				Target: &ethpb.Checkpoint{
+					Root: emptyRoot[:],
+				},
+				Source: &ethpb.Checkpoint{
+					Root: emptyRoot[:],
+				},
+				BeaconBlockRoot: emptyRoot[:],
+			},
+			AggregationBits: bitfield.Bitlist{0xC0, 0xC0, 0xC0, 0xC0, 0x00, 0x00, 0x00, 0x00, 0x01},
+			InclusionDelay:  1,
+		}
+	}
+	base.PreviousEpochAttestations = atts
+	beaconState, err := v1.InitializeFromProto(base)
+	require.NoError(t, err)
+
+	vp, bp, err := New(context.Background(), beaconState)
+	require.NoError(t, err)
+	vp, bp, err = ProcessAttestations(context.Background(), beaconState, vp, bp)
+	require.NoError(t, err)
+
+	deltas, err := AttestationDeltas(beaconState, bp, vp)
+	require.NoError(t, err)
+	require.Equal(t, int(validatorCount), len(deltas))
+	rewards, penalties, err := AttestationsDelta(beaconState, bp, vp)
+	require.NoError(t, err)
+	for i, d := range deltas {
+		assert.Equal(t, rewards[i], d.Reward(), "Unexpected reward of validator %d", i)
+		assert.Equal(t, penalties[i], d.Penalty(), "Unexpected penalty of validator %d", i)
+	}
+
+	attestedIndices := []types.ValidatorIndex{55, 1339, 1746, 1811, 1569}
+	for _, i := range attestedIndices {
+		base, err := baseReward(beaconState, i)
+		require.NoError(t, err)
+		proposerReward := base / params.BeaconConfig().ProposerRewardQuotient
+		assert.Equal(t, base-proposerReward, deltas[i].InclusionDelayReward, "Unexpected inclusion delay reward")
+		assert.NotEqual(t, uint64(0), deltas[i].SourceReward)
+		assert.NotEqual(t, uint64(0), deltas[i].TargetReward)
+		assert.NotEqual(t, uint64(0), deltas[i].HeadReward)
+		assert.Equal(t, uint64(0), deltas[i].Penalty())
+	}
+
+	nonAttestedIndices := []types.ValidatorIndex{434, 677, 872, 791}
+	for _, i := range nonAttestedIndices {
+		base, err := baseReward(beaconState, i)
+		require.NoError(t, err)
+		assert.Equal(t, base, deltas[i].SourcePenalty)
+		assert.Equal(t, base, deltas[i].TargetPenalty)
+		assert.Equal(t, base, deltas[i].HeadPenalty)
+		assert.Equal(t, uint64(0), deltas[i].InactivityPenalty)
+		assert.Equal(t, uint64(0), deltas[i].Reward())
+	}
+}
+
 func TestAttestationDeltas_ZeroEpoch(t *testing.T) {
 	e := params.BeaconConfig().SlotsPerEpoch
 	validatorCount := uint64(2048)
@@ -318,6 +382,7 @@ func TestProposerDeltaPrecompute_HappyCase(t *testing.T) {
 	proposerReward := baseReward / params.BeaconConfig().ProposerRewardQuotient
 
 	assert.Equal(t, proposerReward, r[proposerIndex], "Unexpected proposer reward")
+	assert.Equal(t, proposerReward, ProposerInclusionReward(b, v[0]), "Unexpected proposer inclusion reward")
 }
 
 func TestProposerDeltaPrecompute_ValidatorIndexOutOfRange(t *testing.T) {
@@ -351,6 +416,7 @@ func TestProposerDeltaPrecompute_SlashedCase(t *testing.T) {
 	r, err := ProposersDelta(beaconState, b, v)
 	require.NoError(t, err)
 	assert.Equal(t, uint64(0), r[proposerIndex], "Unexpected proposer reward for slashed")
+	assert.Equal(t, uint64(0), ProposerInclusionReward(b, v[0]), "Unexpected proposer inclusion reward for slashed")
 }
 
 // BaseReward takes state and validator index and calculate
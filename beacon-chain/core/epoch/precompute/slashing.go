@@ -7,6 +7,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/mathutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/version"
 )
 
 // ProcessSlashingsPrecompute processes the slashed validators during epoch processing.
@@ -22,7 +23,11 @@ func ProcessSlashingsPrecompute(state iface.BeaconState, pBal *Balance) error {
 		totalSlashing += slashing
 	}
 
-	minSlashing := mathutil.Min(totalSlashing*params.BeaconConfig().ProportionalSlashingMultiplier, pBal.ActiveCurrentEpoch)
+	multiplier := params.BeaconConfig().ProportionalSlashingMultiplier
+	if state.Version() == version.Altair {
+		multiplier = params.BeaconConfig().ProportionalSlashingMultiplierAltair
+	}
+	minSlashing := mathutil.Min(totalSlashing*multiplier, pBal.ActiveCurrentEpoch)
 	epochToWithdraw := currentEpoch + exitLength/2
 
 	var hasSlashing bool
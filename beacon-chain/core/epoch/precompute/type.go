@@ -37,6 +37,8 @@ type Validator struct {
 	BeforeEpochTransitionBalance uint64
 	// AfterEpochTransitionBalance is the validator balance after epoch transition.
 	AfterEpochTransitionBalance uint64
+	// InactivityScore of the validator, only used post Altair.
+	InactivityScore uint64
 }
 
 // Balance stores the pre computation of the total participated balances for a given epoch
@@ -60,3 +62,34 @@ type Balance struct {
 	// correctly for head block during prev epoch.
 	PrevEpochHeadAttested uint64
 }
+
+// AttestationDelta stores the components of the attestation rewards and penalties of a validator
+// for the previous epoch. The inclusion delay reward is only paid pre Altair.
+type AttestationDelta struct {
+	// SourceReward is the reward for voting on the correct source checkpoint.
+	SourceReward uint64
+	// SourcePenalty is the penalty for not voting on the correct source checkpoint.
+	SourcePenalty uint64
+	// TargetReward is the reward for voting on the correct target checkpoint.
+	TargetReward uint64
+	// TargetPenalty is the penalty for not voting on the correct target checkpoint.
+	TargetPenalty uint64
+	// HeadReward is the reward for voting on the correct head block.
+	HeadReward uint64
+	// HeadPenalty is the penalty for not voting on the correct head block.
+	HeadPenalty uint64
+	// InclusionDelayReward is the reward for getting the attestation included in a block quickly.
+	InclusionDelayReward uint64
+	// InactivityPenalty is the penalty applied during an inactivity leak.
+	InactivityPenalty uint64
+}
+
+// Reward returns the sum of the reward components.
+func (d *AttestationDelta) Reward() uint64 {
+	return d.SourceReward + d.TargetReward + d.HeadReward + d.InclusionDelayReward
+}
+
+// Penalty returns the sum of the penalty components.
+func (d *AttestationDelta) Penalty() uint64 {
+	return d.SourcePenalty + d.TargetPenalty + d.HeadPenalty + d.InactivityPenalty
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	e "github.com/prysmaticlabs/prysm/beacon-chain/core/epoch"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
@@ -21,6 +22,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/mathutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"github.com/prysmaticlabs/prysm/shared/version"
 	"go.opencensus.io/trace"
 )
 
@@ -46,6 +48,18 @@ var processExitFunc = func(ctx context.Context, s iface.BeaconState, blk interfa
 	return b.ProcessVoluntaryExits(ctx, s, blk.Block().Body().VoluntaryExits())
 }
 
+var processAltairDepositsFunc = func(ctx context.Context, s iface.BeaconState, blk interfaces.SignedBeaconBlock) (iface.BeaconState, error) {
+	return altair.ProcessDeposits(ctx, s, blk.Block().Body().Deposits())
+}
+
+var processSyncAggregateFunc = func(ctx context.Context, s iface.BeaconState, blk interfaces.SignedBeaconBlock) (iface.BeaconState, error) {
+	sa, err := blk.Block().Body().SyncAggregate()
+	if err != nil {
+		return nil, err
+	}
+	return altair.ProcessSyncAggregate(s, sa)
+}
+
 // This defines the processing block routine as outlined in the Ethereum Beacon Chain spec:
 // https://github.com/ethereum/eth2.0-specs/blob/dev/specs/phase0/beacon-chain.md#block-processing
 var processingPipeline = []processFunc{
@@ -60,6 +74,21 @@ var processingPipeline = []processFunc{
 	processExitFunc,
 }
 
+// This defines the processing block routine for Altair as outlined in the Ethereum Beacon Chain spec:
+// https://github.com/ethereum/eth2.0-specs/blob/dev/specs/altair/beacon-chain.md#block-processing
+var altairProcessingPipeline = []processFunc{
+	b.ProcessBlockHeader,
+	b.ProcessRandao,
+	processEth1DataFunc,
+	VerifyOperationLengths,
+	processProposerSlashingFunc,
+	processAttesterSlashingFunc,
+	altair.ProcessAttestations,
+	processAltairDepositsFunc,
+	processExitFunc,
+	processSyncAggregateFunc,
+}
+
 // ExecuteStateTransition defines the procedure for a state transition function.
 //
 // Note: This method differs from the spec pseudocode as it uses a batch signature verification.
@@ -205,6 +234,9 @@ func ProcessSlotsUsingNextSlotCache(
 //        if (state.slot + 1) % SLOTS_PER_EPOCH == 0:
 //            process_epoch(state)
 //        state.slot = Slot(state.slot + 1)
+//
+// The Altair fork upgrade is applied once the slot reaches the first slot of ALTAIR_FORK_EPOCH,
+// and per epoch processing is dispatched by the version of the beacon state.
 func ProcessSlots(ctx context.Context, state iface.BeaconState, slot types.Slot) (iface.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "core.state.ProcessSlots")
 	defer span.End()
@@ -272,7 +304,14 @@ func ProcessSlots(ctx context.Context, state iface.BeaconState, slot types.Slot)
 			return nil, errors.Wrap(err, "could not process slot")
 		}
 		if CanProcessEpoch(state) {
-			state, err = ProcessEpochPrecompute(ctx, state)
+			switch state.Version() {
+			case version.Phase0:
+				state, err = ProcessEpochPrecompute(ctx, state)
+			case version.Altair:
+				state, err = altair.ProcessEpoch(ctx, state)
+			default:
+				err = fmt.Errorf("unsupported beacon state version %d", state.Version())
+			}
 			if err != nil {
 				traceutil.AnnotateError(span, err)
 				return nil, errors.Wrap(err, "could not process epoch with optimizations")
@@ -282,6 +321,14 @@ func ProcessSlots(ctx context.Context, state iface.BeaconState, slot types.Slot)
 			traceutil.AnnotateError(span, err)
 			return nil, errors.Wrap(err, "failed to increment state slot")
 		}
+
+		if CanUpgradeToAltair(state) {
+			state, err = altair.UpgradeToAltair(ctx, state)
+			if err != nil {
+				traceutil.AnnotateError(span, err)
+				return nil, errors.Wrap(err, "could not upgrade state to altair")
+			}
+		}
 	}
 
 	if highestSlot < state.Slot() {
@@ -318,7 +365,11 @@ func ProcessBlock(
 		return nil, err
 	}
 
-	for _, p := range processingPipeline {
+	pipeline := processingPipeline
+	if signed.Version() == version.Altair {
+		pipeline = altairProcessingPipeline
+	}
+	for _, p := range pipeline {
 		state, err = p(ctx, state, signed)
 		if err != nil {
 			return nil, errors.Wrap(err, "Could not process block")
@@ -392,6 +443,17 @@ func CanProcessEpoch(state iface.ReadOnlyBeaconState) bool {
 	return (state.Slot()+1)%params.BeaconConfig().SlotsPerEpoch == 0
 }
 
+// CanUpgradeToAltair returns true if the phase 0 state has reached the first slot of the Altair fork epoch.
+//
+// Spec code:
+//    if state.slot % SLOTS_PER_EPOCH == 0 and compute_epoch_at_slot(state.slot) == ALTAIR_FORK_EPOCH:
+//        state = upgrade_to_altair(state)
+func CanUpgradeToAltair(state iface.BeaconState) bool {
+	return state.Version() == version.Phase0 &&
+		helpers.IsEpochStart(state.Slot()) &&
+		helpers.SlotToEpoch(state.Slot()) == params.BeaconConfig().AltairForkEpoch
+}
+
 // ProcessEpochPrecompute describes the per epoch operations that are performed on the beacon state.
 // It's optimized by pre computing validator attested info and epoch total/attested balances upfront.
 func ProcessEpochPrecompute(ctx context.Context, state iface.BeaconState) (iface.BeaconState, error) {
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/interop"
@@ -15,6 +16,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"github.com/prysmaticlabs/prysm/shared/version"
 	"go.opencensus.io/trace"
 )
 
@@ -248,13 +250,27 @@ func ProcessOperationsNoVerifyAttsSigs(
 	if err != nil {
 		return nil, errors.Wrap(err, "could not process block attester slashings")
 	}
-	state, err = b.ProcessAttestationsNoVerifySignature(ctx, state, signedBeaconBlock)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not process block attestations")
-	}
-	state, err = b.ProcessDeposits(ctx, state, signedBeaconBlock.Block().Body().Deposits())
-	if err != nil {
-		return nil, errors.Wrap(err, "could not process block validator deposits")
+	switch signedBeaconBlock.Version() {
+	case version.Phase0:
+		state, err = b.ProcessAttestationsNoVerifySignature(ctx, state, signedBeaconBlock)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not process block attestations")
+		}
+		state, err = b.ProcessDeposits(ctx, state, signedBeaconBlock.Block().Body().Deposits())
+		if err != nil {
+			return nil, errors.Wrap(err, "could not process block validator deposits")
+		}
+	case version.Altair:
+		state, err = altair.ProcessAttestationsNoVerifySignature(ctx, state, signedBeaconBlock)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not process block attestations")
+		}
+		state, err = altair.ProcessDeposits(ctx, state, signedBeaconBlock.Block().Body().Deposits())
+		if err != nil {
+			return nil, errors.Wrap(err, "could not process block validator deposits")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported block version %d", signedBeaconBlock.Version())
 	}
 	state, err = b.ProcessVoluntaryExits(ctx, state, signedBeaconBlock.Block().Body().VoluntaryExits())
 	if err != nil {
@@ -307,5 +323,17 @@ func ProcessBlockForStateRoot(
 		return nil, errors.Wrap(err, "could not process block operation")
 	}
 
+	if signed.Version() == version.Altair {
+		sa, err := signed.Block().Body().SyncAggregate()
+		if err != nil {
+			return nil, err
+		}
+		state, err = altair.ProcessSyncAggregate(state, sa)
+		if err != nil {
+			traceutil.AnnotateError(span, err)
+			return nil, errors.Wrap(err, "could not process sync aggregate")
+		}
+	}
+
 	return state, nil
 }
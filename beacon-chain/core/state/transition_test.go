@@ -25,6 +25,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"github.com/prysmaticlabs/prysm/shared/version"
 	"github.com/sirupsen/logrus"
 )
 
@@ -842,3 +843,43 @@ func TestProcessSlotsUsingNextSlotCache(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, types.Slot(5), s.Slot())
 }
+
+func TestProcessSlots_UpgradesToAltair(t *testing.T) {
+	ctx := context.Background()
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.AltairForkEpoch = 1
+	params.OverrideBeaconConfig(cfg)
+
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	genesisForkVersion := st.Fork().CurrentVersion
+	st, err := state.ProcessSlots(ctx, st, cfg.SlotsPerEpoch-1)
+	require.NoError(t, err)
+	assert.Equal(t, version.Phase0, st.Version())
+
+	// The state is upgraded on the first slot of the fork epoch.
+	st, err = state.ProcessSlots(ctx, st, cfg.SlotsPerEpoch)
+	require.NoError(t, err)
+	require.Equal(t, version.Altair, st.Version())
+	assert.DeepEqual(t, &pb.Fork{
+		PreviousVersion: genesisForkVersion,
+		CurrentVersion:  cfg.AltairForkVersion,
+		Epoch:           1,
+	}, st.Fork())
+
+	// Epoch processing of the upgraded state is the Altair one, which rotates the participation flags.
+	participation := make([]byte, st.NumValidators())
+	for i := range participation {
+		participation[i] = 1<<cfg.TimelySourceFlagIndex | 1<<cfg.TimelyTargetFlagIndex
+	}
+	require.NoError(t, st.SetCurrentParticipationBits(participation))
+	st, err = state.ProcessSlots(ctx, st, 2*cfg.SlotsPerEpoch)
+	require.NoError(t, err)
+	assert.Equal(t, version.Altair, st.Version())
+	previousParticipation, err := st.PreviousEpochParticipation()
+	require.NoError(t, err)
+	assert.DeepEqual(t, participation, previousParticipation)
+	currentParticipation, err := st.CurrentEpochParticipation()
+	require.NoError(t, err)
+	assert.DeepEqual(t, make([]byte, st.NumValidators()), currentParticipation)
+}
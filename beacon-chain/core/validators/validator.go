@@ -11,6 +11,7 @@ import (
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/version"
 )
 
 // InitiateValidatorExit takes in validator index and updates
@@ -121,6 +122,9 @@ func InitiateValidatorExit(state iface.BeaconState, idx types.ValidatorIndex) (i
 //    proposer_reward = Gwei(whistleblower_reward // PROPOSER_REWARD_QUOTIENT)
 //    increase_balance(state, proposer_index, proposer_reward)
 //    increase_balance(state, whistleblower_index, Gwei(whistleblower_reward - proposer_reward))
+//
+// Post Altair, the slashing penalty uses MIN_SLASHING_PENALTY_QUOTIENT_ALTAIR and the proposer
+// reward is computed as whistleblower_reward * PROPOSER_WEIGHT // WEIGHT_DENOMINATOR.
 func SlashValidator(state iface.BeaconState, slashedIdx types.ValidatorIndex) (iface.BeaconState, error) {
 	state, err := InitiateValidatorExit(state, slashedIdx)
 	if err != nil {
@@ -148,7 +152,11 @@ func SlashValidator(state iface.BeaconState, slashedIdx types.ValidatorIndex) (i
 	); err != nil {
 		return nil, err
 	}
-	if err := helpers.DecreaseBalance(state, slashedIdx, validator.EffectiveBalance/params.BeaconConfig().MinSlashingPenaltyQuotient); err != nil {
+	penaltyQuotient := params.BeaconConfig().MinSlashingPenaltyQuotient
+	if state.Version() == version.Altair {
+		penaltyQuotient = params.BeaconConfig().MinSlashingPenaltyQuotientAltair
+	}
+	if err := helpers.DecreaseBalance(state, slashedIdx, validator.EffectiveBalance/penaltyQuotient); err != nil {
 		return nil, err
 	}
 
@@ -160,6 +168,9 @@ func SlashValidator(state iface.BeaconState, slashedIdx types.ValidatorIndex) (i
 	whistleBlowerIdx := proposerIdx
 	whistleblowerReward := validator.EffectiveBalance / params.BeaconConfig().WhistleBlowerRewardQuotient
 	proposerReward := whistleblowerReward / params.BeaconConfig().ProposerRewardQuotient
+	if state.Version() == version.Altair {
+		proposerReward = whistleblowerReward * params.BeaconConfig().ProposerWeight / params.BeaconConfig().WeightDenominator
+	}
 	err = helpers.IncreaseBalance(state, proposerIdx, proposerReward)
 	if err != nil {
 		return nil, err
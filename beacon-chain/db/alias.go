@@ -29,3 +29,10 @@ type SlasherDatabase = iface.SlasherDatabase
 // ErrExistingGenesisState is an error when the user attempts to save a different genesis state
 // when one already exists in a database.
 var ErrExistingGenesisState = iface.ErrExistingGenesisState
+
+// ErrNotFoundOriginBlockRoot is an error when the database was not initialized from a checkpoint
+// sync origin.
+var ErrNotFoundOriginBlockRoot = iface.ErrNotFoundOriginBlockRoot
+
+// ErrNotFoundBackfillBlockRoot is an error when no backfill progress is stored in the database.
+var ErrNotFoundBackfillBlockRoot = iface.ErrNotFoundBackfillBlockRoot
@@ -3,8 +3,11 @@
 package db
 
 import (
+	"context"
+
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kafka"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/slasherkv"
 )
 
 // NewDB initializes a new DB with kafka wrapper.
@@ -16,3 +19,8 @@ func NewDB(dirPath string, stateSummaryCache *kv.stateSummaryCache) (Database, e
 
 	return kafka.Wrap(db)
 }
+
+// NewSlasherDB initializes a new DB for slasher.
+func NewSlasherDB(ctx context.Context, dirPath string, config *slasherkv.Config) (SlasherDatabase, error) {
+	return slasherkv.NewKVStore(ctx, dirPath, config)
+}
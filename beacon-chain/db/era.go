@@ -0,0 +1,76 @@
+package db
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/era"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// ExportEra writes the finalized history of a beacon chain database to era files.
+func ExportEra(cliCtx *cli.Context) error {
+	dir := cliCtx.String(flags.EraDir.Name)
+	if dir == "" {
+		return fmt.Errorf("--%s is required", flags.EraDir.Name)
+	}
+	d, err := openOfflineDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer closeOfflineDB(d)
+
+	startEra := cliCtx.Uint64(cmd.EraStartFlag.Name)
+	endEra := cliCtx.Uint64(cmd.EraEndFlag.Name)
+	slotsPerArchivedPoint := types.Slot(cliCtx.Int(flags.SlotsPerArchivedPoint.Name))
+	paths, err := era.Export(cliCtx.Context, d, dir, startEra, endEra, slotsPerArchivedPoint)
+	if err != nil {
+		return errors.Wrap(err, "could not export era files")
+	}
+	log.WithFields(logrus.Fields{
+		"files": len(paths),
+		"dir":   dir,
+	}).Info("Export completed successfully")
+	return nil
+}
+
+// ImportEra saves the blocks and states of era files into a beacon chain database.
+func ImportEra(cliCtx *cli.Context) error {
+	dir := cliCtx.String(flags.EraDir.Name)
+	if dir == "" {
+		return fmt.Errorf("--%s is required", flags.EraDir.Name)
+	}
+	rootFlag := cliCtx.String(flags.EraFinalizedRoot.Name)
+	if rootFlag == "" {
+		return fmt.Errorf("--%s is required", flags.EraFinalizedRoot.Name)
+	}
+	root, err := hexutil.Decode(rootFlag)
+	if err != nil || len(root) != 32 {
+		return fmt.Errorf("--%s must be a hex encoded 32 byte root", flags.EraFinalizedRoot.Name)
+	}
+	// Unlike the other commands, importing may start from an empty data directory.
+	dbPath := path.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName)
+	d, err := kv.NewKVStore(cliCtx.Context, dbPath, &kv.Config{})
+	if err != nil {
+		return err
+	}
+	defer closeOfflineDB(d)
+
+	stats, err := era.Import(cliCtx.Context, d, dir, bytesutil.ToBytes32(root))
+	if err != nil {
+		return errors.Wrap(err, "could not import era files")
+	}
+	log.WithFields(logrus.Fields{
+		"blocks": stats.Blocks,
+		"states": stats.States,
+	}).Info("Import completed successfully")
+	return nil
+}
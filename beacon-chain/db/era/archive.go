@@ -0,0 +1,103 @@
+package era
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+)
+
+// Archive serves blocks from a directory of era files, keeping the files it reads from open.
+type Archive struct {
+	dir   string
+	lock  sync.Mutex
+	files map[uint64]*File
+}
+
+// NewArchive returns an archive of the era files in the directory.
+func NewArchive(dir string) (*Archive, error) {
+	hasDir, err := fileutil.HasDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !hasDir {
+		return nil, errors.Errorf("era directory %s does not exist", dir)
+	}
+	return &Archive{dir: dir, files: make(map[uint64]*File)}, nil
+}
+
+// Blocks returns the blocks of the archive from the start slot to the end slot, both inclusive,
+// at the given step, ordered by slot.
+func (a *Archive) Blocks(ctx context.Context, startSlot, endSlot types.Slot, step uint64) ([]interfaces.SignedBeaconBlock, [][32]byte, error) {
+	if step == 0 {
+		step = 1
+	}
+	blks := make([]interfaces.SignedBeaconBlock, 0)
+	roots := make([][32]byte, 0)
+	for slot := startSlot; slot <= endSlot; slot = slot.Add(step) {
+		if ctx.Err() != nil {
+			return nil, nil, ctx.Err()
+		}
+		f, err := a.file(EraOfSlot(slot))
+		if err != nil {
+			return nil, nil, err
+		}
+		if f == nil {
+			continue
+		}
+		blk, err := f.Block(slot)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		root, err := blk.Block().HashTreeRoot()
+		if err != nil {
+			return nil, nil, err
+		}
+		blks = append(blks, blk)
+		roots = append(roots, root)
+	}
+	return blks, roots, nil
+}
+
+// Close closes the open era files.
+func (a *Archive) Close() error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	var err error
+	for era, f := range a.files {
+		if f == nil {
+			continue
+		}
+		if closeErr := f.Close(); closeErr != nil {
+			err = closeErr
+		}
+		delete(a.files, era)
+	}
+	return err
+}
+
+// file returns the era file of the era, or nil if the archive does not have it.
+func (a *Archive) file(era uint64) (*File, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if f, ok := a.files[era]; ok && f != nil {
+		return f, nil
+	}
+	path := filepath.Join(a.dir, FileName(era))
+	if !fileutil.FileExists(path) {
+		return nil, nil
+	}
+	f, err := Open(path)
+	if err != nil {
+		return nil, err
+	}
+	a.files[era] = f
+	return f, nil
+}
@@ -0,0 +1,56 @@
+package era
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestArchive_Blocks(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	next := SlotsPerEra()
+	c := makeChain(t, []types.Slot{0, 1, 3, 4, next, next + 2})
+	for era, blks := range map[uint64][]int{0: {0, 1, 2, 3}, 1: {4, 5}} {
+		f, err := os.Create(filepath.Join(dir, FileName(era)))
+		require.NoError(t, err)
+		w, err := NewWriter(f, era)
+		require.NoError(t, err)
+		for _, i := range blks {
+			require.NoError(t, w.AddBlock(c.block(i)))
+		}
+		require.NoError(t, w.Finish())
+		require.NoError(t, f.Close())
+	}
+
+	a, err := NewArchive(dir)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, a.Close())
+	}()
+
+	blks, roots, err := a.Blocks(ctx, 1, 4, 1)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(blks))
+	assert.DeepEqual(t, [][32]byte{c.roots[1], c.roots[2], c.roots[3]}, roots)
+	assert.Equal(t, types.Slot(3), blks[1].Block().Slot())
+
+	blks, roots, err = a.Blocks(ctx, 0, next+2, 2)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(blks))
+	assert.DeepEqual(t, [][32]byte{c.roots[0], c.roots[3], c.roots[4], c.roots[5]}, roots)
+
+	blks, _, err = a.Blocks(ctx, next*2, next*2+10, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(blks))
+}
+
+func TestNewArchive_MissingDir(t *testing.T) {
+	_, err := NewArchive(filepath.Join(t.TempDir(), "missing"))
+	require.ErrorContains(t, "does not exist", err)
+}
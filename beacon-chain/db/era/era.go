@@ -0,0 +1,129 @@
+// Package era implements a flat file archive of finalized beacon chain history. Every era file
+// covers one period of SLOTS_PER_HISTORICAL_ROOT slots and holds the canonical blocks and the
+// archived point states of that period, so that history can be shared between nodes outside of
+// the beacon database and imported again to bootstrap a node without syncing over p2p.
+//
+// An era file is a sequence of records, each made of an 8 byte header followed by its data:
+//
+//	type (2 bytes) | length of the data (4 bytes, little endian) | reserved (2 bytes, zero)
+//
+// The file starts with a version record, followed by a record for every block in the period,
+// ordered by slot, and for every state. The data of a block or state record is the fork version
+// of its type followed by its snappy framed SSZ encoding, so that a file may hold both phase 0
+// and Altair blocks and states:
+//
+//	fork version (4 bytes) | snappy framed SSZ
+//
+// It ends with two slot index records, the first for the blocks and the second for the states.
+// The data of a slot index is
+//
+//	start slot (8 bytes) | offset (8 bytes) per slot of the period | count (8 bytes)
+//
+// with all values little endian and each offset being the position of the record in the file,
+// or zero for slots without a block or state.
+package era
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/version"
+)
+
+const (
+	headerLength      = 8
+	forkVersionLength = 4
+	// FileExtension of era files.
+	FileExtension = ".era"
+)
+
+var (
+	typeVersion   = [2]byte{0x65, 0x32}
+	typeBlock     = [2]byte{0x01, 0x00}
+	typeState     = [2]byte{0x02, 0x00}
+	typeSlotIndex = [2]byte{0x69, 0x32}
+)
+
+// ErrNotFound is returned when an era file does not contain the requested record.
+var ErrNotFound = errors.New("not found in era file")
+
+// SlotsPerEra is the number of slots covered by a single era file.
+func SlotsPerEra() types.Slot {
+	return params.BeaconConfig().SlotsPerHistoricalRoot
+}
+
+// EraOfSlot returns the era containing the slot.
+func EraOfSlot(slot types.Slot) uint64 {
+	return uint64(slot.DivSlot(SlotsPerEra()))
+}
+
+// StartSlot returns the first slot of the era.
+func StartSlot(era uint64) types.Slot {
+	return SlotsPerEra().Mul(era)
+}
+
+// FileName returns the name of the file of the era.
+func FileName(era uint64) string {
+	return fmt.Sprintf("beacon-%05d%s", era, FileExtension)
+}
+
+// forkVersion returns the fork version recorded with blocks and states of the given version.
+func forkVersion(v int) ([]byte, error) {
+	switch v {
+	case version.Phase0:
+		return params.BeaconConfig().GenesisForkVersion, nil
+	case version.Altair:
+		return params.BeaconConfig().AltairForkVersion, nil
+	default:
+		return nil, errors.Errorf("unsupported version %d", v)
+	}
+}
+
+// versionOfFork returns the version of the blocks and states recorded with the fork version.
+func versionOfFork(forkVersion []byte) (int, error) {
+	cfg := params.BeaconConfig()
+	switch {
+	case bytes.Equal(forkVersion, cfg.GenesisForkVersion):
+		return version.Phase0, nil
+	case bytes.Equal(forkVersion, cfg.AltairForkVersion):
+		return version.Altair, nil
+	default:
+		return 0, errors.Errorf("unsupported fork version %#x", forkVersion)
+	}
+}
+
+func writeRecord(w io.Writer, typ [2]byte, data []byte) (int, error) {
+	if uint64(len(data)) > uint64(^uint32(0)) {
+		return 0, errors.Errorf("record of %d bytes is too large", len(data))
+	}
+	header := make([]byte, headerLength)
+	copy(header, typ[:])
+	binary.LittleEndian.PutUint32(header[2:6], uint32(len(data)))
+	n, err := w.Write(header)
+	if err != nil {
+		return n, err
+	}
+	m, err := w.Write(data)
+	return n + m, err
+}
+
+// readRecord reads the record at the offset of the reader and checks it is of the given type.
+func readRecord(r io.ReaderAt, offset int64, typ [2]byte) ([]byte, error) {
+	header := make([]byte, headerLength)
+	if _, err := r.ReadAt(header, offset); err != nil {
+		return nil, errors.Wrapf(err, "could not read record header at offset %d", offset)
+	}
+	if header[0] != typ[0] || header[1] != typ[1] {
+		return nil, errors.Errorf("unexpected record type %#x at offset %d, want %#x", header[:2], offset, typ)
+	}
+	data := make([]byte, binary.LittleEndian.Uint32(header[2:6]))
+	if _, err := r.ReadAt(data, offset+headerLength); err != nil {
+		return nil, errors.Wrapf(err, "could not read record at offset %d", offset)
+	}
+	return data, nil
+}
@@ -0,0 +1,254 @@
+package era
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	v2 "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/version"
+)
+
+// chain holds blocks linked by their parent roots, with the matching post state of every block.
+type chain struct {
+	blocks []*ethpb.SignedBeaconBlock
+	roots  [][32]byte
+	states []*pb.BeaconState
+}
+
+// makeChain builds a chain with a block at every given slot, starting at genesis.
+func makeChain(t *testing.T, slots []types.Slot) *chain {
+	ctx := context.Background()
+	c := &chain{}
+	parent := [32]byte{}
+	for _, slot := range slots {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = slot
+		blk.Block.ParentRoot = bytesutil.SafeCopyBytes(parent[:])
+		bodyRoot, err := blk.Block.Body.HashTreeRoot()
+		require.NoError(t, err)
+		st, err := testutil.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(slot))
+		require.NoError(t, st.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{
+			Slot:       slot,
+			ParentRoot: bytesutil.SafeCopyBytes(parent[:]),
+			StateRoot:  make([]byte, 32),
+			BodyRoot:   bodyRoot[:],
+		}))
+		stateRoot, err := st.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		blk.Block.StateRoot = stateRoot[:]
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		c.blocks = append(c.blocks, blk)
+		c.roots = append(c.roots, root)
+		pbState, err := v1.ProtobufBeaconState(st.CloneInnerState())
+		require.NoError(t, err)
+		c.states = append(c.states, pbState)
+		parent = root
+	}
+	return c
+}
+
+// block returns the block at the index of the chain.
+func (c *chain) block(i int) interfaces.SignedBeaconBlock {
+	return wrapper.WrappedPhase0SignedBeaconBlock(c.blocks[i])
+}
+
+// state returns the state at the index of the chain.
+func (c *chain) state(t *testing.T, i int) iface.BeaconState {
+	st, err := v1.InitializeFromProto(c.states[i])
+	require.NoError(t, err)
+	return st
+}
+
+// altairBlock returns an empty Altair block at the slot, with its fields sized for SSZ encoding.
+func altairBlock(t *testing.T, slot types.Slot) interfaces.SignedBeaconBlock {
+	blk, err := wrapper.WrappedAltairSignedBeaconBlock(&prysmv2.SignedBeaconBlockAltair{
+		Block: &prysmv2.BeaconBlockAltair{
+			Slot:       slot,
+			ParentRoot: make([]byte, 32),
+			StateRoot:  make([]byte, 32),
+			Body: &prysmv2.BeaconBlockBodyAltair{
+				RandaoReveal: make([]byte, 96),
+				Eth1Data: &ethpb.Eth1Data{
+					DepositRoot: make([]byte, 32),
+					BlockHash:   make([]byte, 32),
+				},
+				Graffiti: make([]byte, 32),
+				SyncAggregate: &prysmv2.SyncAggregate{
+					SyncCommitteeBits:      make([]byte, params.BeaconConfig().SyncCommitteeSize/8),
+					SyncCommitteeSignature: make([]byte, 96),
+				},
+			},
+		},
+		Signature: make([]byte, 96),
+	})
+	require.NoError(t, err)
+	return blk
+}
+
+// altairState returns an Altair state at the slot, with its fields sized for SSZ encoding.
+func altairState(t *testing.T, slot types.Slot) iface.BeaconState {
+	phase0, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	p, err := v1.ProtobufBeaconState(phase0.CloneInnerState())
+	require.NoError(t, err)
+	committee := &pb.SyncCommittee{
+		Pubkeys:         make([][]byte, params.BeaconConfig().SyncCommitteeSize),
+		AggregatePubkey: make([]byte, 48),
+	}
+	for i := range committee.Pubkeys {
+		committee.Pubkeys[i] = make([]byte, 48)
+	}
+	st, err := v2.InitializeFromProto(&pb.BeaconStateAltair{
+		Slot:                        slot,
+		GenesisValidatorsRoot:       p.GenesisValidatorsRoot,
+		Fork:                        p.Fork,
+		LatestBlockHeader:           p.LatestBlockHeader,
+		BlockRoots:                  p.BlockRoots,
+		StateRoots:                  p.StateRoots,
+		RandaoMixes:                 p.RandaoMixes,
+		Slashings:                   p.Slashings,
+		Eth1Data:                    p.Eth1Data,
+		JustificationBits:           p.JustificationBits,
+		PreviousJustifiedCheckpoint: p.PreviousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:  p.CurrentJustifiedCheckpoint,
+		FinalizedCheckpoint:         p.FinalizedCheckpoint,
+		CurrentSyncCommittee:        committee,
+		NextSyncCommittee:           committee,
+	})
+	require.NoError(t, err)
+	return st
+}
+
+func setupDB(t *testing.T) *kv.Store {
+	db, err := kv.NewKVStore(context.Background(), t.TempDir(), &kv.Config{})
+	require.NoError(t, err)
+	return db
+}
+
+func TestWriter_RoundTrip(t *testing.T) {
+	era := uint64(1)
+	start := StartSlot(era)
+	c := makeChain(t, []types.Slot{start, start + 1, start + 3})
+
+	buf := new(bytes.Buffer)
+	w, err := NewWriter(buf, era)
+	require.NoError(t, err)
+	for i := range c.blocks {
+		require.NoError(t, w.AddBlock(c.block(i)))
+	}
+	require.NoError(t, w.AddState(c.state(t, 0)))
+	require.NoError(t, w.AddState(c.state(t, 2)))
+	require.NoError(t, w.Finish())
+
+	f, err := NewFile(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	assert.Equal(t, start, f.StartSlot())
+	assert.Equal(t, start+SlotsPerEra()-1, f.EndSlot())
+	assert.DeepEqual(t, []types.Slot{start, start + 1, start + 3}, f.BlockSlots())
+	assert.DeepEqual(t, []types.Slot{start, start + 3}, f.StateSlots())
+
+	for i, b := range c.blocks {
+		blk, err := f.Block(b.Block.Slot)
+		require.NoError(t, err)
+		assert.Equal(t, version.Phase0, blk.Version())
+		root, err := blk.Block().HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, c.roots[i], root)
+	}
+	st, err := f.State(start + 3)
+	require.NoError(t, err)
+	assert.Equal(t, version.Phase0, st.Version())
+	assert.DeepSSZEqual(t, c.states[2], st.InnerStateUnsafe())
+
+	_, err = f.Block(start + 2)
+	assert.ErrorContains(t, ErrNotFound.Error(), err)
+	_, err = f.State(start + 1)
+	assert.ErrorContains(t, ErrNotFound.Error(), err)
+	_, err = f.Block(start - 1)
+	assert.ErrorContains(t, ErrNotFound.Error(), err)
+}
+
+func TestWriter_AltairRoundTrip(t *testing.T) {
+	c := makeChain(t, []types.Slot{0})
+	buf := new(bytes.Buffer)
+	w, err := NewWriter(buf, 0)
+	require.NoError(t, err)
+	require.NoError(t, w.AddBlock(c.block(0)))
+	require.NoError(t, w.AddBlock(altairBlock(t, 1)))
+	require.NoError(t, w.AddState(c.state(t, 0)))
+	altair := altairState(t, 1)
+	require.NoError(t, w.AddState(altair))
+	require.NoError(t, w.Finish())
+
+	f, err := NewFile(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	blk, err := f.Block(0)
+	require.NoError(t, err)
+	assert.Equal(t, version.Phase0, blk.Version())
+	blk, err = f.Block(1)
+	require.NoError(t, err)
+	assert.Equal(t, version.Altair, blk.Version())
+	want, err := altairBlock(t, 1).Block().HashTreeRoot()
+	require.NoError(t, err)
+	root, err := blk.Block().HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, want, root)
+
+	st, err := f.State(0)
+	require.NoError(t, err)
+	assert.Equal(t, version.Phase0, st.Version())
+	st, err = f.State(1)
+	require.NoError(t, err)
+	assert.Equal(t, version.Altair, st.Version())
+	assert.DeepSSZEqual(t, altair.InnerStateUnsafe(), st.InnerStateUnsafe())
+}
+
+func TestWriter_InvalidOrder(t *testing.T) {
+	c := makeChain(t, []types.Slot{0, 1, SlotsPerEra()})
+
+	w, err := NewWriter(new(bytes.Buffer), 0)
+	require.NoError(t, err)
+	require.ErrorContains(t, "is not in era 0", w.AddBlock(c.block(2)))
+	require.NoError(t, w.AddBlock(c.block(1)))
+	require.ErrorContains(t, "is not after the previous slot", w.AddBlock(c.block(0)))
+	require.NoError(t, w.AddState(c.state(t, 0)))
+	require.ErrorContains(t, "blocks must be added before states", w.AddBlock(c.block(1)))
+	require.NoError(t, w.Finish())
+	require.ErrorContains(t, "already finished", w.Finish())
+}
+
+func TestNewFile_Corrupted(t *testing.T) {
+	c := makeChain(t, []types.Slot{0})
+	buf := new(bytes.Buffer)
+	w, err := NewWriter(buf, 0)
+	require.NoError(t, err)
+	require.NoError(t, w.AddBlock(c.block(0)))
+	require.NoError(t, w.Finish())
+	enc := buf.Bytes()
+
+	_, err = NewFile(bytes.NewReader(enc[:len(enc)-1]), int64(len(enc)-1))
+	assert.NotNil(t, err)
+
+	corrupted := append([]byte{}, enc...)
+	corrupted[0] = 0xff
+	_, err = NewFile(bytes.NewReader(corrupted), int64(len(corrupted)))
+	assert.ErrorContains(t, "could not read version record", err)
+}
@@ -0,0 +1,159 @@
+package era
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// LastFinalizedEra returns the last era of which every slot is finalized, and false if no era is
+// finalized yet.
+func LastFinalizedEra(ctx context.Context, db iface.ReadOnlyDatabase) (uint64, bool, error) {
+	finalized, err := db.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+	finalizedSlot, err := helpers.StartSlot(finalized.Epoch)
+	if err != nil {
+		return 0, false, err
+	}
+	next := EraOfSlot(finalizedSlot)
+	if next == 0 {
+		return 0, false, nil
+	}
+	return next - 1, true, nil
+}
+
+// Export writes the era files of the finalized eras from startEra to endEra into the directory.
+// Every file holds the finalized canonical blocks of its era and the states stored at the
+// archived points of the era. It returns the paths of the written files.
+func Export(ctx context.Context, db iface.ReadOnlyDatabase, dir string, startEra, endEra uint64, slotsPerArchivedPoint types.Slot) ([]string, error) {
+	ctx, span := trace.StartSpan(ctx, "era.Export")
+	defer span.End()
+
+	if slotsPerArchivedPoint == 0 {
+		return nil, errors.New("slots per archived point must be greater than 0")
+	}
+	if startEra > endEra {
+		return nil, errors.Errorf("start era %d is after end era %d", startEra, endEra)
+	}
+	lastEra, ok, err := LastFinalizedEra(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	if !ok || startEra > lastEra {
+		return nil, errors.Errorf("era %d is not finalized yet", startEra)
+	}
+	if endEra > lastEra {
+		endEra = lastEra
+	}
+	hasDir, err := fileutil.HasDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !hasDir {
+		if err := fileutil.MkdirAll(dir); err != nil {
+			return nil, err
+		}
+	}
+
+	paths := make([]string, 0, endEra-startEra+1)
+	for era := startEra; era <= endEra; era++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		path := filepath.Join(dir, FileName(era))
+		blocks, states, err := exportEra(ctx, db, path, era, slotsPerArchivedPoint)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not export era %d", era)
+		}
+		log.WithFields(logrus.Fields{
+			"era":    era,
+			"blocks": blocks,
+			"states": states,
+			"path":   path,
+		}).Info("Exported era file")
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// exportEra writes a single era file, first to a temporary file which is renamed once complete.
+func exportEra(ctx context.Context, db iface.ReadOnlyDatabase, path string, era uint64, slotsPerArchivedPoint types.Slot) (int, int, error) {
+	start := StartSlot(era)
+	end := start.Add(uint64(SlotsPerEra())) - 1
+	blks, roots, err := db.Blocks(ctx, filters.NewFilter().SetStartSlot(start).SetEndSlot(end))
+	if err != nil {
+		return 0, 0, err
+	}
+	canonical := make([]interfaces.SignedBeaconBlock, 0, len(blks))
+	for i, b := range blks {
+		if b == nil || b.IsNil() || b.Block().IsNil() {
+			continue
+		}
+		if db.IsFinalizedBlock(ctx, roots[i]) {
+			canonical = append(canonical, b)
+		}
+	}
+	sort.Slice(canonical, func(i, j int) bool {
+		return canonical[i].Block().Slot() < canonical[j].Block().Slot()
+	})
+
+	tmpPath := path + ".tmp"
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
+	if err != nil {
+		return 0, 0, err
+	}
+	w, err := NewWriter(f, era)
+	if err != nil {
+		return 0, 0, closeOnError(f, err)
+	}
+	for _, b := range canonical {
+		if err := w.AddBlock(b); err != nil {
+			return 0, 0, closeOnError(f, err)
+		}
+	}
+	states := 0
+	for slot := start; slot <= end; slot++ {
+		if slot%slotsPerArchivedPoint != 0 || !db.HasArchivedPoint(ctx, slot) {
+			continue
+		}
+		root := db.ArchivedPointRoot(ctx, slot)
+		if !db.IsFinalizedBlock(ctx, root) {
+			continue
+		}
+		st, err := db.State(ctx, root)
+		if err != nil {
+			return 0, 0, closeOnError(f, err)
+		}
+		if st == nil || st.IsNil() {
+			continue
+		}
+		if err := w.AddState(st); err != nil {
+			return 0, 0, closeOnError(f, err)
+		}
+		states++
+	}
+	if err := w.Finish(); err != nil {
+		return 0, 0, closeOnError(f, err)
+	}
+	if err := f.Sync(); err != nil {
+		return 0, 0, closeOnError(f, err)
+	}
+	if err := f.Close(); err != nil {
+		return 0, 0, err
+	}
+	return len(canonical), states, os.Rename(tmpPath, path)
+}
@@ -0,0 +1,204 @@
+package era
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// saveFinalizedChain saves a chain with blocks in the first era, plus a fork block, and a block
+// at the start of the second era which is finalized. States are saved at the even slots.
+func saveFinalizedChain(t *testing.T, db *kv.Store) *chain {
+	ctx := context.Background()
+	c := makeChain(t, []types.Slot{0, 1, 2, 4, 5, SlotsPerEra()})
+	for i, b := range c.blocks {
+		require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b)))
+		require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: b.Block.Slot, Root: c.roots[i][:]}))
+		if b.Block.Slot%2 == 0 {
+			st, err := v1.InitializeFromProto(c.states[i])
+			require.NoError(t, err)
+			require.NoError(t, db.SaveState(ctx, st, c.roots[i]))
+		}
+	}
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, c.roots[0]))
+
+	fork := makeChain(t, []types.Slot{3})
+	fork.blocks[0].Block.ParentRoot = c.roots[2][:]
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(fork.blocks[0])))
+
+	last := len(c.roots) - 1
+	finalized := &ethpb.Checkpoint{Epoch: 1 << 8, Root: c.roots[last][:]}
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, finalized))
+	return c
+}
+
+func TestExportImport_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	src := setupDB(t)
+	c := saveFinalizedChain(t, src)
+
+	lastEra, ok, err := LastFinalizedEra(ctx, src)
+	require.NoError(t, err)
+	require.Equal(t, true, ok)
+	require.Equal(t, uint64(0), lastEra)
+
+	paths, err := Export(ctx, src, dir, 0, 10, 2)
+	require.NoError(t, src.Close())
+	require.NoError(t, err)
+	require.DeepEqual(t, []string{filepath.Join(dir, FileName(0))}, paths)
+
+	f, err := Open(paths[0])
+	require.NoError(t, err)
+	assert.DeepEqual(t, []types.Slot{0, 1, 2, 4, 5}, f.BlockSlots())
+	assert.DeepEqual(t, []types.Slot{0, 2, 4}, f.StateSlots())
+	require.NoError(t, f.Close())
+
+	dst := setupDB(t)
+	defer func() {
+		require.NoError(t, dst.Close())
+	}()
+	stats, err := Import(ctx, dst, dir, c.roots[4])
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), stats.Blocks)
+	assert.Equal(t, uint64(3), stats.States)
+
+	for i := 0; i < len(c.roots)-1; i++ {
+		assert.Equal(t, true, dst.HasBlock(ctx, c.roots[i]))
+		assert.Equal(t, true, dst.HasStateSummary(ctx, c.roots[i]))
+	}
+	assert.Equal(t, true, dst.HasState(ctx, c.roots[3]))
+	assert.Equal(t, false, dst.HasState(ctx, c.roots[4]))
+
+	genesis, err := dst.GenesisBlock(ctx)
+	require.NoError(t, err)
+	genesisRoot, err := genesis.Block().HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, c.roots[0], genesisRoot)
+
+	finalized, err := dst.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, c.roots[3][:], finalized.Root)
+	assert.Equal(t, true, dst.IsFinalizedBlock(ctx, c.roots[2]))
+	head, err := dst.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(4), head.Block().Slot())
+}
+
+func TestExport_NotFinalized(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	_, err := Export(ctx, db, t.TempDir(), 0, 0, 2)
+	require.ErrorContains(t, "era 0 is not finalized yet", err)
+	_, err = Export(ctx, db, t.TempDir(), 1, 0, 2)
+	require.ErrorContains(t, "start era 1 is after end era 0", err)
+}
+
+// writeEraFile writes the blocks and states of the chain at the given indices to the era file of era 0.
+func writeEraFile(t *testing.T, dir string, c *chain, blocks, states []int) {
+	f, err := os.Create(filepath.Join(dir, FileName(0)))
+	require.NoError(t, err)
+	w, err := NewWriter(f, 0)
+	require.NoError(t, err)
+	for _, i := range blocks {
+		require.NoError(t, w.AddBlock(c.block(i)))
+	}
+	for _, i := range states {
+		require.NoError(t, w.AddState(c.state(t, i)))
+	}
+	require.NoError(t, w.Finish())
+	require.NoError(t, f.Close())
+}
+
+func TestImport_MissingParent(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	c := makeChain(t, []types.Slot{0, 1, 2})
+	writeEraFile(t, dir, c, []int{2}, nil)
+
+	db := setupDB(t)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	_, err := Import(ctx, db, dir, c.roots[2])
+	require.ErrorContains(t, "is not a finalized block of the database", err)
+
+	// A parent which is in the database but not finalized is not trusted either.
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(c.blocks[1])))
+	_, err = Import(ctx, db, dir, c.roots[2])
+	require.ErrorContains(t, "is not a finalized block of the database", err)
+	assert.Equal(t, false, db.HasBlock(ctx, c.roots[2]))
+}
+
+func TestImport_TrustedFinalizedRoot(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	c := makeChain(t, []types.Slot{0, 1, 2, 4})
+	writeEraFile(t, dir, c, []int{0, 1, 2, 3}, []int{0, 2, 3})
+
+	db := setupDB(t)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	_, err := Import(ctx, db, dir, [32]byte{})
+	require.ErrorContains(t, "the root of a trusted finalized block is required", err)
+	_, err = Import(ctx, db, dir, [32]byte{'a'})
+	require.ErrorContains(t, "is not in the era files", err)
+	// Nothing is saved when the trusted block is not found.
+	assert.Equal(t, false, db.HasBlock(ctx, c.roots[0]))
+
+	stats, err := Import(ctx, db, dir, c.roots[2])
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), stats.Blocks)
+	assert.Equal(t, uint64(2), stats.States)
+	// Blocks and states after the trusted block are not imported.
+	assert.Equal(t, false, db.HasBlock(ctx, c.roots[3]))
+	assert.Equal(t, false, db.HasState(ctx, c.roots[3]))
+	finalized, err := db.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, c.roots[2][:], finalized.Root)
+}
+
+func TestImport_GenesisMismatch(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	c := makeChain(t, []types.Slot{0, 1})
+	writeEraFile(t, dir, c, []int{0, 1}, nil)
+
+	db := setupDB(t)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	other := makeChain(t, []types.Slot{0})
+	other.blocks[0].Block.Body.Graffiti[0] = 'a'
+	otherRoot, err := other.blocks[0].Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(other.blocks[0])))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, otherRoot))
+
+	_, err = Import(ctx, db, dir, c.roots[1])
+	require.ErrorContains(t, "does not match the genesis block", err)
+
+	// A chain which builds on the genesis block of the database is accepted.
+	next := makeChain(t, []types.Slot{1})
+	next.blocks[0].Block.ParentRoot = otherRoot[:]
+	nextRoot, err := next.blocks[0].Block.HashTreeRoot()
+	require.NoError(t, err)
+	writeEraFile(t, dir, next, []int{0}, nil)
+	stats, err := Import(ctx, db, dir, nextRoot)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), stats.Blocks)
+}
@@ -0,0 +1,357 @@
+package era
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbIface "github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// ImportStats describes the data saved by Import.
+type ImportStats struct {
+	Blocks uint64
+	States uint64
+}
+
+// importer holds the progress of an import across era files.
+type importer struct {
+	db            dbIface.HeadAccessDatabase
+	stats         *ImportStats
+	finalizedRoot [32]byte
+	endSlot       types.Slot
+	prevRoot      [32]byte
+	prevSlot      types.Slot
+	hasPrev       bool
+	lastState     *ethpb.Checkpoint
+	lastSlot      types.Slot
+}
+
+// Files returns the paths of the era files in the directory, ordered by era.
+func Files(dir string) ([]string, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	paths := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), FileExtension) {
+			continue
+		}
+		paths = append(paths, filepath.Join(dir, e.Name()))
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// Import saves the blocks and states of the era files in the directory into the database. The
+// blocks of consecutive files must form a single chain which starts either at the genesis block
+// of the database, at genesis for an empty database, or at a finalized block of the database,
+// and which contains the trusted finalized block root given by the operator. As blocks are not
+// verified, the chain is only trusted because it leads to that root: nothing after the trusted
+// block is imported. Once imported, the block of the latest imported state becomes the finalized
+// checkpoint, unless the database already finalized a later one.
+func Import(ctx context.Context, db dbIface.HeadAccessDatabase, dir string, finalizedRoot [32]byte) (*ImportStats, error) {
+	ctx, span := trace.StartSpan(ctx, "era.Import")
+	defer span.End()
+
+	if finalizedRoot == params.BeaconConfig().ZeroHash {
+		return nil, errors.New("the root of a trusted finalized block is required")
+	}
+	paths, err := Files(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, errors.Errorf("no era files in %s", dir)
+	}
+	im := &importer{db: db, stats: &ImportStats{}, finalizedRoot: finalizedRoot}
+	// The chain is verified before anything is saved, so that untrusted blocks never reach the database.
+	if err := im.verifyChain(ctx, paths); err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		f, err := Open(path)
+		if err != nil {
+			return nil, err
+		}
+		if f.StartSlot() > im.endSlot {
+			if err := f.Close(); err != nil {
+				log.WithError(err).Error("Could not close era file")
+			}
+			break
+		}
+		err = im.importFile(ctx, f)
+		if closeErr := f.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close era file")
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not import %s", path)
+		}
+		log.WithField("path", path).Info("Imported era file")
+	}
+	if err := im.finalize(ctx); err != nil {
+		return nil, err
+	}
+	return im.stats, nil
+}
+
+// verifyChain checks that the blocks of the era files form a single chain from a trusted parent
+// up to the trusted finalized block, and sets the slot of that block as the end of the import.
+func (im *importer) verifyChain(ctx context.Context, paths []string) error {
+	defer func() {
+		im.prevRoot, im.prevSlot, im.hasPrev = [32]byte{}, 0, false
+	}()
+	for _, path := range paths {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		f, err := Open(path)
+		if err != nil {
+			return err
+		}
+		found, err := im.verifyFile(ctx, f)
+		if closeErr := f.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close era file")
+		}
+		if err != nil {
+			return errors.Wrapf(err, "could not verify %s", path)
+		}
+		if found {
+			return nil
+		}
+	}
+	return errors.Errorf("trusted finalized block %#x is not in the era files", im.finalizedRoot)
+}
+
+// verifyFile checks the parent of every block of the file, and returns true once it reaches the
+// trusted finalized block.
+func (im *importer) verifyFile(ctx context.Context, f *File) (bool, error) {
+	for _, slot := range f.BlockSlots() {
+		blk, err := f.Block(slot)
+		if err != nil {
+			return false, err
+		}
+		if blk.Block().Slot() != slot {
+			return false, errors.Errorf("block at slot %d is indexed at slot %d", blk.Block().Slot(), slot)
+		}
+		root, err := blk.Block().HashTreeRoot()
+		if err != nil {
+			return false, err
+		}
+		if err := im.checkParent(ctx, blk, root); err != nil {
+			return false, err
+		}
+		im.prevRoot, im.prevSlot, im.hasPrev = root, slot, true
+		if root == im.finalizedRoot {
+			im.endSlot = slot
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (im *importer) importFile(ctx context.Context, f *File) error {
+	// Block roots by slot of this file, to find the block root of every state.
+	roots := make(map[types.Slot][32]byte)
+	blks := make([]interfaces.SignedBeaconBlock, 0)
+	summaries := make([]*pb.StateSummary, 0)
+	var genesisRoot *[32]byte
+	// The latest block before this file, for states at the start of the era which follow skipped slots.
+	prevFileRoot, hasPrevFile := im.prevRoot, im.hasPrev
+	for _, slot := range f.BlockSlots() {
+		if slot > im.endSlot {
+			break
+		}
+		blk, err := f.Block(slot)
+		if err != nil {
+			return err
+		}
+		root, err := blk.Block().HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		if slot == 0 {
+			genesisRoot = &root
+		}
+		im.prevRoot, im.prevSlot, im.hasPrev = root, slot, true
+		roots[slot] = root
+		blks = append(blks, blk)
+		summaries = append(summaries, &pb.StateSummary{Slot: slot, Root: root[:]})
+	}
+	if err := im.db.SaveBlocks(ctx, blks); err != nil {
+		return errors.Wrap(err, "could not save blocks")
+	}
+	if err := im.db.SaveStateSummaries(ctx, summaries); err != nil {
+		return errors.Wrap(err, "could not save state summaries")
+	}
+	im.stats.Blocks += uint64(len(blks))
+	if genesisRoot != nil {
+		genesis, err := im.db.GenesisBlock(ctx)
+		if err != nil {
+			return err
+		}
+		if genesis == nil || genesis.IsNil() {
+			if err := im.db.SaveGenesisBlockRoot(ctx, *genesisRoot); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, slot := range f.StateSlots() {
+		if slot > im.endSlot {
+			break
+		}
+		st, err := f.State(slot)
+		if err != nil {
+			return err
+		}
+		root, ok := blockRootAt(roots, f.StartSlot(), slot)
+		if !ok {
+			if !hasPrevFile {
+				continue
+			}
+			root = prevFileRoot
+		}
+		if err := checkStateMatchesBlock(ctx, st, root); err != nil {
+			return errors.Wrapf(err, "state at slot %d", slot)
+		}
+		if err := im.db.SaveState(ctx, st, root); err != nil {
+			return errors.Wrap(err, "could not save state")
+		}
+		im.stats.States++
+		im.lastState = &ethpb.Checkpoint{Epoch: helpers.SlotToEpoch(slot), Root: bytesutil.SafeCopyBytes(root[:])}
+		im.lastSlot = slot
+	}
+	return nil
+}
+
+// checkParent ensures the block builds on the previous block of the era files. The first block
+// must either be the genesis block of the database, or genesis if the database has none, or build
+// on the genesis, origin or a finalized block of the database.
+func (im *importer) checkParent(ctx context.Context, blk interfaces.SignedBeaconBlock, root [32]byte) error {
+	slot := blk.Block().Slot()
+	parent := bytesutil.ToBytes32(blk.Block().ParentRoot())
+	if im.hasPrev {
+		if parent != im.prevRoot || slot <= im.prevSlot {
+			return errors.Errorf("block at slot %d does not build on the block at slot %d", slot, im.prevSlot)
+		}
+		return nil
+	}
+	genesis, err := im.db.GenesisBlock(ctx)
+	if err != nil {
+		return err
+	}
+	var genesisRoot [32]byte
+	hasGenesis := genesis != nil && !genesis.IsNil()
+	if hasGenesis {
+		genesisRoot, err = genesis.Block().HashTreeRoot()
+		if err != nil {
+			return err
+		}
+	}
+	if slot == 0 {
+		if hasGenesis && root != genesisRoot {
+			return errors.Errorf("genesis block %#x does not match the genesis block %#x of the database", root, genesisRoot)
+		}
+		return nil
+	}
+	if hasGenesis && parent == genesisRoot {
+		return nil
+	}
+	originRoot, err := im.db.OriginBlockRoot(ctx)
+	if err != nil && !errors.Is(err, dbIface.ErrNotFoundOriginBlockRoot) {
+		return err
+	}
+	if err == nil && parent == originRoot {
+		return nil
+	}
+	if im.db.IsFinalizedBlock(ctx, parent) {
+		return nil
+	}
+	return errors.Errorf("parent %#x of the first block at slot %d is not a finalized block of the database", parent, slot)
+}
+
+// finalize makes the block of the latest imported state the finalized and justified checkpoint and
+// the head, if they are behind it.
+func (im *importer) finalize(ctx context.Context) error {
+	if im.lastState == nil {
+		return nil
+	}
+	finalized, err := im.db.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(finalized.Root, params.BeaconConfig().ZeroHash[:]) && finalized.Epoch >= im.lastState.Epoch {
+		return nil
+	}
+	if err := im.db.SaveJustifiedCheckpoint(ctx, im.lastState); err != nil {
+		return errors.Wrap(err, "could not save justified checkpoint")
+	}
+	if err := im.db.SaveFinalizedCheckpoint(ctx, im.lastState); err != nil {
+		return errors.Wrap(err, "could not save finalized checkpoint")
+	}
+	head, err := im.db.HeadBlock(ctx)
+	if err != nil {
+		return err
+	}
+	if head == nil || head.IsNil() || head.Block().Slot() < im.lastSlot {
+		if err := im.db.SaveHeadBlockRoot(ctx, bytesutil.ToBytes32(im.lastState.Root)); err != nil {
+			return errors.Wrap(err, "could not save head block root")
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"epoch": im.lastState.Epoch,
+		"root":  bytesutil.Trunc(im.lastState.Root),
+	}).Info("Updated finalized checkpoint from era files")
+	return nil
+}
+
+// blockRootAt returns the root of the latest block at or before the slot within the era.
+func blockRootAt(roots map[types.Slot][32]byte, start, slot types.Slot) ([32]byte, bool) {
+	for s := slot; ; s-- {
+		if r, ok := roots[s]; ok {
+			return r, true
+		}
+		if s == start {
+			return [32]byte{}, false
+		}
+	}
+}
+
+// checkStateMatchesBlock ensures the latest block header of the state is the block of the root.
+func checkStateMatchesBlock(ctx context.Context, st iface.BeaconState, root [32]byte) error {
+	header := st.LatestBlockHeader()
+	if bytes.Equal(header.StateRoot, params.BeaconConfig().ZeroHash[:]) {
+		stateRoot, err := st.HashTreeRoot(ctx)
+		if err != nil {
+			return err
+		}
+		header.StateRoot = stateRoot[:]
+	}
+	headerRoot, err := header.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	if headerRoot != root {
+		return errors.Errorf("latest block header %#x does not match block %#x", headerRoot, root)
+	}
+	return nil
+}
@@ -0,0 +1,244 @@
+package era
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	v2 "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/version"
+)
+
+// File gives access to the blocks and states of an era file.
+type File struct {
+	r            io.ReaderAt
+	closer       io.Closer
+	startSlot    types.Slot
+	blockOffsets []int64
+	stateOffsets []int64
+}
+
+// Open opens the era file at the path.
+func Open(path string) (*File, error) {
+	f, err := os.Open(path) // #nosec G304
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return nil, closeOnError(f, err)
+	}
+	file, err := NewFile(f, info.Size())
+	if err != nil {
+		return nil, closeOnError(f, errors.Wrapf(err, "could not read era file %s", path))
+	}
+	file.closer = f
+	return file, nil
+}
+
+// NewFile reads the slot indices of the era file of the given size held by r.
+func NewFile(r io.ReaderAt, size int64) (*File, error) {
+	if _, err := readRecord(r, 0, typeVersion); err != nil {
+		return nil, errors.Wrap(err, "could not read version record")
+	}
+	stateIndexOffset, stateStart, stateOffsets, err := readSlotIndex(r, size)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read state index")
+	}
+	_, blockStart, blockOffsets, err := readSlotIndex(r, stateIndexOffset)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read block index")
+	}
+	if blockStart != stateStart || len(blockOffsets) != len(stateOffsets) {
+		return nil, errors.New("block and state indices do not cover the same slots")
+	}
+	return &File{
+		r:            r,
+		startSlot:    blockStart,
+		blockOffsets: blockOffsets,
+		stateOffsets: stateOffsets,
+	}, nil
+}
+
+// Close closes the underlying file.
+func (f *File) Close() error {
+	if f.closer == nil {
+		return nil
+	}
+	return f.closer.Close()
+}
+
+// StartSlot returns the first slot covered by the era file.
+func (f *File) StartSlot() types.Slot {
+	return f.startSlot
+}
+
+// EndSlot returns the last slot covered by the era file.
+func (f *File) EndSlot() types.Slot {
+	return f.startSlot.Add(uint64(len(f.blockOffsets))) - 1
+}
+
+// Block returns the block at the slot, or ErrNotFound if the slot has no block.
+func (f *File) Block(slot types.Slot) (interfaces.SignedBeaconBlock, error) {
+	offset, err := f.offset(f.blockOffsets, slot)
+	if err != nil {
+		return nil, err
+	}
+	v, enc, err := readCompressed(f.r, offset, typeBlock)
+	if err != nil {
+		return nil, err
+	}
+	var blk interfaces.SignedBeaconBlock
+	switch v {
+	case version.Phase0:
+		b := &ethpb.SignedBeaconBlock{}
+		if err := b.UnmarshalSSZ(enc); err != nil {
+			return nil, errors.Wrapf(err, "could not unmarshal block at slot %d", slot)
+		}
+		blk = wrapper.WrappedPhase0SignedBeaconBlock(b)
+	default:
+		b := &prysmv2.SignedBeaconBlockAltair{}
+		if err := b.UnmarshalSSZ(enc); err != nil {
+			return nil, errors.Wrapf(err, "could not unmarshal block at slot %d", slot)
+		}
+		blk, err = wrapper.WrappedAltairSignedBeaconBlock(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if blk.IsNil() || blk.Block().IsNil() {
+		return nil, errors.Errorf("nil block at slot %d", slot)
+	}
+	return blk, nil
+}
+
+// State returns the state at the slot, or ErrNotFound if the file holds no state for the slot.
+func (f *File) State(slot types.Slot) (iface.BeaconState, error) {
+	offset, err := f.offset(f.stateOffsets, slot)
+	if err != nil {
+		return nil, err
+	}
+	v, enc, err := readCompressed(f.r, offset, typeState)
+	if err != nil {
+		return nil, err
+	}
+	switch v {
+	case version.Phase0:
+		st := &pb.BeaconState{}
+		if err := st.UnmarshalSSZ(enc); err != nil {
+			return nil, errors.Wrapf(err, "could not unmarshal state at slot %d", slot)
+		}
+		return v1.InitializeFromProtoUnsafe(st)
+	default:
+		st := &pb.BeaconStateAltair{}
+		if err := st.UnmarshalSSZ(enc); err != nil {
+			return nil, errors.Wrapf(err, "could not unmarshal state at slot %d", slot)
+		}
+		return v2.InitializeFromProtoUnsafe(st)
+	}
+}
+
+// BlockSlots returns the slots of the blocks in the era file.
+func (f *File) BlockSlots() []types.Slot {
+	return f.slots(f.blockOffsets)
+}
+
+// StateSlots returns the slots of the states in the era file.
+func (f *File) StateSlots() []types.Slot {
+	return f.slots(f.stateOffsets)
+}
+
+func (f *File) slots(offsets []int64) []types.Slot {
+	slots := make([]types.Slot, 0)
+	for i, o := range offsets {
+		if o != 0 {
+			slots = append(slots, f.startSlot.Add(uint64(i)))
+		}
+	}
+	return slots
+}
+
+func (f *File) offset(offsets []int64, slot types.Slot) (int64, error) {
+	if slot < f.startSlot || slot > f.EndSlot() {
+		return 0, ErrNotFound
+	}
+	offset := offsets[slot.SubSlot(f.startSlot)]
+	if offset == 0 {
+		return 0, ErrNotFound
+	}
+	return offset, nil
+}
+
+// readSlotIndex reads the slot index record ending at the given position. It returns the offset
+// of the record, the start slot of the index and its offsets.
+func readSlotIndex(r io.ReaderAt, end int64) (int64, types.Slot, []int64, error) {
+	if end < headerLength+16 {
+		return 0, 0, nil, errors.New("file too short")
+	}
+	buf := make([]byte, 8)
+	if _, err := r.ReadAt(buf, end-8); err != nil {
+		return 0, 0, nil, err
+	}
+	count := binary.LittleEndian.Uint64(buf)
+	length := headerLength + 8*(int64(count)+2)
+	if count > uint64(end) || length > end {
+		return 0, 0, nil, errors.Errorf("invalid slot index count %d", count)
+	}
+	offset := end - length
+	data, err := readRecord(r, offset, typeSlotIndex)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	if int64(len(data)) != length-headerLength {
+		return 0, 0, nil, errors.Errorf("invalid slot index length %d", len(data))
+	}
+	offsets := make([]int64, count)
+	for i := range offsets {
+		o := int64(binary.LittleEndian.Uint64(data[8*(i+1):]))
+		if o < 0 || o >= offset {
+			return 0, 0, nil, errors.Errorf("invalid offset %d in slot index", o)
+		}
+		offsets[i] = o
+	}
+	return offset, types.Slot(binary.LittleEndian.Uint64(data)), offsets, nil
+}
+
+// readCompressed reads the block or state record at the offset and returns the version of its
+// fork along with its SSZ encoding.
+func readCompressed(r io.ReaderAt, offset int64, typ [2]byte) (int, []byte, error) {
+	data, err := readRecord(r, offset, typ)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(data) < forkVersionLength {
+		return 0, nil, errors.Errorf("record at offset %d is too short", offset)
+	}
+	v, err := versionOfFork(data[:forkVersionLength])
+	if err != nil {
+		return 0, nil, errors.Wrapf(err, "record at offset %d", offset)
+	}
+	enc, err := ioutil.ReadAll(snappy.NewReader(bytes.NewReader(data[forkVersionLength:])))
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "could not decompress record")
+	}
+	return v, enc, nil
+}
+
+func closeOnError(c io.Closer, err error) error {
+	if closeErr := c.Close(); closeErr != nil {
+		log.WithError(closeErr).Error("Could not close era file")
+	}
+	return err
+}
@@ -0,0 +1,159 @@
+package era
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+)
+
+// Writer writes the records of a single era file. Blocks and states must be added in increasing
+// slot order, all blocks before the states, and Finish must be called once all are added.
+type Writer struct {
+	w            io.Writer
+	era          uint64
+	offset       int64
+	blockOffsets []int64
+	stateOffsets []int64
+	lastSlot     types.Slot
+	hasRecord    bool
+	writingState bool
+	finished     bool
+}
+
+// NewWriter writes the version record of an era file to w and returns a Writer for the rest of it.
+func NewWriter(w io.Writer, era uint64) (*Writer, error) {
+	n, err := writeRecord(w, typeVersion, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not write version record")
+	}
+	return &Writer{
+		w:            w,
+		era:          era,
+		offset:       int64(n),
+		blockOffsets: make([]int64, SlotsPerEra()),
+		stateOffsets: make([]int64, SlotsPerEra()),
+	}, nil
+}
+
+// AddBlock adds a block of the era.
+func (w *Writer) AddBlock(blk interfaces.SignedBeaconBlock) error {
+	if blk == nil || blk.IsNil() || blk.Block().IsNil() {
+		return errors.New("nil block")
+	}
+	if w.writingState {
+		return errors.New("blocks must be added before states")
+	}
+	idx, err := w.index(blk.Block().Slot())
+	if err != nil {
+		return err
+	}
+	fork, err := forkVersion(blk.Version())
+	if err != nil {
+		return err
+	}
+	enc, err := blk.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "could not marshal block")
+	}
+	offset, err := w.writeCompressed(typeBlock, fork, enc)
+	if err != nil {
+		return err
+	}
+	w.blockOffsets[idx] = offset
+	return nil
+}
+
+// AddState adds a state of the era.
+func (w *Writer) AddState(st iface.BeaconState) error {
+	if st == nil || st.IsNil() {
+		return errors.New("nil state")
+	}
+	if !w.writingState {
+		w.writingState, w.hasRecord = true, false
+	}
+	idx, err := w.index(st.Slot())
+	if err != nil {
+		return err
+	}
+	fork, err := forkVersion(st.Version())
+	if err != nil {
+		return err
+	}
+	enc, err := st.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "could not marshal state")
+	}
+	offset, err := w.writeCompressed(typeState, fork, enc)
+	if err != nil {
+		return err
+	}
+	w.stateOffsets[idx] = offset
+	return nil
+}
+
+// Finish writes the slot indices which complete the era file.
+func (w *Writer) Finish() error {
+	if w.finished {
+		return errors.New("era file already finished")
+	}
+	w.finished = true
+	if _, err := writeRecord(w.w, typeSlotIndex, w.slotIndex(w.blockOffsets)); err != nil {
+		return errors.Wrap(err, "could not write block index")
+	}
+	if _, err := writeRecord(w.w, typeSlotIndex, w.slotIndex(w.stateOffsets)); err != nil {
+		return errors.Wrap(err, "could not write state index")
+	}
+	return nil
+}
+
+// index checks that a record at the slot can be added and returns its position in the slot index.
+func (w *Writer) index(slot types.Slot) (uint64, error) {
+	if w.finished {
+		return 0, errors.New("era file already finished")
+	}
+	if EraOfSlot(slot) != w.era {
+		return 0, errors.Errorf("slot %d is not in era %d", slot, w.era)
+	}
+	if w.hasRecord && slot <= w.lastSlot {
+		return 0, errors.Errorf("slot %d is not after the previous slot %d", slot, w.lastSlot)
+	}
+	w.lastSlot, w.hasRecord = slot, true
+	return uint64(slot.SubSlot(StartSlot(w.era))), nil
+}
+
+func (w *Writer) writeCompressed(typ [2]byte, forkVersion, enc []byte) (int64, error) {
+	if len(forkVersion) != forkVersionLength {
+		return 0, errors.Errorf("invalid fork version %#x", forkVersion)
+	}
+	buf := bytes.NewBuffer(append([]byte{}, forkVersion...))
+	sw := snappy.NewBufferedWriter(buf)
+	if _, err := sw.Write(enc); err != nil {
+		return 0, errors.Wrap(err, "could not compress record")
+	}
+	if err := sw.Close(); err != nil {
+		return 0, errors.Wrap(err, "could not compress record")
+	}
+	offset := w.offset
+	n, err := writeRecord(w.w, typ, buf.Bytes())
+	if err != nil {
+		return 0, err
+	}
+	w.offset += int64(n)
+	return offset, nil
+}
+
+func (w *Writer) slotIndex(offsets []int64) []byte {
+	data := make([]byte, 8*(len(offsets)+2))
+	binary.LittleEndian.PutUint64(data, uint64(StartSlot(w.era)))
+	for i, o := range offsets {
+		binary.LittleEndian.PutUint64(data[8*(i+1):], uint64(o))
+	}
+	binary.LittleEndian.PutUint64(data[8*(len(offsets)+1):], uint64(len(offsets)))
+	return data
+}
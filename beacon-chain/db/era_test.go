@@ -0,0 +1,35 @@
+package db
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/urfave/cli/v2"
+)
+
+func eraCliContext(t *testing.T, dataDir, eraDir string) *cli.Context {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, "", "")
+	set.String(flags.EraDir.Name, "", "")
+	set.Int(flags.SlotsPerArchivedPoint.Name, 2048, "")
+	require.NoError(t, set.Set(cmd.DataDirFlag.Name, dataDir))
+	require.NoError(t, set.Set(flags.EraDir.Name, eraDir))
+	return cli.NewContext(&app, set, nil)
+}
+
+func TestExportEra_NotFinalized(t *testing.T) {
+	dataDir, _ := setupOfflineDB(t)
+	require.ErrorContains(t, "--era-dir is required", ExportEra(eraCliContext(t, dataDir, "")))
+	err := ExportEra(eraCliContext(t, dataDir, t.TempDir()))
+	require.ErrorContains(t, "era 0 is not finalized yet", err)
+}
+
+func TestImportEra_NoFiles(t *testing.T) {
+	eraDir := t.TempDir()
+	err := ImportEra(eraCliContext(t, t.TempDir(), eraDir))
+	require.ErrorContains(t, "no era files in "+eraDir, err)
+}
@@ -8,4 +8,12 @@ var (
 	// ErrExistingGenesisState is an error when the user attempts to save a different genesis state
 	// when one already exists in a database.
 	ErrExistingGenesisState = errors.New("genesis state exists already in the DB")
+
+	// ErrNotFoundOriginBlockRoot is an error when the database was not initialized from a checkpoint
+	// sync origin, so no origin block root is stored.
+	ErrNotFoundOriginBlockRoot = errors.New("origin checkpoint block root not found in the DB")
+
+	// ErrNotFoundBackfillBlockRoot is an error when no backfill progress is stored, as the database
+	// was not initialized from a checkpoint sync origin.
+	ErrNotFoundBackfillBlockRoot = errors.New("backfill block root not found in the DB")
 )
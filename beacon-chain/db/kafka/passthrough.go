@@ -139,6 +139,26 @@ func (e Exporter) SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte)
 	return e.db.SaveGenesisBlockRoot(ctx, blockRoot)
 }
 
+// OriginBlockRoot -- passthrough.
+func (e Exporter) OriginBlockRoot(ctx context.Context) ([32]byte, error) {
+	return e.db.OriginBlockRoot(ctx)
+}
+
+// SaveOriginBlockRoot -- passthrough.
+func (e Exporter) SaveOriginBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	return e.db.SaveOriginBlockRoot(ctx, blockRoot)
+}
+
+// BackfillBlockRoot -- passthrough.
+func (e Exporter) BackfillBlockRoot(ctx context.Context) ([32]byte, error) {
+	return e.db.BackfillBlockRoot(ctx)
+}
+
+// SaveBackfillBlockRoot -- passthrough.
+func (e Exporter) SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	return e.db.SaveBackfillBlockRoot(ctx, blockRoot)
+}
+
 // SaveState -- passthrough.
 func (e Exporter) SaveState(ctx context.Context, st iface.ReadOnlyBeaconState, blockRoot [32]byte) error {
 	return e.db.SaveState(ctx, st, blockRoot)
@@ -229,6 +249,16 @@ func (e Exporter) SavePowchainData(ctx context.Context, data *db.ETH1ChainData)
 	return e.db.SavePowchainData(ctx, data)
 }
 
+// DepositSnapshot -- passthrough
+func (e Exporter) DepositSnapshot(ctx context.Context) (*db.DepositSnapshot, error) {
+	return e.db.DepositSnapshot(ctx)
+}
+
+// SaveDepositSnapshot -- passthrough
+func (e Exporter) SaveDepositSnapshot(ctx context.Context, snapshot *db.DepositSnapshot) error {
+	return e.db.SaveDepositSnapshot(ctx, snapshot)
+}
+
 // ArchivedPointRoot -- passthrough
 func (e Exporter) ArchivedPointRoot(ctx context.Context, index types.Slot) [32]byte {
 	return e.db.ArchivedPointRoot(ctx, index)
@@ -254,6 +284,26 @@ func (e Exporter) HighestSlotStatesBelow(ctx context.Context, slot types.Slot) (
 	return e.db.HighestSlotStatesBelow(ctx, slot)
 }
 
+// HierarchicalState -- passthrough
+func (e Exporter) HierarchicalState(ctx context.Context, slot types.Slot) ([]byte, error) {
+	return e.db.HierarchicalState(ctx, slot)
+}
+
+// HasHierarchicalState -- passthrough
+func (e Exporter) HasHierarchicalState(ctx context.Context, slot types.Slot) bool {
+	return e.db.HasHierarchicalState(ctx, slot)
+}
+
+// LowestHierarchicalStateSlot -- passthrough
+func (e Exporter) LowestHierarchicalStateSlot(ctx context.Context) (types.Slot, bool, error) {
+	return e.db.LowestHierarchicalStateSlot(ctx)
+}
+
+// SaveHierarchicalState -- passthrough
+func (e Exporter) SaveHierarchicalState(ctx context.Context, slot types.Slot, enc []byte) error {
+	return e.db.SaveHierarchicalState(ctx, slot, enc)
+}
+
 // LastArchivedSlot -- passthrough
 func (e Exporter) LastArchivedSlot(ctx context.Context) (types.Slot, error) {
 	return e.db.LastArchivedSlot(ctx)
@@ -283,3 +333,8 @@ func (e Exporter) SaveGenesisData(ctx context.Context, state iface.BeaconState)
 func (e Exporter) EnsureEmbeddedGenesis(ctx context.Context) error {
 	return e.db.EnsureEmbeddedGenesis(ctx)
 }
+
+// SaveOrigin -- passthrough.
+func (e Exporter) SaveOrigin(ctx context.Context, serState, serBlock []byte) error {
+	return e.db.SaveOrigin(ctx, serState, serBlock)
+}
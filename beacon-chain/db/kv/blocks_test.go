@@ -10,14 +10,40 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
 	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/version"
 	"google.golang.org/protobuf/proto"
 )
 
+// altairBlock returns an empty Altair block at the slot, with its fields sized for SSZ encoding.
+func altairBlock(slot types.Slot) *prysmv2.SignedBeaconBlockAltair {
+	return &prysmv2.SignedBeaconBlockAltair{
+		Block: &prysmv2.BeaconBlockAltair{
+			Slot:       slot,
+			ParentRoot: make([]byte, 32),
+			StateRoot:  make([]byte, 32),
+			Body: &prysmv2.BeaconBlockBodyAltair{
+				RandaoReveal: make([]byte, 96),
+				Eth1Data: &ethpb.Eth1Data{
+					DepositRoot: make([]byte, 32),
+					BlockHash:   make([]byte, 32),
+				},
+				Graffiti: make([]byte, 32),
+				SyncAggregate: &prysmv2.SyncAggregate{
+					SyncCommitteeBits:      make([]byte, params.BeaconConfig().SyncCommitteeSize/8),
+					SyncCommitteeSignature: make([]byte, 96),
+				},
+			},
+		},
+		Signature: make([]byte, 96),
+	}
+}
+
 func TestStore_SaveBlock_NoDuplicates(t *testing.T) {
 	BlockCacheSize = 1
 	db := setupDB(t)
@@ -67,6 +93,44 @@ func TestStore_BlocksCRUD(t *testing.T) {
 	assert.Equal(t, false, db.HasBlock(ctx, blockRoot), "Expected block to have been deleted from the db")
 }
 
+func TestStore_AltairBlocksCRUD(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	phase0Block := testutil.NewBeaconBlock()
+	phase0Block.Block.Slot = 19
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(phase0Block)))
+	blk, err := wrapper.WrappedAltairSignedBeaconBlock(altairBlock(20))
+	require.NoError(t, err)
+	blockRoot, err := blk.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, blk))
+
+	// Read the block from the database rather than from the block cache.
+	db.blockCache.Clear()
+	retrieved, err := db.Block(ctx, blockRoot)
+	require.NoError(t, err)
+	assert.Equal(t, version.Altair, retrieved.Version())
+	assert.Equal(t, true, proto.Equal(blk.Proto(), retrieved.Proto()), "Wanted: %v, received: %v", blk, retrieved)
+
+	blks, roots, err := db.Blocks(ctx, filters.NewFilter().SetStartSlot(19).SetEndSlot(20))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(blks))
+	assert.Equal(t, version.Phase0, blks[0].Version())
+	assert.Equal(t, version.Altair, blks[1].Version())
+	assert.Equal(t, blockRoot, roots[1])
+	hasBlocks, blks, err := db.BlocksBySlot(ctx, 20)
+	require.NoError(t, err)
+	require.Equal(t, true, hasBlocks)
+	assert.Equal(t, true, proto.Equal(blk.Proto(), blks[0].Proto()))
+
+	require.NoError(t, db.deleteBlock(ctx, blockRoot))
+	assert.Equal(t, false, db.HasBlock(ctx, blockRoot))
+	hasBlocks, _, err = db.BlocksBySlot(ctx, 20)
+	require.NoError(t, err)
+	assert.Equal(t, false, hasBlocks)
+}
+
 func TestStore_BlocksBatchDelete(t *testing.T) {
 	db := setupDB(t)
 	ctx := context.Background()
@@ -1,6 +1,7 @@
 package kv
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"reflect"
@@ -9,6 +10,7 @@ import (
 	"github.com/golang/snappy"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	"go.opencensus.io/trace"
 	"google.golang.org/protobuf/proto"
 )
@@ -55,8 +57,12 @@ func isSSZStorageFormat(obj interface{}) bool {
 	switch obj.(type) {
 	case *pb.BeaconState:
 		return true
+	case *pb.BeaconStateAltair:
+		return true
 	case *ethpb.SignedBeaconBlock:
 		return true
+	case *prysmv2.SignedBeaconBlockAltair:
+		return true
 	case *ethpb.SignedAggregateAttestationAndProof:
 		return true
 	case *ethpb.BeaconBlock:
@@ -71,7 +77,21 @@ func isSSZStorageFormat(obj interface{}) bool {
 		return true
 	case *ethpb.VoluntaryExit:
 		return true
+	case *ethpb.Validator:
+		return true
 	default:
 		return false
 	}
 }
+
+// withAltairKey prefixes an encoded Altair object with altairKey.
+func withAltairKey(enc []byte) []byte {
+	prefixed := make([]byte, 0, len(altairKey)+len(enc))
+	prefixed = append(prefixed, altairKey...)
+	return append(prefixed, enc...)
+}
+
+// hasAltairKey checks whether an encoded object is an Altair object.
+func hasAltairKey(enc []byte) bool {
+	return bytes.HasPrefix(enc, altairKey)
+}
@@ -48,6 +48,7 @@ func (s *Store) updateFinalizedBlockRoots(ctx context.Context, tx *bolt.Tx, chec
 	root := checkpoint.Root
 	var previousRoot []byte
 	genesisRoot := tx.Bucket(blocksBucket).Get(genesisBlockRootKey)
+	originRoot := tx.Bucket(blocksBucket).Get(originBlockRootKey)
 
 	// De-index recent finalized block roots, to be re-indexed.
 	previousFinalizedCheckpoint := &ethpb.Checkpoint{}
@@ -107,6 +108,11 @@ func (s *Store) updateFinalizedBlockRoots(ctx context.Context, tx *bolt.Tx, chec
 			return err
 		}
 
+		// Blocks older than a checkpoint sync origin are not in the database.
+		if originRoot != nil && bytes.Equal(root, originRoot) {
+			break
+		}
+
 		// Found parent, loop exit condition.
 		if parentBytes := bkt.Get(block.ParentRoot()); parentBytes != nil {
 			parent := &dbpb.FinalizedBlockRootContainer{}
@@ -188,7 +194,7 @@ func (s *Store) FinalizedChildBlock(ctx context.Context, blockRoot [32]byte) (in
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.FinalizedChildBlock")
 	defer span.End()
 
-	var blk *ethpb.SignedBeaconBlock
+	var blk interfaces.SignedBeaconBlock = wrapper.WrappedPhase0SignedBeaconBlock(nil)
 	err := s.db.View(func(tx *bolt.Tx) error {
 		blkBytes := tx.Bucket(finalizedBlockRootsIndexBucket).Get(blockRoot[:])
 		if blkBytes == nil {
@@ -206,9 +212,10 @@ func (s *Store) FinalizedChildBlock(ctx context.Context, blockRoot [32]byte) (in
 		if enc == nil {
 			return nil
 		}
-		blk = &ethpb.SignedBeaconBlock{}
-		return decode(ctx, enc, blk)
+		var err error
+		blk, err = unmarshalBlock(ctx, enc)
+		return err
 	})
 	traceutil.AnnotateError(span, err)
-	return wrapper.WrappedPhase0SignedBeaconBlock(blk), err
+	return blk, err
 }
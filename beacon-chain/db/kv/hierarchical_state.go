@@ -0,0 +1,79 @@
+package kv
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// HierarchicalState returns the encoded hierarchical state entry saved at the given slot,
+// or nil if there is none. The entry is either a full state snapshot or a diff against
+// the entry of an earlier slot, its encoding is owned by the state generator.
+func (s *Store) HierarchicalState(ctx context.Context, slot types.Slot) ([]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.HierarchicalState")
+	defer span.End()
+
+	var enc []byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(hierarchicalStateBucket)
+		v := bkt.Get(bytesutil.SlotToBytesBigEndian(slot))
+		if v == nil {
+			return nil
+		}
+		enc = make([]byte, len(v))
+		copy(enc, v)
+		return nil
+	})
+	return enc, err
+}
+
+// HasHierarchicalState returns true if a hierarchical state entry exists at the given slot.
+func (s *Store) HasHierarchicalState(ctx context.Context, slot types.Slot) bool {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.HasHierarchicalState")
+	defer span.End()
+
+	var exists bool
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(hierarchicalStateBucket)
+		exists = bkt.Get(bytesutil.SlotToBytesBigEndian(slot)) != nil
+		return nil
+	}); err != nil { // This view never returns an error, but we'll handle anyway for sanity.
+		panic(err)
+	}
+	return exists
+}
+
+// LowestHierarchicalStateSlot returns the lowest slot with a hierarchical state entry.
+// It returns false if no entry has been saved yet.
+func (s *Store) LowestHierarchicalStateSlot(ctx context.Context) (types.Slot, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.LowestHierarchicalStateSlot")
+	defer span.End()
+
+	var slot types.Slot
+	var exists bool
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(hierarchicalStateBucket)
+		k, _ := bkt.Cursor().First()
+		if k == nil {
+			return nil
+		}
+		slot = bytesutil.BytesToSlotBigEndian(k)
+		exists = true
+		return nil
+	})
+	return slot, exists, err
+}
+
+// SaveHierarchicalState saves an encoded hierarchical state entry at the given slot.
+func (s *Store) SaveHierarchicalState(ctx context.Context, slot types.Slot, enc []byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveHierarchicalState")
+	defer span.End()
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(hierarchicalStateBucket)
+		return bkt.Put(bytesutil.SlotToBytesBigEndian(slot), enc)
+	})
+}
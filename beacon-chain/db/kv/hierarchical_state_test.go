@@ -0,0 +1,36 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestHierarchicalState_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	_, exists, err := db.LowestHierarchicalStateSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, false, exists, "Should not have a lowest slot")
+	enc, err := db.HierarchicalState(ctx, 64)
+	require.NoError(t, err)
+	assert.Equal(t, true, enc == nil, "Should not have been saved")
+	assert.Equal(t, false, db.HasHierarchicalState(ctx, 64))
+
+	require.NoError(t, db.SaveHierarchicalState(ctx, 64, []byte{'A'}))
+	require.NoError(t, db.SaveHierarchicalState(ctx, 32, []byte{'B'}))
+	require.NoError(t, db.SaveHierarchicalState(ctx, 96, []byte{'C'}))
+
+	assert.Equal(t, true, db.HasHierarchicalState(ctx, 64))
+	enc, err = db.HierarchicalState(ctx, 64)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{'A'}, enc)
+	slot, exists, err := db.LowestHierarchicalStateSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists, "Should have a lowest slot")
+	assert.Equal(t, types.Slot(32), slot)
+}
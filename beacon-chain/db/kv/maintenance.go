@@ -0,0 +1,410 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// The methods in this file are meant for offline maintenance of the database of a stopped node.
+
+// compactTxMaxSize is the amount of data copied in a single transaction when compacting the database.
+const compactTxMaxSize = 64 * 1024 * 1024
+
+// BucketStats describes the contents of a single bucket of the database.
+type BucketStats struct {
+	Name         string
+	Rows         uint64
+	KeysSize     uint64
+	ValuesSize   uint64
+	MaxValueSize uint64
+}
+
+// PruneStats describes the data removed by PruneBeforeSlot.
+type PruneStats struct {
+	BlocksDeleted uint64
+	StatesDeleted uint64
+}
+
+// FinalizedChainReport describes the result of walking the finalized chain with VerifyFinalizedChain.
+type FinalizedChainReport struct {
+	BlocksChecked uint64
+	Problems      []string
+}
+
+// BucketStats returns the number of rows and the size of the keys and values of every bucket.
+func (s *Store) BucketStats(ctx context.Context) ([]*BucketStats, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BucketStats")
+	defer span.End()
+
+	var stats []*BucketStats
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			st := &BucketStats{Name: string(name)}
+			if err := b.ForEach(func(k, v []byte) error {
+				st.Rows++
+				st.KeysSize += uint64(len(k))
+				st.ValuesSize += uint64(len(v))
+				if uint64(len(v)) > st.MaxValueSize {
+					st.MaxValueSize = uint64(len(v))
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			stats = append(stats, st)
+			return nil
+		})
+	})
+	return stats, err
+}
+
+// MigrationStatus reports for every known migration whether it has been applied to the database.
+func (s *Store) MigrationStatus(ctx context.Context) (map[string]bool, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.MigrationStatus")
+	defer span.End()
+
+	status := make(map[string]bool, len(migrationKeys))
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(migrationsBucket)
+		for _, k := range migrationKeys {
+			status[string(k)] = bytes.Equal(bkt.Get(k), migrationCompleted)
+		}
+		return nil
+	})
+	return status, err
+}
+
+// PruneBeforeSlot deletes the blocks below the given slot which are not part of the finalized
+// canonical chain, together with their states and state summaries. Finalized states below the slot
+// are deleted as well, except for the ones kept at archived points (see CleanUpDirtyStates) and the
+// genesis, origin, justified, finalized and head states. The slot must not be above the start slot
+// of the finalized epoch, as it is not known yet which blocks past it are canonical.
+func (s *Store) PruneBeforeSlot(ctx context.Context, slot, slotsPerArchivedPoint types.Slot) (*PruneStats, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneBeforeSlot")
+	defer span.End()
+
+	if slotsPerArchivedPoint == 0 {
+		return nil, errors.New("slots per archived point must be greater than 0")
+	}
+	// Work on the state summaries in the bucket only.
+	if err := s.saveCachedStateSummariesDB(ctx); err != nil {
+		return nil, err
+	}
+	finalized, err := s.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+	finalizedSlot, err := helpers.StartSlot(finalized.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	if slot > finalizedSlot {
+		return nil, fmt.Errorf("cannot prune above the finalized slot %d", finalizedSlot)
+	}
+	justified, err := s.JustifiedCheckpoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &PruneStats{}
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		blkBkt := tx.Bucket(blocksBucket)
+		finalizedBkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+
+		protected := make(map[[32]byte]bool)
+		for _, r := range [][]byte{
+			blkBkt.Get(genesisBlockRootKey),
+			blkBkt.Get(originBlockRootKey),
+			blkBkt.Get(backfillBlockRootKey),
+			blkBkt.Get(headBlockRootKey),
+			finalized.Root,
+			justified.Root,
+		} {
+			if len(r) == 32 {
+				protected[bytesutil.ToBytes32(r)] = true
+			}
+		}
+		// Blocks below a checkpoint sync origin were backfilled, only canonical blocks are backfilled.
+		var originSlot types.Slot
+		hasOrigin := false
+		if r := blkBkt.Get(originBlockRootKey); r != nil {
+			origin, err := blockInTx(ctx, tx, r)
+			if err != nil {
+				return err
+			}
+			if origin != nil {
+				originSlot, hasOrigin = origin.Block().Slot(), true
+			}
+		}
+
+		forkRoots := make(map[[32]byte]bool)
+		c := tx.Bucket(blockSlotIndicesBucket).Cursor()
+		for k, v := c.First(); k != nil && bytesutil.BytesToSlotBigEndian(k) < slot; k, v = c.Next() {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			blockSlot := bytesutil.BytesToSlotBigEndian(k)
+			for i := 0; i+32 <= len(v); i += 32 {
+				root := bytesutil.ToBytes32(v[i : i+32])
+				if protected[root] || finalizedBkt.Get(root[:]) != nil || (hasOrigin && blockSlot <= originSlot) {
+					continue
+				}
+				forkRoots[root] = true
+			}
+		}
+
+		type stateAtSlot struct {
+			root [32]byte
+			slot types.Slot
+		}
+		var deletedStates []stateAtSlot
+		c = tx.Bucket(stateSlotIndicesBucket).Cursor()
+		for k, v := c.First(); k != nil && bytesutil.BytesToSlotBigEndian(k) < slot; k, v = c.Next() {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			stateSlot := bytesutil.BytesToSlotBigEndian(k)
+			for i := 0; i+32 <= len(v); i += 32 {
+				root := bytesutil.ToBytes32(v[i : i+32])
+				if protected[root] {
+					continue
+				}
+				if forkRoots[root] || !isArchivedPointState(stateSlot, slotsPerArchivedPoint) {
+					deletedStates = append(deletedStates, stateAtSlot{root: root, slot: stateSlot})
+				}
+			}
+		}
+
+		stateBkt := tx.Bucket(stateBucket)
+		for _, st := range deletedStates {
+			if err := deleteValueForIndices(ctx, createStateIndicesFromStateSlot(ctx, st.slot), st.root[:], tx); err != nil {
+				return errors.Wrap(err, "could not delete root for DB indices")
+			}
+			if err := deleteStateValidators(tx, st.root[:]); err != nil {
+				return errors.Wrap(err, "could not delete state validators")
+			}
+			if err := stateBkt.Delete(st.root[:]); err != nil {
+				return err
+			}
+			stats.StatesDeleted++
+		}
+
+		summaryBkt := tx.Bucket(stateSummaryBucket)
+		for root := range forkRoots {
+			blk, err := blockInTx(ctx, tx, root[:])
+			if err != nil {
+				return err
+			}
+			if blk == nil {
+				continue
+			}
+			indicesByBucket := createBlockIndicesFromBlock(ctx, blk.Block())
+			if err := deleteValueForIndices(ctx, indicesByBucket, root[:], tx); err != nil {
+				return errors.Wrap(err, "could not delete root for DB indices")
+			}
+			s.blockCache.Del(string(root[:]))
+			if err := blkBkt.Delete(root[:]); err != nil {
+				return err
+			}
+			if err := summaryBkt.Delete(root[:]); err != nil {
+				return err
+			}
+			stats.BlocksDeleted++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// VerifyFinalizedChain walks the finalized chain from the finalized checkpoint down to genesis, or
+// down to the lowest backfilled block for a database initialized from a checkpoint. It checks that
+// every block links to its parent, that the finalized block roots index agrees with the blocks and
+// that a state or state summary exists for every block above the origin.
+func (s *Store) VerifyFinalizedChain(ctx context.Context) (*FinalizedChainReport, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.VerifyFinalizedChain")
+	defer span.End()
+
+	if err := s.saveCachedStateSummariesDB(ctx); err != nil {
+		return nil, err
+	}
+	finalized, err := s.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report := &FinalizedChainReport{}
+	err = s.db.View(func(tx *bolt.Tx) error {
+		blkBkt := tx.Bucket(blocksBucket)
+		finalizedBkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+		summaryBkt := tx.Bucket(stateSummaryBucket)
+		stateBkt := tx.Bucket(stateBucket)
+		genesisRoot := blkBkt.Get(genesisBlockRootKey)
+		originRoot := blkBkt.Get(originBlockRootKey)
+		backfillRoot := blkBkt.Get(backfillBlockRootKey)
+
+		root := finalized.Root
+		// Nothing has been finalized yet, only the genesis block is considered final.
+		if bytes.Equal(root, params.BeaconConfig().ZeroHash[:]) {
+			if genesisRoot == nil {
+				return nil
+			}
+			root = genesisRoot
+		}
+		var childRoot []byte
+		belowOrigin := false
+		for {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			blk, err := blockInTx(ctx, tx, root)
+			if err != nil {
+				return err
+			}
+			if blk == nil || blk.IsNil() {
+				report.Problems = append(report.Problems, fmt.Sprintf("missing block %#x", root))
+				return nil
+			}
+			report.BlocksChecked++
+			isGenesis := bytes.Equal(root, genesisRoot)
+
+			if !belowOrigin && !isGenesis {
+				if enc := finalizedBkt.Get(root); enc == nil {
+					report.Problems = append(report.Problems, fmt.Sprintf("block %#x at slot %d is missing from the finalized block roots index", root, blk.Block().Slot()))
+				} else {
+					container := &dbpb.FinalizedBlockRootContainer{}
+					if err := decode(ctx, enc, container); err != nil {
+						return err
+					}
+					if !bytes.Equal(container.ParentRoot, blk.Block().ParentRoot()) {
+						report.Problems = append(report.Problems, fmt.Sprintf("finalized block roots index has parent %#x for block %#x, want %#x", container.ParentRoot, root, blk.Block().ParentRoot()))
+					}
+					if childRoot != nil && !bytes.Equal(container.ChildRoot, childRoot) {
+						report.Problems = append(report.Problems, fmt.Sprintf("finalized block roots index has child %#x for block %#x, want %#x", container.ChildRoot, root, childRoot))
+					}
+				}
+				if summaryBkt.Get(root) == nil && stateBkt.Get(root) == nil {
+					report.Problems = append(report.Problems, fmt.Sprintf("missing state summary for block %#x at slot %d", root, blk.Block().Slot()))
+				}
+			}
+
+			if isGenesis || blk.Block().Slot() == 0 {
+				return nil
+			}
+			if originRoot != nil && bytes.Equal(root, originRoot) {
+				if backfillRoot == nil {
+					return nil
+				}
+				belowOrigin = true
+			}
+			if belowOrigin && bytes.Equal(root, backfillRoot) {
+				return nil
+			}
+			childRoot = root
+			root = blk.Block().ParentRoot()
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// Compact copies the contents of the database into a new database file at the given path. As
+// bolt never shrinks its data file, this reclaims the space of the pages freed by deletions.
+func (s *Store) Compact(ctx context.Context, dstPath string) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.Compact")
+	defer span.End()
+
+	dst, err := bolt.Open(
+		dstPath,
+		params.BeaconIoConfig().ReadWritePermissions,
+		&bolt.Options{NoSync: true, Timeout: params.BeaconIoConfig().BoltTimeout, FreelistType: bolt.FreelistMapType},
+	)
+	if err != nil {
+		return err
+	}
+	dst.AllocSize = boltAllocSize
+
+	dstTx, err := dst.Begin(true)
+	if err != nil {
+		return err
+	}
+	size := 0
+	err = s.db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if _, err := dstTx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+			c := b.Cursor()
+			for k, v := c.First(); k != nil; k, v = c.Next() {
+				// Start a new transaction once enough data was written in the current one.
+				if size+len(k)+len(v) > compactTxMaxSize {
+					if err := dstTx.Commit(); err != nil {
+						return err
+					}
+					dstTx, err = dst.Begin(true)
+					if err != nil {
+						return err
+					}
+					size = 0
+				}
+				if err := dstTx.Bucket(name).Put(k, v); err != nil {
+					return err
+				}
+				size += len(k) + len(v)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		if rbErr := dstTx.Rollback(); rbErr != nil {
+			log.WithError(rbErr).Error("Could not roll back compaction transaction")
+		}
+		if closeErr := dst.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close compacted database")
+		}
+		return err
+	}
+	if err := dstTx.Commit(); err != nil {
+		return err
+	}
+	if err := dst.Sync(); err != nil {
+		return err
+	}
+	return dst.Close()
+}
+
+// isArchivedPointState returns true if a finalized state at the slot is kept under the archived
+// point interval rules of CleanUpDirtyStates.
+func isArchivedPointState(slot, slotsPerArchivedPoint types.Slot) bool {
+	mod := slot % slotsPerArchivedPoint
+	return mod == 0 || mod > slotsPerArchivedPoint-slotsPerArchivedPoint/3
+}
+
+// blockInTx retrieves a block by root within the given transaction, returning nil if it does not exist.
+func blockInTx(ctx context.Context, tx *bolt.Tx, blockRoot []byte) (interfaces.SignedBeaconBlock, error) {
+	enc := tx.Bucket(blocksBucket).Get(blockRoot)
+	if enc == nil {
+		return nil, nil
+	}
+	return unmarshalBlock(ctx, enc)
+}
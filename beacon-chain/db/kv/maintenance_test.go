@@ -0,0 +1,196 @@
+package kv
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+// setupFinalizedChain saves a genesis block followed by three epochs of blocks with a state for
+// every block, plus a fork block at slot 2, and finalizes epoch 2.
+func setupFinalizedChain(t *testing.T, db *Store) ([]interfaces.SignedBeaconBlock, [32]byte) {
+	ctx := context.Background()
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+
+	genesis := testutil.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(genesis)))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	blks := makeBlocks(t, 0, slotsPerEpoch*3, genesisRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+
+	fork := testutil.NewBeaconBlock()
+	fork.Block.Slot = 2
+	fork.Block.ParentRoot = genesisRoot[:]
+	fork.Block.Body.Graffiti = bytesutil.PadTo([]byte("fork"), 32)
+	forkRoot, err := fork.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(fork)))
+
+	for _, b := range append(blks, wrapper.WrappedPhase0SignedBeaconBlock(fork)) {
+		root, err := b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		st, err := testutil.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(b.Block().Slot()))
+		require.NoError(t, db.SaveState(ctx, st, root))
+		require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: b.Block().Slot(), Root: root[:]}))
+	}
+
+	finalizedRoot, err := blks[slotsPerEpoch*2-1].Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: finalizedRoot[:]}))
+	return blks, forkRoot
+}
+
+func TestStore_PruneBeforeSlot(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	blks, forkRoot := setupFinalizedChain(t, db)
+
+	_, err := db.PruneBeforeSlot(ctx, slotsPerEpoch*2+1, slotsPerEpoch)
+	require.ErrorContains(t, "cannot prune above the finalized slot", err)
+
+	stats, err := db.PruneBeforeSlot(ctx, slotsPerEpoch*2, slotsPerEpoch)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), stats.BlocksDeleted)
+	assert.Equal(t, false, db.HasBlock(ctx, forkRoot))
+	assert.Equal(t, false, db.HasState(ctx, forkRoot))
+	assert.Equal(t, false, db.HasStateSummary(ctx, forkRoot))
+
+	deletedStates := uint64(1)
+	for _, b := range blks {
+		root, err := b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, db.HasBlock(ctx, root), "Canonical block at slot %d was pruned", b.Block().Slot())
+		slot := b.Block().Slot()
+		wantState := slot >= slotsPerEpoch*2 || isArchivedPointState(slot, slotsPerEpoch)
+		if !wantState {
+			deletedStates++
+		}
+		assert.Equal(t, wantState, db.HasState(ctx, root), "Unexpected state at slot %d", slot)
+	}
+	assert.Equal(t, deletedStates, stats.StatesDeleted)
+
+	report, err := db.VerifyFinalizedChain(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(report.Problems))
+}
+
+func TestStore_VerifyFinalizedChain(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	blks, _ := setupFinalizedChain(t, db)
+
+	report, err := db.VerifyFinalizedChain(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(report.Problems))
+	// The finalized block, all of its ancestors and the genesis block.
+	assert.Equal(t, slotsPerEpoch*2+1, report.BlocksChecked)
+
+	// Remove the state of a finalized block.
+	root, err := blks[3].Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(stateSummaryBucket).Delete(root[:]); err != nil {
+			return err
+		}
+		return tx.Bucket(stateBucket).Delete(root[:])
+	}))
+	report, err = db.VerifyFinalizedChain(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(report.Problems))
+	assert.Equal(t, true, strings.Contains(report.Problems[0], "missing state summary"), report.Problems[0])
+
+	// Remove a finalized block.
+	root, err = blks[5].Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.deleteBlock(ctx, root))
+	report, err = db.VerifyFinalizedChain(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(report.Problems))
+	assert.Equal(t, true, strings.Contains(report.Problems[0], "missing block"), report.Problems[0])
+}
+
+func TestStore_MigrationStatus(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	status, err := db.MigrationStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, len(migrations)+len(batchedMigrations), len(status))
+	assert.Equal(t, false, status[string(migrationStateValidatorsKey)])
+
+	require.NoError(t, db.RunMigrations(ctx))
+	status, err = db.MigrationStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, status[string(migrationStateValidatorsKey)])
+}
+
+func TestStore_BucketStats(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	blk := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(blk)))
+	stats, err := db.BucketStats(ctx)
+	require.NoError(t, err)
+	found := false
+	for _, st := range stats {
+		if st.Name != string(blocksBucket) {
+			continue
+		}
+		found = true
+		assert.Equal(t, uint64(1), st.Rows)
+		assert.Equal(t, uint64(32), st.KeysSize)
+		assert.Equal(t, st.ValuesSize, st.MaxValueSize)
+	}
+	assert.Equal(t, true, found)
+}
+
+func TestStore_Compact(t *testing.T) {
+	ctx := context.Background()
+	db, err := NewKVStore(ctx, t.TempDir(), &Config{})
+	require.NoError(t, err)
+	blks, forkRoot := setupFinalizedChain(t, db)
+
+	dir := t.TempDir()
+	require.NoError(t, db.Compact(ctx, filepath.Join(dir, DatabaseFileName)))
+	require.NoError(t, db.Close())
+
+	compacted, err := NewKVStore(ctx, dir, &Config{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, compacted.Close())
+	}()
+	for _, b := range blks {
+		root, err := b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, compacted.HasBlock(ctx, root))
+		assert.Equal(t, true, compacted.HasState(ctx, root))
+	}
+	assert.Equal(t, true, compacted.HasBlock(ctx, forkRoot))
+	st, err := compacted.State(ctx, forkRoot)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(2), st.Slot())
+	report, err := compacted.VerifyFinalizedChain(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(report.Problems))
+}
@@ -15,6 +15,22 @@ var migrations = []migration{
 	migrateBlockSlotIndex,
 }
 
+// batchedMigration is a migration rewriting too much data for a single transaction, which
+// manages its own transactions.
+type batchedMigration func(context.Context, *bolt.DB) error
+
+// batchedMigrations run after the migrations above.
+var batchedMigrations = []batchedMigration{
+	migrateStateValidators,
+}
+
+// migrationKeys are the keys marking the migrations above as completed, in the same order.
+var migrationKeys = [][]byte{
+	migrationArchivedIndex0Key,
+	migrationBlockSlotIndex0Key,
+	migrationStateValidatorsKey,
+}
+
 // RunMigrations defined in the migrations array.
 func (s *Store) RunMigrations(ctx context.Context) error {
 	for _, m := range migrations {
@@ -26,5 +42,10 @@ func (s *Store) RunMigrations(ctx context.Context) error {
 			return err
 		}
 	}
+	for _, m := range batchedMigrations {
+		if err := m(ctx, s.db); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -0,0 +1,111 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	bolt "go.etcd.io/bbolt"
+)
+
+var migrationStateValidatorsKey = []byte("state_validators_0")
+
+// migrationStateValidatorsCursorKey records the root of the last state visited by an unfinished
+// state validators migration.
+var migrationStateValidatorsCursorKey = []byte("state_validators_0_cursor")
+
+// stateValidatorsMigrationBatchSize is the number of states visited in a single transaction.
+var stateValidatorsMigrationBatchSize = 64
+
+// migrateStateValidators moves the validators of the states stored with their full
+// validator registry to the content-addressed validators bucket. As every stored state is
+// rewritten, the states are migrated in batches, each in its own transaction, and the last
+// visited root is recorded so that an interrupted migration resumes where it stopped.
+func migrateStateValidators(ctx context.Context, db *bolt.DB) error {
+	migrated := 0
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		var done bool
+		if err := db.Update(func(tx *bolt.Tx) error {
+			var n int
+			var err error
+			n, done, err = migrateStateValidatorsBatch(ctx, tx)
+			migrated += n
+			return err
+		}); err != nil {
+			return err
+		}
+		if done {
+			break
+		}
+	}
+	if migrated > 0 {
+		log.WithField("count", migrated).Info("Migrated state validators to the deduplicated validators bucket")
+	}
+	return nil
+}
+
+// migrateStateValidatorsBatch migrates the states among the next stateValidatorsMigrationBatchSize
+// stored states, returning the number of migrated states and whether the migration is completed.
+func migrateStateValidatorsBatch(ctx context.Context, tx *bolt.Tx) (int, bool, error) {
+	mb := tx.Bucket(migrationsBucket)
+	if b := mb.Get(migrationStateValidatorsKey); bytes.Equal(b, migrationCompleted) {
+		return 0, true, nil // Migration already completed.
+	}
+
+	stateBkt := tx.Bucket(stateBucket)
+	idxBkt := tx.Bucket(blockRootValidatorHashesBucket)
+
+	// The state bucket can't be modified while iterating over it, collect the roots first.
+	var roots [][]byte
+	var last []byte
+	c := stateBkt.Cursor()
+	k, _ := c.First()
+	if cursor := mb.Get(migrationStateValidatorsCursorKey); cursor != nil {
+		k, _ = c.Seek(cursor)
+		if bytes.Equal(k, cursor) {
+			k, _ = c.Next()
+		}
+	}
+	for visited := 0; k != nil && visited < stateValidatorsMigrationBatchSize; k, _ = c.Next() {
+		visited++
+		last = append([]byte{}, k...)
+		if idxBkt.Get(k) == nil {
+			roots = append(roots, last)
+		}
+	}
+	done := k == nil
+
+	for _, root := range roots {
+		if err := migrateStoredStateValidators(ctx, tx, root, stateBkt.Get(root)); err != nil {
+			return 0, false, err
+		}
+	}
+
+	if done {
+		if err := mb.Delete(migrationStateValidatorsCursorKey); err != nil {
+			return 0, false, err
+		}
+		return len(roots), true, mb.Put(migrationStateValidatorsKey, migrationCompleted)
+	}
+	return len(roots), false, mb.Put(migrationStateValidatorsCursorKey, last)
+}
+
+// migrateStoredStateValidators rewrites a state stored with its full validator registry.
+func migrateStoredStateValidators(ctx context.Context, tx *bolt.Tx, root []byte, enc []byte) error {
+	if hasAltairKey(enc) {
+		st := &pb.BeaconStateAltair{}
+		if err := decode(ctx, enc[len(altairKey):], st); err != nil {
+			return errors.Wrapf(err, "could not decode state %#x", root)
+		}
+		return saveAltairStateWithoutValidators(ctx, tx, root, st)
+	}
+	st, err := createState(ctx, enc)
+	if err != nil {
+		return errors.Wrapf(err, "could not decode state %#x", root)
+	}
+	return saveStateWithoutValidators(ctx, tx, root, st)
+}
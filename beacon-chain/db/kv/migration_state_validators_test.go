@@ -0,0 +1,169 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"go.etcd.io/bbolt"
+)
+
+func Test_migrateStateValidators(t *testing.T) {
+	ctx := context.Background()
+	root := [32]byte{'A'}
+	st := stateWithValidators(t, 4)
+	pbState, err := v1.ProtobufBeaconState(st.InnerStateUnsafe())
+	require.NoError(t, err)
+	genesisSt, _ := testutil.DeterministicGenesisState(t, 4)
+	altairSt, err := altair.UpgradeToAltair(ctx, genesisSt)
+	require.NoError(t, err)
+	altairPbState, ok := altairSt.InnerStateUnsafe().(*pb.BeaconStateAltair)
+	require.Equal(t, true, ok)
+
+	tests := []struct {
+		name  string
+		setup func(t *testing.T, db *bbolt.DB)
+		eval  func(t *testing.T, db *Store)
+	}{
+		{
+			name: "only runs once",
+			setup: func(t *testing.T, db *bbolt.DB) {
+				err := db.Update(func(tx *bbolt.Tx) error {
+					enc, err := encode(ctx, pbState)
+					if err != nil {
+						return err
+					}
+					if err := tx.Bucket(stateBucket).Put(root[:], enc); err != nil {
+						return err
+					}
+					return tx.Bucket(migrationsBucket).Put(migrationStateValidatorsKey, migrationCompleted)
+				})
+				assert.NoError(t, err)
+			},
+			eval: func(t *testing.T, db *Store) {
+				assert.Equal(t, 0, validatorEntriesCount(t, db))
+				saved, err := db.State(ctx, root)
+				require.NoError(t, err)
+				assert.DeepSSZEqual(t, st.InnerStateUnsafe(), saved.InnerStateUnsafe())
+			},
+		},
+		{
+			name: "moves validators out of stored states",
+			setup: func(t *testing.T, db *bbolt.DB) {
+				err := db.Update(func(tx *bbolt.Tx) error {
+					enc, err := encode(ctx, pbState)
+					if err != nil {
+						return err
+					}
+					return tx.Bucket(stateBucket).Put(root[:], enc)
+				})
+				assert.NoError(t, err)
+			},
+			eval: func(t *testing.T, db *Store) {
+				assert.Equal(t, 4, validatorEntriesCount(t, db))
+				err := db.db.View(func(tx *bbolt.Tx) error {
+					stored, err := createState(ctx, tx.Bucket(stateBucket).Get(root[:]))
+					require.NoError(t, err)
+					assert.Equal(t, 0, len(stored.Validators))
+					return nil
+				})
+				require.NoError(t, err)
+				saved, err := db.State(ctx, root)
+				require.NoError(t, err)
+				assert.DeepSSZEqual(t, st.InnerStateUnsafe(), saved.InnerStateUnsafe())
+			},
+		},
+		{
+			name: "moves validators out of stored Altair states",
+			setup: func(t *testing.T, db *bbolt.DB) {
+				err := db.Update(func(tx *bbolt.Tx) error {
+					enc, err := encode(ctx, altairPbState)
+					if err != nil {
+						return err
+					}
+					return tx.Bucket(stateBucket).Put(root[:], withAltairKey(enc))
+				})
+				assert.NoError(t, err)
+			},
+			eval: func(t *testing.T, db *Store) {
+				assert.Equal(t, 4, validatorEntriesCount(t, db))
+				saved, err := db.State(ctx, root)
+				require.NoError(t, err)
+				assert.Equal(t, version.Altair, saved.Version())
+				assert.DeepSSZEqual(t, altairSt.InnerStateUnsafe(), saved.InnerStateUnsafe())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupDB(t)
+			tt.setup(t, db.db)
+			assert.NoError(t, migrateStateValidators(ctx, db.db), "migrateStateValidators() error")
+			tt.eval(t, db)
+		})
+	}
+}
+
+func Test_migrateStateValidators_Batches(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	st := stateWithValidators(t, 4)
+	pbState, err := v1.ProtobufBeaconState(st.InnerStateUnsafe())
+	require.NoError(t, err)
+	enc, err := encode(ctx, pbState)
+	require.NoError(t, err)
+	roots := make([][32]byte, 5)
+	require.NoError(t, db.db.Update(func(tx *bbolt.Tx) error {
+		for i := range roots {
+			roots[i] = [32]byte{byte(i + 1)}
+			if err := tx.Bucket(stateBucket).Put(roots[i][:], enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	prevBatchSize := stateValidatorsMigrationBatchSize
+	stateValidatorsMigrationBatchSize = 2
+	defer func() {
+		stateValidatorsMigrationBatchSize = prevBatchSize
+	}()
+
+	// A single batch migrates the first states and records where to resume.
+	require.NoError(t, db.db.Update(func(tx *bbolt.Tx) error {
+		n, done, err := migrateStateValidatorsBatch(ctx, tx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, n)
+		assert.Equal(t, false, done)
+		return nil
+	}))
+	require.NoError(t, db.db.View(func(tx *bbolt.Tx) error {
+		mb := tx.Bucket(migrationsBucket)
+		assert.DeepEqual(t, roots[1][:], mb.Get(migrationStateValidatorsCursorKey))
+		assert.Equal(t, 0, len(mb.Get(migrationStateValidatorsKey)))
+		assert.NotNil(t, tx.Bucket(blockRootValidatorHashesBucket).Get(roots[1][:]))
+		assert.Equal(t, 0, len(tx.Bucket(blockRootValidatorHashesBucket).Get(roots[2][:])))
+		return nil
+	}))
+
+	// Resuming migrates the remaining states.
+	require.NoError(t, migrateStateValidators(ctx, db.db))
+	require.NoError(t, db.db.View(func(tx *bbolt.Tx) error {
+		mb := tx.Bucket(migrationsBucket)
+		assert.Equal(t, 0, len(mb.Get(migrationStateValidatorsCursorKey)))
+		assert.DeepEqual(t, migrationCompleted, mb.Get(migrationStateValidatorsKey))
+		return nil
+	}))
+	assert.Equal(t, 4, validatorEntriesCount(t, db))
+	for _, root := range roots {
+		saved, err := db.State(ctx, root)
+		require.NoError(t, err)
+		assert.DeepSSZEqual(t, st.InnerStateUnsafe(), saved.InnerStateUnsafe())
+	}
+}
@@ -0,0 +1,247 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbIface "github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	v2 "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveOrigin bootstraps the beaconDB with a finalized block and its state, both SSZ encoded, so the
+// node can sync forward from that point instead of from genesis. The genesis data must already be
+// in the database, as the origin state is checked against it. The block and state are saved as the
+// finalized and justified checkpoint, the head and the origin of the database. The state may be a
+// phase 0 or an Altair state, as told by the fork version in its encoding.
+func (s *Store) SaveOrigin(ctx context.Context, serState, serBlock []byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveOrigin")
+	defer span.End()
+
+	originState, err := unmarshalOriginState(serState)
+	if err != nil {
+		return err
+	}
+	blk, err := unmarshalOriginBlock(serBlock, originState)
+	if err != nil {
+		return err
+	}
+
+	genesisState, err := s.GenesisState(ctx)
+	if err != nil {
+		return err
+	}
+	if genesisState == nil || genesisState.IsNil() {
+		return errors.New("no genesis state in db, a genesis state is required to sync from a checkpoint")
+	}
+	if !bytes.Equal(genesisState.GenesisValidatorRoot(), originState.GenesisValidatorRoot()) {
+		return fmt.Errorf("checkpoint state genesis validators root %#x does not match the genesis state %#x",
+			originState.GenesisValidatorRoot(), genesisState.GenesisValidatorRoot())
+	}
+	finalized, err := s.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(finalized.Root, params.BeaconConfig().ZeroHash[:]) {
+		return errors.New("db already has a finalized checkpoint, checkpoint sync requires an empty db")
+	}
+
+	// The block must be the latest block applied to the state. The latest block header of the state
+	// only has its state root filled in once the next slot is processed, so it is filled in here
+	// when missing.
+	stateRoot, err := originState.HashTreeRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not compute checkpoint state root")
+	}
+	header := originState.LatestBlockHeader()
+	if bytes.Equal(header.StateRoot, params.BeaconConfig().ZeroHash[:]) {
+		header.StateRoot = stateRoot[:]
+	}
+	headerRoot, err := header.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	blockRoot, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not compute checkpoint block root")
+	}
+	if blockRoot != headerRoot {
+		return fmt.Errorf("checkpoint block root %#x does not match the latest block header root %#x of the checkpoint state",
+			blockRoot, headerRoot)
+	}
+
+	if err := s.SaveBlock(ctx, blk); err != nil {
+		return errors.Wrap(err, "could not save checkpoint block")
+	}
+	if err := s.SaveState(ctx, originState, blockRoot); err != nil {
+		return errors.Wrap(err, "could not save checkpoint state")
+	}
+	if err := s.SaveStateSummary(ctx, &pbp2p.StateSummary{
+		Slot: originState.Slot(),
+		Root: blockRoot[:],
+	}); err != nil {
+		return err
+	}
+	if err := s.SaveOriginBlockRoot(ctx, blockRoot); err != nil {
+		return errors.Wrap(err, "could not save origin block root")
+	}
+	// Blocks before the origin are backfilled from peers, starting from the origin block.
+	if err := s.SaveBackfillBlockRoot(ctx, blockRoot); err != nil {
+		return errors.Wrap(err, "could not save backfill block root")
+	}
+	if err := s.SaveHeadBlockRoot(ctx, blockRoot); err != nil {
+		return errors.Wrap(err, "could not save head block root")
+	}
+	checkpoint := &ethpb.Checkpoint{
+		Epoch: helpers.SlotToEpoch(originState.Slot()),
+		Root:  blockRoot[:],
+	}
+	if err := s.SaveJustifiedCheckpoint(ctx, checkpoint); err != nil {
+		return errors.Wrap(err, "could not save justified checkpoint")
+	}
+	if err := s.SaveFinalizedCheckpoint(ctx, checkpoint); err != nil {
+		return errors.Wrap(err, "could not save finalized checkpoint")
+	}
+	return nil
+}
+
+// The fork of a state follows its genesis time, genesis validators root and slot in its SSZ
+// encoding, and the current version of the fork follows the previous version.
+const (
+	stateCurrentForkVersionOffset = 8 + 32 + 8 + 4
+	// The block of a signed block is preceded by its offset and the signature, and starts with its slot.
+	signedBlockSlotOffset = 4 + 96
+)
+
+// unmarshalOriginState unmarshals the SSZ encoded checkpoint state into the state type of the fork
+// matching the current fork version of the state.
+func unmarshalOriginState(serState []byte) (iface.BeaconState, error) {
+	if len(serState) < stateCurrentForkVersionOffset+4 {
+		return nil, errors.New("checkpoint state is too short")
+	}
+	forkVersion := serState[stateCurrentForkVersionOffset : stateCurrentForkVersionOffset+4]
+	cfg := params.BeaconConfig()
+	switch {
+	case bytes.Equal(forkVersion, cfg.GenesisForkVersion):
+		st := &pbp2p.BeaconState{}
+		if err := st.UnmarshalSSZ(serState); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal checkpoint state")
+		}
+		originState, err := v1.InitializeFromProtoUnsafe(st)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not initialize checkpoint state")
+		}
+		return originState, nil
+	case bytes.Equal(forkVersion, cfg.AltairForkVersion):
+		st := &pbp2p.BeaconStateAltair{}
+		if err := st.UnmarshalSSZ(serState); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal checkpoint state")
+		}
+		originState, err := v2.InitializeFromProtoUnsafe(st)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not initialize checkpoint state")
+		}
+		return originState, nil
+	default:
+		return nil, fmt.Errorf("checkpoint state has unsupported fork version %#x", forkVersion)
+	}
+}
+
+// unmarshalOriginBlock unmarshals the SSZ encoded checkpoint block into the block type of its fork.
+// The block is an Altair block if the checkpoint state is an Altair state and the block is not
+// older than the fork of the state.
+func unmarshalOriginBlock(serBlock []byte, originState iface.BeaconState) (interfaces.SignedBeaconBlock, error) {
+	if len(serBlock) < signedBlockSlotOffset+8 {
+		return nil, errors.New("checkpoint block is too short")
+	}
+	slot := types.Slot(binary.LittleEndian.Uint64(serBlock[signedBlockSlotOffset : signedBlockSlotOffset+8]))
+	if originState.Version() == version.Altair && helpers.SlotToEpoch(slot) >= originState.Fork().Epoch {
+		blk := &prysmv2.SignedBeaconBlockAltair{}
+		if err := blk.UnmarshalSSZ(serBlock); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal checkpoint block")
+		}
+		if blk.Block == nil {
+			return nil, errors.New("nil checkpoint block")
+		}
+		return wrapper.WrappedAltairSignedBeaconBlock(blk)
+	}
+	blk := &ethpb.SignedBeaconBlock{}
+	if err := blk.UnmarshalSSZ(serBlock); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal checkpoint block")
+	}
+	if blk.Block == nil {
+		return nil, errors.New("nil checkpoint block")
+	}
+	return wrapper.WrappedPhase0SignedBeaconBlock(blk), nil
+}
+
+// OriginBlockRoot returns the block root of the checkpoint the database was initialized from.
+// dbIface.ErrNotFoundOriginBlockRoot is returned if the node was synced from genesis.
+func (s *Store) OriginBlockRoot(ctx context.Context) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.OriginBlockRoot")
+	defer span.End()
+
+	var root [32]byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		r := tx.Bucket(blocksBucket).Get(originBlockRootKey)
+		if len(r) == 0 {
+			return dbIface.ErrNotFoundOriginBlockRoot
+		}
+		copy(root[:], r)
+		return nil
+	})
+	return root, err
+}
+
+// SaveOriginBlockRoot saves the block root of the checkpoint the database was initialized from.
+func (s *Store) SaveOriginBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveOriginBlockRoot")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(blocksBucket).Put(originBlockRootKey, blockRoot[:])
+	})
+}
+
+// BackfillBlockRoot returns the root of the lowest block that blocks have been backfilled down to
+// since the database was initialized from a checkpoint. All blocks from there up to the origin are
+// in the database. dbIface.ErrNotFoundBackfillBlockRoot is returned if the node was synced from
+// genesis.
+func (s *Store) BackfillBlockRoot(ctx context.Context) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BackfillBlockRoot")
+	defer span.End()
+
+	var root [32]byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		r := tx.Bucket(blocksBucket).Get(backfillBlockRootKey)
+		if len(r) == 0 {
+			return dbIface.ErrNotFoundBackfillBlockRoot
+		}
+		copy(root[:], r)
+		return nil
+	})
+	return root, err
+}
+
+// SaveBackfillBlockRoot saves the root of the lowest block that blocks have been backfilled down to.
+func (s *Store) SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBackfillBlockRoot")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(blocksBucket).Put(backfillBlockRootKey, blockRoot[:])
+	})
+}
@@ -0,0 +1,187 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	dbIface "github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/version"
+)
+
+// originPair returns an SSZ encoded state and its latest block at the start of the given epoch,
+// derived from the genesis state.
+func originPair(t *testing.T, genesis iface.BeaconState, epoch types.Epoch) ([]byte, []byte, [32]byte) {
+	ctx := context.Background()
+	st := genesis.Copy()
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch.Mul(uint64(epoch))))
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = st.Slot()
+	blk.Block.ParentRoot = bytesutil.PadTo([]byte{'p'}, 32)
+	bodyRoot, err := blk.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{
+		Slot:       blk.Block.Slot,
+		ParentRoot: blk.Block.ParentRoot,
+		StateRoot:  params.BeaconConfig().ZeroHash[:],
+		BodyRoot:   bodyRoot[:],
+	}))
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	blk.Block.StateRoot = stateRoot[:]
+	blockRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	serState, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	serBlock, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	return serState, serBlock, blockRoot
+}
+
+// altairOriginPair returns an SSZ encoded Altair state, upgraded at the start of the given epoch,
+// and its latest block.
+func altairOriginPair(t *testing.T, genesis iface.BeaconState, epoch types.Epoch) ([]byte, []byte, [32]byte) {
+	ctx := context.Background()
+	st := genesis.Copy()
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch.Mul(uint64(epoch))))
+	st, err := altair.UpgradeToAltair(ctx, st)
+	require.NoError(t, err)
+	blk := altairBlock(st.Slot())
+	blk.Block.ParentRoot = bytesutil.PadTo([]byte{'p'}, 32)
+	bodyRoot, err := blk.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{
+		Slot:       blk.Block.Slot,
+		ParentRoot: blk.Block.ParentRoot,
+		StateRoot:  params.BeaconConfig().ZeroHash[:],
+		BodyRoot:   bodyRoot[:],
+	}))
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	blk.Block.StateRoot = stateRoot[:]
+	blockRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	serState, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	serBlock, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	return serState, serBlock, blockRoot
+}
+
+func TestStore_SaveOrigin(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	gs, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, gs.SetGenesisValidatorRoot(bytesutil.PadTo([]byte{'g'}, 32)))
+	require.NoError(t, db.SaveGenesisData(ctx, gs))
+
+	serState, serBlock, blockRoot := originPair(t, gs, 10)
+	require.NoError(t, db.SaveOrigin(ctx, serState, serBlock))
+
+	origin, err := db.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, blockRoot, origin)
+	backfill, err := db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, blockRoot, backfill)
+
+	finalized, err := db.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, blockRoot[:], finalized.Root)
+	assert.Equal(t, types.Epoch(10), finalized.Epoch)
+	justified, err := db.JustifiedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, finalized, justified)
+
+	head, err := db.HeadBlock(ctx)
+	require.NoError(t, err)
+	headRoot, err := head.Block().HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, blockRoot, headRoot)
+	assert.Equal(t, true, db.HasState(ctx, blockRoot))
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, blockRoot))
+
+	// An initialized database cannot be initialized again.
+	assert.ErrorContains(t, "checkpoint sync requires an empty db", db.SaveOrigin(ctx, serState, serBlock))
+}
+
+func TestStore_SaveOrigin_Altair(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	gs, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, db.SaveGenesisData(ctx, gs))
+
+	serState, serBlock, blockRoot := altairOriginPair(t, gs, 10)
+	require.NoError(t, db.SaveOrigin(ctx, serState, serBlock))
+
+	finalized, err := db.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, blockRoot[:], finalized.Root)
+	assert.Equal(t, types.Epoch(10), finalized.Epoch)
+
+	head, err := db.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, version.Altair, head.Version())
+	headRoot, err := head.Block().HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, blockRoot, headRoot)
+
+	st, err := db.State(ctx, blockRoot)
+	require.NoError(t, err)
+	assert.Equal(t, version.Altair, st.Version())
+	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch.Mul(10), st.Slot())
+}
+
+func TestStore_SaveOrigin_Errors(t *testing.T) {
+	ctx := context.Background()
+	gs, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	serState, serBlock, _ := originPair(t, gs, 2)
+
+	db := setupDB(t)
+	assert.ErrorContains(t, "no genesis state in db", db.SaveOrigin(ctx, serState, serBlock))
+
+	require.NoError(t, db.SaveGenesisData(ctx, gs))
+	_, otherBlock, _ := originPair(t, gs, 3)
+	assert.ErrorContains(t, "does not match the latest block header root", db.SaveOrigin(ctx, serState, otherBlock))
+
+	other := gs.Copy()
+	require.NoError(t, other.SetGenesisValidatorRoot(bytesutil.PadTo([]byte{'o'}, 32)))
+	otherState, otherBlock, _ := originPair(t, other, 2)
+	assert.ErrorContains(t, "genesis validators root", db.SaveOrigin(ctx, otherState, otherBlock))
+
+	unknownFork := make([]byte, len(serState))
+	copy(unknownFork, serState)
+	copy(unknownFork[stateCurrentForkVersionOffset:], []byte{0xff, 0xff, 0xff, 0xff})
+	assert.ErrorContains(t, "unsupported fork version", db.SaveOrigin(ctx, unknownFork, serBlock))
+
+	_, err = db.OriginBlockRoot(ctx)
+	assert.Equal(t, true, errors.Is(err, dbIface.ErrNotFoundOriginBlockRoot))
+	_, err = db.BackfillBlockRoot(ctx)
+	assert.Equal(t, true, errors.Is(err, dbIface.ErrNotFoundBackfillBlockRoot))
+}
+
+func TestStore_BackfillBlockRoot(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	root := bytesutil.ToBytes32([]byte{'a'})
+	require.NoError(t, db.SaveBackfillBlockRoot(ctx, root))
+	got, err := db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, root, got)
+}
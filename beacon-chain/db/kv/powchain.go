@@ -51,3 +51,44 @@ func (s *Store) PowchainData(ctx context.Context) (*db.ETH1ChainData, error) {
 	})
 	return data, err
 }
+
+// SaveDepositSnapshot saves the snapshot of the finalized deposit tree.
+func (s *Store) SaveDepositSnapshot(ctx context.Context, snapshot *db.DepositSnapshot) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveDepositSnapshot")
+	defer span.End()
+
+	if snapshot == nil {
+		err := errors.New("cannot save nil deposit snapshot")
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(powchainBucket)
+		enc, err := proto.Marshal(snapshot)
+		if err != nil {
+			return err
+		}
+		return bkt.Put(depositSnapshotKey, enc)
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
+
+// DepositSnapshot retrieves the snapshot of the finalized deposit tree, or nil if none was saved.
+func (s *Store) DepositSnapshot(ctx context.Context) (*db.DepositSnapshot, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DepositSnapshot")
+	defer span.End()
+
+	var snapshot *db.DepositSnapshot
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(powchainBucket)
+		enc := bkt.Get(depositSnapshotKey)
+		if len(enc) == 0 {
+			return nil
+		}
+		snapshot = &db.DepositSnapshot{}
+		return proto.Unmarshal(enc, snapshot)
+	})
+	return snapshot, err
+}
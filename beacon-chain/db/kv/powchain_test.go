@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
 
 func TestStore_SavePowchainData(t *testing.T) {
@@ -33,3 +36,25 @@ func TestStore_SavePowchainData(t *testing.T) {
 		})
 	}
 }
+
+func TestStore_DepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	store := setupDB(t)
+
+	snapshot, err := store.DepositSnapshot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*db.DepositSnapshot)(nil), snapshot)
+	require.ErrorContains(t, "cannot save nil deposit snapshot", store.SaveDepositSnapshot(ctx, nil))
+
+	want := &db.DepositSnapshot{
+		Finalized:            [][]byte{bytesutil.PadTo([]byte{'a'}, 32), bytesutil.PadTo([]byte{'b'}, 32)},
+		DepositRoot:          bytesutil.PadTo([]byte{'c'}, 32),
+		DepositCount:         5,
+		ExecutionBlockHash:   bytesutil.PadTo([]byte{'d'}, 32),
+		ExecutionBlockHeight: 100,
+	}
+	require.NoError(t, store.SaveDepositSnapshot(ctx, want))
+	snapshot, err = store.DepositSnapshot(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, snapshot)
+}
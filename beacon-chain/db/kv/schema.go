@@ -17,6 +17,8 @@ var (
 	chainMetadataBucket     = []byte("chain-metadata")
 	checkpointBucket        = []byte("check-point")
 	powchainBucket          = []byte("powchain")
+	stateValidatorsBucket   = []byte("state-validators")
+	hierarchicalStateBucket = []byte("hierarchical-states")
 
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
 	slotsHasObjectBucket = []byte("slots-has-objects")
@@ -33,14 +35,18 @@ var (
 	attestationTargetRootIndicesBucket  = []byte("attestation-target-root-indices")
 	attestationTargetEpochIndicesBucket = []byte("attestation-target-epoch-indices")
 	finalizedBlockRootsIndexBucket      = []byte("finalized-block-roots-index")
+	blockRootValidatorHashesBucket      = []byte("block-root-validator-hashes")
 
 	// Specific item keys.
 	headBlockRootKey          = []byte("head-root")
 	genesisBlockRootKey       = []byte("genesis-root")
+	originBlockRootKey        = []byte("origin-checkpoint-block-root")
+	backfillBlockRootKey      = []byte("backfill-block-root")
 	depositContractAddressKey = []byte("deposit-contract")
 	justifiedCheckpointKey    = []byte("justified-checkpoint")
 	finalizedCheckpointKey    = []byte("finalized-checkpoint")
 	powchainDataKey           = []byte("powchain-data")
+	depositSnapshotKey        = []byte("deposit-snapshot")
 
 	// Deprecated: This index key was migrated in PR 6461. Do not use, except for migrations.
 	lastArchivedIndexKey = []byte("last-archived")
@@ -52,4 +58,12 @@ var (
 
 	// Migrations
 	migrationsBucket = []byte("migrations")
+
+	// Altair blocks and states are stored with this prefix, to tell them apart from phase 0 ones,
+	// which are stored unprefixed as before the fork:
+	//   block: [altairKey] | snappy(ssz(signed block))
+	//   state: [altairKey] | snappy(ssz(state without its validators))
+	// The validators of a state of either fork are stored in stateValidatorsBucket, see
+	// state_validators.go. States saved with their validators are migrated by migrateStateValidators.
+	altairKey = []byte("altair")
 )
@@ -10,9 +10,9 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/genesis"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	v2 "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
-	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
@@ -25,21 +25,20 @@ import (
 func (s *Store) State(ctx context.Context, blockRoot [32]byte) (iface.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.State")
 	defer span.End()
-	var st *pb.BeaconState
-	enc, err := s.stateBytes(ctx, blockRoot)
-	if err != nil {
-		return nil, err
-	}
-
-	if len(enc) == 0 {
-		return nil, nil
-	}
-
-	st, err = createState(ctx, enc)
+	var st iface.BeaconState
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(stateBucket).Get(blockRoot[:])
+		if len(enc) == 0 {
+			return nil
+		}
+		var err error
+		st, err = unmarshalState(ctx, tx, blockRoot[:], enc)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
-	return v1.InitializeFromProtoUnsafe(st)
+	return st, nil
 }
 
 // GenesisState returns the genesis state in beacon chain.
@@ -71,7 +70,7 @@ func (s *Store) GenesisState(ctx context.Context) (iface.BeaconState, error) {
 		}
 
 		var err error
-		st, err = createState(ctx, enc)
+		st, err = stateFromBytes(ctx, tx, genesisBlockRoot, enc)
 		return err
 	})
 	if err != nil {
@@ -98,26 +97,14 @@ func (s *Store) SaveStates(ctx context.Context, states []iface.ReadOnlyBeaconSta
 	if states == nil {
 		return errors.New("nil state")
 	}
-	multipleEncs := make([][]byte, len(states))
-	for i, st := range states {
-		pbState, err := v1.ProtobufBeaconState(st.InnerStateUnsafe())
-		if err != nil {
-			return err
-		}
-		multipleEncs[i], err = encode(ctx, pbState)
-		if err != nil {
-			return err
-		}
-	}
 
 	return s.db.Update(func(tx *bolt.Tx) error {
-		bucket := tx.Bucket(stateBucket)
 		for i, rt := range blockRoots {
 			indicesByBucket := createStateIndicesFromStateSlot(ctx, states[i].Slot())
 			if err := updateValueForIndices(ctx, indicesByBucket, rt[:], tx); err != nil {
 				return errors.Wrap(err, "could not update DB indices")
 			}
-			if err := bucket.Put(rt[:], multipleEncs[i]); err != nil {
+			if err := saveState(ctx, tx, rt[:], states[i]); err != nil {
 				return err
 			}
 		}
@@ -125,6 +112,36 @@ func (s *Store) SaveStates(ctx context.Context, states []iface.ReadOnlyBeaconSta
 	})
 }
 
+// saveState stores a state under the block root without its validators, which are stored
+// separately. The encoding of Altair states is prefixed with altairKey.
+func saveState(ctx context.Context, tx *bolt.Tx, blockRoot []byte, st iface.ReadOnlyBeaconState) error {
+	switch pbState := st.InnerStateUnsafe().(type) {
+	case *pb.BeaconState:
+		return saveStateWithoutValidators(ctx, tx, blockRoot, pbState)
+	case *pb.BeaconStateAltair:
+		return saveAltairStateWithoutValidators(ctx, tx, blockRoot, pbState)
+	default:
+		return errors.Errorf("unsupported state type %T", pbState)
+	}
+}
+
+// unmarshalState decodes a state stored under the block root, which is an Altair state when its
+// encoding is prefixed with altairKey and a phase 0 state otherwise.
+func unmarshalState(ctx context.Context, tx *bolt.Tx, blockRoot []byte, enc []byte) (iface.BeaconState, error) {
+	if hasAltairKey(enc) {
+		st, err := altairStateFromBytes(ctx, tx, blockRoot, enc[len(altairKey):])
+		if err != nil {
+			return nil, err
+		}
+		return v2.InitializeFromProtoUnsafe(st)
+	}
+	st, err := stateFromBytes(ctx, tx, blockRoot, enc)
+	if err != nil {
+		return nil, err
+	}
+	return v1.InitializeFromProtoUnsafe(st)
+}
+
 // HasState checks if a state by root exists in the db.
 func (s *Store) HasState(ctx context.Context, blockRoot [32]byte) bool {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.HasState")
@@ -178,6 +195,9 @@ func (s *Store) DeleteState(ctx context.Context, blockRoot [32]byte) error {
 		if err := deleteValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
 			return errors.Wrap(err, "could not delete root for DB indices")
 		}
+		if err := deleteStateValidators(tx, blockRoot[:]); err != nil {
+			return errors.Wrap(err, "could not delete state validators")
+		}
 
 		return bkt.Delete(blockRoot[:])
 	})
@@ -206,28 +226,6 @@ func createState(ctx context.Context, enc []byte) (*pb.BeaconState, error) {
 	return protoState, nil
 }
 
-// HasState checks if a state by root exists in the db.
-func (s *Store) stateBytes(ctx context.Context, blockRoot [32]byte) ([]byte, error) {
-	ctx, span := trace.StartSpan(ctx, "BeaconDB.stateBytes")
-	defer span.End()
-	var dst []byte
-	err := s.db.View(func(tx *bolt.Tx) error {
-		bkt := tx.Bucket(stateBucket)
-		stBytes := bkt.Get(blockRoot[:])
-		if len(stBytes) == 0 {
-			return nil
-		}
-		// Due to https://github.com/boltdb/bolt/issues/204, we need to
-		// allocate a byte slice separately in the transaction or there
-		// is the possibility of a panic when accessing that particular
-		// area of memory.
-		dst = make([]byte, len(stBytes))
-		copy(dst, stBytes)
-		return nil
-	})
-	return dst, err
-}
-
 // slotByBlockRoot retrieves the corresponding slot of the input block root.
 func slotByBlockRoot(ctx context.Context, tx *bolt.Tx, blockRoot []byte) (types.Slot, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.slotByBlockRoot")
@@ -248,24 +246,23 @@ func slotByBlockRoot(ctx context.Context, tx *bolt.Tx, blockRoot []byte) (types.
 			if enc == nil {
 				return 0, errors.New("state enc can't be nil")
 			}
-			s, err := createState(ctx, enc)
+			s, err := unmarshalState(ctx, tx, blockRoot, enc)
 			if err != nil {
 				return 0, err
 			}
-			if s == nil {
+			if s == nil || s.IsNil() {
 				return 0, errors.New("state can't be nil")
 			}
-			return s.Slot, nil
+			return s.Slot(), nil
 		}
-		b := &ethpb.SignedBeaconBlock{}
-		err := decode(ctx, enc, b)
+		b, err := unmarshalBlock(ctx, enc)
 		if err != nil {
 			return 0, err
 		}
-		if err := helpers.VerifyNilBeaconBlock(wrapper.WrappedPhase0SignedBeaconBlock(b)); err != nil {
+		if err := helpers.VerifyNilBeaconBlock(b); err != nil {
 			return 0, err
 		}
-		return b.Block.Slot, nil
+		return b.Block().Slot(), nil
 	}
 	stateSummary := &pb.StateSummary{}
 	if err := decode(ctx, enc, stateSummary); err != nil {
@@ -375,11 +372,10 @@ func (s *Store) CleanUpDirtyStates(ctx context.Context, slotsPerArchivedPoint ty
 
 			finalizedChkpt := bytesutil.ToBytes32(f.Root) == bytesutil.ToBytes32(v)
 			slot := bytesutil.BytesToSlotBigEndian(k)
-			mod := slot % slotsPerArchivedPoint
 			nonFinalized := slot > finalizedSlot
 
 			// The following conditions cover 1, 2, 3 and 4 above.
-			if mod != 0 && mod <= slotsPerArchivedPoint-slotsPerArchivedPoint/3 && !finalizedChkpt && !nonFinalized {
+			if !isArchivedPointState(slot, slotsPerArchivedPoint) && !finalizedChkpt && !nonFinalized {
 				deletedRoots = append(deletedRoots, bytesutil.ToBytes32(v))
 			}
 			return nil
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
@@ -15,6 +16,8 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	bolt "go.etcd.io/bbolt"
 	"gopkg.in/d4l3k/messagediff.v1"
 )
 
@@ -45,6 +48,38 @@ func TestState_CanSaveRetrieve(t *testing.T) {
 	assert.Equal(t, iface.ReadOnlyBeaconState(nil), savedS, "Unsaved state should've been nil")
 }
 
+func TestState_CanSaveRetrieveAltair(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	r := [32]byte{'A'}
+
+	genesisState, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, genesisState.SetSlot(100))
+	// A phase 0 state saved under the same root is replaced, its validators are shared.
+	require.NoError(t, db.SaveState(ctx, genesisState, r))
+	st, err := altair.UpgradeToAltair(ctx, genesisState)
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, r))
+	assert.Equal(t, true, db.HasState(ctx, r))
+
+	savedS, err := db.State(ctx, r)
+	require.NoError(t, err)
+	assert.Equal(t, version.Altair, savedS.Version())
+	assert.DeepSSZEqual(t, st.InnerStateUnsafe(), savedS.InnerStateUnsafe(), "Did not retrieve saved state")
+
+	assert.Equal(t, 64, validatorEntriesCount(t, db))
+	require.NoError(t, db.db.View(func(tx *bolt.Tx) error {
+		assert.Equal(t, 64*32, len(tx.Bucket(blockRootValidatorHashesBucket).Get(r[:])), "Validators were not stored separately")
+		stored, err := altairStateFromBytes(ctx, tx, []byte("unknown root"), tx.Bucket(stateBucket).Get(r[:])[len(altairKey):])
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(stored.Validators), "Validators were stored with the state")
+		slot, err := slotByBlockRoot(ctx, tx, r[:])
+		require.NoError(t, err)
+		assert.Equal(t, types.Slot(100), slot)
+		return nil
+	}))
+}
+
 func TestGenesisState_CanSaveRetrieve(t *testing.T) {
 	db := setupDB(t)
 
@@ -0,0 +1,248 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// The validator registry makes up most of a stored state but barely changes from one state
+// to the next. Instead of storing it with every state, each validator is stored once in
+// stateValidatorsBucket, keyed by its hash tree root, and blockRootValidatorHashesBucket
+// maps the block root of a state to the concatenated hashes of its validators.
+// Validator entries are prefixed by a reference count so that they can be removed once the
+// last state referencing them is deleted.
+
+const refCountLength = 8
+
+// saveStateValidators stores the validators of the state saved under the block root and
+// records their hashes for the state to reference.
+func saveStateValidators(ctx context.Context, tx *bolt.Tx, blockRoot []byte, validators []*ethpb.Validator) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.saveStateValidators")
+	defer span.End()
+
+	// Drop the references of a state previously saved under the same root.
+	if err := deleteStateValidators(tx, blockRoot); err != nil {
+		return err
+	}
+
+	valBkt := tx.Bucket(stateValidatorsBucket)
+	hashes := make([]byte, 0, len(validators)*32)
+	for _, v := range validators {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		h, err := v.HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "could not compute validator hash")
+		}
+		hashes = append(hashes, h[:]...)
+
+		entry := valBkt.Get(h[:])
+		if entry != nil {
+			if err := valBkt.Put(h[:], withRefCount(entry, refCount(entry)+1)); err != nil {
+				return err
+			}
+			continue
+		}
+		enc, err := encode(ctx, v)
+		if err != nil {
+			return err
+		}
+		if err := valBkt.Put(h[:], withRefCount(append(make([]byte, refCountLength), enc...), 1)); err != nil {
+			return err
+		}
+	}
+	return tx.Bucket(blockRootValidatorHashesBucket).Put(blockRoot, hashes)
+}
+
+// stateValidators retrieves the validators referenced by the state saved under the block root.
+// It returns false if the state was stored with its validators.
+func stateValidators(ctx context.Context, tx *bolt.Tx, blockRoot []byte) ([]*ethpb.Validator, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.stateValidators")
+	defer span.End()
+
+	hashes := tx.Bucket(blockRootValidatorHashesBucket).Get(blockRoot)
+	if hashes == nil {
+		return nil, false, nil
+	}
+	if len(hashes)%32 != 0 {
+		return nil, false, errors.Errorf("invalid validator hashes length %d", len(hashes))
+	}
+	valBkt := tx.Bucket(stateValidatorsBucket)
+	validators := make([]*ethpb.Validator, len(hashes)/32)
+	for i := range validators {
+		h := hashes[i*32 : (i+1)*32]
+		entry := valBkt.Get(h)
+		if len(entry) < refCountLength {
+			return nil, false, errors.Errorf("missing validator entry %#x", h)
+		}
+		v := &ethpb.Validator{}
+		if err := decode(ctx, entry[refCountLength:], v); err != nil {
+			return nil, false, err
+		}
+		validators[i] = v
+	}
+	return validators, true, nil
+}
+
+// deleteStateValidators removes the references of the state saved under the block root,
+// deleting the validator entries which are no longer referenced by any state.
+func deleteStateValidators(tx *bolt.Tx, blockRoot []byte) error {
+	idxBkt := tx.Bucket(blockRootValidatorHashesBucket)
+	hashes := idxBkt.Get(blockRoot)
+	if hashes == nil {
+		return nil
+	}
+	valBkt := tx.Bucket(stateValidatorsBucket)
+	for i := 0; i+32 <= len(hashes); i += 32 {
+		h := hashes[i : i+32]
+		entry := valBkt.Get(h)
+		if len(entry) < refCountLength {
+			continue
+		}
+		count := refCount(entry)
+		if count <= 1 {
+			if err := valBkt.Delete(h); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := valBkt.Put(h, withRefCount(entry, count-1)); err != nil {
+			return err
+		}
+	}
+	return idxBkt.Delete(blockRoot)
+}
+
+// saveStateWithoutValidators encodes the state without its validators, storing them separately.
+func saveStateWithoutValidators(ctx context.Context, tx *bolt.Tx, blockRoot []byte, st *pb.BeaconState) error {
+	if err := saveStateValidators(ctx, tx, blockRoot, st.Validators); err != nil {
+		return errors.Wrap(err, "could not save state validators")
+	}
+	enc, err := encode(ctx, stateWithoutValidators(st))
+	if err != nil {
+		return err
+	}
+	return tx.Bucket(stateBucket).Put(blockRoot, enc)
+}
+
+// saveAltairStateWithoutValidators encodes the Altair state without its validators, storing them
+// separately, and prefixes the encoding with altairKey.
+func saveAltairStateWithoutValidators(ctx context.Context, tx *bolt.Tx, blockRoot []byte, st *pb.BeaconStateAltair) error {
+	if err := saveStateValidators(ctx, tx, blockRoot, st.Validators); err != nil {
+		return errors.Wrap(err, "could not save state validators")
+	}
+	enc, err := encode(ctx, altairStateWithoutValidators(st))
+	if err != nil {
+		return err
+	}
+	return tx.Bucket(stateBucket).Put(blockRoot, withAltairKey(enc))
+}
+
+// stateFromBytes decodes a stored state and fills in its validators if they were stored separately.
+func stateFromBytes(ctx context.Context, tx *bolt.Tx, blockRoot []byte, enc []byte) (*pb.BeaconState, error) {
+	st, err := createState(ctx, enc)
+	if err != nil {
+		return nil, err
+	}
+	validators, ok, err := stateValidators(ctx, tx, blockRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve state validators")
+	}
+	if ok {
+		st.Validators = validators
+	}
+	return st, nil
+}
+
+// stateWithoutValidators returns a shallow copy of the state with an empty validator registry.
+func stateWithoutValidators(st *pb.BeaconState) *pb.BeaconState {
+	return &pb.BeaconState{
+		GenesisTime:                 st.GenesisTime,
+		GenesisValidatorsRoot:       st.GenesisValidatorsRoot,
+		Slot:                        st.Slot,
+		Fork:                        st.Fork,
+		LatestBlockHeader:           st.LatestBlockHeader,
+		BlockRoots:                  st.BlockRoots,
+		StateRoots:                  st.StateRoots,
+		HistoricalRoots:             st.HistoricalRoots,
+		Eth1Data:                    st.Eth1Data,
+		Eth1DataVotes:               st.Eth1DataVotes,
+		Eth1DepositIndex:            st.Eth1DepositIndex,
+		Validators:                  []*ethpb.Validator{},
+		Balances:                    st.Balances,
+		RandaoMixes:                 st.RandaoMixes,
+		Slashings:                   st.Slashings,
+		PreviousEpochAttestations:   st.PreviousEpochAttestations,
+		CurrentEpochAttestations:    st.CurrentEpochAttestations,
+		JustificationBits:           st.JustificationBits,
+		PreviousJustifiedCheckpoint: st.PreviousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:  st.CurrentJustifiedCheckpoint,
+		FinalizedCheckpoint:         st.FinalizedCheckpoint,
+	}
+}
+
+// altairStateFromBytes decodes a stored Altair state, without its altairKey prefix, and fills in
+// its validators if they were stored separately.
+func altairStateFromBytes(ctx context.Context, tx *bolt.Tx, blockRoot []byte, enc []byte) (*pb.BeaconStateAltair, error) {
+	st := &pb.BeaconStateAltair{}
+	if err := decode(ctx, enc, st); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal encoding")
+	}
+	validators, ok, err := stateValidators(ctx, tx, blockRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve state validators")
+	}
+	if ok {
+		st.Validators = validators
+	}
+	return st, nil
+}
+
+// altairStateWithoutValidators returns a shallow copy of the Altair state with an empty validator registry.
+func altairStateWithoutValidators(st *pb.BeaconStateAltair) *pb.BeaconStateAltair {
+	return &pb.BeaconStateAltair{
+		GenesisTime:                 st.GenesisTime,
+		GenesisValidatorsRoot:       st.GenesisValidatorsRoot,
+		Slot:                        st.Slot,
+		Fork:                        st.Fork,
+		LatestBlockHeader:           st.LatestBlockHeader,
+		BlockRoots:                  st.BlockRoots,
+		StateRoots:                  st.StateRoots,
+		HistoricalRoots:             st.HistoricalRoots,
+		Eth1Data:                    st.Eth1Data,
+		Eth1DataVotes:               st.Eth1DataVotes,
+		Eth1DepositIndex:            st.Eth1DepositIndex,
+		Validators:                  []*ethpb.Validator{},
+		Balances:                    st.Balances,
+		RandaoMixes:                 st.RandaoMixes,
+		Slashings:                   st.Slashings,
+		PreviousEpochParticipation:  st.PreviousEpochParticipation,
+		CurrentEpochParticipation:   st.CurrentEpochParticipation,
+		JustificationBits:           st.JustificationBits,
+		PreviousJustifiedCheckpoint: st.PreviousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:  st.CurrentJustifiedCheckpoint,
+		FinalizedCheckpoint:         st.FinalizedCheckpoint,
+		InactivityScores:            st.InactivityScores,
+		CurrentSyncCommittee:        st.CurrentSyncCommittee,
+		NextSyncCommittee:           st.NextSyncCommittee,
+	}
+}
+
+func refCount(entry []byte) uint64 {
+	return binary.BigEndian.Uint64(entry[:refCountLength])
+}
+
+// withRefCount returns a copy of the validator entry with the given reference count.
+func withRefCount(entry []byte, count uint64) []byte {
+	updated := make([]byte, len(entry))
+	copy(updated, entry)
+	binary.BigEndian.PutUint64(updated[:refCountLength], count)
+	return updated
+}
@@ -0,0 +1,109 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func stateWithValidators(t *testing.T, count uint64) iface.BeaconState {
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	validators := make([]*ethpb.Validator, count)
+	balances := make([]uint64, count)
+	for i := uint64(0); i < count; i++ {
+		validators[i] = &ethpb.Validator{
+			PublicKey:                  bytesutil.PadTo(bytesutil.Bytes8(i), 48),
+			WithdrawalCredentials:      make([]byte, 32),
+			EffectiveBalance:           params.BeaconConfig().MaxEffectiveBalance,
+			ActivationEligibilityEpoch: params.BeaconConfig().FarFutureEpoch,
+			ActivationEpoch:            0,
+			ExitEpoch:                  params.BeaconConfig().FarFutureEpoch,
+			WithdrawableEpoch:          params.BeaconConfig().FarFutureEpoch,
+		}
+		balances[i] = params.BeaconConfig().MaxEffectiveBalance
+	}
+	require.NoError(t, st.SetValidators(validators))
+	require.NoError(t, st.SetBalances(balances))
+	return st
+}
+
+func validatorEntriesCount(t *testing.T, db *Store) int {
+	count := 0
+	require.NoError(t, db.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(stateValidatorsBucket).ForEach(func(_, _ []byte) error {
+			count++
+			return nil
+		})
+	}))
+	return count
+}
+
+func TestStore_SaveState_DeduplicatesValidators(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	st := stateWithValidators(t, 8)
+	require.NoError(t, st.SetSlot(1))
+	r1 := [32]byte{'A'}
+	require.NoError(t, db.SaveState(ctx, st, r1))
+	assert.Equal(t, 8, validatorEntriesCount(t, db))
+
+	// A later state with a single changed validator only adds one entry.
+	st2 := st.Copy()
+	require.NoError(t, st2.SetSlot(2))
+	require.NoError(t, st2.UpdateValidatorAtIndex(3, &ethpb.Validator{
+		PublicKey:             bytesutil.PadTo(bytesutil.Bytes8(3), 48),
+		WithdrawalCredentials: make([]byte, 32),
+		Slashed:               true,
+		ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+		WithdrawableEpoch:     params.BeaconConfig().FarFutureEpoch,
+	}))
+	r2 := [32]byte{'B'}
+	require.NoError(t, db.SaveState(ctx, st2, r2))
+	assert.Equal(t, 9, validatorEntriesCount(t, db))
+
+	// Saving a state under the same root again must not leak references.
+	require.NoError(t, db.SaveState(ctx, st2, r2))
+	assert.Equal(t, 9, validatorEntriesCount(t, db))
+
+	saved, err := db.State(ctx, r1)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, st.InnerStateUnsafe(), saved.InnerStateUnsafe())
+	saved, err = db.State(ctx, r2)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, st2.InnerStateUnsafe(), saved.InnerStateUnsafe())
+
+	// Deleting the first state removes the validator only it referenced.
+	require.NoError(t, db.DeleteState(ctx, r1))
+	assert.Equal(t, 8, validatorEntriesCount(t, db))
+	saved, err = db.State(ctx, r2)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, st2.InnerStateUnsafe(), saved.InnerStateUnsafe())
+
+	require.NoError(t, db.DeleteState(ctx, r2))
+	assert.Equal(t, 0, validatorEntriesCount(t, db))
+}
+
+func TestStore_HighestSlotStatesBelow_RebuildsValidators(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	st := stateWithValidators(t, 4)
+	require.NoError(t, st.SetSlot(10))
+	require.NoError(t, db.SaveState(ctx, st, [32]byte{'A'}))
+
+	highest, err := db.HighestSlotStatesBelow(ctx, 11)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(highest))
+	assert.DeepSSZEqual(t, st.InnerStateUnsafe(), highest[0].InnerStateUnsafe())
+	assert.Equal(t, 4, highest[0].NumValidators())
+}
@@ -0,0 +1,216 @@
+package db
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"sort"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// openOfflineDB opens the database in the data directory of a stopped beacon node.
+func openOfflineDB(cliCtx *cli.Context) (*kv.Store, error) {
+	dbPath := path.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName)
+	if !fileutil.FileExists(kv.KVStoreDatafilePath(dbPath)) {
+		return nil, fmt.Errorf("no database found in %s", dbPath)
+	}
+	return kv.NewKVStore(cliCtx.Context, dbPath, &kv.Config{})
+}
+
+func closeOfflineDB(d *kv.Store) {
+	if err := d.Close(); err != nil {
+		log.WithError(err).Error("Could not close database")
+	}
+}
+
+// Inspect prints the size of every bucket of a beacon chain database along with the head,
+// checkpoints and migration status it contains.
+func Inspect(cliCtx *cli.Context) error {
+	d, err := openOfflineDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer closeOfflineDB(d)
+	ctx := cliCtx.Context
+
+	stats, err := d.BucketStats(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not read bucket stats")
+	}
+	total := uint64(0)
+	fmt.Printf("%-40s %12s %12s %12s\n", "BUCKET", "ROWS", "SIZE", "MAX VALUE")
+	for _, st := range stats {
+		size := st.KeysSize + st.ValuesSize
+		total += size
+		fmt.Printf("%-40s %12d %12s %12s\n", st.Name, st.Rows, humanize.Bytes(size), humanize.Bytes(st.MaxValueSize))
+	}
+	fmt.Printf("%-40s %12s %12s\n", "TOTAL", "", humanize.Bytes(total))
+	if fi, err := os.Stat(kv.KVStoreDatafilePath(d.DatabasePath())); err == nil {
+		fmt.Printf("%-40s %12s %12s\n", "FILE", "", humanize.Bytes(uint64(fi.Size())))
+	}
+	fmt.Println()
+
+	genesis, err := d.GenesisBlock(ctx)
+	if err != nil {
+		return err
+	}
+	if genesis != nil && !genesis.IsNil() {
+		root, err := genesis.Block().HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Genesis block:        %#x\n", root)
+	}
+	head, err := d.HeadBlock(ctx)
+	if err != nil {
+		return err
+	}
+	if head != nil && !head.IsNil() {
+		root, err := head.Block().HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Head block:           %#x (slot %d)\n", root, head.Block().Slot())
+	}
+	justified, err := d.JustifiedCheckpoint(ctx)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Justified checkpoint: %#x (epoch %d)\n", justified.Root, justified.Epoch)
+	finalized, err := d.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Finalized checkpoint: %#x (epoch %d)\n", finalized.Root, finalized.Epoch)
+	origin, err := d.OriginBlockRoot(ctx)
+	switch {
+	case errors.Is(err, ErrNotFoundOriginBlockRoot):
+	case err != nil:
+		return err
+	default:
+		fmt.Printf("Origin block:         %#x\n", origin)
+	}
+	backfill, err := d.BackfillBlockRoot(ctx)
+	switch {
+	case errors.Is(err, ErrNotFoundBackfillBlockRoot):
+	case err != nil:
+		return err
+	default:
+		fmt.Printf("Backfilled down to:   %#x\n", backfill)
+	}
+	fmt.Println()
+
+	status, err := d.MigrationStatus(ctx)
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(status))
+	for name := range status {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fmt.Println("Migrations:")
+	for _, name := range names {
+		state := "pending"
+		if status[name] {
+			state = "done"
+		}
+		fmt.Printf("  %-38s %s\n", name, state)
+	}
+	return nil
+}
+
+// Prune removes the non-finalized forks and the old hot states below a slot from a beacon chain
+// database.
+func Prune(cliCtx *cli.Context) error {
+	if !cliCtx.IsSet(cmd.PruneBeforeSlotFlag.Name) {
+		return fmt.Errorf("--%s is required", cmd.PruneBeforeSlotFlag.Name)
+	}
+	d, err := openOfflineDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer closeOfflineDB(d)
+
+	slot := types.Slot(cliCtx.Uint64(cmd.PruneBeforeSlotFlag.Name))
+	slotsPerArchivedPoint := types.Slot(cliCtx.Int(flags.SlotsPerArchivedPoint.Name))
+	stats, err := d.PruneBeforeSlot(cliCtx.Context, slot, slotsPerArchivedPoint)
+	if err != nil {
+		return errors.Wrap(err, "could not prune database")
+	}
+	log.WithFields(logrus.Fields{
+		"beforeSlot":    slot,
+		"blocksDeleted": stats.BlocksDeleted,
+		"statesDeleted": stats.StatesDeleted,
+	}).Info("Prune completed successfully, run the compact command to reclaim the freed disk space")
+	return nil
+}
+
+// Compact rewrites a beacon chain database into a new file to reclaim the space of free pages.
+func Compact(cliCtx *cli.Context) error {
+	d, err := openOfflineDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	dbFile := kv.KVStoreDatafilePath(d.DatabasePath())
+	compactedFile := dbFile + ".compact"
+	before, err := os.Stat(dbFile)
+	if err != nil {
+		closeOfflineDB(d)
+		return err
+	}
+
+	if err := d.Compact(cliCtx.Context, compactedFile); err != nil {
+		closeOfflineDB(d)
+		if rmErr := os.Remove(compactedFile); rmErr != nil && !os.IsNotExist(rmErr) {
+			log.WithError(rmErr).Error("Could not remove partially compacted database")
+		}
+		return errors.Wrap(err, "could not compact database")
+	}
+	if err := d.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(compactedFile, dbFile); err != nil {
+		return errors.Wrap(err, "could not replace database with compacted database")
+	}
+	after, err := os.Stat(dbFile)
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"sizeBefore": humanize.Bytes(uint64(before.Size())),
+		"sizeAfter":  humanize.Bytes(uint64(after.Size())),
+	}).Info("Compaction completed successfully")
+	return nil
+}
+
+// Verify walks the finalized chain of a beacon chain database and reports inconsistencies.
+func Verify(cliCtx *cli.Context) error {
+	d, err := openOfflineDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer closeOfflineDB(d)
+
+	report, err := d.VerifyFinalizedChain(cliCtx.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not verify database")
+	}
+	for _, p := range report.Problems {
+		log.Error(p)
+	}
+	if len(report.Problems) > 0 {
+		return fmt.Errorf("found %d problems in %d finalized blocks", len(report.Problems), report.BlocksChecked)
+	}
+	log.WithField("blocksChecked", report.BlocksChecked).Info("Verification completed successfully")
+	return nil
+}
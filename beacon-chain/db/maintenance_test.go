@@ -0,0 +1,77 @@
+package db
+
+import (
+	"context"
+	"flag"
+	"path"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/urfave/cli/v2"
+)
+
+func setupOfflineDB(t *testing.T) (string, [32]byte) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+	d, err := kv.NewKVStore(ctx, path.Join(dataDir, kv.BeaconNodeDbDirName), &kv.Config{})
+	require.NoError(t, err)
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, d.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(genesis)))
+	root, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, d.SaveGenesisBlockRoot(ctx, root))
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, d.SaveState(ctx, st, root))
+	require.NoError(t, d.SaveHeadBlockRoot(ctx, root))
+	require.NoError(t, d.Close())
+	return dataDir, root
+}
+
+func offlineCliContext(t *testing.T, dataDir string) *cli.Context {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, "", "")
+	require.NoError(t, set.Set(cmd.DataDirFlag.Name, dataDir))
+	return cli.NewContext(&app, set, nil)
+}
+
+func TestCompact(t *testing.T) {
+	logHook := logTest.NewGlobal()
+	dataDir, root := setupOfflineDB(t)
+
+	require.NoError(t, Compact(offlineCliContext(t, dataDir)))
+	assert.LogsContain(t, logHook, "Compaction completed successfully")
+
+	d, err := kv.NewKVStore(context.Background(), path.Join(dataDir, kv.BeaconNodeDbDirName), &kv.Config{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, d.Close())
+	}()
+	head, err := d.HeadBlock(context.Background())
+	require.NoError(t, err)
+	headRoot, err := head.Block().HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, root, headRoot)
+	assert.Equal(t, types.Slot(0), head.Block().Slot())
+}
+
+func TestVerify(t *testing.T) {
+	logHook := logTest.NewGlobal()
+	dataDir, _ := setupOfflineDB(t)
+
+	require.NoError(t, Verify(offlineCliContext(t, dataDir)))
+	assert.LogsContain(t, logHook, "Verification completed successfully")
+}
+
+func TestInspect_NoDatabase(t *testing.T) {
+	err := Inspect(offlineCliContext(t, t.TempDir()))
+	assert.ErrorContains(t, "no database found", err)
+}
@@ -2,6 +2,7 @@ package forkchoice
 
 import (
 	"context"
+	"time"
 
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
@@ -12,8 +13,10 @@ type ForkChoicer interface {
 	HeadRetriever        // to compute head.
 	BlockProcessor       // to track new block for fork choice.
 	AttestationProcessor // to track new attestation for fork choice.
+	SlashingProcessor    // to stop counting the votes of equivocating validators.
 	Pruner               // to clean old data for fork choice.
 	Getter               // to retrieve fork choice information.
+	ProposerBooster      // to boost the score of timely blocks.
 }
 
 // HeadRetriever retrieves head root of the current chain.
@@ -31,14 +34,26 @@ type AttestationProcessor interface {
 	ProcessAttestation(context.Context, []uint64, [32]byte, types.Epoch)
 }
 
+// SlashingProcessor processes the equivocating validators of attester slashings for fork choice.
+type SlashingProcessor interface {
+	InsertEquivocatingIndices(context.Context, []uint64)
+}
+
 // Pruner prunes the fork choice upon new finalization. This is used to keep fork choice sane.
 type Pruner interface {
 	Prune(context.Context, [32]byte) error
 }
 
+// ProposerBooster boosts the score of the timely block of the current slot during fork choice.
+type ProposerBooster interface {
+	BoostProposerRoot(ctx context.Context, blockSlot types.Slot, blockRoot [32]byte, genesisTime, receivedTime time.Time) error
+	ResetBoostedProposerRoot(ctx context.Context, currentSlot types.Slot) error
+}
+
 // Getter returns fork choice related information.
 type Getter interface {
 	Nodes() []*protoarray.Node
+	Votes() []protoarray.Vote
 	Node([32]byte) *protoarray.Node
 	HasNode([32]byte) bool
 	Store() *protoarray.Store
@@ -0,0 +1,61 @@
+package protoarray
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestVotes_EquivocatingValidatorsAreIgnored(t *testing.T) {
+	ctx := context.Background()
+	balances := []uint64{10, 10, 10, 10}
+	f := setup(1, 1)
+
+	// Insert blocks 1 and 2 into the tree:
+	//         0
+	//        / \
+	//       1   2
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(2), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+
+	// Validators 0 and 1 vote for block 1, validator 2 votes for block 2.
+	f.ProcessAttestation(ctx, []uint64{0, 1}, indexToHash(1), 2)
+	f.ProcessAttestation(ctx, []uint64{2}, indexToHash(2), 2)
+	r, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), r, "Incorrect head")
+	assert.Equal(t, uint64(20), f.store.nodes[f.store.nodesIndices[indexToHash(1)]].weight)
+
+	// Validators 0 and 1 are slashed, their votes are removed and block 2 becomes head.
+	f.InsertEquivocatingIndices(ctx, []uint64{0, 1})
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(2), r, "Incorrect head after slashing")
+	assert.Equal(t, uint64(0), f.store.nodes[f.store.nodesIndices[indexToHash(1)]].weight)
+	assert.Equal(t, uint64(10), f.store.nodes[f.store.nodesIndices[indexToHash(2)]].weight)
+
+	// Recomputing head does not remove the votes twice.
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(2), r, "Incorrect head after recomputing")
+	assert.Equal(t, uint64(0), f.store.nodes[f.store.nodesIndices[indexToHash(1)]].weight)
+
+	// Later votes of the slashed validators are not counted.
+	f.ProcessAttestation(ctx, []uint64{0, 1}, indexToHash(1), 3)
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(2), r, "Incorrect head after votes of slashed validators")
+	assert.Equal(t, uint64(0), f.store.nodes[f.store.nodesIndices[indexToHash(1)]].weight)
+
+	// A vote of an honest validator still counts.
+	f.ProcessAttestation(ctx, []uint64{3}, indexToHash(1), 3)
+	f.ProcessAttestation(ctx, []uint64{2}, indexToHash(1), 3)
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), r, "Incorrect head after honest votes")
+	assert.Equal(t, uint64(20), f.store.nodes[f.store.nodesIndices[indexToHash(1)]].weight)
+	assert.Equal(t, uint64(0), f.store.nodes[f.store.nodesIndices[indexToHash(2)]].weight)
+}
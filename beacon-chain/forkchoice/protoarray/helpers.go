@@ -9,11 +9,13 @@ import (
 
 // This computes validator balance delta from validator votes.
 // It returns a list of deltas that represents the difference between old balances and new balances.
+// The votes of equivocating validators are removed from the weights and never counted again.
 func computeDeltas(
 	ctx context.Context,
 	blockIndices map[[32]byte]uint64,
 	votes []Vote,
 	oldBalances, newBalances []uint64,
+	equivocatingIndices map[uint64]bool,
 ) ([]int, []Vote, error) {
 	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.computeDeltas")
 	defer span.End()
@@ -38,6 +40,21 @@ func computeDeltas(
 			newBalance = newBalances[validatorIndex]
 		}
 
+		// Remove the vote of an equivocating validator which is still counted, and clear its current root
+		// so that it is not removed again.
+		if equivocatingIndices[uint64(validatorIndex)] {
+			currentDeltaIndex, ok := blockIndices[vote.currentRoot]
+			if ok && vote.currentRoot != params.BeaconConfig().ZeroHash {
+				if int(currentDeltaIndex) >= len(deltas) {
+					return nil, nil, errInvalidNodeDelta
+				}
+				deltas[currentDeltaIndex] -= int(oldBalance)
+			}
+			vote.currentRoot = params.BeaconConfig().ZeroHash
+			votes[validatorIndex] = vote
+			continue
+		}
+
 		// Perform delta only if the validator's balance or vote has changed.
 		if vote.currentRoot != vote.nextRoot || oldBalance != newBalance {
 			// Ignore the vote if it's not known in `blockIndices`,
@@ -0,0 +1,118 @@
+package protoarray
+
+import (
+	"context"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// BoostProposerRoot sets the block root which gets the proposer boost in the weight calculations
+// of fork choice. Only a block of the current slot which is received before the attestation
+// deadline of the slot is boosted, this rewards timely proposers against ex ante reorgs.
+//
+// Spec pseudocode definition:
+//   # Add proposer score boost if the block is timely
+//   time_into_slot = (store.time - store.genesis_time) % SECONDS_PER_SLOT
+//   is_before_attesting_interval = time_into_slot < SECONDS_PER_SLOT // INTERVALS_PER_SLOT
+//   if get_current_slot(store) == block.slot and is_before_attesting_interval:
+//       store.proposer_boost_root = hash_tree_root(block)
+func (f *ForkChoice) BoostProposerRoot(_ context.Context, blockSlot types.Slot, blockRoot [32]byte, genesisTime, receivedTime time.Time) error {
+	if receivedTime.Before(genesisTime) {
+		return nil
+	}
+	secondsPerSlot := params.BeaconConfig().SecondsPerSlot
+	intervalsPerSlot := params.BeaconConfig().IntervalsPerSlot
+	if secondsPerSlot == 0 || intervalsPerSlot == 0 {
+		return nil
+	}
+	sinceGenesis := uint64(receivedTime.Sub(genesisTime).Seconds())
+	currentSlot := types.Slot(sinceGenesis / secondsPerSlot)
+	timeIntoSlot := sinceGenesis % secondsPerSlot
+	if currentSlot != blockSlot || timeIntoSlot >= secondsPerSlot/intervalsPerSlot {
+		return nil
+	}
+
+	f.store.proposerBoostLock.Lock()
+	defer f.store.proposerBoostLock.Unlock()
+	f.store.proposerBoostRoot = blockRoot
+	f.store.proposerBoostSlot = blockSlot
+	return nil
+}
+
+// ResetBoostedProposerRoot clears the proposer boost at the start of a new slot. A block of the
+// current slot which was boosted before the call keeps its boost, so that a reset which runs late
+// does not race with the timely block of the new slot.
+//
+// Spec pseudocode definition:
+//   # Reset store.proposer_boost_root if this is a new slot
+//   if current_slot > previous_slot:
+//       store.proposer_boost_root = Root()
+func (f *ForkChoice) ResetBoostedProposerRoot(_ context.Context, currentSlot types.Slot) error {
+	f.store.proposerBoostLock.Lock()
+	defer f.store.proposerBoostLock.Unlock()
+	if f.store.proposerBoostSlot < currentSlot {
+		f.store.proposerBoostRoot = [32]byte{}
+	}
+	return nil
+}
+
+// applyProposerBoostScore adjusts the node deltas with the proposer boost. The score applied in the
+// previous weight update is removed from its node and the score of the currently boosted node is added,
+// the deltas then get back propagated to the ancestors like the ones of the votes.
+func (s *Store) applyProposerBoostScore(newBalances []uint64, delta []int) error {
+	s.proposerBoostLock.Lock()
+	defer s.proposerBoostLock.Unlock()
+
+	if s.previousProposerBoostRoot != params.BeaconConfig().ZeroHash {
+		// The previously boosted node may have been pruned in the meantime.
+		if i, ok := s.nodesIndices[s.previousProposerBoostRoot]; ok {
+			if i >= uint64(len(delta)) {
+				return errInvalidNodeIndex
+			}
+			delta[i] -= int(s.previousProposerBoostScore)
+		}
+	}
+
+	score := uint64(0)
+	if s.proposerBoostRoot != params.BeaconConfig().ZeroHash {
+		if i, ok := s.nodesIndices[s.proposerBoostRoot]; ok {
+			if i >= uint64(len(delta)) {
+				return errInvalidNodeIndex
+			}
+			score = computeProposerBoostScore(newBalances)
+			delta[i] += int(score)
+		}
+	}
+	s.previousProposerBoostRoot = s.proposerBoostRoot
+	s.previousProposerBoostScore = score
+	return nil
+}
+
+// computeProposerBoostScore computes the proposer boost as a fraction of the weight of a committee. The
+// input balances are the ones of the justified state, where inactive validators have a zero balance.
+//
+// Spec pseudocode definition:
+//   num_validators = len(get_active_validator_indices(state, get_current_epoch(state)))
+//   avg_balance = get_total_active_balance(state) // num_validators
+//   committee_size = num_validators // SLOTS_PER_EPOCH
+//   committee_weight = committee_size * avg_balance
+//   proposer_score = (committee_weight * PROPOSER_SCORE_BOOST) // 100
+func computeProposerBoostScore(balances []uint64) uint64 {
+	totalActiveBalance, numActive := uint64(0), uint64(0)
+	for _, b := range balances {
+		if b == 0 {
+			continue
+		}
+		totalActiveBalance += b
+		numActive++
+	}
+	if numActive == 0 {
+		return 0
+	}
+	avgBalance := totalActiveBalance / numActive
+	committeeSize := numActive / uint64(params.BeaconConfig().SlotsPerEpoch)
+	committeeWeight := committeeSize * avgBalance
+	return committeeWeight * params.BeaconConfig().ProposerScoreBoost / 100
+}
@@ -0,0 +1,184 @@
+package protoarray
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// boostBalances returns the balances of 64 active validators, which gives a proposer score of
+// (64 / 32 * 10) * 70 / 100 = 14 with the mainnet config.
+func boostBalances() []uint64 {
+	balances := make([]uint64, 64)
+	for i := range balances {
+		balances[i] = 10
+	}
+	return balances
+}
+
+func slotTime(genesisTime time.Time, slot types.Slot, offset time.Duration) time.Time {
+	return genesisTime.Add(time.Duration(uint64(slot)*params.BeaconConfig().SecondsPerSlot)*time.Second + offset)
+}
+
+func TestComputeProposerBoostScore(t *testing.T) {
+	assert.Equal(t, uint64(14), computeProposerBoostScore(boostBalances()))
+	// Inactive validators do not count toward the committee weight.
+	assert.Equal(t, uint64(14), computeProposerBoostScore(append(boostBalances(), 0, 0, 0)))
+	// Less active validators than slots in an epoch make empty committees.
+	assert.Equal(t, uint64(0), computeProposerBoostScore([]uint64{10, 10}))
+	assert.Equal(t, uint64(0), computeProposerBoostScore(nil))
+}
+
+func TestForkChoice_BoostProposerRoot(t *testing.T) {
+	ctx := context.Background()
+	genesisTime := time.Unix(1600000000, 0)
+	root := [32]byte{'a'}
+
+	tests := []struct {
+		name         string
+		blockSlot    types.Slot
+		receivedTime time.Time
+		boosted      bool
+	}{
+		{
+			name:         "timely block",
+			blockSlot:    1,
+			receivedTime: slotTime(genesisTime, 1, time.Second),
+			boosted:      true,
+		},
+		{
+			name:         "block received after the attestation deadline",
+			blockSlot:    1,
+			receivedTime: slotTime(genesisTime, 1, 5*time.Second),
+			boosted:      false,
+		},
+		{
+			name:         "block of a previous slot",
+			blockSlot:    1,
+			receivedTime: slotTime(genesisTime, 2, 0),
+			boosted:      false,
+		},
+		{
+			name:         "block received before genesis",
+			blockSlot:    0,
+			receivedTime: genesisTime.Add(-time.Second),
+			boosted:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := setup(0, 0)
+			require.NoError(t, f.BoostProposerRoot(ctx, tt.blockSlot, root, genesisTime, tt.receivedTime))
+			if tt.boosted {
+				assert.Equal(t, root, f.store.proposerBoostRoot)
+			} else {
+				assert.Equal(t, [32]byte{}, f.store.proposerBoostRoot)
+			}
+		})
+	}
+}
+
+func TestForkChoice_ResetBoostedProposerRoot(t *testing.T) {
+	ctx := context.Background()
+	f := setup(0, 0)
+	f.store.proposerBoostRoot = [32]byte{'a'}
+	f.store.proposerBoostSlot = 5
+
+	// A block boosted in the current slot before the reset keeps its boost.
+	require.NoError(t, f.ResetBoostedProposerRoot(ctx, 5))
+	assert.Equal(t, [32]byte{'a'}, f.store.proposerBoostRoot)
+
+	require.NoError(t, f.ResetBoostedProposerRoot(ctx, 6))
+	assert.Equal(t, [32]byte{}, f.store.proposerBoostRoot)
+}
+
+// Mirrors the test_proposer_boost_correct_head fork choice test of the consensus specs.
+func TestForkChoice_ProposerBoost_CorrectHead(t *testing.T) {
+	ctx := context.Background()
+	genesisTime := time.Unix(1600000000, 0)
+	zeroHash := params.BeaconConfig().ZeroHash
+	balances := boostBalances()
+	f := setup(0, 0)
+
+	// Block 2 serves as the current head and remains the head after the slot of block 1. Its root is
+	// higher than the one of block 1, so it wins the tie break without any votes.
+	root1, root2 := [32]byte{'a'}, [32]byte{'b'}
+
+	// Block 2 is received late at the slot of block 1, so it is not boosted.
+	require.NoError(t, f.ProcessBlock(ctx, 3, root2, zeroHash, [32]byte{}, 0, 0))
+	require.NoError(t, f.BoostProposerRoot(ctx, 3, root2, genesisTime, slotTime(genesisTime, 4, 0)))
+	assert.Equal(t, [32]byte{}, f.store.proposerBoostRoot)
+	r, err := f.Head(ctx, 0, zeroHash, balances, 0)
+	require.NoError(t, err)
+	assert.Equal(t, root2, r, "Incorrect head before block 1")
+
+	// Block 1 arrives in a timely manner, the head temporarily changes to it.
+	require.NoError(t, f.ProcessBlock(ctx, 4, root1, zeroHash, [32]byte{}, 0, 0))
+	require.NoError(t, f.BoostProposerRoot(ctx, 4, root1, genesisTime, slotTime(genesisTime, 4, time.Second)))
+	assert.Equal(t, root1, f.store.proposerBoostRoot)
+	r, err = f.Head(ctx, 0, zeroHash, balances, 0)
+	require.NoError(t, err)
+	assert.Equal(t, root1, r, "Incorrect head with proposer boost")
+	assert.Equal(t, uint64(14), f.store.nodes[f.store.nodesIndices[root1]].weight)
+
+	// After the slot of block 1, the head reverts to block 2.
+	require.NoError(t, f.ResetBoostedProposerRoot(ctx, 5))
+	r, err = f.Head(ctx, 0, zeroHash, balances, 0)
+	require.NoError(t, err)
+	assert.Equal(t, root2, r, "Incorrect head after the proposer boost was reset")
+	assert.Equal(t, uint64(0), f.store.nodes[f.store.nodesIndices[root1]].weight)
+}
+
+func TestForkChoice_ProposerBoost_BackPropagatesToAncestors(t *testing.T) {
+	ctx := context.Background()
+	genesisTime := time.Unix(1600000000, 0)
+	zeroHash := params.BeaconConfig().ZeroHash
+	balances := boostBalances()
+	f := setup(0, 0)
+
+	//   0
+	//   |
+	//   a
+	//  / \
+	// b   c <- boosted
+	rootA, rootB, rootC := [32]byte{'a'}, [32]byte{'b'}, [32]byte{'c'}
+	require.NoError(t, f.ProcessBlock(ctx, 1, rootA, zeroHash, [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 2, rootB, rootA, [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 3, rootC, rootA, [32]byte{}, 0, 0))
+	require.NoError(t, f.BoostProposerRoot(ctx, 3, rootC, genesisTime, slotTime(genesisTime, 3, 0)))
+
+	// A single vote for b does not outweigh the boost of c.
+	f.ProcessAttestation(ctx, []uint64{0}, rootB, 0)
+	r, err := f.Head(ctx, 0, zeroHash, balances, 0)
+	require.NoError(t, err)
+	assert.Equal(t, rootC, r, "Incorrect head with a lower vote weight than the proposer boost")
+	assert.Equal(t, uint64(10), f.store.nodes[f.store.nodesIndices[rootB]].weight)
+	assert.Equal(t, uint64(14), f.store.nodes[f.store.nodesIndices[rootC]].weight)
+	assert.Equal(t, uint64(24), f.store.nodes[f.store.nodesIndices[rootA]].weight)
+
+	// Recomputing the head in the same slot does not apply the boost twice.
+	r, err = f.Head(ctx, 0, zeroHash, balances, 0)
+	require.NoError(t, err)
+	assert.Equal(t, rootC, r)
+	assert.Equal(t, uint64(24), f.store.nodes[f.store.nodesIndices[rootA]].weight)
+
+	// Two votes for b outweigh the boost of c.
+	f.ProcessAttestation(ctx, []uint64{1}, rootB, 0)
+	r, err = f.Head(ctx, 0, zeroHash, balances, 0)
+	require.NoError(t, err)
+	assert.Equal(t, rootB, r, "Incorrect head with a higher vote weight than the proposer boost")
+	assert.Equal(t, uint64(34), f.store.nodes[f.store.nodesIndices[rootA]].weight)
+
+	// Once the boost is reset, only the votes remain.
+	require.NoError(t, f.ResetBoostedProposerRoot(ctx, 4))
+	r, err = f.Head(ctx, 0, zeroHash, balances, 0)
+	require.NoError(t, err)
+	assert.Equal(t, rootB, r)
+	assert.Equal(t, uint64(0), f.store.nodes[f.store.nodesIndices[rootC]].weight)
+	assert.Equal(t, uint64(20), f.store.nodes[f.store.nodesIndices[rootA]].weight)
+}
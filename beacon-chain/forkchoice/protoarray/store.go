@@ -34,7 +34,7 @@ func New(justifiedEpoch, finalizedEpoch types.Epoch, finalizedRoot [32]byte) *Fo
 	b := make([]uint64, 0)
 	v := make([]Vote, 0)
 
-	return &ForkChoice{store: s, balances: b, votes: v}
+	return &ForkChoice{store: s, balances: b, votes: v, equivocatingIndices: make(map[uint64]bool)}
 }
 
 // Head returns the head root from fork choice store.
@@ -58,12 +58,16 @@ func (f *ForkChoice) Head(
 	// Using the write lock here because `updateCanonicalNodes` that gets called subsequently requires a write operation.
 	f.store.nodesLock.Lock()
 	defer f.store.nodesLock.Unlock()
-	deltas, newVotes, err := computeDeltas(ctx, f.store.nodesIndices, f.votes, f.balances, newBalances)
+	deltas, newVotes, err := computeDeltas(ctx, f.store.nodesIndices, f.votes, f.balances, newBalances, f.equivocatingIndices)
 	if err != nil {
 		return [32]byte{}, errors.Wrap(err, "Could not compute deltas")
 	}
 	f.votes = newVotes
 
+	if err := f.store.applyProposerBoostScore(newBalances, deltas); err != nil {
+		return [32]byte{}, errors.Wrap(err, "Could not apply proposer boost score")
+	}
+
 	if err := f.store.applyWeightChanges(ctx, justifiedEpoch, finalizedEpoch, deltas); err != nil {
 		return [32]byte{}, errors.Wrap(err, "Could not apply score changes")
 	}
@@ -100,6 +104,24 @@ func (f *ForkChoice) ProcessAttestation(ctx context.Context, validatorIndices []
 	processedAttestationCount.Inc()
 }
 
+// InsertEquivocatingIndices marks validators as equivocating, after they were slashed for conflicting attestations.
+// Their latest votes are removed from the block weights on the next head computation and no later vote is counted.
+//
+// Spec pseudocode definition:
+//   def on_attester_slashing(store: Store, attester_slashing: AttesterSlashing) -> None:
+//    ...
+//    indices = set(attestation_1.attesting_indices).intersection(attestation_2.attesting_indices)
+//    for index in indices:
+//        store.equivocating_indices.add(index)
+func (f *ForkChoice) InsertEquivocatingIndices(_ context.Context, indices []uint64) {
+	f.votesLock.Lock()
+	defer f.votesLock.Unlock()
+
+	for _, index := range indices {
+		f.equivocatingIndices[index] = true
+	}
+}
+
 // ProcessBlock processes a new block by inserting it to the fork choice store.
 func (f *ForkChoice) ProcessBlock(
 	ctx context.Context,
@@ -129,6 +151,16 @@ func (f *ForkChoice) Nodes() []*Node {
 	return cpy
 }
 
+// Votes returns the copied list of the latest votes of the validators, indexed by validator index.
+func (f *ForkChoice) Votes() []Vote {
+	f.votesLock.RLock()
+	defer f.votesLock.RUnlock()
+
+	cpy := make([]Vote, len(f.votes))
+	copy(cpy, f.votes)
+	return cpy
+}
+
 // Store returns the fork choice store object which contains all the information regarding proto array fork choice.
 func (f *ForkChoice) Store() *Store {
 	f.store.nodesLock.Lock()
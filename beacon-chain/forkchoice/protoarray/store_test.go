@@ -97,6 +97,22 @@ func TestForkChoice_Nodes(t *testing.T) {
 	require.DeepEqual(t, s.nodes, f.Nodes())
 }
 
+func TestForkChoice_Votes(t *testing.T) {
+	f := New(0, 0, [32]byte{})
+	f.ProcessAttestation(context.Background(), []uint64{1}, [32]byte{'a'}, 2)
+
+	votes := f.Votes()
+	require.Equal(t, 2, len(votes))
+	assert.Equal(t, [32]byte{}, votes[0].NextRoot())
+	assert.Equal(t, [32]byte{}, votes[1].CurrentRoot())
+	assert.Equal(t, [32]byte{'a'}, votes[1].NextRoot())
+	assert.Equal(t, types.Epoch(2), votes[1].NextEpoch())
+
+	// The returned votes are a copy of the votes in the fork choice.
+	votes[1] = Vote{}
+	assert.Equal(t, [32]byte{'a'}, f.Votes()[1].NextRoot())
+}
+
 func TestStore_Head_UnknownJustifiedRoot(t *testing.T) {
 	s := &Store{nodesIndices: make(map[[32]byte]uint64)}
 
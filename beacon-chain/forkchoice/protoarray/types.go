@@ -12,6 +12,8 @@ type ForkChoice struct {
 	votes     []Vote // tracks individual validator's last vote.
 	votesLock sync.RWMutex
 	balances  []uint64 // tracks individual validator's last justified balances.
+
+	equivocatingIndices map[uint64]bool // validators slashed for equivocating attestations, their votes are not counted.
 }
 
 // Store defines the fork choice store which includes block nodes and the last view of checkpoint information.
@@ -24,6 +26,12 @@ type Store struct {
 	nodesIndices   map[[32]byte]uint64 // the root of block node and the nodes index in the list.
 	canonicalNodes map[[32]byte]bool   // the canonical block nodes.
 	nodesLock      sync.RWMutex
+
+	proposerBoostRoot          [32]byte   // root of the timely block of the current slot which gets the proposer boost.
+	proposerBoostSlot          types.Slot // slot of the block which gets the proposer boost.
+	previousProposerBoostRoot  [32]byte   // root of the block which got the proposer boost in the last weight update.
+	previousProposerBoostScore uint64     // proposer boost score applied in the last weight update.
+	proposerBoostLock          sync.Mutex
 }
 
 // Node defines the individual block which includes its block parent, ancestor and how much weight accounted for it.
@@ -0,0 +1,20 @@
+package protoarray
+
+import (
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// CurrentRoot of the validator's vote.
+func (v Vote) CurrentRoot() [32]byte {
+	return v.currentRoot
+}
+
+// NextRoot of the validator's vote.
+func (v Vote) NextRoot() [32]byte {
+	return v.nextRoot
+}
+
+// NextEpoch of the validator's vote.
+func (v Vote) NextEpoch() types.Epoch {
+	return v.nextEpoch
+}
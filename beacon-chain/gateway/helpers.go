@@ -29,12 +29,16 @@ func DefaultConfig(enableDebugRPCEndpoints bool) MuxConfig {
 	v1Registrations := []gateway.PbHandlerRegistration{
 		ethpbv1.RegisterBeaconNodeHandler,
 		ethpbv1.RegisterBeaconChainHandler,
+		ethpbv1.RegisterBeaconDepositSnapshotHandler,
+		ethpbv1.RegisterBeaconRewardsHandler,
+		ethpbv1.RegisterBeaconChainSSZHandler,
 		ethpbv1.RegisterBeaconValidatorHandler,
+		ethpbv1.RegisterBeaconValidatorSSZHandler,
 		ethpbv1.RegisterEventsHandler,
 	}
 	if enableDebugRPCEndpoints {
 		v1Alpha1Registrations = append(v1Alpha1Registrations, pbrpc.RegisterDebugHandler)
-		v1Registrations = append(v1Registrations, ethpbv1.RegisterBeaconDebugHandler)
+		v1Registrations = append(v1Registrations, ethpbv1.RegisterBeaconDebugHandler, ethpbv1.RegisterBeaconDebugForkChoiceHandler)
 
 	}
 	v1Alpha1Mux := gwruntime.NewServeMux(
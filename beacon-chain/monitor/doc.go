@@ -0,0 +1,6 @@
+// Package monitor implements a validator monitor for the beacon node. It tracks a configured set
+// of validators, given by index or public key, and reports their activity as seen by the beacon
+// node: block proposals and missed proposals, attestations received over gossip and included in
+// blocks, attestation correctness and inclusion delay, voluntary exits, slashings and the change
+// of their balances every epoch. The activity is reported in logs and as Prometheus metrics.
+package monitor
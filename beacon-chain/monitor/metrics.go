@@ -0,0 +1,65 @@
+package monitor
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	proposedBlocksTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "monitor_validator_proposed_blocks_total",
+		Help: "Total number of blocks proposed by a tracked validator",
+	}, []string{"validator_index"})
+	missedProposalsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "monitor_validator_missed_proposals_total",
+		Help: "Total number of block proposals missed by a tracked validator",
+	}, []string{"validator_index"})
+	gossipAttestationsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "monitor_validator_gossip_attestations_total",
+		Help: "Total number of unaggregated attestations of a tracked validator received over gossip or the API",
+	}, []string{"validator_index"})
+	gossipAggregatesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "monitor_validator_gossip_aggregates_total",
+		Help: "Total number of aggregated attestations of a tracked aggregator received over gossip",
+	}, []string{"validator_index"})
+	includedAttestationsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "monitor_validator_included_attestations_total",
+		Help: "Total number of attestations of a tracked validator included in blocks",
+	}, []string{"validator_index"})
+	inclusionDelayGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "monitor_validator_inclusion_delay_slots",
+		Help: "Inclusion delay in slots of the latest included attestation of a tracked validator",
+	}, []string{"validator_index"})
+	correctSourceTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "monitor_validator_correct_source_total",
+		Help: "Total number of epochs a tracked validator attested to the correct source",
+	}, []string{"validator_index"})
+	correctTargetTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "monitor_validator_correct_target_total",
+		Help: "Total number of epochs a tracked validator attested to the correct target",
+	}, []string{"validator_index"})
+	correctHeadTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "monitor_validator_correct_head_total",
+		Help: "Total number of epochs a tracked validator attested to the correct head",
+	}, []string{"validator_index"})
+	missedAttestationsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "monitor_validator_missed_attestations_total",
+		Help: "Total number of epochs a tracked active validator had no attestation included",
+	}, []string{"validator_index"})
+	balanceGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "monitor_validator_balance_gwei",
+		Help: "Balance of a tracked validator at the start of the latest epoch",
+	}, []string{"validator_index"})
+	balanceChangeGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "monitor_validator_balance_change_gwei",
+		Help: "Balance change of a tracked validator over the previous epoch",
+	}, []string{"validator_index"})
+	voluntaryExitsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "monitor_validator_voluntary_exits_total",
+		Help: "Total number of voluntary exits of a tracked validator included in blocks",
+	}, []string{"validator_index"})
+	slashingsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "monitor_validator_slashings_total",
+		Help: "Total number of slashings of a tracked validator included in blocks",
+	}, []string{"validator_index", "kind"})
+)
@@ -0,0 +1,125 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/sirupsen/logrus"
+)
+
+// attestingIndices returns the indices of the validators which signed the given attestation.
+func attestingIndices(st iface.ReadOnlyBeaconState, att *ethpb.Attestation) ([]uint64, error) {
+	committee, err := helpers.BeaconCommitteeFromState(st, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return nil, err
+	}
+	return attestationutil.AttestingIndices(att.AggregationBits, committee)
+}
+
+// processIncludedAttestations reports the attestations of the tracked validators included in a
+// block with their inclusion delay. An attestation of a validator is only reported the first
+// time it is included, aggregates may include it again in later blocks.
+func (s *Service) processIncludedAttestations(ctx context.Context, st iface.ReadOnlyBeaconState, blk interfaces.SignedBeaconBlock) {
+	slot := blk.Block().Slot()
+	for _, att := range blk.Block().Body().Attestations() {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := helpers.ValidateNilAttestation(att); err != nil {
+			continue
+		}
+		indices, err := attestingIndices(st, att)
+		if err != nil {
+			log.WithError(err).Error("Could not get attesting indices of included attestation")
+			continue
+		}
+		for _, i := range indices {
+			idx := types.ValidatorIndex(i)
+			if !s.isTracked(idx) {
+				continue
+			}
+			s.lock.Lock()
+			perf := s.performance(idx)
+			isNew := !perf.hasAttested || att.Data.Slot > perf.attestedSlot
+			if isNew {
+				perf.attestedSlot = att.Data.Slot
+				perf.hasAttested = true
+			}
+			s.lock.Unlock()
+			if !isNew {
+				continue
+			}
+			inclusionDelay := slot - att.Data.Slot
+			label := fmt.Sprintf("%d", idx)
+			includedAttestationsTotal.WithLabelValues(label).Inc()
+			inclusionDelayGauge.WithLabelValues(label).Set(float64(inclusionDelay))
+			log.WithFields(logrus.Fields{
+				"validatorIndex": idx,
+				"slot":           att.Data.Slot,
+				"inclusionSlot":  slot,
+				"inclusionDelay": inclusionDelay,
+				"sourceEpoch":    att.Data.Source.Epoch,
+				"targetEpoch":    att.Data.Target.Epoch,
+				"headRoot":       fmt.Sprintf("%#x", att.Data.BeaconBlockRoot),
+			}).Info("Attestation was included")
+		}
+	}
+}
+
+// processUnaggregatedAttestation reports an unaggregated attestation of a tracked validator
+// received over gossip or the API.
+func (s *Service) processUnaggregatedAttestation(ctx context.Context, att *ethpb.Attestation) {
+	if err := helpers.ValidateNilAttestation(att); err != nil {
+		return
+	}
+	st, err := s.cfg.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not get head state")
+		return
+	}
+	if st == nil || st.IsNil() {
+		return
+	}
+	// Attestations are received before they are validated, the ones which cannot be mapped to a
+	// committee of the head state are ignored.
+	indices, err := attestingIndices(st, att)
+	if err != nil {
+		log.WithError(err).Debug("Could not get attesting indices of received attestation")
+		return
+	}
+	for _, i := range indices {
+		idx := types.ValidatorIndex(i)
+		if !s.isTracked(idx) {
+			continue
+		}
+		gossipAttestationsTotal.WithLabelValues(fmt.Sprintf("%d", idx)).Inc()
+		log.WithFields(logrus.Fields{
+			"validatorIndex": idx,
+			"slot":           att.Data.Slot,
+			"sourceEpoch":    att.Data.Source.Epoch,
+			"targetEpoch":    att.Data.Target.Epoch,
+			"headRoot":       fmt.Sprintf("%#x", att.Data.BeaconBlockRoot),
+		}).Info("Unaggregated attestation was received")
+	}
+}
+
+// processAggregatedAttestation reports an aggregated attestation of a tracked aggregator received
+// over gossip.
+func (s *Service) processAggregatedAttestation(agg *ethpb.AggregateAttestationAndProof) {
+	if agg == nil || helpers.ValidateNilAttestation(agg.Aggregate) != nil || !s.isTracked(agg.AggregatorIndex) {
+		return
+	}
+	gossipAggregatesTotal.WithLabelValues(fmt.Sprintf("%d", agg.AggregatorIndex)).Inc()
+	log.WithFields(logrus.Fields{
+		"validatorIndex": agg.AggregatorIndex,
+		"slot":           agg.Aggregate.Data.Slot,
+		"committeeIndex": agg.Aggregate.Data.CommitteeIndex,
+		"attesters":      agg.Aggregate.AggregationBits.Count(),
+	}).Info("Aggregated attestation was received")
+}
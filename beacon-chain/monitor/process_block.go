@@ -0,0 +1,132 @@
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"github.com/sirupsen/logrus"
+)
+
+// processBlock reports the activity of the tracked validators in a processed block: its proposal,
+// the included attestations, voluntary exits and slashings. The first block of an epoch also
+// triggers the summary of the previous epochs.
+func (s *Service) processBlock(ctx context.Context, blk interfaces.SignedBeaconBlock, root [32]byte) {
+	if blk == nil || blk.IsNil() || blk.Block().IsNil() {
+		return
+	}
+	st, err := s.cfg.StateGen.StateByRoot(ctx, root)
+	if err != nil {
+		log.WithError(err).WithField("blockRoot", fmt.Sprintf("%#x", root)).Error("Could not get post state of block")
+		return
+	}
+
+	b := blk.Block()
+	if s.isTracked(b.ProposerIndex()) {
+		proposedBlocksTotal.WithLabelValues(fmt.Sprintf("%d", b.ProposerIndex())).Inc()
+		log.WithFields(logrus.Fields{
+			"validatorIndex": b.ProposerIndex(),
+			"slot":           b.Slot(),
+			"blockRoot":      fmt.Sprintf("%#x", root),
+			"attestations":   len(b.Body().Attestations()),
+		}).Info("Proposed beacon block was included")
+	}
+	s.processIncludedAttestations(ctx, st, blk)
+	s.processVoluntaryExits(b.Body().VoluntaryExits())
+	s.processSlashings(b.Body().ProposerSlashings(), b.Body().AttesterSlashings())
+
+	epoch := helpers.SlotToEpoch(b.Slot())
+	s.lock.RLock()
+	newEpoch := epoch > s.latestEpoch
+	s.lock.RUnlock()
+	if newEpoch {
+		s.processEpoch(ctx, blk, st)
+	}
+}
+
+// processVoluntaryExits reports the voluntary exits of the tracked validators included in a block.
+func (s *Service) processVoluntaryExits(exits []*ethpb.SignedVoluntaryExit) {
+	for _, exit := range exits {
+		if exit == nil || exit.Exit == nil || !s.isTracked(exit.Exit.ValidatorIndex) {
+			continue
+		}
+		voluntaryExitsTotal.WithLabelValues(fmt.Sprintf("%d", exit.Exit.ValidatorIndex)).Inc()
+		log.WithFields(logrus.Fields{
+			"validatorIndex": exit.Exit.ValidatorIndex,
+			"epoch":          exit.Exit.Epoch,
+		}).Info("Voluntary exit was included")
+	}
+}
+
+// processExit reports a voluntary exit of a tracked validator received over gossip or the API.
+func (s *Service) processExit(exit *ethpb.SignedVoluntaryExit) {
+	if exit == nil || exit.Exit == nil || !s.isTracked(exit.Exit.ValidatorIndex) {
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"validatorIndex": exit.Exit.ValidatorIndex,
+		"epoch":          exit.Exit.Epoch,
+	}).Info("Voluntary exit was received")
+}
+
+// processSlashings reports the slashings of the tracked validators included in a block.
+func (s *Service) processSlashings(proposerSlashings []*ethpb.ProposerSlashing, attesterSlashings []*ethpb.AttesterSlashing) {
+	for _, slashing := range proposerSlashings {
+		if slashing == nil || slashing.Header_1 == nil || slashing.Header_1.Header == nil {
+			continue
+		}
+		idx := slashing.Header_1.Header.ProposerIndex
+		if !s.isTracked(idx) {
+			continue
+		}
+		slashingsTotal.WithLabelValues(fmt.Sprintf("%d", idx), "proposer").Inc()
+		log.WithFields(logrus.Fields{
+			"validatorIndex": idx,
+			"slot":           slashing.Header_1.Header.Slot,
+		}).Warn("Proposer slashing was included")
+	}
+	for _, slashing := range attesterSlashings {
+		if slashing == nil || slashing.Attestation_1 == nil || slashing.Attestation_2 == nil {
+			continue
+		}
+		for _, i := range sliceutil.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices) {
+			idx := types.ValidatorIndex(i)
+			if !s.isTracked(idx) {
+				continue
+			}
+			slashingsTotal.WithLabelValues(fmt.Sprintf("%d", idx), "attester").Inc()
+			log.WithFields(logrus.Fields{
+				"validatorIndex": idx,
+				"slot1":          slashing.Attestation_1.Data.Slot,
+				"slot2":          slashing.Attestation_2.Data.Slot,
+			}).Warn("Attester slashing was included")
+		}
+	}
+}
+
+// proposedAtSlot returns true if a block was proposed at the given slot, which must not be above
+// the slot of the state nor older than the block roots history of the state.
+func proposedAtSlot(st iface.ReadOnlyBeaconState, slot types.Slot) (bool, error) {
+	if slot == st.Slot() {
+		return st.LatestBlockHeader().Slot == slot, nil
+	}
+	// The block root of a skipped slot is the root of the block of the previous slot.
+	root, err := helpers.BlockRootAtSlot(st, slot)
+	if err != nil {
+		return false, err
+	}
+	if slot == 0 {
+		return true, nil
+	}
+	prevRoot, err := helpers.BlockRootAtSlot(st, slot-1)
+	if err != nil {
+		return false, err
+	}
+	return !bytes.Equal(root, prevRoot), nil
+}
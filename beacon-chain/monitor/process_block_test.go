@@ -0,0 +1,104 @@
+package monitor
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	eth "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestProcessBlock(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	st := testState(t, 64)
+	require.NoError(t, st.SetSlot(2))
+	committee, err := helpers.BeaconCommitteeFromState(st, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, true, len(committee) > 1)
+	attester := committee[1]
+
+	srv, err := NewService(ctx, &ServiceConfig{
+		StateGen:       stategen.NewMockService(),
+		TrackedIndices: []types.ValidatorIndex{attester, 7, 8, 9},
+	})
+	require.NoError(t, err)
+	root := [32]byte{'a'}
+	srv.cfg.StateGen.(*stategen.MockStateManager).AddStateForRoot(st, root)
+
+	aggBits := bitfield.NewBitlist(uint64(len(committee)))
+	aggBits.SetBitAt(1, true)
+	att := testutil.HydrateAttestation(&eth.Attestation{
+		AggregationBits: aggBits,
+		Data:            &eth.AttestationData{Slot: 1},
+	})
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 2
+	blk.Block.ProposerIndex = 7
+	blk.Block.Body.Attestations = []*eth.Attestation{att, att}
+	blk.Block.Body.VoluntaryExits = []*eth.SignedVoluntaryExit{
+		{Exit: &eth.VoluntaryExit{ValidatorIndex: 8}},
+		{Exit: &eth.VoluntaryExit{ValidatorIndex: 10}},
+	}
+	blk.Block.Body.ProposerSlashings = []*eth.ProposerSlashing{{
+		Header_1: testutil.HydrateSignedBeaconHeader(&eth.SignedBeaconBlockHeader{
+			Header: &eth.BeaconBlockHeader{ProposerIndex: 9},
+		}),
+	}}
+	blk.Block.Body.AttesterSlashings = []*eth.AttesterSlashing{{
+		Attestation_1: testutil.HydrateIndexedAttestation(&eth.IndexedAttestation{AttestingIndices: []uint64{8, 10}}),
+		Attestation_2: testutil.HydrateIndexedAttestation(&eth.IndexedAttestation{AttestingIndices: []uint64{8, 10}}),
+	}}
+	srv.processBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(blk), root)
+
+	require.LogsContain(t, hook, "Proposed beacon block was included")
+	require.LogsContain(t, hook, "Voluntary exit was included")
+	require.LogsContain(t, hook, "Proposer slashing was included")
+	require.LogsContain(t, hook, "Attester slashing was included")
+	require.LogsDoNotContain(t, hook, "validatorIndex=10")
+	// The attestation is only reported the first time it is included.
+	included := 0
+	for _, e := range hook.AllEntries() {
+		if e.Message == "Attestation was included" {
+			included++
+			assert.Equal(t, attester, e.Data["validatorIndex"])
+			assert.Equal(t, types.Slot(1), e.Data["inclusionDelay"])
+		}
+	}
+	assert.Equal(t, 1, included)
+	assert.Equal(t, types.Slot(1), srv.latestPerformance[attester].attestedSlot)
+}
+
+func TestProposedAtSlot(t *testing.T) {
+	st := testState(t, 8)
+	roots := make([][]byte, params.BeaconConfig().SlotsPerHistoricalRoot)
+	for i := range roots {
+		roots[i] = make([]byte, 32)
+	}
+	// Blocks at slots 0, 1, 3 and 5, slots 2 and 4 are skipped.
+	roots[0][0] = 'a'
+	roots[1][0] = 'b'
+	roots[2][0] = 'b'
+	roots[3][0] = 'c'
+	roots[4][0] = 'c'
+	require.NoError(t, st.SetBlockRoots(roots))
+	require.NoError(t, st.SetSlot(5))
+	require.NoError(t, st.SetLatestBlockHeader(testutil.HydrateBeaconHeader(&eth.BeaconBlockHeader{Slot: 5})))
+
+	for slot, want := range []bool{true, true, false, true, false, true} {
+		proposed, err := proposedAtSlot(st, types.Slot(slot))
+		require.NoError(t, err)
+		assert.Equal(t, want, proposed, "slot %d", slot)
+	}
+	_, err := proposedAtSlot(st, 6)
+	assert.ErrorContains(t, "out of bounds", err)
+}
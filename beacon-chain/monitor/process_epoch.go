@@ -0,0 +1,186 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"github.com/sirupsen/logrus"
+)
+
+// processEpoch summarizes the previous epochs when the first block of an epoch is processed. The
+// proposals of the previous epoch and the attestations of the epoch before it, which can no longer
+// be included, are taken from the state at the end of the previous epoch. The balances are taken
+// from the post state of the block, their change over the previous epoch includes the rewards and
+// penalties of the summarized attestations.
+func (s *Service) processEpoch(ctx context.Context, blk interfaces.SignedBeaconBlock, postState iface.ReadOnlyBeaconState) {
+	epoch := helpers.SlotToEpoch(blk.Block().Slot())
+	s.lock.Lock()
+	s.latestEpoch = epoch
+	s.lock.Unlock()
+	s.resolvePendingPubkeys(postState)
+
+	st, err := s.epochEndState(ctx, bytesutil.ToBytes32(blk.Block().ParentRoot()), epoch-1)
+	if err != nil {
+		log.WithError(err).Error("Could not get state at the end of the previous epoch")
+		return
+	}
+	if err := s.processProposals(st, epoch-1); err != nil {
+		log.WithError(err).Error("Could not process proposals of the previous epoch")
+	}
+	var vp []*precompute.Validator
+	// The participation of the genesis epoch is only complete at the end of the next epoch.
+	if epoch >= 2 {
+		vp, err = attestationPerformance(ctx, st)
+		if err != nil {
+			log.WithError(err).Error("Could not compute attestation performance")
+		}
+	}
+
+	for _, idx := range s.trackedIndicesList() {
+		balance, err := postState.BalanceAtIndex(idx)
+		if err != nil {
+			// The validator is not in the registry yet.
+			continue
+		}
+		label := fmt.Sprintf("%d", idx)
+		fields := logrus.Fields{
+			"validatorIndex": idx,
+			"epoch":          epoch,
+			"balance":        balance,
+		}
+		s.lock.Lock()
+		perf := s.performance(idx)
+		prevBalance, hasBalance := perf.balance, perf.hasBalance
+		perf.balance = balance
+		perf.hasBalance = true
+		s.lock.Unlock()
+		balanceGauge.WithLabelValues(label).Set(float64(balance))
+		if hasBalance {
+			change := int64(balance) - int64(prevBalance)
+			balanceChangeGauge.WithLabelValues(label).Set(float64(change))
+			fields["balanceChange"] = change
+		}
+
+		if uint64(idx) < uint64(len(vp)) && vp[idx].IsActivePrevEpoch {
+			v := vp[idx]
+			fields["attestationEpoch"] = epoch - 2
+			fields["correctSource"] = v.IsPrevEpochAttester
+			fields["correctTarget"] = v.IsPrevEpochTargetAttester
+			fields["correctHead"] = v.IsPrevEpochHeadAttester
+			if v.IsPrevEpochAttester {
+				correctSourceTotal.WithLabelValues(label).Inc()
+				// The inclusion delay of the attestations is only tracked by the state pre Altair.
+				if st.Version() == version.Phase0 {
+					fields["inclusionDelay"] = v.InclusionDistance
+				}
+			} else {
+				missedAttestationsTotal.WithLabelValues(label).Inc()
+			}
+			if v.IsPrevEpochTargetAttester {
+				correctTargetTotal.WithLabelValues(label).Inc()
+			}
+			if v.IsPrevEpochHeadAttester {
+				correctHeadTotal.WithLabelValues(label).Inc()
+			}
+		}
+		log.WithFields(fields).Info("Validator epoch summary")
+	}
+}
+
+// epochEndState returns a copy of the state of the given block advanced to the last slot of the
+// given epoch, before the epoch processing.
+func (s *Service) epochEndState(ctx context.Context, root [32]byte, epoch types.Epoch) (iface.BeaconState, error) {
+	st, err := s.cfg.StateGen.StateByRoot(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	if st == nil || st.IsNil() {
+		return nil, errors.New("state is nil")
+	}
+	endSlot, err := helpers.EndSlot(epoch)
+	if err != nil {
+		return nil, err
+	}
+	// The state may be shared with the state caches, copy it before advancing it.
+	st = st.Copy()
+	if st.Slot() < endSlot {
+		return state.ProcessSlots(ctx, st, endSlot)
+	}
+	return st, nil
+}
+
+// processProposals reports the proposals of the tracked validators missed during the given epoch,
+// using the state at the end of the epoch.
+func (s *Service) processProposals(st iface.BeaconState, epoch types.Epoch) error {
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return err
+	}
+	endSlot, err := helpers.EndSlot(epoch)
+	if err != nil {
+		return err
+	}
+	// The slot of the state is changed to compute the proposer of every slot.
+	proposerState := st.Copy()
+	for slot := startSlot; slot <= endSlot; slot++ {
+		// There is no proposer at the genesis slot.
+		if slot == 0 {
+			continue
+		}
+		if err := proposerState.SetSlot(slot); err != nil {
+			return err
+		}
+		proposer, err := helpers.BeaconProposerIndex(proposerState)
+		if err != nil {
+			return errors.Wrapf(err, "could not get proposer at slot %d", slot)
+		}
+		if !s.isTracked(proposer) {
+			continue
+		}
+		proposed, err := proposedAtSlot(st, slot)
+		if err != nil {
+			return err
+		}
+		if proposed {
+			continue
+		}
+		missedProposalsTotal.WithLabelValues(fmt.Sprintf("%d", proposer)).Inc()
+		log.WithFields(logrus.Fields{
+			"validatorIndex": proposer,
+			"slot":           slot,
+		}).Warn("Block proposal was missed")
+	}
+	return nil
+}
+
+// attestationPerformance returns the attestation records of the previous epoch of the given state.
+func attestationPerformance(ctx context.Context, st iface.BeaconState) ([]*precompute.Validator, error) {
+	switch st.Version() {
+	case version.Phase0:
+		vp, bp, err := precompute.New(ctx, st)
+		if err != nil {
+			return nil, err
+		}
+		vp, _, err = precompute.ProcessAttestations(ctx, st, vp, bp)
+		return vp, err
+	case version.Altair:
+		vp, bp, err := altair.InitializeEpochValidators(ctx, st)
+		if err != nil {
+			return nil, err
+		}
+		vp, _, err = altair.ProcessEpochParticipation(ctx, st, bp, vp)
+		return vp, err
+	default:
+		return nil, errors.Errorf("unsupported state version %d", st.Version())
+	}
+}
@@ -0,0 +1,97 @@
+package monitor
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	eth "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestProcessEpoch(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	// The state at the end of epoch 2, with an attestation of epoch 1 included with a delay of 2 slots.
+	parentState := testState(t, 64)
+	endSlot, err := helpers.EndSlot(2)
+	require.NoError(t, err)
+	require.NoError(t, parentState.SetSlot(endSlot))
+	committee, err := helpers.BeaconCommitteeFromState(parentState, slotsPerEpoch, 0)
+	require.NoError(t, err)
+	attester, absent := committee[0], committee[1]
+	aggBits := bitfield.NewBitlist(uint64(len(committee)))
+	aggBits.SetBitAt(0, true)
+	require.NoError(t, parentState.AppendPreviousEpochAttestations(&pb.PendingAttestation{
+		AggregationBits: aggBits,
+		Data: testutil.HydrateAttestationData(&eth.AttestationData{
+			Slot:   slotsPerEpoch,
+			Target: &eth.Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+		}),
+		InclusionDelay: 2,
+	}))
+	// No block was proposed during epoch 2.
+	proposerState := parentState.Copy()
+	require.NoError(t, proposerState.SetSlot(endSlot-1))
+	proposer, err := helpers.BeaconProposerIndex(proposerState)
+	require.NoError(t, err)
+
+	postState := testState(t, 64)
+	require.NoError(t, postState.SetSlot(endSlot+1))
+	require.NoError(t, postState.UpdateBalancesAtIndex(attester, params.BeaconConfig().MaxEffectiveBalance+10))
+
+	srv, err := NewService(ctx, &ServiceConfig{
+		StateGen:       stategen.NewMockService(),
+		TrackedIndices: []types.ValidatorIndex{attester, absent, proposer},
+	})
+	require.NoError(t, err)
+	srv.initializePerformance(parentState)
+	parentRoot, root := [32]byte{'a'}, [32]byte{'b'}
+	srv.cfg.StateGen.(*stategen.MockStateManager).AddStateForRoot(parentState, parentRoot)
+	srv.cfg.StateGen.(*stategen.MockStateManager).AddStateForRoot(postState, root)
+
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = endSlot + 1
+	blk.Block.ParentRoot = parentRoot[:]
+	srv.processBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(blk), root)
+
+	assert.Equal(t, types.Epoch(3), srv.latestEpoch)
+	missed := false
+	summaries := make(map[types.ValidatorIndex]map[string]interface{})
+	for _, e := range hook.AllEntries() {
+		switch e.Message {
+		case "Block proposal was missed":
+			if e.Data["validatorIndex"] == proposer && e.Data["slot"] == endSlot-1 {
+				missed = true
+			}
+		case "Validator epoch summary":
+			summaries[e.Data["validatorIndex"].(types.ValidatorIndex)] = e.Data
+		}
+	}
+	assert.Equal(t, true, missed)
+
+	require.NotNil(t, summaries[attester])
+	assert.Equal(t, types.Epoch(1), summaries[attester]["attestationEpoch"])
+	assert.Equal(t, true, summaries[attester]["correctSource"])
+	assert.Equal(t, true, summaries[attester]["correctTarget"])
+	assert.Equal(t, true, summaries[attester]["correctHead"])
+	assert.Equal(t, types.Slot(2), summaries[attester]["inclusionDelay"])
+	assert.Equal(t, int64(10), summaries[attester]["balanceChange"])
+
+	require.NotNil(t, summaries[absent])
+	assert.Equal(t, false, summaries[absent]["correctSource"])
+	assert.Equal(t, false, summaries[absent]["correctTarget"])
+	assert.Equal(t, nil, summaries[absent]["inclusionDelay"])
+	assert.Equal(t, int64(0), summaries[absent]["balanceChange"])
+}
@@ -0,0 +1,37 @@
+package monitor
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// PubkeysFromFile reads the validator public keys to monitor from a file of hex encoded public
+// keys, one per line. Empty lines and lines starting with # are ignored.
+func PubkeysFromFile(path string) ([][48]byte, error) {
+	enc, err := fileutil.ReadFileAsBytes(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read public keys file")
+	}
+	var pubkeys [][48]byte
+	for i, line := range strings.Split(string(enc), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		b, err := hex.DecodeString(strings.TrimPrefix(line, "0x"))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode public key on line %d", i+1)
+		}
+		if len(b) != params.BeaconConfig().BLSPubkeyLength {
+			return nil, errors.Errorf("public key on line %d has length %d, want %d", i+1, len(b), params.BeaconConfig().BLSPubkeyLength)
+		}
+		var pubkey [48]byte
+		copy(pubkey[:], b)
+		pubkeys = append(pubkeys, pubkey)
+	}
+	return pubkeys, nil
+}
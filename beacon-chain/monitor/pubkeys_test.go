@@ -0,0 +1,28 @@
+package monitor
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestPubkeysFromFile(t *testing.T) {
+	dir := t.TempDir()
+	pk1, pk2 := testPubkey(1), testPubkey(2)
+	path := filepath.Join(dir, "pubkeys.txt")
+	require.NoError(t, ioutil.WriteFile(path, []byte(fmt.Sprintf("# monitored validators\n%#x\n\n  %x  \n", pk1, pk2)), 0600))
+	pubkeys, err := PubkeysFromFile(path)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][48]byte{pk1, pk2}, pubkeys)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(fmt.Sprintf("%#x\n0x1234\n", pk1)), 0600))
+	_, err = PubkeysFromFile(path)
+	assert.ErrorContains(t, "public key on line 2 has length 2", err)
+
+	_, err = PubkeysFromFile(filepath.Join(dir, "missing.txt"))
+	assert.ErrorContains(t, "could not read public keys file", err)
+}
@@ -0,0 +1,289 @@
+package monitor
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	beaconsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+)
+
+var _ shared.Service = (*Service)(nil)
+
+// ServiceConfig for the validator monitor service in the beacon node.
+type ServiceConfig struct {
+	StateNotifier      statefeed.Notifier
+	OperationNotifier  opfeed.Notifier
+	HeadFetcher        blockchain.HeadFetcher
+	GenesisTimeFetcher blockchain.TimeFetcher
+	StateGen           stategen.StateManager
+	SyncChecker        beaconsync.Checker
+	// TrackedIndices are the indices of the validators to monitor.
+	TrackedIndices []types.ValidatorIndex
+	// TrackedPubkeys are the public keys of the validators to monitor. Public keys which are not
+	// in the validator registry yet are resolved to indices once their deposit is processed.
+	TrackedPubkeys [][48]byte
+}
+
+// validatorLatestPerformance keeps the latest activity of a tracked validator, used to only
+// report new activity and to compute the balance changes.
+type validatorLatestPerformance struct {
+	balance      uint64
+	hasBalance   bool
+	attestedSlot types.Slot
+	hasAttested  bool
+}
+
+// Service monitoring the activity of a set of tracked validators.
+type Service struct {
+	cfg               *ServiceConfig
+	ctx               context.Context
+	cancel            context.CancelFunc
+	genesisTime       time.Time
+	lock              sync.RWMutex
+	trackedIndices    map[types.ValidatorIndex]bool
+	pendingPubkeys    map[[48]byte]bool
+	latestPerformance map[types.ValidatorIndex]*validatorLatestPerformance
+	latestEpoch       types.Epoch
+}
+
+// NewService instantiates a new validator monitor from configuration values.
+func NewService(ctx context.Context, cfg *ServiceConfig) (*Service, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		cfg:               cfg,
+		ctx:               ctx,
+		cancel:            cancel,
+		trackedIndices:    make(map[types.ValidatorIndex]bool, len(cfg.TrackedIndices)),
+		pendingPubkeys:    make(map[[48]byte]bool, len(cfg.TrackedPubkeys)),
+		latestPerformance: make(map[types.ValidatorIndex]*validatorLatestPerformance),
+	}
+	for _, idx := range cfg.TrackedIndices {
+		s.trackedIndices[idx] = true
+	}
+	for _, pubkey := range cfg.TrackedPubkeys {
+		s.pendingPubkeys[pubkey] = true
+	}
+	return s, nil
+}
+
+// Start monitoring the tracked validators.
+func (s *Service) Start() {
+	go s.run()
+}
+
+// Stop the validator monitor.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the validator monitor.
+func (s *Service) Status() error {
+	return nil
+}
+
+func (s *Service) run() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	genesisTime, ok := s.waitForChainInitialization(stateChannel)
+	// The state feed blocks its senders until all subscribers have received the events, the
+	// subscription is not kept while waiting for the node to sync.
+	stateSub.Unsubscribe()
+	if !ok {
+		return
+	}
+	s.genesisTime = genesisTime
+	if !s.waitForSync() {
+		return
+	}
+
+	headState, err := s.cfg.HeadFetcher.HeadState(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not get head state")
+		return
+	}
+	if headState == nil || headState.IsNil() {
+		log.Error("Head state is nil")
+		return
+	}
+	s.initializePerformance(headState)
+	log.WithField("validatorIndices", s.trackedIndicesList()).Info("Started monitoring validators")
+
+	stateChannel = make(chan *feed.Event, 1)
+	stateSub = s.cfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	opChannel := make(chan *feed.Event, 1)
+	opSub := s.cfg.OperationNotifier.OperationFeed().Subscribe(opChannel)
+	defer opSub.Unsubscribe()
+
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type != statefeed.BlockProcessed {
+				continue
+			}
+			data, ok := event.Data.(*statefeed.BlockProcessedData)
+			if !ok {
+				log.Error("Event feed data is not type *statefeed.BlockProcessedData")
+				continue
+			}
+			s.processBlock(s.ctx, data.SignedBlock, data.BlockRoot)
+		case event := <-opChannel:
+			switch event.Type {
+			case opfeed.UnaggregatedAttReceived:
+				data, ok := event.Data.(*opfeed.UnAggregatedAttReceivedData)
+				if !ok {
+					log.Error("Event feed data is not type *operation.UnAggregatedAttReceivedData")
+					continue
+				}
+				s.processUnaggregatedAttestation(s.ctx, data.Attestation)
+			case opfeed.AggregatedAttReceived:
+				data, ok := event.Data.(*opfeed.AggregatedAttReceivedData)
+				if !ok {
+					log.Error("Event feed data is not type *operation.AggregatedAttReceivedData")
+					continue
+				}
+				s.processAggregatedAttestation(data.Attestation)
+			case opfeed.ExitReceived:
+				data, ok := event.Data.(*opfeed.ExitReceivedData)
+				if !ok {
+					log.Error("Event feed data is not type *operation.ExitReceivedData")
+					continue
+				}
+				s.processExit(data.Exit)
+			}
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Could not subscribe to state notifier")
+			return
+		case err := <-opSub.Err():
+			log.WithError(err).Error("Could not subscribe to operation notifier")
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// Waits for the beacon chain to be initialized, returning the genesis time of the chain.
+// Returns false if the service is stopped before that.
+func (s *Service) waitForChainInitialization(stateChannel chan *feed.Event) (time.Time, bool) {
+	if genesisTime := s.cfg.GenesisTimeFetcher.GenesisTime(); !genesisTime.IsZero() {
+		return genesisTime, true
+	}
+	for {
+		select {
+		case stateEvent := <-stateChannel:
+			if stateEvent.Type != statefeed.Initialized {
+				continue
+			}
+			data, ok := stateEvent.Data.(*statefeed.InitializedData)
+			if !ok {
+				log.Error("Could not receive chain start notification, want *statefeed.InitializedData")
+				return time.Time{}, false
+			}
+			return data.StartTime, true
+		case <-s.ctx.Done():
+			return time.Time{}, false
+		}
+	}
+}
+
+// Waits for the beacon node to be synced to the head of the chain, as the activity of the
+// validators in historical blocks is not reported. Returns false if the service is stopped
+// before that.
+func (s *Service) waitForSync() bool {
+	if !s.cfg.SyncChecker.Syncing() {
+		return true
+	}
+	slotTicker := slotutil.NewSlotTicker(s.genesisTime, params.BeaconConfig().SecondsPerSlot)
+	defer slotTicker.Done()
+	for {
+		select {
+		case <-slotTicker.C():
+			if s.cfg.SyncChecker.Syncing() {
+				continue
+			}
+			return true
+		case <-s.ctx.Done():
+			return false
+		}
+	}
+}
+
+// initializePerformance resolves the tracked public keys and records the current balances of
+// the tracked validators, which the first balance changes are computed against.
+func (s *Service) initializePerformance(st iface.ReadOnlyBeaconState) {
+	s.resolvePendingPubkeys(st)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.latestEpoch = helpers.SlotToEpoch(st.Slot())
+	for idx := range s.trackedIndices {
+		perf := s.performance(idx)
+		balance, err := st.BalanceAtIndex(idx)
+		if err != nil {
+			continue
+		}
+		perf.balance = balance
+		perf.hasBalance = true
+	}
+}
+
+// resolvePendingPubkeys tracks the indices of the tracked public keys which are in the validator
+// registry of the given state.
+func (s *Service) resolvePendingPubkeys(st iface.ReadOnlyBeaconState) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for pubkey := range s.pendingPubkeys {
+		idx, ok := st.ValidatorIndexByPubkey(pubkey)
+		if !ok {
+			continue
+		}
+		s.trackedIndices[idx] = true
+		delete(s.pendingPubkeys, pubkey)
+		log.WithField("validatorIndex", idx).Info("Started monitoring validator")
+	}
+}
+
+// isTracked returns true if the validator with the given index is tracked.
+func (s *Service) isTracked(idx types.ValidatorIndex) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.trackedIndices[idx]
+}
+
+// trackedIndicesList returns the sorted indices of the tracked validators.
+func (s *Service) trackedIndicesList() []types.ValidatorIndex {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	indices := make([]types.ValidatorIndex, 0, len(s.trackedIndices))
+	for idx := range s.trackedIndices {
+		indices = append(indices, idx)
+	}
+	sort.Slice(indices, func(i, j int) bool {
+		return indices[i] < indices[j]
+	})
+	return indices
+}
+
+// performance returns the latest performance of the given validator, the lock must be held.
+func (s *Service) performance(idx types.ValidatorIndex) *validatorLatestPerformance {
+	perf, ok := s.latestPerformance[idx]
+	if !ok {
+		perf = &validatorLatestPerformance{}
+		s.latestPerformance[idx] = perf
+	}
+	return perf
+}
@@ -0,0 +1,158 @@
+package monitor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	eth "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+// testPubkey returns a distinct public key for the validator with the given index.
+func testPubkey(i int) [48]byte {
+	return bytesutil.ToBytes48(bytesutil.Bytes8(uint64(i + 1)))
+}
+
+// testState returns a phase 0 state with the given number of active validators.
+func testState(t *testing.T, n int) iface.BeaconState {
+	validators := make([]*eth.Validator, n)
+	balances := make([]uint64, n)
+	for i := range validators {
+		pubkey := testPubkey(i)
+		validators[i] = &eth.Validator{
+			PublicKey:             pubkey[:],
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      params.BeaconConfig().MaxEffectiveBalance,
+			ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+			WithdrawableEpoch:     params.BeaconConfig().FarFutureEpoch,
+		}
+		balances[i] = params.BeaconConfig().MaxEffectiveBalance
+	}
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetValidators(validators))
+	require.NoError(t, st.SetBalances(balances))
+	return st
+}
+
+func TestNewService_TrackedValidators(t *testing.T) {
+	srv, err := NewService(context.Background(), &ServiceConfig{
+		TrackedIndices: []types.ValidatorIndex{3, 1},
+		TrackedPubkeys: [][48]byte{testPubkey(5), testPubkey(100)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, true, srv.isTracked(1))
+	assert.Equal(t, true, srv.isTracked(3))
+	assert.Equal(t, false, srv.isTracked(5))
+
+	hook := logTest.NewGlobal()
+	st := testState(t, 8)
+	require.NoError(t, st.UpdateBalancesAtIndex(3, 100))
+	srv.initializePerformance(st)
+	// The public key which is not in the registry yet stays pending.
+	assert.DeepEqual(t, []types.ValidatorIndex{1, 3, 5}, srv.trackedIndicesList())
+	assert.Equal(t, 1, len(srv.pendingPubkeys))
+	assert.Equal(t, true, srv.pendingPubkeys[testPubkey(100)])
+	assert.Equal(t, uint64(100), srv.latestPerformance[3].balance)
+	assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, srv.latestPerformance[5].balance)
+	require.LogsContain(t, hook, "validatorIndex=5")
+}
+
+func TestService_waitForSync_Stopped(t *testing.T) {
+	srv, err := NewService(context.Background(), &ServiceConfig{})
+	require.NoError(t, err)
+	require.NoError(t, srv.Stop())
+
+	srv.genesisTime = time.Now().Add(-time.Hour)
+
+	srv.cfg.SyncChecker = &mockSync.Sync{IsSyncing: false}
+	assert.Equal(t, true, srv.waitForSync())
+	srv.cfg.SyncChecker = &mockSync.Sync{IsSyncing: true}
+	assert.Equal(t, false, srv.waitForSync())
+}
+
+func TestService_run_OperationEvents(t *testing.T) {
+	hook := logTest.NewGlobal()
+	st := testState(t, 64)
+	require.NoError(t, st.SetSlot(2))
+	committee, err := helpers.BeaconCommitteeFromState(st, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, true, len(committee) > 1)
+	attester := committee[1]
+
+	chain := &chainMock.ChainService{Genesis: time.Now(), State: st}
+	srv, err := NewService(context.Background(), &ServiceConfig{
+		StateNotifier:      chain.StateNotifier(),
+		OperationNotifier:  chain.OperationNotifier(),
+		HeadFetcher:        chain,
+		GenesisTimeFetcher: chain,
+		SyncChecker:        &mockSync.Sync{IsSyncing: false},
+		TrackedIndices:     []types.ValidatorIndex{attester, 7, 8},
+	})
+	require.NoError(t, err)
+	opFeed := chain.OperationNotifier().OperationFeed()
+	exitRoutine := make(chan bool)
+	go func() {
+		srv.run()
+		exitRoutine <- true
+	}()
+
+	send := func(typ feed.EventType, data interface{}) {
+		// The events sent before the service subscribed to the feed are dropped.
+		for opFeed.Send(&feed.Event{Type: typ, Data: data}) == 0 {
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	aggBits := bitfield.NewBitlist(uint64(len(committee)))
+	aggBits.SetBitAt(1, true)
+	att := testutil.HydrateAttestation(&eth.Attestation{
+		AggregationBits: aggBits,
+		Data:            &eth.AttestationData{Slot: 1},
+	})
+	send(opfeed.UnaggregatedAttReceived, &opfeed.UnAggregatedAttReceivedData{Attestation: att})
+	// An attestation which cannot be mapped to a committee of the head state is ignored.
+	send(opfeed.UnaggregatedAttReceived, &opfeed.UnAggregatedAttReceivedData{
+		Attestation: testutil.HydrateAttestation(&eth.Attestation{
+			AggregationBits: aggBits,
+			Data:            &eth.AttestationData{Slot: 1, CommitteeIndex: 100},
+		}),
+	})
+	send(opfeed.AggregatedAttReceived, &opfeed.AggregatedAttReceivedData{
+		Attestation: &eth.AggregateAttestationAndProof{AggregatorIndex: 7, Aggregate: att},
+	})
+	send(opfeed.AggregatedAttReceived, &opfeed.AggregatedAttReceivedData{
+		Attestation: &eth.AggregateAttestationAndProof{AggregatorIndex: 10, Aggregate: att},
+	})
+	send(opfeed.ExitReceived, &opfeed.ExitReceivedData{
+		Exit: &eth.SignedVoluntaryExit{Exit: &eth.VoluntaryExit{ValidatorIndex: 8, Epoch: 1}},
+	})
+	// The last event is only received once the previous ones were processed.
+	send(opfeed.ExitReceived, &opfeed.ExitReceivedData{
+		Exit: &eth.SignedVoluntaryExit{Exit: &eth.VoluntaryExit{ValidatorIndex: 10}},
+	})
+	require.NoError(t, srv.Stop())
+	<-exitRoutine
+
+	received := make(map[string][]interface{})
+	for _, e := range hook.AllEntries() {
+		received[e.Message] = append(received[e.Message], e.Data["validatorIndex"])
+	}
+	assert.DeepEqual(t, []interface{}{attester}, received["Unaggregated attestation was received"])
+	assert.DeepEqual(t, []interface{}{types.ValidatorIndex(7)}, received["Aggregated attestation was received"])
+	assert.DeepEqual(t, []interface{}{types.ValidatorIndex(8)}, received["Voluntary exit was received"])
+	require.LogsDoNotContain(t, hook, "validatorIndex=10")
+}
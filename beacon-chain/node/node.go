@@ -16,25 +16,34 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/era"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/slasherkv"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	gateway2 "github.com/prysmaticlabs/prysm/beacon-chain/gateway"
 	interopcoldstart "github.com/prysmaticlabs/prysm/beacon-chain/interop-cold-start"
+	"github.com/prysmaticlabs/prysm/beacon-chain/monitor"
 	"github.com/prysmaticlabs/prysm/beacon-chain/node/registration"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain/engine"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apimiddleware"
+	"github.com/prysmaticlabs/prysm/beacon-chain/slasher"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	regularsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/checkpoint"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared"
@@ -44,6 +53,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/gateway"
+	"github.com/prysmaticlabs/prysm/shared/httputils"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/prereq"
 	"github.com/prysmaticlabs/prysm/shared/prometheus"
@@ -59,23 +69,28 @@ const testSkipPowFlag = "test-skip-pow"
 // full PoS node. It handles the lifecycle of the entire system and registers
 // services to a service registry.
 type BeaconNode struct {
-	cliCtx          *cli.Context
-	ctx             context.Context
-	cancel          context.CancelFunc
-	services        *shared.ServiceRegistry
-	lock            sync.RWMutex
-	stop            chan struct{} // Channel to wait for termination notifications.
-	db              db.Database
-	attestationPool attestations.Pool
-	exitPool        voluntaryexits.PoolManager
-	slashingsPool   slashings.PoolManager
-	depositCache    *depositcache.DepositCache
-	stateFeed       *event.Feed
-	blockFeed       *event.Feed
-	opFeed          *event.Feed
-	forkChoiceStore forkchoice.ForkChoicer
-	stateGen        *stategen.State
-	collector       *bcnodeCollector
+	cliCtx                  *cli.Context
+	ctx                     context.Context
+	cancel                  context.CancelFunc
+	services                *shared.ServiceRegistry
+	lock                    sync.RWMutex
+	stop                    chan struct{} // Channel to wait for termination notifications.
+	db                      db.Database
+	slasherDB               db.SlasherDatabase
+	attestationPool         attestations.Pool
+	exitPool                voluntaryexits.PoolManager
+	slashingsPool           slashings.PoolManager
+	syncCommitteePool       synccommittee.Pool
+	depositCache            *depositcache.DepositCache
+	stateFeed               *event.Feed
+	blockFeed               *event.Feed
+	opFeed                  *event.Feed
+	slasherAttestationsFeed *event.Feed
+	slasherBlockHeadersFeed *event.Feed
+	forkChoiceStore         forkchoice.ForkChoicer
+	stateGen                *stategen.State
+	eraArchive              *era.Archive
+	collector               *bcnodeCollector
 }
 
 // New creates a new node instance, sets up configuration options, and registers
@@ -99,17 +114,20 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 
 	ctx, cancel := context.WithCancel(cliCtx.Context)
 	beacon := &BeaconNode{
-		cliCtx:          cliCtx,
-		ctx:             ctx,
-		cancel:          cancel,
-		services:        registry,
-		stop:            make(chan struct{}),
-		stateFeed:       new(event.Feed),
-		blockFeed:       new(event.Feed),
-		opFeed:          new(event.Feed),
-		attestationPool: attestations.NewPool(),
-		exitPool:        voluntaryexits.NewPool(),
-		slashingsPool:   slashings.NewPool(),
+		cliCtx:                  cliCtx,
+		ctx:                     ctx,
+		cancel:                  cancel,
+		services:                registry,
+		stop:                    make(chan struct{}),
+		stateFeed:               new(event.Feed),
+		blockFeed:               new(event.Feed),
+		opFeed:                  new(event.Feed),
+		slasherAttestationsFeed: new(event.Feed),
+		slasherBlockHeadersFeed: new(event.Feed),
+		attestationPool:         attestations.NewPool(),
+		exitPool:                voluntaryexits.NewPool(),
+		slashingsPool:           slashings.NewPool(),
+		syncCommitteePool:       synccommittee.NewPool(),
 	}
 
 	depositAddress, err := registration.DepositContractAddress()
@@ -120,6 +138,12 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if featureconfig.Get().EnableSlasher {
+		if err := beacon.startSlasherDB(cliCtx); err != nil {
+			return nil, err
+		}
+	}
+
 	beacon.startStateGen()
 
 	if err := beacon.registerP2P(cliCtx); err != nil {
@@ -130,6 +154,10 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.registerExecutionEngineService(); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.registerAttestationPool(); err != nil {
 		return nil, err
 	}
@@ -152,6 +180,20 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.registerBackfillService(); err != nil {
+		return nil, err
+	}
+
+	if featureconfig.Get().EnableSlasher {
+		if err := beacon.registerSlasherService(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := beacon.registerValidatorMonitorService(); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.registerRPCService(); err != nil {
 		return nil, err
 	}
@@ -237,6 +279,16 @@ func (b *BeaconNode) Close() {
 	if err := b.db.Close(); err != nil {
 		log.Errorf("Failed to close database: %v", err)
 	}
+	if b.slasherDB != nil {
+		if err := b.slasherDB.Close(); err != nil {
+			log.Errorf("Failed to close slasher database: %v", err)
+		}
+	}
+	if b.eraArchive != nil {
+		if err := b.eraArchive.Close(); err != nil {
+			log.Errorf("Failed to close era files: %v", err)
+		}
+	}
 	b.collector.unregister()
 	b.cancel()
 	close(b.stop)
@@ -324,6 +376,10 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 		return err
 	}
 
+	if err := b.initializeFromCheckpoint(cliCtx); err != nil {
+		return errors.Wrap(err, "could not initialize database from checkpoint")
+	}
+
 	knownContract, err := b.db.DepositContractAddress(b.ctx)
 	if err != nil {
 		return err
@@ -345,6 +401,95 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 	return nil
 }
 
+// initializeFromCheckpoint seeds the database with a finalized state and block when the node is
+// started with the checkpoint sync flags, so it syncs forward from there instead of from genesis.
+// The deposit snapshot is imported from its own flag or, failing that, from the checkpoint sync url.
+func (b *BeaconNode) initializeFromCheckpoint(cliCtx *cli.Context) error {
+	statePath := cliCtx.String(flags.CheckpointState.Name)
+	blockPath := cliCtx.String(flags.CheckpointBlock.Name)
+	syncURL := cliCtx.String(flags.CheckpointSyncURL.Name)
+	snapshotPath := cliCtx.String(flags.DepositSnapshot.Name)
+
+	var initializer checkpoint.Initializer
+	var err error
+	switch {
+	case syncURL != "" && (statePath != "" || blockPath != ""):
+		return fmt.Errorf("--%s cannot be used together with --%s or --%s",
+			flags.CheckpointSyncURL.Name, flags.CheckpointState.Name, flags.CheckpointBlock.Name)
+	case syncURL != "":
+		initializer, err = checkpoint.NewAPIInitializer(syncURL)
+	case statePath != "" || blockPath != "":
+		initializer, err = checkpoint.NewFileInitializer(statePath, blockPath)
+	}
+	if err != nil {
+		return err
+	}
+	if initializer != nil {
+		if err := initializer.Initialize(b.ctx, b.db); err != nil {
+			return err
+		}
+	}
+
+	if snapshotPath != "" {
+		return checkpoint.ImportDepositSnapshotFile(b.ctx, b.db, snapshotPath)
+	}
+	if api, ok := initializer.(*checkpoint.APIInitializer); ok {
+		// Not every beacon node serves a deposit snapshot, in which case the deposit logs are
+		// processed from the deployment of the deposit contract as before.
+		if err := api.ImportDepositSnapshot(b.ctx, b.db); err != nil {
+			log.WithError(err).Warn("Could not import deposit snapshot from checkpoint sync url")
+		}
+	}
+	return nil
+}
+
+func (b *BeaconNode) startSlasherDB(cliCtx *cli.Context) error {
+	baseDir := cliCtx.String(cmd.DataDirFlag.Name)
+	if cliCtx.IsSet(flags.SlasherDirFlag.Name) {
+		baseDir = cliCtx.String(flags.SlasherDirFlag.Name)
+	}
+	dbPath := filepath.Join(baseDir, kv.BeaconNodeDbDirName)
+	clearDB := cliCtx.Bool(cmd.ClearDB.Name)
+	forceClearDB := cliCtx.Bool(cmd.ForceClearDB.Name)
+
+	log.WithField("database-path", dbPath).Info("Checking slasher DB")
+
+	d, err := db.NewSlasherDB(b.ctx, dbPath, &slasherkv.Config{
+		InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+	clearDBConfirmed := false
+	if clearDB && !forceClearDB {
+		actionText := "This will delete your slasher database stored in your data directory. " +
+			"Your database backups will not be removed - do you want to proceed? (Y/N)"
+		deniedText := "Slasher database will not be deleted. No changes have been made."
+		clearDBConfirmed, err = cmd.ConfirmAction(actionText, deniedText)
+		if err != nil {
+			return err
+		}
+	}
+	if clearDBConfirmed || forceClearDB {
+		log.Warning("Removing slasher database")
+		if err := d.Close(); err != nil {
+			return errors.Wrap(err, "could not close slasher db prior to clearing")
+		}
+		if err := d.ClearDB(); err != nil {
+			return errors.Wrap(err, "could not clear slasher database")
+		}
+		d, err = db.NewSlasherDB(b.ctx, dbPath, &slasherkv.Config{
+			InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+		})
+		if err != nil {
+			return errors.Wrap(err, "could not create new slasher database")
+		}
+	}
+
+	b.slasherDB = d
+	return nil
+}
+
 func (b *BeaconNode) startStateGen() {
 	b.stateGen = stategen.New(b.db)
 }
@@ -356,25 +501,27 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 	}
 
 	svc, err := p2p.NewService(b.ctx, &p2p.Config{
-		NoDiscovery:       cliCtx.Bool(cmd.NoDiscovery.Name),
-		StaticPeers:       sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
-		BootstrapNodeAddr: bootstrapNodeAddrs,
-		RelayNodeAddr:     cliCtx.String(cmd.RelayNode.Name),
-		DataDir:           dataDir,
-		LocalIP:           cliCtx.String(cmd.P2PIP.Name),
-		HostAddress:       cliCtx.String(cmd.P2PHost.Name),
-		HostDNS:           cliCtx.String(cmd.P2PHostDNS.Name),
-		PrivateKey:        cliCtx.String(cmd.P2PPrivKey.Name),
-		MetaDataDir:       cliCtx.String(cmd.P2PMetadata.Name),
-		TCPPort:           cliCtx.Uint(cmd.P2PTCPPort.Name),
-		UDPPort:           cliCtx.Uint(cmd.P2PUDPPort.Name),
-		MaxPeers:          cliCtx.Uint(cmd.P2PMaxPeers.Name),
-		AllowListCIDR:     cliCtx.String(cmd.P2PAllowList.Name),
-		DenyListCIDR:      sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
-		EnableUPnP:        cliCtx.Bool(cmd.EnableUPnPFlag.Name),
-		DisableDiscv5:     cliCtx.Bool(flags.DisableDiscv5.Name),
-		StateNotifier:     b,
-		DB:                b.db,
+		NoDiscovery:           cliCtx.Bool(cmd.NoDiscovery.Name),
+		StaticPeers:           sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
+		BootstrapNodeAddr:     bootstrapNodeAddrs,
+		RelayNodeAddr:         cliCtx.String(cmd.RelayNode.Name),
+		DataDir:               dataDir,
+		LocalIP:               cliCtx.String(cmd.P2PIP.Name),
+		HostAddress:           cliCtx.String(cmd.P2PHost.Name),
+		HostDNS:               cliCtx.String(cmd.P2PHostDNS.Name),
+		PrivateKey:            cliCtx.String(cmd.P2PPrivKey.Name),
+		MetaDataDir:           cliCtx.String(cmd.P2PMetadata.Name),
+		TCPPort:               cliCtx.Uint(cmd.P2PTCPPort.Name),
+		UDPPort:               cliCtx.Uint(cmd.P2PUDPPort.Name),
+		MaxPeers:              cliCtx.Uint(cmd.P2PMaxPeers.Name),
+		AllowListCIDR:         cliCtx.String(cmd.P2PAllowList.Name),
+		DenyListCIDR:          sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
+		ColocationLimit:       cliCtx.Uint(cmd.P2PColocationLimit.Name),
+		SubnetColocationLimit: cliCtx.Uint(cmd.P2PSubnetColocationLimit.Name),
+		EnableUPnP:            cliCtx.Bool(cmd.EnableUPnPFlag.Name),
+		DisableDiscv5:         cliCtx.Bool(flags.DisableDiscv5.Name),
+		StateNotifier:         b,
+		DB:                    b.db,
 	})
 	if err != nil {
 		return err
@@ -432,6 +579,7 @@ func (b *BeaconNode) registerBlockchainService() error {
 		AttService:              attService,
 		StateGen:                b.stateGen,
 		WeakSubjectivityCheckpt: wsCheckpt,
+		SlasherBlockHeadersFeed: b.slasherBlockHeadersFeed,
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not register blockchain service")
@@ -473,6 +621,45 @@ func (b *BeaconNode) registerPOWChainService() error {
 	return b.services.RegisterService(web3Service)
 }
 
+func (b *BeaconNode) registerExecutionEngineService() error {
+	primary := b.cliCtx.String(flags.ExecutionEngineEndpoint.Name)
+	fallbacks := b.cliCtx.StringSlice(flags.FallbackExecutionEngineEndpoint.Name)
+	if primary == "" && len(fallbacks) == 0 {
+		return nil
+	}
+
+	var endpoints []httputils.Endpoint
+	seen := make(map[string]bool)
+	for _, e := range append([]string{primary}, fallbacks...) {
+		if e == "" || seen[e] {
+			continue
+		}
+		seen[e] = true
+		endpoints = append(endpoints, powchain.HttpEndpoint(e))
+	}
+
+	var jwtSecret []byte
+	if secretPath := b.cliCtx.String(flags.ExecutionJWTSecretFlag.Name); secretPath != "" {
+		secret, err := engine.LoadJWTSecret(secretPath)
+		if err != nil {
+			return errors.Wrap(err, "could not load JWT secret")
+		}
+		jwtSecret = secret
+	} else {
+		log.Warnf("No --%s specified, requests to the execution engine will not be authenticated with a JWT",
+			flags.ExecutionJWTSecretFlag.Name)
+	}
+
+	engineService, err := engine.NewService(b.ctx, &engine.Config{
+		Endpoints: endpoints,
+		JWTSecret: jwtSecret,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not register execution engine service")
+	}
+	return b.services.RegisterService(engineService)
+}
+
 func (b *BeaconNode) registerSyncService() error {
 	var web3Service *powchain.Service
 	if err := b.services.FetchService(&web3Service); err != nil {
@@ -489,18 +676,29 @@ func (b *BeaconNode) registerSyncService() error {
 		return err
 	}
 
+	if eraDir := b.cliCtx.String(flags.EraDir.Name); eraDir != "" {
+		archive, err := era.NewArchive(eraDir)
+		if err != nil {
+			return err
+		}
+		b.eraArchive = archive
+	}
+
 	rs := regularsync.NewService(b.ctx, &regularsync.Config{
-		DB:                  b.db,
-		P2P:                 b.fetchP2P(),
-		Chain:               chainService,
-		InitialSync:         initSync,
-		StateNotifier:       b,
-		BlockNotifier:       b,
-		AttestationNotifier: b,
-		AttPool:             b.attestationPool,
-		ExitPool:            b.exitPool,
-		SlashingPool:        b.slashingsPool,
-		StateGen:            b.stateGen,
+		DB:                      b.db,
+		P2P:                     b.fetchP2P(),
+		Chain:                   chainService,
+		InitialSync:             initSync,
+		StateNotifier:           b,
+		BlockNotifier:           b,
+		AttestationNotifier:     b,
+		AttPool:                 b.attestationPool,
+		ExitPool:                b.exitPool,
+		SlashingPool:            b.slashingsPool,
+		SyncCommsPool:           b.syncCommitteePool,
+		StateGen:                b.stateGen,
+		SlasherAttestationsFeed: b.slasherAttestationsFeed,
+		EraArchive:              b.eraArchive,
 	})
 
 	return b.services.RegisterService(rs)
@@ -522,6 +720,102 @@ func (b *BeaconNode) registerInitialSyncService() error {
 	return b.services.RegisterService(is)
 }
 
+func (b *BeaconNode) registerBackfillService() error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	var initSync *initialsync.Service
+	if err := b.services.FetchService(&initSync); err != nil {
+		return err
+	}
+
+	bs := backfill.NewService(b.ctx, &backfill.Config{
+		DB:               b.db,
+		P2P:              b.fetchP2P(),
+		Chain:            chainService,
+		InitialSync:      initSync,
+		VerifySignatures: b.cliCtx.Bool(flags.BackfillVerifySignatures.Name),
+	})
+	return b.services.RegisterService(bs)
+}
+
+func (b *BeaconNode) registerSlasherService() error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	var initSync *initialsync.Service
+	if err := b.services.FetchService(&initSync); err != nil {
+		return err
+	}
+
+	slasherSrv, err := slasher.NewService(b.ctx, &slasher.ServiceConfig{
+		IndexedAttestationsFeed: b.slasherAttestationsFeed,
+		BeaconBlockHeadersFeed:  b.slasherBlockHeadersFeed,
+		Database:                b.slasherDB,
+		StateNotifier:           b,
+		HeadStateFetcher:        chainService,
+		GenesisTimeFetcher:      chainService,
+		SlashingPoolInserter:    b.slashingsPool,
+		SyncChecker:             initSync,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not register slasher service")
+	}
+	return b.services.RegisterService(slasherSrv)
+}
+
+func (b *BeaconNode) registerValidatorMonitorService() error {
+	indices := b.cliCtx.IntSlice(flags.MonitorIndices.Name)
+	pubkeysFile := b.cliCtx.String(flags.MonitorPubkeysFile.Name)
+	if len(indices) == 0 && pubkeysFile == "" {
+		return nil
+	}
+	trackedIndices := make([]types.ValidatorIndex, len(indices))
+	for i, idx := range indices {
+		if idx < 0 {
+			return fmt.Errorf("invalid validator index %d to monitor", idx)
+		}
+		trackedIndices[i] = types.ValidatorIndex(idx)
+	}
+	var trackedPubkeys [][48]byte
+	if pubkeysFile != "" {
+		var err error
+		trackedPubkeys, err = monitor.PubkeysFromFile(pubkeysFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	var initSync *initialsync.Service
+	if err := b.services.FetchService(&initSync); err != nil {
+		return err
+	}
+
+	monitorSrv, err := monitor.NewService(b.ctx, &monitor.ServiceConfig{
+		StateNotifier:      b,
+		OperationNotifier:  b,
+		HeadFetcher:        chainService,
+		GenesisTimeFetcher: chainService,
+		StateGen:           b.stateGen,
+		SyncChecker:        initSync,
+		TrackedIndices:     trackedIndices,
+		TrackedPubkeys:     trackedPubkeys,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not register validator monitor service")
+	}
+	return b.services.RegisterService(monitorSrv)
+}
+
 func (b *BeaconNode) registerRPCService() error {
 	var chainService *blockchain.Service
 	if err := b.services.FetchService(&chainService); err != nil {
@@ -588,6 +882,7 @@ func (b *BeaconNode) registerRPCService() error {
 		AttestationsPool:        b.attestationPool,
 		ExitPool:                b.exitPool,
 		SlashingsPool:           b.slashingsPool,
+		SyncCommitteeObjectPool: b.syncCommitteePool,
 		POWChainService:         web3Service,
 		ChainStartFetcher:       chainStartFetcher,
 		MockEth1Votes:           mockEth1DataVotes,
@@ -8,7 +8,10 @@ import (
 	"testing"
 
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/slasher"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/urfave/cli/v2"
@@ -60,3 +63,40 @@ func TestClearDB(t *testing.T) {
 	require.LogsContain(t, hook, "Removing database")
 	require.NoError(t, os.RemoveAll(tmp))
 }
+
+// TestClearDB_Slasher tests clearing the slasher database when the slasher is enabled.
+func TestClearDB_Slasher(t *testing.T) {
+	hook := logTest.NewGlobal()
+
+	tmp := filepath.Join(t.TempDir(), "datadirtest")
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String("datadir", tmp, "node data directory")
+	set.Bool(cmd.ForceClearDB.Name, true, "force clear db")
+	set.Bool("slasher", true, "enable slasher")
+
+	context := cli.NewContext(&app, set, nil)
+	node, err := New(context)
+	require.NoError(t, err)
+	defer featureconfig.Init(&featureconfig.Flags{})
+
+	require.LogsContain(t, hook, "Removing slasher database")
+	require.NotNil(t, node.slasherDB)
+	var slasherSrv *slasher.Service
+	require.NoError(t, node.services.FetchService(&slasherSrv))
+
+	node.Close()
+	require.NoError(t, os.RemoveAll(tmp))
+}
+
+func TestInitializeFromCheckpoint_ConflictingFlags(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.CheckpointSyncURL.Name, "http://localhost:3500", "")
+	set.String(flags.CheckpointState.Name, "state.ssz", "")
+	context := cli.NewContext(&app, set, nil)
+
+	b := &BeaconNode{}
+	require.ErrorContains(t, "cannot be used together", b.initializeFromCheckpoint(context))
+}
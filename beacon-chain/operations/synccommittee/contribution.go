@@ -0,0 +1,60 @@
+package synccommittee
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/aggregation/sync_contribution"
+	"github.com/prysmaticlabs/prysm/shared/copyutil"
+)
+
+// SaveSyncCommitteeContribution saves a sync committee contribution in the cache.
+// The contribution is aggregated with the existing contributions of the same block root
+// and subcommittee index. Contributions older than the retention window of the newest
+// saved slot are pruned.
+func (s *Store) SaveSyncCommitteeContribution(cont *prysmv2.SyncCommitteeContribution) error {
+	if cont == nil {
+		return errors.New("nil sync committee contribution")
+	}
+
+	s.contributionLock.Lock()
+	defer s.contributionLock.Unlock()
+
+	existing := s.contributionCache[cont.Slot]
+	matching := []*prysmv2.SyncCommitteeContribution{copyutil.CopySyncCommitteeContribution(cont)}
+	others := make([]*prysmv2.SyncCommitteeContribution, 0, len(existing))
+	for _, c := range existing {
+		if c.SubcommitteeIndex == cont.SubcommitteeIndex && bytes.Equal(c.BlockRoot, cont.BlockRoot) {
+			matching = append(matching, c)
+		} else {
+			others = append(others, c)
+		}
+	}
+	aggregated, err := sync_contribution.Aggregate(matching)
+	if err != nil {
+		return errors.Wrap(err, "could not aggregate sync committee contributions")
+	}
+	s.contributionCache[cont.Slot] = append(others, aggregated...)
+
+	for slot := range s.contributionCache {
+		if slot+syncCommitteeMaxSlotRetention < cont.Slot {
+			delete(s.contributionCache, slot)
+		}
+	}
+	return nil
+}
+
+// SyncCommitteeContributions returns sync committee contributions in cache by slot.
+func (s *Store) SyncCommitteeContributions(slot types.Slot) ([]*prysmv2.SyncCommitteeContribution, error) {
+	s.contributionLock.RLock()
+	defer s.contributionLock.RUnlock()
+
+	conts := s.contributionCache[slot]
+	res := make([]*prysmv2.SyncCommitteeContribution, len(conts))
+	for i, c := range conts {
+		res[i] = copyutil.CopySyncCommitteeContribution(c)
+	}
+	return res, nil
+}
@@ -0,0 +1,69 @@
+package synccommittee
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSyncCommitteeContributions_CanSaveRetrieve(t *testing.T) {
+	store := NewStore()
+	rootA := [32]byte{'a'}
+	rootB := [32]byte{'b'}
+
+	bitsA := bitfield.NewBitvector128()
+	bitsA.SetBitAt(0, true)
+	bitsAB := bitfield.NewBitvector128()
+	bitsAB.SetBitAt(0, true)
+	bitsAB.SetBitAt(1, true)
+
+	conts := []*prysmv2.SyncCommitteeContribution{
+		{Slot: 1, BlockRoot: rootA[:], SubcommitteeIndex: 0, AggregationBits: bitsA, Signature: []byte{'A'}},
+		// Superset of the first contribution, replaces it.
+		{Slot: 1, BlockRoot: rootA[:], SubcommitteeIndex: 0, AggregationBits: bitsAB, Signature: []byte{'B'}},
+		{Slot: 1, BlockRoot: rootA[:], SubcommitteeIndex: 1, AggregationBits: bitsA, Signature: []byte{'C'}},
+		{Slot: 1, BlockRoot: rootB[:], SubcommitteeIndex: 0, AggregationBits: bitsA, Signature: []byte{'D'}},
+		{Slot: 2, BlockRoot: rootA[:], SubcommitteeIndex: 0, AggregationBits: bitsA, Signature: []byte{'E'}},
+	}
+	for _, c := range conts {
+		require.NoError(t, store.SaveSyncCommitteeContribution(c))
+	}
+
+	got, err := store.SyncCommitteeContributions(1)
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(got))
+	found := false
+	for _, c := range got {
+		if c.SubcommitteeIndex == 0 && c.BlockRoot[0] == 'a' {
+			found = true
+			assert.DeepEqual(t, conts[1], c)
+		}
+	}
+	assert.Equal(t, true, found, "Superset contribution not found")
+
+	got, err = store.SyncCommitteeContributions(2)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*prysmv2.SyncCommitteeContribution{conts[4]}, got)
+}
+
+func TestSyncCommitteeContributions_PrunesOldSlots(t *testing.T) {
+	store := NewStore()
+	bits := bitfield.NewBitvector128()
+	require.NoError(t, store.SaveSyncCommitteeContribution(&prysmv2.SyncCommitteeContribution{Slot: 1, AggregationBits: bits}))
+	require.NoError(t, store.SaveSyncCommitteeContribution(&prysmv2.SyncCommitteeContribution{
+		Slot:            1 + syncCommitteeMaxSlotRetention + 1,
+		AggregationBits: bits,
+	}))
+
+	got, err := store.SyncCommitteeContributions(1)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(got))
+}
+
+func TestSyncCommitteeContributions_NilContribution(t *testing.T) {
+	store := NewStore()
+	require.ErrorContains(t, "nil sync committee contribution", store.SaveSyncCommitteeContribution(nil))
+}
@@ -0,0 +1,7 @@
+/*
+Package synccommittee defines the caches for the sync committee messages and
+contributions received over gossip or from local validators. Messages are
+used by sync committee aggregators to produce contributions, and contributions
+are used by block proposers to build the sync aggregate of a block.
+*/
+package synccommittee
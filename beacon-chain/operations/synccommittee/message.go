@@ -0,0 +1,57 @@
+package synccommittee
+
+import (
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/copyutil"
+)
+
+// SaveSyncCommitteeMessage saves a sync committee message in the cache.
+// Only the first message of a validator for a given slot and block root is kept.
+// Messages older than the retention window of the newest saved slot are pruned.
+func (s *Store) SaveSyncCommitteeMessage(msg *prysmv2.SyncCommitteeMessage) error {
+	if msg == nil {
+		return errors.New("nil sync committee message")
+	}
+	if len(msg.BlockRoot) != 32 {
+		return errors.New("invalid block root length")
+	}
+
+	s.messageLock.Lock()
+	defer s.messageLock.Unlock()
+
+	root := bytesutil.ToBytes32(msg.BlockRoot)
+	byRoot, ok := s.messageCache[msg.Slot]
+	if !ok {
+		byRoot = make(map[[32]byte][]*prysmv2.SyncCommitteeMessage)
+		s.messageCache[msg.Slot] = byRoot
+	}
+	for _, m := range byRoot[root] {
+		if m.ValidatorIndex == msg.ValidatorIndex {
+			return nil
+		}
+	}
+	byRoot[root] = append(byRoot[root], copyutil.CopySyncCommitteeMessage(msg))
+
+	for slot := range s.messageCache {
+		if slot+syncCommitteeMaxSlotRetention < msg.Slot {
+			delete(s.messageCache, slot)
+		}
+	}
+	return nil
+}
+
+// SyncCommitteeMessages returns sync committee messages in cache by slot and block root.
+func (s *Store) SyncCommitteeMessages(slot types.Slot, root [32]byte) ([]*prysmv2.SyncCommitteeMessage, error) {
+	s.messageLock.RLock()
+	defer s.messageLock.RUnlock()
+
+	msgs := s.messageCache[slot][root]
+	res := make([]*prysmv2.SyncCommitteeMessage, len(msgs))
+	for i, m := range msgs {
+		res[i] = copyutil.CopySyncCommitteeMessage(m)
+	}
+	return res, nil
+}
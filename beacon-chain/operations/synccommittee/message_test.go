@@ -0,0 +1,60 @@
+package synccommittee
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSyncCommitteeMessages_CanSaveRetrieve(t *testing.T) {
+	store := NewStore()
+	rootA := [32]byte{'a'}
+	rootB := [32]byte{'b'}
+	msgs := []*prysmv2.SyncCommitteeMessage{
+		{Slot: 1, BlockRoot: rootA[:], ValidatorIndex: 0, Signature: []byte{'A'}},
+		{Slot: 1, BlockRoot: rootA[:], ValidatorIndex: 1, Signature: []byte{'B'}},
+		{Slot: 1, BlockRoot: rootB[:], ValidatorIndex: 2, Signature: []byte{'C'}},
+		{Slot: 2, BlockRoot: rootA[:], ValidatorIndex: 3, Signature: []byte{'D'}},
+		// Duplicate of the first message, ignored.
+		{Slot: 1, BlockRoot: rootA[:], ValidatorIndex: 0, Signature: []byte{'E'}},
+	}
+	for _, m := range msgs {
+		require.NoError(t, store.SaveSyncCommitteeMessage(m))
+	}
+
+	got, err := store.SyncCommitteeMessages(1, rootA)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*prysmv2.SyncCommitteeMessage{msgs[0], msgs[1]}, got)
+	got, err = store.SyncCommitteeMessages(1, rootB)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*prysmv2.SyncCommitteeMessage{msgs[2]}, got)
+	got, err = store.SyncCommitteeMessages(2, rootB)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(got))
+}
+
+func TestSyncCommitteeMessages_PrunesOldSlots(t *testing.T) {
+	store := NewStore()
+	root := [32]byte{'a'}
+	require.NoError(t, store.SaveSyncCommitteeMessage(&prysmv2.SyncCommitteeMessage{Slot: 1, BlockRoot: root[:]}))
+	require.NoError(t, store.SaveSyncCommitteeMessage(&prysmv2.SyncCommitteeMessage{
+		Slot:      1 + syncCommitteeMaxSlotRetention + 1,
+		BlockRoot: root[:],
+	}))
+
+	got, err := store.SyncCommitteeMessages(1, root)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(got))
+	got, err = store.SyncCommitteeMessages(types.Slot(1)+syncCommitteeMaxSlotRetention+1, root)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(got))
+}
+
+func TestSyncCommitteeMessages_InvalidInput(t *testing.T) {
+	store := NewStore()
+	require.ErrorContains(t, "nil sync committee message", store.SaveSyncCommitteeMessage(nil))
+	require.ErrorContains(t, "invalid block root length", store.SaveSyncCommitteeMessage(&prysmv2.SyncCommitteeMessage{}))
+}
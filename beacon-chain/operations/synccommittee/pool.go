@@ -0,0 +1,50 @@
+package synccommittee
+
+import (
+	"sync"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+)
+
+var _ = Pool(&Store{})
+
+// syncCommitteeMaxSlotRetention is the number of slots for which sync committee objects
+// are kept in the pool. Older objects are no longer useful to aggregators or proposers.
+const syncCommitteeMaxSlotRetention = types.Slot(4)
+
+// Pool defines the necessary methods for Prysm sync pool to serve
+// validators. In the current design, aggregated sync committee contributions
+// are used by proposers to build the sync aggregate of a block. Unaggregated
+// sync committee messages are used by aggregators to produce contributions.
+type Pool interface {
+	// Methods for Sync Contributions.
+	SaveSyncCommitteeContribution(contr *prysmv2.SyncCommitteeContribution) error
+	SyncCommitteeContributions(slot types.Slot) ([]*prysmv2.SyncCommitteeContribution, error)
+
+	// Methods for Sync Committee Messages.
+	SaveSyncCommitteeMessage(msg *prysmv2.SyncCommitteeMessage) error
+	SyncCommitteeMessages(slot types.Slot, root [32]byte) ([]*prysmv2.SyncCommitteeMessage, error)
+}
+
+// Store defines the caches for various sync committee objects
+// such as message(un-aggregated) and contribution(aggregated).
+type Store struct {
+	messageLock       sync.RWMutex
+	messageCache      map[types.Slot]map[[32]byte][]*prysmv2.SyncCommitteeMessage
+	contributionLock  sync.RWMutex
+	contributionCache map[types.Slot][]*prysmv2.SyncCommitteeContribution
+}
+
+// NewPool returns a new sync committee pool, keyed by slot and block root.
+func NewPool() *Store {
+	return NewStore()
+}
+
+// NewStore initializes a new sync committee store.
+func NewStore() *Store {
+	return &Store{
+		messageCache:      make(map[types.Slot]map[[32]byte][]*prysmv2.SyncCommitteeMessage),
+		contributionCache: make(map[types.Slot][]*prysmv2.SyncCommitteeContribution),
+	}
+}
@@ -8,24 +8,26 @@ import (
 // Config for the p2p service. These parameters are set from application level flags
 // to initialize the p2p service.
 type Config struct {
-	NoDiscovery         bool
-	EnableUPnP          bool
-	DisableDiscv5       bool
-	StaticPeers         []string
-	BootstrapNodeAddr   []string
-	Discv5BootStrapAddr []string
-	RelayNodeAddr       string
-	LocalIP             string
-	HostAddress         string
-	HostDNS             string
-	PrivateKey          string
-	DataDir             string
-	MetaDataDir         string
-	TCPPort             uint
-	UDPPort             uint
-	MaxPeers            uint
-	AllowListCIDR       string
-	DenyListCIDR        []string
-	StateNotifier       statefeed.Notifier
-	DB                  db.ReadOnlyDatabase
+	NoDiscovery           bool
+	EnableUPnP            bool
+	DisableDiscv5         bool
+	StaticPeers           []string
+	BootstrapNodeAddr     []string
+	Discv5BootStrapAddr   []string
+	RelayNodeAddr         string
+	LocalIP               string
+	HostAddress           string
+	HostDNS               string
+	PrivateKey            string
+	DataDir               string
+	MetaDataDir           string
+	TCPPort               uint
+	UDPPort               uint
+	MaxPeers              uint
+	AllowListCIDR         string
+	DenyListCIDR          []string
+	ColocationLimit       uint
+	SubnetColocationLimit uint
+	StateNotifier         statefeed.Notifier
+	DB                    db.ReadOnlyDatabase
 }
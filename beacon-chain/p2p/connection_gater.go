@@ -1,6 +1,7 @@
 package p2p
 
 import (
+	"errors"
 	"net"
 	"runtime"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/multiformats/go-multiaddr"
 	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
 	"github.com/sirupsen/logrus"
 )
 
@@ -59,8 +61,8 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 
 // InterceptSecured tests whether a given connection, now authenticated,
 // is allowed.
-func (s *Service) InterceptSecured(_ network.Direction, _ peer.ID, _ network.ConnMultiaddrs) (allow bool) {
-	return true
+func (s *Service) InterceptSecured(_ network.Direction, pid peer.ID, n network.ConnMultiaddrs) (allow bool) {
+	return !s.isColocated(pid, n.RemoteMultiaddr(), "gater")
 }
 
 // InterceptUpgraded tests whether a fully capable connection is allowed.
@@ -81,6 +83,22 @@ func (s *Service) validateDial(addr multiaddr.Multiaddr) bool {
 	return true
 }
 
+// isColocated checks whether the peer shares its ip address or subnet with too many
+// active peers. Rejections are recorded with the given source.
+func (s *Service) isColocated(pid peer.ID, addr multiaddr.Multiaddr, source string) bool {
+	err := s.peers.Scorers().ColocationScorer().ValidateColocation(pid, addr)
+	if err == nil {
+		return false
+	}
+	reason := "ip"
+	if errors.Is(err, scorers.ErrSubnetColocationLimit) {
+		reason = "subnet"
+	}
+	colocationRejections.WithLabelValues(source, reason).Inc()
+	log.WithFields(logrus.Fields{"peer": addr, "reason": err}).Trace("Not connecting to colocated peer")
+	return true
+}
+
 var privateCIDRList = []string{
 	// Private ip addresses specified by rfc-1918.
 	// See: https://tools.ietf.org/html/rfc1918
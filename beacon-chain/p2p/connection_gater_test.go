@@ -7,6 +7,7 @@ import (
 
 	"github.com/kevinms/leakybucket-go"
 	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
@@ -132,6 +133,41 @@ func TestService_RejectInboundPeersBeyondLimit(t *testing.T) {
 	}
 }
 
+func TestService_InterceptSecured_ColocationLimits(t *testing.T) {
+	s := &Service{
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit: 20,
+			ScorerParams: &scorers.Config{
+				ColocationScorerConfig: &scorers.ColocationScorerConfig{
+					IPLimit:     2,
+					SubnetLimit: 3,
+				},
+			},
+		}),
+	}
+	addColocatedPeer := func(addr string) {
+		multiAddress, err := ma.NewMultiaddr(addr)
+		require.NoError(t, err)
+		pid := addPeer(t, s.peers, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+		s.peers.Add(nil, pid, multiAddress, network.DirInbound)
+	}
+	intercept := func(addr string) bool {
+		multiAddress, err := ma.NewMultiaddr(addr)
+		require.NoError(t, err)
+		return s.InterceptSecured(network.DirInbound, "new-peer", &maEndpoints{raddr: multiAddress})
+	}
+
+	addColocatedPeer("/ip4/212.67.10.122/tcp/3000")
+	assert.Equal(t, true, intercept("/ip4/212.67.10.122/tcp/3001"), "Expected peer below ip limit to be accepted")
+	addColocatedPeer("/ip4/212.67.10.122/tcp/3001")
+	assert.Equal(t, false, intercept("/ip4/212.67.10.122/tcp/3002"), "Expected peer at ip limit to be rejected")
+
+	assert.Equal(t, true, intercept("/ip4/212.67.10.123/tcp/3000"), "Expected peer below subnet limit to be accepted")
+	addColocatedPeer("/ip4/212.67.10.123/tcp/3000")
+	assert.Equal(t, false, intercept("/ip4/212.67.10.124/tcp/3000"), "Expected peer at subnet limit to be rejected")
+	assert.Equal(t, true, intercept("/ip4/212.67.11.124/tcp/3000"), "Expected peer from another subnet to be accepted")
+}
+
 func TestPeer_BelowMaxLimit(t *testing.T) {
 	// create host and remote peer
 	ipAddr, pkey := createAddrAndPrivKey(t)
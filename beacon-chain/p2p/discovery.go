@@ -206,7 +206,8 @@ func (s *Service) createLocalNode(
 	if err != nil {
 		return nil, errors.Wrap(err, "could not add eth2 fork version entry to enr")
 	}
-	return intializeAttSubnets(localNode), nil
+	localNode = intializeAttSubnets(localNode)
+	return initializeSyncCommSubnets(localNode), nil
 }
 
 func (s *Service) startDiscoveryV5(
@@ -229,9 +230,10 @@ func (s *Service) startDiscoveryV5(
 //    connect to.
 // 2) Peer has a valid IP and TCP port set in their enr.
 // 3) Peer hasn't been marked as 'bad'
-// 4) Peer is not currently active or connected.
-// 5) Peer is ready to receive incoming connections.
-// 6) Peer's fork digest in their ENR matches that of
+// 4) Peer's ip address and subnet are within our colocation limits.
+// 5) Peer is not currently active or connected.
+// 6) Peer is ready to receive incoming connections.
+// 7) Peer's fork digest in their ENR matches that of
 // 	  our localnodes.
 func (s *Service) filterPeer(node *enode.Node) bool {
 	// Ignore nil node entries passed in.
@@ -257,6 +259,9 @@ func (s *Service) filterPeer(node *enode.Node) bool {
 	if s.peers.IsBad(peerData.ID) {
 		return false
 	}
+	if s.isColocated(peerData.ID, multiAddr, "discovery") {
+		return false
+	}
 	if s.peers.IsActive(peerData.ID) {
 		return false
 	}
@@ -15,6 +15,12 @@ var (
 		Name: "p2p_repeat_attempts",
 		Help: "The number of repeat attempts the connection handler is triggered for a peer.",
 	})
+	colocationRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "p2p_colocation_rejections_total",
+		Help: "The number of peers that were not connected to because they share their ip address " +
+			"or subnet with too many other peers.",
+	},
+		[]string{"source", "reason"})
 	savedAttestationBroadcasts = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "p2p_attestation_subnet_recovered_broadcasts",
 		Help: "The number of attestations that were attempted to be broadcast with no peers on " +
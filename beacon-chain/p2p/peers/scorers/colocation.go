@@ -0,0 +1,172 @@
+package scorers
+
+import (
+	"errors"
+	"math"
+	"net"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+var _ Scorer = (*ColocationScorer)(nil)
+
+const (
+	// DefaultColocationIPLimit is the default maximum number of active peers sharing a single ip address.
+	DefaultColocationIPLimit = 5
+	// DefaultColocationSubnetLimit is the default maximum number of active peers sharing a single
+	// /24 (ipv4) or /64 (ipv6) subnet.
+	DefaultColocationSubnetLimit = 10
+
+	// ipv4SubnetMaskBits and ipv6SubnetMaskBits define the prefix length of the subnets peers are grouped by.
+	ipv4SubnetMaskBits = 24
+	ipv6SubnetMaskBits = 64
+)
+
+var (
+	// ErrIPColocationLimit is returned when a peer shares its ip address with too many active peers.
+	ErrIPColocationLimit = errors.New("ip colocation limit reached")
+	// ErrSubnetColocationLimit is returned when a peer shares its subnet with too many active peers.
+	ErrSubnetColocationLimit = errors.New("subnet colocation limit reached")
+)
+
+// ColocationScorer represents scorer that evaluates peers based on how many other active peers
+// share their ip address or ip subnet. Colocated peers are scored lower, so that they are pruned first.
+type ColocationScorer struct {
+	config *ColocationScorerConfig
+	store  *peerdata.Store
+}
+
+// ColocationScorerConfig holds configuration parameters for colocation scoring service.
+type ColocationScorerConfig struct {
+	// IPLimit is the maximum number of active peers allowed from a single ip address.
+	IPLimit int
+	// SubnetLimit is the maximum number of active peers allowed from a single /24 (ipv4) or /64 (ipv6) subnet.
+	SubnetLimit int
+}
+
+// newColocationScorer creates new colocation scoring service.
+func newColocationScorer(store *peerdata.Store, config *ColocationScorerConfig) *ColocationScorer {
+	if config == nil {
+		config = &ColocationScorerConfig{}
+	}
+	scorer := &ColocationScorer{
+		config: config,
+		store:  store,
+	}
+	if scorer.config.IPLimit <= 0 {
+		scorer.config.IPLimit = DefaultColocationIPLimit
+	}
+	if scorer.config.SubnetLimit <= 0 {
+		scorer.config.SubnetLimit = DefaultColocationSubnetLimit
+	}
+	return scorer
+}
+
+// Score returns calculated peer score. A peer within the colocation limits has a score of zero,
+// a colocated peer is scored according to the share of peers above the limit on its ip or subnet.
+func (s *ColocationScorer) Score(pid peer.ID) float64 {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	return s.score(pid)
+}
+
+// score is a lock-free version of Score.
+func (s *ColocationScorer) score(pid peer.ID) float64 {
+	peerData, ok := s.store.PeerData(pid)
+	if !ok || peerData.Address == nil {
+		return 0
+	}
+	ip, err := manet.ToIP(peerData.Address)
+	if err != nil || ip.IsLoopback() {
+		return 0
+	}
+	// Counts include the peer itself.
+	ipCount, subnetCount := s.colocatedPeers(pid, ip)
+	ipCount++
+	subnetCount++
+	score := float64(0)
+	if ipCount > s.config.IPLimit {
+		score = math.Min(score, -float64(ipCount-s.config.IPLimit)/float64(ipCount))
+	}
+	if subnetCount > s.config.SubnetLimit {
+		score = math.Min(score, -float64(subnetCount-s.config.SubnetLimit)/float64(subnetCount))
+	}
+	return math.Round(score*ScoreRoundingFactor) / ScoreRoundingFactor
+}
+
+// IsBadPeer states if the peer is to be considered bad. Colocation alone never marks a peer as bad.
+func (s *ColocationScorer) IsBadPeer(_ peer.ID) bool {
+	return false
+}
+
+// BadPeers returns the peers that are considered bad.
+func (s *ColocationScorer) BadPeers() []peer.ID {
+	return []peer.ID{}
+}
+
+// Params exposes scorer's parameters.
+func (s *ColocationScorer) Params() *ColocationScorerConfig {
+	return s.config
+}
+
+// ValidateColocation checks whether a connection from the given peer and address would exceed
+// the ip or subnet colocation limits. Loopback addresses are never limited.
+func (s *ColocationScorer) ValidateColocation(pid peer.ID, addr ma.Multiaddr) error {
+	ip, err := manet.ToIP(addr)
+	if err != nil || ip.IsLoopback() {
+		return nil
+	}
+
+	s.store.RLock()
+	defer s.store.RUnlock()
+	ipCount, subnetCount := s.colocatedPeers(pid, ip)
+	if ipCount >= s.config.IPLimit {
+		return ErrIPColocationLimit
+	}
+	if subnetCount >= s.config.SubnetLimit {
+		return ErrSubnetColocationLimit
+	}
+	return nil
+}
+
+// colocatedPeers returns the number of active peers, other than the given one, that share
+// the given ip address and its subnet.
+func (s *ColocationScorer) colocatedPeers(pid peer.ID, ip net.IP) (ipCount, subnetCount int) {
+	subnet := ipSubnet(ip)
+	for id, peerData := range s.store.Peers() {
+		if id == pid || peerData.Address == nil || !isActive(peerData.ConnState) {
+			continue
+		}
+		peerIP, err := manet.ToIP(peerData.Address)
+		if err != nil {
+			continue
+		}
+		if peerIP.Equal(ip) {
+			ipCount++
+		}
+		if subnet.Contains(peerIP) {
+			subnetCount++
+		}
+	}
+	return ipCount, subnetCount
+}
+
+// ipSubnet returns the /24 subnet of an ipv4 address, or the /64 subnet of an ipv6 address.
+func ipSubnet(ip net.IP) *net.IPNet {
+	if ip4 := ip.To4(); ip4 != nil {
+		mask := net.CIDRMask(ipv4SubnetMaskBits, 8*net.IPv4len)
+		return &net.IPNet{IP: ip4.Mask(mask), Mask: mask}
+	}
+	mask := net.CIDRMask(ipv6SubnetMaskBits, 8*net.IPv6len)
+	return &net.IPNet{IP: ip.Mask(mask), Mask: mask}
+}
+
+// isActive checks whether the connection state is either connecting or connected.
+func isActive(state peerdata.PeerConnectionState) bool {
+	return state == peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED) ||
+		state == peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTING)
+}
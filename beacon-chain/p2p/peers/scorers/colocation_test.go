@@ -0,0 +1,142 @@
+package scorers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestScorers_Colocation_DefaultParams(t *testing.T) {
+	peerStatuses := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		ScorerParams: &scorers.Config{},
+	})
+	params := peerStatuses.Scorers().ColocationScorer().Params()
+	assert.Equal(t, scorers.DefaultColocationIPLimit, params.IPLimit)
+	assert.Equal(t, scorers.DefaultColocationSubnetLimit, params.SubnetLimit)
+}
+
+func TestScorers_Colocation_ValidateColocation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	newStatus := func() *peers.Status {
+		return peers.NewStatus(ctx, &peers.StatusConfig{
+			ScorerParams: &scorers.Config{
+				ColocationScorerConfig: &scorers.ColocationScorerConfig{
+					IPLimit:     2,
+					SubnetLimit: 3,
+				},
+			},
+		})
+	}
+	addPeer := func(p *peers.Status, pid peer.ID, addr string, state peerdata.PeerConnectionState) {
+		a, err := ma.NewMultiaddr(addr)
+		require.NoError(t, err)
+		p.Add(nil, pid, a, network.DirInbound)
+		p.SetConnectionState(pid, state)
+	}
+	multiAddr := func(addr string) ma.Multiaddr {
+		a, err := ma.NewMultiaddr(addr)
+		require.NoError(t, err)
+		return a
+	}
+
+	t.Run("below ip limit", func(t *testing.T) {
+		p := newStatus()
+		addPeer(p, "peer1", "/ip4/211.227.218.116/tcp/3000", peers.PeerConnected)
+		err := p.Scorers().ColocationScorer().ValidateColocation("peer2", multiAddr("/ip4/211.227.218.116/tcp/3001"))
+		assert.NoError(t, err)
+	})
+
+	t.Run("at ip limit", func(t *testing.T) {
+		p := newStatus()
+		addPeer(p, "peer1", "/ip4/211.227.218.116/tcp/3000", peers.PeerConnected)
+		addPeer(p, "peer2", "/ip4/211.227.218.116/tcp/3001", peers.PeerConnecting)
+		err := p.Scorers().ColocationScorer().ValidateColocation("peer3", multiAddr("/ip4/211.227.218.116/tcp/3002"))
+		assert.ErrorContains(t, scorers.ErrIPColocationLimit.Error(), err)
+		// A known peer is not counted against itself.
+		err = p.Scorers().ColocationScorer().ValidateColocation("peer2", multiAddr("/ip4/211.227.218.116/tcp/3001"))
+		assert.NoError(t, err)
+	})
+
+	t.Run("inactive peers are ignored", func(t *testing.T) {
+		p := newStatus()
+		addPeer(p, "peer1", "/ip4/211.227.218.116/tcp/3000", peers.PeerDisconnected)
+		addPeer(p, "peer2", "/ip4/211.227.218.116/tcp/3001", peers.PeerDisconnecting)
+		err := p.Scorers().ColocationScorer().ValidateColocation("peer3", multiAddr("/ip4/211.227.218.116/tcp/3002"))
+		assert.NoError(t, err)
+	})
+
+	t.Run("at ipv4 subnet limit", func(t *testing.T) {
+		p := newStatus()
+		for i := 0; i < 3; i++ {
+			addPeer(p, peer.ID(fmt.Sprintf("peer%d", i)), fmt.Sprintf("/ip4/211.227.218.%d/tcp/3000", 10+i), peers.PeerConnected)
+		}
+		err := p.Scorers().ColocationScorer().ValidateColocation("peer4", multiAddr("/ip4/211.227.218.100/tcp/3000"))
+		assert.ErrorContains(t, scorers.ErrSubnetColocationLimit.Error(), err)
+		err = p.Scorers().ColocationScorer().ValidateColocation("peer4", multiAddr("/ip4/211.227.219.100/tcp/3000"))
+		assert.NoError(t, err)
+	})
+
+	t.Run("at ipv6 subnet limit", func(t *testing.T) {
+		p := newStatus()
+		for i := 0; i < 3; i++ {
+			addPeer(p, peer.ID(fmt.Sprintf("peer%d", i)), fmt.Sprintf("/ip6/2001:db8:0:1::%d/tcp/3000", 10+i), peers.PeerConnected)
+		}
+		err := p.Scorers().ColocationScorer().ValidateColocation("peer4", multiAddr("/ip6/2001:db8:0:1:ffff::1/tcp/3000"))
+		assert.ErrorContains(t, scorers.ErrSubnetColocationLimit.Error(), err)
+		err = p.Scorers().ColocationScorer().ValidateColocation("peer4", multiAddr("/ip6/2001:db8:0:2::1/tcp/3000"))
+		assert.NoError(t, err)
+	})
+
+	t.Run("loopback is not limited", func(t *testing.T) {
+		p := newStatus()
+		for i := 0; i < 3; i++ {
+			addPeer(p, peer.ID(fmt.Sprintf("peer%d", i)), fmt.Sprintf("/ip4/127.0.0.1/tcp/%d", 3000+i), peers.PeerConnected)
+		}
+		err := p.Scorers().ColocationScorer().ValidateColocation("peer4", multiAddr("/ip4/127.0.0.1/tcp/4000"))
+		assert.NoError(t, err)
+	})
+}
+
+func TestScorers_Colocation_Score(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peerStatuses := peers.NewStatus(ctx, &peers.StatusConfig{
+		ScorerParams: &scorers.Config{
+			ColocationScorerConfig: &scorers.ColocationScorerConfig{
+				IPLimit:     2,
+				SubnetLimit: 10,
+			},
+		},
+	})
+	scorer := peerStatuses.Scorers().ColocationScorer()
+	assert.Equal(t, 0.0, scorer.Score("peer1"), "Unexpected score for unknown peer")
+
+	for i := 0; i < 4; i++ {
+		addr, err := ma.NewMultiaddr(fmt.Sprintf("/ip4/211.227.218.116/tcp/%d", 3000+i))
+		require.NoError(t, err)
+		pid := peer.ID(fmt.Sprintf("peer%d", i))
+		peerStatuses.Add(nil, pid, addr, network.DirInbound)
+		peerStatuses.SetConnectionState(pid, peers.PeerConnected)
+	}
+	addr, err := ma.NewMultiaddr("/ip4/211.227.219.116/tcp/3000")
+	require.NoError(t, err)
+	peerStatuses.Add(nil, "peer5", addr, network.DirInbound)
+	peerStatuses.SetConnectionState("peer5", peers.PeerConnected)
+
+	// Four peers share an ip address with a limit of two, so half of them are above the limit.
+	assert.Equal(t, -0.5, scorer.Score("peer0"), "Unexpected score for colocated peer")
+	assert.Equal(t, 0.0, scorer.Score("peer5"), "Unexpected score for peer within limits")
+	assert.Equal(t, false, scorer.IsBadPeer("peer0"), "Colocated peer should not be bad")
+}
@@ -33,6 +33,7 @@ type Service struct {
 		blockProviderScorer *BlockProviderScorer
 		peerStatusScorer    *PeerStatusScorer
 		gossipScorer        *GossipScorer
+		colocationScorer    *ColocationScorer
 	}
 	weights     map[Scorer]float64
 	totalWeight float64
@@ -44,6 +45,7 @@ type Config struct {
 	BlockProviderScorerConfig *BlockProviderScorerConfig
 	PeerStatusScorerConfig    *PeerStatusScorerConfig
 	GossipScorerConfig        *GossipScorerConfig
+	ColocationScorerConfig    *ColocationScorerConfig
 }
 
 // NewService provides fully initialized peer scoring service.
@@ -62,6 +64,8 @@ func NewService(ctx context.Context, store *peerdata.Store, config *Config) *Ser
 	s.setScorerWeight(s.scorers.peerStatusScorer, 0.0)
 	s.scorers.gossipScorer = newGossipScorer(store, config.GossipScorerConfig)
 	s.setScorerWeight(s.scorers.gossipScorer, 0.0)
+	s.scorers.colocationScorer = newColocationScorer(store, config.ColocationScorerConfig)
+	s.setScorerWeight(s.scorers.colocationScorer, 0.0)
 
 	// Start background tasks.
 	go s.loop(ctx)
@@ -89,6 +93,11 @@ func (s *Service) GossipScorer() *GossipScorer {
 	return s.scorers.gossipScorer
 }
 
+// ColocationScorer exposes the peer's ip colocation scoring service.
+func (s *Service) ColocationScorer() *ColocationScorer {
+	return s.scorers.colocationScorer
+}
+
 // ActiveScorersCount returns number of scorers that can affect score (have non-zero weight).
 func (s *Service) ActiveScorersCount() int {
 	cnt := 0
@@ -113,6 +122,7 @@ func (s *Service) Score(pid peer.ID) float64 {
 	score += s.scorers.blockProviderScorer.score(pid) * s.scorerWeight(s.scorers.blockProviderScorer)
 	score += s.scorers.peerStatusScorer.score(pid) * s.scorerWeight(s.scorers.peerStatusScorer)
 	score += s.scorers.gossipScorer.score(pid) * s.scorerWeight(s.scorers.gossipScorer)
+	score += s.scorers.colocationScorer.score(pid) * s.scorerWeight(s.scorers.colocationScorer)
 	return math.Round(score*ScoreRoundingFactor) / ScoreRoundingFactor
 }
 
@@ -57,8 +57,9 @@ const (
 )
 
 const (
-	// ColocationLimit restricts how many peer identities we can see from a single ip or ipv6 subnet.
-	ColocationLimit = 5
+	// ColocationLimit is the default limit of how many peer identities we can see from a single ip.
+	// It can be overridden with the colocation scorer's ip limit.
+	ColocationLimit = scorers.DefaultColocationIPLimit
 
 	// Additional buffer beyond current peer limit, from which we can store the relevant peer statuses.
 	maxLimitBuffer = 150
@@ -696,14 +697,13 @@ func (p *Status) PeersToPrune() []peer.ID {
 	if len(activePeers) <= int(connLimit) {
 		return []peer.ID{}
 	}
-	p.store.Lock()
-	defer p.store.Unlock()
-
 	type peerResp struct {
-		pid     peer.ID
-		badResp int
+		pid             peer.ID
+		badResp         int
+		colocationScore float64
 	}
 	peersToPrune := make([]*peerResp, 0)
+	p.store.Lock()
 	// Select connected and inbound peers to prune.
 	for pid, peerData := range p.store.Peers() {
 		if peerData.ConnState == PeerConnected &&
@@ -714,10 +714,21 @@ func (p *Status) PeersToPrune() []peer.ID {
 			})
 		}
 	}
+	p.store.Unlock()
 
-	// Sort in descending order to favour pruning peers with a
-	// higher bad response count.
+	colocationScorer := p.scorers.ColocationScorer()
+	for _, pr := range peersToPrune {
+		pr.colocationScore = colocationScorer.Score(pr.pid)
+	}
+
+	// Sort in ascending order of colocation score to favour pruning
+	// peers sharing an ip or subnet with too many other peers, then in
+	// descending order to favour pruning peers with a higher bad
+	// response count.
 	sort.Slice(peersToPrune, func(i, j int) bool {
+		if peersToPrune[i].colocationScore != peersToPrune[j].colocationScore {
+			return peersToPrune[i].colocationScore < peersToPrune[j].colocationScore
+		}
 		return peersToPrune[i].badResp > peersToPrune[j].badResp
 	})
 
@@ -784,7 +795,7 @@ func (p *Status) isfromBadIP(pid peer.ID) bool {
 		return true
 	}
 	if val, ok := p.ipTracker[ip.String()]; ok {
-		if val > ColocationLimit {
+		if val > uint64(p.scorers.ColocationScorer().Params().IPLimit) {
 			return true
 		}
 	}
@@ -3,6 +3,7 @@ package peers_test
 import (
 	"context"
 	"crypto/rand"
+	"fmt"
 	"strconv"
 	"testing"
 	"time"
@@ -720,6 +721,41 @@ func TestPrunePeers(t *testing.T) {
 	}
 }
 
+func TestPrunePeers_ColocatedFirst(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 5,
+			},
+			ColocationScorerConfig: &scorers.ColocationScorerConfig{
+				IPLimit: 2,
+			},
+		},
+	})
+	for i := 0; i < 30; i++ {
+		addr, err := ma.NewMultiaddr(fmt.Sprintf("/ip4/211.227.%d.116/tcp/3000", i))
+		require.NoError(t, err)
+		pid := createPeer(t, p, addr, network.DirInbound, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+		// Peers on distinct ips have bad responses, so they are pruned first by bad response count.
+		p.Scorers().BadResponsesScorer().Increment(pid)
+	}
+	colocatedPeers := make(map[peer.ID]bool)
+	for i := 0; i < 4; i++ {
+		addr, err := ma.NewMultiaddr(fmt.Sprintf("/ip4/212.67.10.122/tcp/%d", 3000+i))
+		require.NoError(t, err)
+		pid := createPeer(t, p, addr, network.DirInbound, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+		colocatedPeers[pid] = true
+	}
+
+	// Excess inbound peers are pruned, starting with the colocated ones.
+	peersToPrune := p.PeersToPrune()
+	assert.Equal(t, 10, len(peersToPrune))
+	for _, pid := range peersToPrune[:len(colocatedPeers)] {
+		assert.Equal(t, true, colocatedPeers[pid], "Expected colocated peer to be pruned first")
+	}
+}
+
 func TestStatus_BestPeer(t *testing.T) {
 	type peerConfig struct {
 		headSlot       types.Slot
@@ -161,6 +161,10 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 				Threshold:     maxBadResponses,
 				DecayInterval: time.Hour,
 			},
+			ColocationScorerConfig: &scorers.ColocationScorerConfig{
+				IPLimit:     int(s.cfg.ColocationLimit),
+				SubnetLimit: int(s.cfg.SubnetColocationLimit),
+			},
 		},
 	})
 
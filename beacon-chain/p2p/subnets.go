@@ -2,6 +2,7 @@ package p2p
 
 import (
 	"context"
+	"strings"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/p2p/enode"
@@ -16,7 +17,14 @@ import (
 
 var attestationSubnetCount = params.BeaconNetworkConfig().AttestationSubnetCount
 
+var syncCommsSubnetCount = params.BeaconConfig().SyncCommitteeSubnetCount
+
 var attSubnetEnrKey = params.BeaconNetworkConfig().AttSubnetKey
+var syncCommsSubnetEnrKey = params.BeaconNetworkConfig().SyncCommsSubnetKey
+
+// The prefix of the sync committee gossip topic, used to select the
+// ENR entry to filter peers by when searching for a subnet.
+const syncCommitteeTopicPrefix = "/sync_committee_"
 
 // FindPeersWithSubnet performs a network search for peers
 // subscribed to a particular subnet. Then we try to connect
@@ -34,9 +42,14 @@ func (s *Service) FindPeersWithSubnet(ctx context.Context, topic string,
 		return false, nil
 	}
 
+	filter := s.filterPeerForSubnet(index)
+	if strings.Contains(topic, syncCommitteeTopicPrefix) {
+		filter = s.filterPeerForSyncSubnet(index)
+	}
+
 	topic += s.Encoding().ProtocolSuffix()
 	iterator := s.dv5Listener.RandomNodes()
-	iterator = filterNodes(ctx, iterator, s.filterPeerForSubnet(index))
+	iterator = filterNodes(ctx, iterator, filter)
 
 	currNum := uint64(len(s.pubsub.ListPeers(topic)))
 	wg := new(sync.WaitGroup)
@@ -89,6 +102,27 @@ func (s *Service) filterPeerForSubnet(index uint64) func(node *enode.Node) bool
 	}
 }
 
+// returns a method with filters peers specifically for a particular sync committee subnet.
+func (s *Service) filterPeerForSyncSubnet(index uint64) func(node *enode.Node) bool {
+	return func(node *enode.Node) bool {
+		if !s.filterPeer(node) {
+			return false
+		}
+		subnets, err := syncSubnets(node.Record())
+		if err != nil {
+			return false
+		}
+		indExists := false
+		for _, comIdx := range subnets {
+			if comIdx == index {
+				indExists = true
+				break
+			}
+		}
+		return indExists
+	}
+}
+
 // lower threshold to broadcast object compared to searching
 // for a subnet. So that even in the event of poor peer
 // connectivity, we can still broadcast an attestation.
@@ -118,6 +152,15 @@ func intializeAttSubnets(node *enode.LocalNode) *enode.LocalNode {
 	return node
 }
 
+// Initializes a bitvector of sync committee subnets beacon nodes is subscribed to
+// and creates a new ENR entry with its default value.
+func initializeSyncCommSubnets(node *enode.LocalNode) *enode.LocalNode {
+	bitV := bitfield.Bitvector4{byte(0x00)}
+	entry := enr.WithEntry(syncCommsSubnetEnrKey, bitV.Bytes())
+	node.Set(entry)
+	return node
+}
+
 // Reads the attestation subnets entry from a node's ENR and determines
 // the committee indices of the attestation subnets the node is subscribed to.
 func attSubnets(record *enr.Record) ([]uint64, error) {
@@ -146,6 +189,34 @@ func bitvector(record *enr.Record) (bitfield.Bitvector64, error) {
 	return bitV, nil
 }
 
+// Reads the sync subnets entry from a node's ENR and determines
+// the committee indices of the sync subnets the node is subscribed to.
+func syncSubnets(record *enr.Record) ([]uint64, error) {
+	bitV, err := syncBitvector(record)
+	if err != nil {
+		return nil, err
+	}
+	var committeeIdxs []uint64
+	for i := uint64(0); i < syncCommsSubnetCount; i++ {
+		if bitV.BitAt(i) {
+			committeeIdxs = append(committeeIdxs, i)
+		}
+	}
+	return committeeIdxs, nil
+}
+
+// Parses the sync committee subnets ENR entry in a node and extracts its value
+// as a bitvector for further manipulation.
+func syncBitvector(record *enr.Record) (bitfield.Bitvector4, error) {
+	bitV := bitfield.Bitvector4{byte(0x00)}
+	entry := enr.WithEntry(syncCommsSubnetEnrKey, &bitV)
+	err := record.Load(entry)
+	if err != nil {
+		return nil, err
+	}
+	return bitV, nil
+}
+
 func (s *Service) subnetLocker(i uint64) *sync.RWMutex {
 	s.subnetsLockLock.Lock()
 	defer s.subnetsLockLock.Unlock()
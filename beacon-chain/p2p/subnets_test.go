@@ -2,11 +2,14 @@ package p2p
 
 import (
 	"context"
+	"crypto/rand"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/p2p/discover"
+	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/prysmaticlabs/go-bitfield"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
@@ -135,3 +138,25 @@ func TestStartDiscV5_DiscoverPeersWithSubnets(t *testing.T) {
 	assert.NoError(t, s.Stop())
 	exitRoutine <- true
 }
+
+func TestSyncSubnets_ReadFromRecord(t *testing.T) {
+	db, err := enode.OpenDB("")
+	require.NoError(t, err)
+	defer db.Close()
+	priv, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	localNode := enode.NewLocalNode(db, convertFromInterfacePrivKey(priv))
+	localNode = initializeSyncCommSubnets(localNode)
+
+	subnets, err := syncSubnets(localNode.Node().Record())
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(subnets))
+
+	bitV := bitfield.Bitvector4{byte(0x00)}
+	bitV.SetBitAt(1, true)
+	bitV.SetBitAt(3, true)
+	localNode.Set(enr.WithEntry(syncCommsSubnetEnrKey, &bitV))
+	subnets, err = syncSubnets(localNode.Node().Record())
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{1, 3}, subnets)
+}
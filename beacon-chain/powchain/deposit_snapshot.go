@@ -0,0 +1,95 @@
+package powchain
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"github.com/sirupsen/logrus"
+)
+
+// initializeFromDepositSnapshot creates the deposit trie from the deposit snapshot saved in the
+// database, if there is one, so that the deposit logs are followed from the block of the snapshot
+// instead of from the deployment of the deposit contract.
+func (s *Service) initializeFromDepositSnapshot(ctx context.Context) error {
+	snapshot, err := s.cfg.BeaconDB.DepositSnapshot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve deposit snapshot")
+	}
+	if snapshot == nil || snapshot.DepositCount == 0 {
+		return nil
+	}
+	depositTrie, err := trieutil.TrieFromSnapshot(snapshot, params.BeaconConfig().DepositContractTreeDepth)
+	if err != nil {
+		return errors.Wrap(err, "could not create deposit trie from snapshot")
+	}
+	s.depositTrie = depositTrie
+	s.depositSnapshot = snapshot
+	s.latestEth1Data.LastRequestedBlock = snapshot.ExecutionBlockHeight
+	log.WithFields(logrus.Fields{
+		"depositCount": snapshot.DepositCount,
+		"eth1Block":    snapshot.ExecutionBlockHeight,
+	}).Info("Following deposit logs from the finalized deposit snapshot")
+	return nil
+}
+
+// saveDepositSnapshot saves a snapshot of the deposit trie up to the deposits of the finalized
+// state with the given block root. The snapshot moves forward with the finalized deposits, so
+// that the deposit logs before it never have to be processed again.
+func (s *Service) saveDepositSnapshot(ctx context.Context, finalizedRoot [32]byte) error {
+	fState, err := s.cfg.StateGen.StateByRoot(ctx, finalizedRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized state")
+	}
+	if fState == nil || fState.IsNil() {
+		return errors.Errorf("finalized state with root %#x does not exist in the db", finalizedRoot)
+	}
+	eth1Data := fState.Eth1Data()
+	if eth1Data == nil || eth1Data.DepositCount == 0 {
+		return nil
+	}
+	current, err := s.cfg.BeaconDB.DepositSnapshot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve deposit snapshot")
+	}
+	if current != nil && current.DepositCount >= eth1Data.DepositCount {
+		return nil
+	}
+	// Deposits which are not included in the finalized state yet still need their proofs, which
+	// cannot be computed from a snapshot, so the snapshot waits until all of them are included.
+	if fState.Eth1DepositIndex() != eth1Data.DepositCount {
+		return nil
+	}
+	// The deposit logs of the finalized eth1 data have not all been processed yet.
+	if uint64(s.depositTrie.NumOfItems()) < eth1Data.DepositCount {
+		return nil
+	}
+	snapshot, err := s.depositTrie.Snapshot(eth1Data.DepositCount)
+	if err != nil {
+		return errors.Wrap(err, "could not create deposit snapshot")
+	}
+	if !bytes.Equal(snapshot.DepositRoot, eth1Data.DepositRoot) {
+		return errors.Errorf("deposit snapshot root %#x does not match the finalized deposit root %#x",
+			snapshot.DepositRoot, eth1Data.DepositRoot)
+	}
+	exists, height, err := s.BlockExists(ctx, common.BytesToHash(eth1Data.BlockHash))
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized eth1 block")
+	}
+	if !exists {
+		return errors.Errorf("finalized eth1 block %#x does not exist", eth1Data.BlockHash)
+	}
+	snapshot.ExecutionBlockHash = eth1Data.BlockHash
+	snapshot.ExecutionBlockHeight = height.Uint64()
+	if err := s.cfg.BeaconDB.SaveDepositSnapshot(ctx, snapshot); err != nil {
+		return errors.Wrap(err, "could not save deposit snapshot")
+	}
+	log.WithFields(logrus.Fields{
+		"depositCount": snapshot.DepositCount,
+		"eth1Block":    snapshot.ExecutionBlockHeight,
+	}).Debug("Saved finalized deposit snapshot")
+	return nil
+}
@@ -0,0 +1,119 @@
+package powchain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+func snapshotTestTrie(t *testing.T, n int) *trieutil.SparseMerkleTrie {
+	items := make([][]byte, n)
+	for i := range items {
+		items[i] = []byte(fmt.Sprintf("deposit %d", i))
+	}
+	trie, err := trieutil.GenerateTrieFromItems(items, params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err)
+	return trie
+}
+
+func TestService_InitializeFromDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbutil.SetupDB(t)
+	genState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveGenesisData(ctx, genState))
+
+	snapshot, err := snapshotTestTrie(t, 3).Snapshot(3)
+	require.NoError(t, err)
+	snapshot.ExecutionBlockHeight = 100
+	require.NoError(t, beaconDB.SaveDepositSnapshot(ctx, snapshot))
+
+	// The deposit snapshot is used for a node without deposit data, and kept across restarts.
+	for i := 0; i < 2; i++ {
+		cache, err := depositcache.New()
+		require.NoError(t, err)
+		s, err := NewService(ctx, &Web3ServiceConfig{
+			BeaconDB:     beaconDB,
+			DepositCache: cache,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3, s.depositTrie.NumOfItems())
+		root := s.depositTrie.HashTreeRoot()
+		assert.DeepEqual(t, snapshot.DepositRoot, root[:])
+		assert.Equal(t, int64(2), s.lastReceivedMerkleIndex)
+		assert.Equal(t, uint64(100), s.latestEth1Data.LastRequestedBlock)
+		assert.Equal(t, int64(2), cache.FinalizedDeposits(ctx).MerkleTrieIndex)
+
+		eth1Data, err := beaconDB.PowchainData(ctx)
+		require.NoError(t, err)
+		assert.DeepEqual(t, snapshot, eth1Data.DepositSnapshot)
+		require.NoError(t, s.savePowchainData(ctx))
+	}
+}
+
+func TestService_SaveDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbutil.SetupDB(t)
+	cache, err := depositcache.New()
+	require.NoError(t, err)
+	s, err := NewService(ctx, &Web3ServiceConfig{
+		BeaconDB:     beaconDB,
+		DepositCache: cache,
+		StateGen:     stategen.New(beaconDB),
+	})
+	require.NoError(t, err)
+	s.depositTrie = snapshotTestTrie(t, 5)
+
+	header := &gethTypes.Header{Number: big.NewInt(50)}
+	require.NoError(t, s.headerCache.AddHeader(header))
+	finalized := snapshotTestTrie(t, 4).HashTreeRoot()
+	hash := header.Hash()
+
+	saveState := func(root [32]byte, eth1Data *ethpb.Eth1Data, depositIndex uint64) {
+		st, err := testutil.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetEth1Data(eth1Data))
+		require.NoError(t, st.SetEth1DepositIndex(depositIndex))
+		require.NoError(t, beaconDB.SaveState(ctx, st, root))
+	}
+	saveState([32]byte{'a'}, &ethpb.Eth1Data{DepositRoot: finalized[:], DepositCount: 4, BlockHash: hash[:]}, 4)
+	saveState([32]byte{'b'}, &ethpb.Eth1Data{DepositRoot: make([]byte, 32), DepositCount: 5, BlockHash: hash[:]}, 5)
+	saveState([32]byte{'c'}, &ethpb.Eth1Data{DepositRoot: make([]byte, 32), DepositCount: 6, BlockHash: hash[:]}, 6)
+	saveState([32]byte{'d'}, &ethpb.Eth1Data{DepositRoot: finalized[:], DepositCount: 4, BlockHash: hash[:]}, 3)
+
+	// Deposits which are not included in the finalized state yet are not snapshotted.
+	require.NoError(t, s.saveDepositSnapshot(ctx, [32]byte{'d'}))
+	snapshot, err := beaconDB.DepositSnapshot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, snapshot == nil)
+
+	require.NoError(t, s.saveDepositSnapshot(ctx, [32]byte{'a'}))
+	snapshot, err = beaconDB.DepositSnapshot(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, snapshot)
+	assert.Equal(t, uint64(4), snapshot.DepositCount)
+	assert.DeepEqual(t, finalized[:], snapshot.DepositRoot)
+	assert.DeepEqual(t, hash[:], snapshot.ExecutionBlockHash)
+	assert.Equal(t, uint64(50), snapshot.ExecutionBlockHeight)
+
+	err = s.saveDepositSnapshot(ctx, [32]byte{'b'})
+	assert.ErrorContains(t, "does not match the finalized deposit root", err)
+
+	// Deposit logs which have not been processed yet are not snapshotted.
+	require.NoError(t, s.saveDepositSnapshot(ctx, [32]byte{'c'}))
+	snapshot, err = beaconDB.DepositSnapshot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), snapshot.DepositCount)
+}
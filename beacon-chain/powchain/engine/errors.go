@@ -0,0 +1,63 @@
+package engine
+
+import (
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrNotConnected when the execution engine has not been connected to yet.
+	ErrNotConnected = errors.New("not connected to execution engine")
+	// ErrParse corresponds to JSON-RPC code -32700.
+	ErrParse = errors.New("invalid JSON was received by the server")
+	// ErrInvalidRequest corresponds to JSON-RPC code -32600.
+	ErrInvalidRequest = errors.New("JSON sent is not valid request object")
+	// ErrMethodNotFound corresponds to JSON-RPC code -32601.
+	ErrMethodNotFound = errors.New("method not found")
+	// ErrInvalidParams corresponds to JSON-RPC code -32602.
+	ErrInvalidParams = errors.New("invalid method parameter(s)")
+	// ErrInternal corresponds to JSON-RPC code -32603.
+	ErrInternal = errors.New("internal JSON-RPC error")
+	// ErrServer corresponds to JSON-RPC code -32000.
+	ErrServer = errors.New("client error while processing request")
+	// ErrUnknownPayload corresponds to engine API code -38001.
+	ErrUnknownPayload = errors.New("payload does not exist or is not available")
+	// ErrInvalidForkchoiceState corresponds to engine API code -38002.
+	ErrInvalidForkchoiceState = errors.New("invalid forkchoice state")
+	// ErrInvalidPayloadAttributes corresponds to engine API code -38003.
+	ErrInvalidPayloadAttributes = errors.New("payload attributes are invalid or inconsistent")
+)
+
+// handleRPCError maps the JSON-RPC error codes of the engine API to the errors above,
+// keeping the message returned by the execution engine.
+func handleRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+	e, ok := err.(gethRPC.Error)
+	if !ok {
+		return errors.Wrap(err, "got an unexpected error")
+	}
+	switch e.ErrorCode() {
+	case -32700:
+		return errors.Wrap(ErrParse, err.Error())
+	case -32600:
+		return errors.Wrap(ErrInvalidRequest, err.Error())
+	case -32601:
+		return errors.Wrap(ErrMethodNotFound, err.Error())
+	case -32602:
+		return errors.Wrap(ErrInvalidParams, err.Error())
+	case -32603:
+		return errors.Wrap(ErrInternal, err.Error())
+	case -38001:
+		return errors.Wrap(ErrUnknownPayload, err.Error())
+	case -38002:
+		return errors.Wrap(ErrInvalidForkchoiceState, err.Error())
+	case -38003:
+		return errors.Wrap(ErrInvalidPayloadAttributes, err.Error())
+	case -32000:
+		return errors.Wrap(ErrServer, err.Error())
+	default:
+		return err
+	}
+}
@@ -0,0 +1,61 @@
+package engine
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/form3tech-oss/jwt-go"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+)
+
+// jwtSecretLength is the length in bytes of the secret shared with the execution engine.
+const jwtSecretLength = 32
+
+// LoadJWTSecret reads the hex encoded secret shared with the execution engine from the given file.
+func LoadJWTSecret(path string) ([]byte, error) {
+	expandedPath, err := fileutil.ExpandPath(path)
+	if err != nil {
+		return nil, err
+	}
+	enc, err := ioutil.ReadFile(expandedPath) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read JWT secret file %s", expandedPath)
+	}
+	strData := strings.TrimSpace(string(enc))
+	if !strings.HasPrefix(strData, "0x") {
+		strData = "0x" + strData
+	}
+	secret, err := hexutil.Decode(strData)
+	if err != nil {
+		return nil, errors.Wrap(err, "JWT secret is not hex encoded")
+	}
+	if len(secret) != jwtSecretLength {
+		return nil, errors.Errorf("JWT secret must be %d bytes, got %d", jwtSecretLength, len(secret))
+	}
+	return secret, nil
+}
+
+// jwtTransport signs every outgoing request with a freshly issued HS256 token, as the execution
+// engine rejects tokens whose issued-at time is too far from its own clock.
+type jwtTransport struct {
+	underlyingTransport http.RoundTripper
+	jwtSecret           []byte
+}
+
+// RoundTrip adds the authorization header to the request before forwarding it to the underlying transport.
+func (t *jwtTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iat": time.Now().Unix(),
+	})
+	tokenString, err := token.SignedString(t.jwtSecret)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not sign JWT token")
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+tokenString)
+	return t.underlyingTransport.RoundTrip(req)
+}
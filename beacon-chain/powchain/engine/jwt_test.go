@@ -0,0 +1,83 @@
+package engine
+
+import (
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/form3tech-oss/jwt-go"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestLoadJWTSecret(t *testing.T) {
+	dir := t.TempDir()
+	secret := "0x0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	t.Run("with 0x prefix", func(t *testing.T) {
+		path := filepath.Join(dir, "prefixed")
+		require.NoError(t, ioutil.WriteFile(path, []byte(secret+"\n"), 0600))
+		got, err := LoadJWTSecret(path)
+		require.NoError(t, err)
+		assert.Equal(t, jwtSecretLength, len(got))
+		assert.Equal(t, byte(0x01), got[0])
+	})
+	t.Run("without 0x prefix", func(t *testing.T) {
+		path := filepath.Join(dir, "unprefixed")
+		require.NoError(t, ioutil.WriteFile(path, []byte(strings.TrimPrefix(secret, "0x")), 0600))
+		got, err := LoadJWTSecret(path)
+		require.NoError(t, err)
+		assert.Equal(t, jwtSecretLength, len(got))
+	})
+	t.Run("not hex", func(t *testing.T) {
+		path := filepath.Join(dir, "nothex")
+		require.NoError(t, ioutil.WriteFile(path, []byte("secret"), 0600))
+		_, err := LoadJWTSecret(path)
+		require.ErrorContains(t, "not hex encoded", err)
+	})
+	t.Run("wrong length", func(t *testing.T) {
+		path := filepath.Join(dir, "short")
+		require.NoError(t, ioutil.WriteFile(path, []byte("0x0123"), 0600))
+		_, err := LoadJWTSecret(path)
+		require.ErrorContains(t, "JWT secret must be 32 bytes", err)
+	})
+	t.Run("missing file", func(t *testing.T) {
+		_, err := LoadJWTSecret(filepath.Join(dir, "missing"))
+		require.ErrorContains(t, "could not read JWT secret file", err)
+	})
+}
+
+type recordingTransport struct {
+	req *http.Request
+}
+
+func (r *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.req = req
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+}
+
+func TestJWTTransport_RoundTrip(t *testing.T) {
+	recorder := &recordingTransport{}
+	transport := &jwtTransport{underlyingTransport: recorder, jwtSecret: testSecret}
+	req, err := http.NewRequest(http.MethodPost, "http://localhost", http.NoBody)
+	require.NoError(t, err)
+	_, err = transport.RoundTrip(req)
+	require.NoError(t, err)
+
+	// The original request must not be modified.
+	assert.Equal(t, "", req.Header.Get("Authorization"))
+	auth := recorder.req.Header.Get("Authorization")
+	require.Equal(t, true, strings.HasPrefix(auth, "Bearer "))
+	token, err := jwt.Parse(strings.TrimPrefix(auth, "Bearer "), func(token *jwt.Token) (interface{}, error) {
+		return testSecret, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, true, token.Valid)
+	assert.Equal(t, jwt.SigningMethodHS256.Alg(), token.Method.Alg())
+	claims, ok := token.Claims.(jwt.MapClaims)
+	require.Equal(t, true, ok)
+	_, ok = claims["iat"]
+	assert.Equal(t, true, ok)
+}
@@ -0,0 +1,30 @@
+package engine
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	engineConnectedGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "engine_api_connected",
+		Help: "Whether the beacon node is connected to an execution engine: 1 if connected, 0 otherwise",
+	})
+	enginePrimaryConnectedGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "engine_api_primary_connected",
+		Help: "Whether the beacon node is connected to its primary execution engine endpoint: 1 if so, 0 otherwise",
+	})
+	engineFallbackCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "engine_api_endpoint_fallbacks_total",
+		Help: "The number of times the beacon node switched to another execution engine endpoint",
+	})
+	engineRequestLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "engine_api_request_latency_milliseconds",
+		Help:    "Captures the latency of requests to the execution engine in milliseconds",
+		Buckets: []float64{5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000},
+	}, []string{"method"})
+	engineRequestErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "engine_api_request_errors_total",
+		Help: "The number of failed requests to the execution engine",
+	}, []string{"method"})
+)
@@ -0,0 +1,428 @@
+// Package engine defines a runtime service which connects the beacon node to an
+// execution engine through the engine API, a JSON-RPC interface authenticated with
+// a JWT secret shared between the two clients.
+package engine
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/httputils"
+	"github.com/prysmaticlabs/prysm/shared/httputils/authorizationmethod"
+	"github.com/prysmaticlabs/prysm/shared/logutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+const (
+	// NewPayloadMethod is the engine API method to send an execution payload to the execution engine.
+	NewPayloadMethod = "engine_newPayloadV1"
+	// ForkchoiceUpdatedMethod is the engine API method to update the fork choice of the execution engine.
+	ForkchoiceUpdatedMethod = "engine_forkchoiceUpdatedV1"
+	// GetPayloadMethod is the engine API method to retrieve a payload built by the execution engine.
+	GetPayloadMethod = "engine_getPayloadV1"
+	// ChainIDMethod is used to check that the execution engine is alive and on the expected chain.
+	ChainIDMethod = "eth_chainId"
+)
+
+var (
+	// time to wait before trying to reconnect with the execution engine.
+	backOffPeriod = 15 * time.Second
+	// period between health checks of the connected execution engine.
+	healthCheckPeriod = 30 * time.Second
+	// amount of times before we log the status of the dial attempt.
+	logThreshold = 8
+	// timeout of a single request to the execution engine.
+	requestTimeout = 8 * time.Second
+)
+
+// Caller defines the engine API methods the beacon node uses to drive the execution engine.
+type Caller interface {
+	NewPayload(ctx context.Context, payload *ExecutionPayload) (*PayloadStatus, error)
+	ForkchoiceUpdated(ctx context.Context, state *ForkchoiceState, attrs *PayloadAttributes) (*ForkchoiceUpdatedResponse, error)
+	GetPayload(ctx context.Context, payloadID PayloadID) (*ExecutionPayload, error)
+}
+
+// Config defines a config struct for the execution engine service to use through its life cycle.
+type Config struct {
+	// Endpoints of the execution engine, the first one is the primary endpoint and the others are fallbacks.
+	Endpoints []httputils.Endpoint
+	// JWTSecret is shared with the execution engine to authenticate requests. When empty, the
+	// authorization data of the endpoint is used instead.
+	JWTSecret []byte
+}
+
+// Service maintains a connection to an execution engine, falling back to the
+// next configured endpoint whenever the current one becomes unavailable.
+type Service struct {
+	cfg          *Config
+	ctx          context.Context
+	cancel       context.CancelFunc
+	isRunning    bool
+	connected    bool
+	runError     error
+	currEndpoint httputils.Endpoint
+	clientLock   sync.RWMutex
+	rpcClient    *gethRPC.Client
+}
+
+var _ Caller = (*Service)(nil)
+
+// NewService sets up a new execution engine service with the given configuration.
+func NewService(ctx context.Context, cfg *Config) (*Service, error) {
+	if len(cfg.JWTSecret) != 0 && len(cfg.JWTSecret) != jwtSecretLength {
+		return nil, errors.Errorf("JWT secret must be %d bytes, got %d", jwtSecretLength, len(cfg.JWTSecret))
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		cfg:    cfg,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	if len(cfg.Endpoints) > 0 {
+		s.currEndpoint = cfg.Endpoints[0]
+	}
+	return s, nil
+}
+
+// Start connects to the execution engine and monitors the health of the connection.
+func (s *Service) Start() {
+	// Exit early if no endpoint is set.
+	if s.currEndpoint.Url == "" {
+		return
+	}
+	s.isRunning = true
+	go func() {
+		s.waitForConnection()
+		if s.ctx.Err() != nil {
+			log.Info("Context closed, exiting execution engine goroutine")
+			return
+		}
+		s.run(s.ctx.Done())
+	}()
+}
+
+// Stop the service and close the connection to the execution engine.
+func (s *Service) Stop() error {
+	if s.cancel != nil {
+		defer s.cancel()
+	}
+	s.closeClient()
+	return nil
+}
+
+// Status is service health checks. Return nil or error.
+func (s *Service) Status() error {
+	// Service don't start
+	if !s.isRunning {
+		return nil
+	}
+	return s.runError
+}
+
+// IsConnected checks if the beacon node is connected to an execution engine.
+func (s *Service) IsConnected() bool {
+	s.clientLock.RLock()
+	defer s.clientLock.RUnlock()
+	return s.connected
+}
+
+// NewPayload sends an execution payload to the execution engine for validation.
+func (s *Service) NewPayload(ctx context.Context, payload *ExecutionPayload) (*PayloadStatus, error) {
+	ctx, span := trace.StartSpan(ctx, "engine.NewPayload")
+	defer span.End()
+	if payload == nil {
+		return nil, errors.New("nil execution payload")
+	}
+	result := &PayloadStatus{}
+	if err := s.call(ctx, result, NewPayloadMethod, payload); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ForkchoiceUpdated updates the fork choice of the execution engine and, if payload attributes
+// are provided, starts building a payload on top of the new head.
+func (s *Service) ForkchoiceUpdated(
+	ctx context.Context, state *ForkchoiceState, attrs *PayloadAttributes,
+) (*ForkchoiceUpdatedResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "engine.ForkchoiceUpdated")
+	defer span.End()
+	if state == nil {
+		return nil, errors.New("nil forkchoice state")
+	}
+	result := &ForkchoiceUpdatedResponse{}
+	if err := s.call(ctx, result, ForkchoiceUpdatedMethod, state, attrs); err != nil {
+		return nil, err
+	}
+	if result.PayloadStatus == nil {
+		return nil, errors.New("execution engine returned a nil payload status")
+	}
+	return result, nil
+}
+
+// GetPayload retrieves the payload built by the execution engine for the given payload ID.
+func (s *Service) GetPayload(ctx context.Context, payloadID PayloadID) (*ExecutionPayload, error) {
+	ctx, span := trace.StartSpan(ctx, "engine.GetPayload")
+	defer span.End()
+	result := &ExecutionPayload{}
+	if err := s.call(ctx, result, GetPayloadMethod, payloadID); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// call performs a request to the currently connected execution engine and records its metrics.
+func (s *Service) call(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	s.clientLock.RLock()
+	client := s.rpcClient
+	s.clientLock.RUnlock()
+	if client == nil {
+		return ErrNotConnected
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	start := time.Now()
+	err := client.CallContext(ctx, result, method, args...)
+	engineRequestLatency.WithLabelValues(method).Observe(float64(time.Since(start).Milliseconds()))
+	if err != nil {
+		engineRequestErrors.WithLabelValues(method).Inc()
+		return handleRPCError(err)
+	}
+	return nil
+}
+
+// dial creates a client for the given endpoint and checks that the execution engine behind it
+// is alive and on the expected chain.
+func (s *Service) dial(ctx context.Context, endpoint httputils.Endpoint) (*gethRPC.Client, error) {
+	var client *gethRPC.Client
+	var err error
+	if len(s.cfg.JWTSecret) > 0 {
+		client, err = gethRPC.DialHTTPWithClient(endpoint.Url, &http.Client{
+			Timeout: requestTimeout,
+			Transport: &jwtTransport{
+				underlyingTransport: http.DefaultTransport,
+				jwtSecret:           s.cfg.JWTSecret,
+			},
+		})
+	} else {
+		client, err = gethRPC.DialContext(ctx, endpoint.Url)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(s.cfg.JWTSecret) == 0 && endpoint.Auth.Method != authorizationmethod.None {
+		header, err := endpoint.Auth.ToHeaderValue()
+		if err != nil {
+			client.Close()
+			return nil, err
+		}
+		client.SetHeader("Authorization", header)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	var chainID hexutil.Uint64
+	if err := client.CallContext(ctx, &chainID, ChainIDMethod); err != nil {
+		client.Close()
+		return nil, handleRPCError(err)
+	}
+	if uint64(chainID) != params.BeaconConfig().DepositChainID {
+		client.Close()
+		return nil, fmt.Errorf("execution engine using incorrect chain id, %d != %d", chainID, params.BeaconConfig().DepositChainID)
+	}
+	return client, nil
+}
+
+func (s *Service) connect() error {
+	client, err := s.dial(s.ctx, s.currEndpoint)
+	if err != nil {
+		return errors.Wrap(err, "could not dial execution engine")
+	}
+	s.clientLock.Lock()
+	s.rpcClient = client
+	s.clientLock.Unlock()
+	s.updateConnected(true)
+	return nil
+}
+
+// closes down our active execution engine client.
+func (s *Service) closeClient() {
+	s.clientLock.Lock()
+	defer s.clientLock.Unlock()
+	if s.rpcClient != nil {
+		s.rpcClient.Close()
+		s.rpcClient = nil
+	}
+}
+
+func (s *Service) waitForConnection() {
+	errConnect := s.connect()
+	if errConnect == nil {
+		s.runError = nil
+		log.WithFields(logrus.Fields{
+			"endpoint": logutil.MaskCredentialsLogging(s.currEndpoint.Url),
+		}).Info("Connected to execution engine")
+		return
+	}
+	s.runError = errConnect
+	log.WithError(errConnect).Error("Could not connect to execution engine endpoint")
+	s.fallbackToNextEndpoint()
+
+	// Use a custom logger to only log errors
+	// once in  a while.
+	logCounter := 0
+	errorLogger := func(err error, msg string) {
+		if logCounter > logThreshold {
+			log.Errorf("%s: %v", msg, err)
+			logCounter = 0
+		}
+		logCounter++
+	}
+
+	ticker := time.NewTicker(backOffPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			log.Debugf("Trying to dial endpoint: %s", logutil.MaskCredentialsLogging(s.currEndpoint.Url))
+			if errConnect := s.connect(); errConnect != nil {
+				errorLogger(errConnect, "Could not connect to execution engine endpoint")
+				s.runError = errConnect
+				s.fallbackToNextEndpoint()
+				continue
+			}
+			s.runError = nil
+			log.WithFields(logrus.Fields{
+				"endpoint": logutil.MaskCredentialsLogging(s.currEndpoint.Url),
+			}).Info("Connected to execution engine")
+			return
+		case <-s.ctx.Done():
+			log.Debug("Received cancelled context, closing existing execution engine service")
+			return
+		}
+	}
+}
+
+// Reconnect to the execution engine in case of any failure.
+func (s *Service) retryConnection(err error) {
+	s.runError = err
+	s.closeClient()
+	s.updateConnected(false)
+	s.waitForConnection()
+}
+
+func (s *Service) run(done <-chan struct{}) {
+	ticker := time.NewTicker(healthCheckPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			s.isRunning = false
+			s.runError = nil
+			s.updateConnected(false)
+			log.Debug("Context closed, exiting goroutine")
+			return
+		case <-ticker.C:
+			if err := s.checkHealth(); err != nil {
+				log.WithError(err).Error("Execution engine is unhealthy, reconnecting")
+				s.fallbackToNextEndpoint()
+				s.retryConnection(err)
+				continue
+			}
+			s.checkDefaultEndpoint()
+		}
+	}
+}
+
+// checkHealth makes a simple call to ensure the connected execution engine is still alive.
+func (s *Service) checkHealth() error {
+	var chainID hexutil.Uint64
+	return s.call(s.ctx, &chainID, ChainIDMethod)
+}
+
+// This performs a health check on our primary endpoint, and if it
+// is ready to serve we connect to it again. This method is only
+// relevant if we are on our backup endpoint.
+func (s *Service) checkDefaultEndpoint() {
+	primaryEndpoint := s.cfg.Endpoints[0]
+	// Return early if we are running on our primary
+	// endpoint.
+	if s.currEndpoint.Equals(primaryEndpoint) {
+		return
+	}
+
+	client, err := s.dial(s.ctx, primaryEndpoint)
+	if err != nil {
+		log.Debugf("Primary endpoint not ready: %v", err)
+		return
+	}
+	log.Info("Primary endpoint ready again, switching back to it")
+	client.Close()
+
+	// Switch back to primary endpoint and try connecting
+	// to it again.
+	s.updateCurrEndpoint(primaryEndpoint)
+	s.retryConnection(nil)
+}
+
+// This is an inefficient way to search for the next endpoint, but given N is expected to be
+// small ( < 25), it is fine to search this way.
+func (s *Service) fallbackToNextEndpoint() {
+	currIndex := 0
+	totalEndpoints := len(s.cfg.Endpoints)
+
+	for i, endpoint := range s.cfg.Endpoints {
+		if endpoint.Equals(s.currEndpoint) {
+			currIndex = i
+			break
+		}
+	}
+	nextIndex := currIndex + 1
+	if nextIndex >= totalEndpoints {
+		nextIndex = 0
+	}
+	if nextIndex != currIndex {
+		engineFallbackCount.Inc()
+		log.Infof("Falling back to alternative endpoint: %s", logutil.MaskCredentialsLogging(s.cfg.Endpoints[nextIndex].Url))
+	}
+	s.updateCurrEndpoint(s.cfg.Endpoints[nextIndex])
+}
+
+func (s *Service) updateCurrEndpoint(endpoint httputils.Endpoint) {
+	s.currEndpoint = endpoint
+	s.updateMetrics()
+}
+
+func (s *Service) updateConnected(connected bool) {
+	s.clientLock.Lock()
+	s.connected = connected
+	s.clientLock.Unlock()
+	s.updateMetrics()
+}
+
+func (s *Service) updateMetrics() {
+	connected := s.IsConnected()
+	if connected {
+		engineConnectedGauge.Set(1)
+	} else {
+		engineConnectedGauge.Set(0)
+	}
+	if connected && s.primaryConnected() {
+		enginePrimaryConnectedGauge.Set(1)
+	} else {
+		enginePrimaryConnectedGauge.Set(0)
+	}
+}
+
+func (s *Service) primaryConnected() bool {
+	return len(s.cfg.Endpoints) > 0 && s.currEndpoint.Equals(s.cfg.Endpoints[0])
+}
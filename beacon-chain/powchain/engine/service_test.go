@@ -0,0 +1,249 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/form3tech-oss/jwt-go"
+	"github.com/prysmaticlabs/prysm/shared/httputils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+var testSecret = []byte("0123456789abcdef0123456789abcdef")
+
+// stubEngine implements the engine namespace of the engine API.
+type stubEngine struct {
+	payloads map[PayloadID]*ExecutionPayload
+}
+
+func (e *stubEngine) NewPayloadV1(payload ExecutionPayload) (*PayloadStatus, error) {
+	if payload.BlockNumber == 0 {
+		errMsg := "invalid block number"
+		return &PayloadStatus{Status: PayloadStatusInvalid, ValidationError: &errMsg}, nil
+	}
+	return &PayloadStatus{Status: PayloadStatusValid, LatestValidHash: &payload.BlockHash}, nil
+}
+
+func (e *stubEngine) ForkchoiceUpdatedV1(state ForkchoiceState, attrs *PayloadAttributes) (*ForkchoiceUpdatedResponse, error) {
+	resp := &ForkchoiceUpdatedResponse{
+		PayloadStatus: &PayloadStatus{Status: PayloadStatusValid, LatestValidHash: &state.HeadBlockHash},
+	}
+	if attrs != nil {
+		id := PayloadID{1, 2, 3, 4, 5, 6, 7, 8}
+		e.payloads[id] = &ExecutionPayload{
+			ParentHash:    state.HeadBlockHash,
+			FeeRecipient:  attrs.SuggestedFeeRecipient,
+			PrevRandao:    attrs.PrevRandao,
+			Timestamp:     attrs.Timestamp,
+			BlockNumber:   1,
+			BaseFeePerGas: (*hexutil.Big)(big.NewInt(7)),
+			LogsBloom:     make([]byte, 256),
+			Transactions:  []hexutil.Bytes{{0x01, 0x02}},
+		}
+		resp.PayloadID = &id
+	}
+	return resp, nil
+}
+
+func (e *stubEngine) GetPayloadV1(id PayloadID) (*ExecutionPayload, error) {
+	payload, ok := e.payloads[id]
+	if !ok {
+		return nil, &stubError{code: -38001, msg: "unknown payload"}
+	}
+	return payload, nil
+}
+
+// stubEth implements the eth methods the engine endpoint exposes.
+type stubEth struct {
+	chainID uint64
+}
+
+func (e *stubEth) ChainId() hexutil.Uint64 {
+	return hexutil.Uint64(e.chainID)
+}
+
+type stubError struct {
+	code int
+	msg  string
+}
+
+func (e *stubError) Error() string  { return e.msg }
+func (e *stubError) ErrorCode() int { return e.code }
+
+// newStubServer runs an in-process JSON-RPC server which only serves requests carrying a valid JWT.
+func newStubServer(t *testing.T, chainID uint64) *httptest.Server {
+	srv := gethRPC.NewServer()
+	require.NoError(t, srv.RegisterName("engine", &stubEngine{payloads: make(map[PayloadID]*ExecutionPayload)}))
+	require.NoError(t, srv.RegisterName("eth", &stubEth{chainID: chainID}))
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		token, err := jwt.Parse(strings.TrimPrefix(auth, "Bearer "), func(token *jwt.Token) (interface{}, error) {
+			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+			}
+			return testSecret, nil
+		})
+		if err != nil || !token.Valid {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		claims, ok := token.Claims.(jwt.MapClaims)
+		if !ok || !claims.VerifyIssuedAt(time.Now().Add(5*time.Second).Unix(), true) {
+			http.Error(w, "stale token", http.StatusUnauthorized)
+			return
+		}
+		srv.ServeHTTP(w, r)
+	})
+	server := httptest.NewServer(handler)
+	t.Cleanup(func() {
+		server.Close()
+		srv.Stop()
+	})
+	return server
+}
+
+func newConnectedService(t *testing.T, urls ...string) *Service {
+	endpoints := make([]httputils.Endpoint, len(urls))
+	for i, url := range urls {
+		endpoints[i] = httputils.Endpoint{Url: url}
+	}
+	s, err := NewService(context.Background(), &Config{Endpoints: endpoints, JWTSecret: testSecret})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, s.Stop())
+	})
+	return s
+}
+
+func TestService_Connect(t *testing.T) {
+	server := newStubServer(t, params.BeaconConfig().DepositChainID)
+	s := newConnectedService(t, server.URL)
+	require.NoError(t, s.connect())
+	assert.Equal(t, true, s.IsConnected())
+}
+
+func TestService_Connect_WrongChainID(t *testing.T) {
+	server := newStubServer(t, params.BeaconConfig().DepositChainID+1)
+	s := newConnectedService(t, server.URL)
+	require.ErrorContains(t, "incorrect chain id", s.connect())
+	assert.Equal(t, false, s.IsConnected())
+}
+
+func TestService_Connect_WrongSecret(t *testing.T) {
+	server := newStubServer(t, params.BeaconConfig().DepositChainID)
+	s, err := NewService(context.Background(), &Config{
+		Endpoints: []httputils.Endpoint{{Url: server.URL}},
+		JWTSecret: []byte("fedcba9876543210fedcba9876543210"),
+	})
+	require.NoError(t, err)
+	require.ErrorContains(t, "Unauthorized", s.connect())
+}
+
+func TestNewService_InvalidSecretLength(t *testing.T) {
+	_, err := NewService(context.Background(), &Config{JWTSecret: []byte("short")})
+	require.ErrorContains(t, "JWT secret must be 32 bytes", err)
+}
+
+func TestService_NotConnected(t *testing.T) {
+	s := newConnectedService(t, "http://127.0.0.1:0")
+	_, err := s.NewPayload(context.Background(), &ExecutionPayload{})
+	assert.ErrorContains(t, ErrNotConnected.Error(), err)
+}
+
+func TestService_NewPayload(t *testing.T) {
+	server := newStubServer(t, params.BeaconConfig().DepositChainID)
+	s := newConnectedService(t, server.URL)
+	require.NoError(t, s.connect())
+
+	payload := &ExecutionPayload{
+		BlockNumber:   1,
+		BlockHash:     common.Hash{'a'},
+		BaseFeePerGas: (*hexutil.Big)(big.NewInt(7)),
+		LogsBloom:     make([]byte, 256),
+	}
+	status, err := s.NewPayload(context.Background(), payload)
+	require.NoError(t, err)
+	assert.Equal(t, PayloadStatusValid, status.Status)
+	assert.Equal(t, payload.BlockHash, *status.LatestValidHash)
+
+	payload.BlockNumber = 0
+	status, err = s.NewPayload(context.Background(), payload)
+	require.NoError(t, err)
+	assert.Equal(t, PayloadStatusInvalid, status.Status)
+	assert.Equal(t, "invalid block number", *status.ValidationError)
+}
+
+func TestService_ForkchoiceUpdatedAndGetPayload(t *testing.T) {
+	server := newStubServer(t, params.BeaconConfig().DepositChainID)
+	s := newConnectedService(t, server.URL)
+	require.NoError(t, s.connect())
+	ctx := context.Background()
+
+	state := &ForkchoiceState{
+		HeadBlockHash:      common.Hash{'h'},
+		SafeBlockHash:      common.Hash{'s'},
+		FinalizedBlockHash: common.Hash{'f'},
+	}
+	resp, err := s.ForkchoiceUpdated(ctx, state, nil)
+	require.NoError(t, err)
+	assert.Equal(t, PayloadStatusValid, resp.PayloadStatus.Status)
+	assert.Equal(t, (*PayloadID)(nil), resp.PayloadID)
+
+	attrs := &PayloadAttributes{
+		Timestamp:             100,
+		PrevRandao:            common.Hash{'r'},
+		SuggestedFeeRecipient: common.Address{'c'},
+	}
+	resp, err = s.ForkchoiceUpdated(ctx, state, attrs)
+	require.NoError(t, err)
+	require.NotNil(t, resp.PayloadID)
+	assert.Equal(t, PayloadID{1, 2, 3, 4, 5, 6, 7, 8}, *resp.PayloadID)
+
+	payload, err := s.GetPayload(ctx, *resp.PayloadID)
+	require.NoError(t, err)
+	assert.Equal(t, state.HeadBlockHash, payload.ParentHash)
+	assert.Equal(t, attrs.SuggestedFeeRecipient, payload.FeeRecipient)
+	assert.Equal(t, attrs.PrevRandao, payload.PrevRandao)
+	assert.Equal(t, attrs.Timestamp, payload.Timestamp)
+	assert.Equal(t, int64(7), payload.BaseFeePerGas.ToInt().Int64())
+	assert.DeepEqual(t, []hexutil.Bytes{{0x01, 0x02}}, payload.Transactions)
+
+	_, err = s.GetPayload(ctx, PayloadID{9})
+	assert.ErrorContains(t, ErrUnknownPayload.Error(), err)
+}
+
+func TestService_FallbackToNextEndpoint(t *testing.T) {
+	server := newStubServer(t, params.BeaconConfig().DepositChainID)
+	s := newConnectedService(t, "http://127.0.0.1:0", server.URL)
+	require.NotNil(t, s.connect())
+
+	s.fallbackToNextEndpoint()
+	assert.Equal(t, server.URL, s.currEndpoint.Url)
+	require.NoError(t, s.connect())
+	assert.Equal(t, false, s.primaryConnected())
+
+	s.fallbackToNextEndpoint()
+	assert.Equal(t, "http://127.0.0.1:0", s.currEndpoint.Url)
+}
+
+func TestService_StartAndStop(t *testing.T) {
+	server := newStubServer(t, params.BeaconConfig().DepositChainID)
+	s := newConnectedService(t, server.URL)
+	s.Start()
+	require.NoError(t, s.Status())
+	for i := 0; i < 100 && !s.IsConnected(); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, true, s.IsConnected())
+}
@@ -0,0 +1,74 @@
+package engine
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Payload statuses returned by the execution engine.
+const (
+	PayloadStatusValid            = "VALID"
+	PayloadStatusInvalid          = "INVALID"
+	PayloadStatusSyncing          = "SYNCING"
+	PayloadStatusAccepted         = "ACCEPTED"
+	PayloadStatusInvalidBlockHash = "INVALID_BLOCK_HASH"
+)
+
+// ExecutionPayload is the execution block body exchanged with the execution engine.
+type ExecutionPayload struct {
+	ParentHash    common.Hash     `json:"parentHash"`
+	FeeRecipient  common.Address  `json:"feeRecipient"`
+	StateRoot     common.Hash     `json:"stateRoot"`
+	ReceiptsRoot  common.Hash     `json:"receiptsRoot"`
+	LogsBloom     hexutil.Bytes   `json:"logsBloom"`
+	PrevRandao    common.Hash     `json:"prevRandao"`
+	BlockNumber   hexutil.Uint64  `json:"blockNumber"`
+	GasLimit      hexutil.Uint64  `json:"gasLimit"`
+	GasUsed       hexutil.Uint64  `json:"gasUsed"`
+	Timestamp     hexutil.Uint64  `json:"timestamp"`
+	ExtraData     hexutil.Bytes   `json:"extraData"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+	BlockHash     common.Hash     `json:"blockHash"`
+	Transactions  []hexutil.Bytes `json:"transactions"`
+}
+
+// PayloadAttributes are the attributes the execution engine uses to start building a new payload.
+type PayloadAttributes struct {
+	Timestamp             hexutil.Uint64 `json:"timestamp"`
+	PrevRandao            common.Hash    `json:"prevRandao"`
+	SuggestedFeeRecipient common.Address `json:"suggestedFeeRecipient"`
+}
+
+// ForkchoiceState is the view of the fork choice the execution engine should follow.
+type ForkchoiceState struct {
+	HeadBlockHash      common.Hash `json:"headBlockHash"`
+	SafeBlockHash      common.Hash `json:"safeBlockHash"`
+	FinalizedBlockHash common.Hash `json:"finalizedBlockHash"`
+}
+
+// PayloadStatus is the result of the execution engine processing a payload.
+type PayloadStatus struct {
+	Status          string       `json:"status"`
+	LatestValidHash *common.Hash `json:"latestValidHash"`
+	ValidationError *string      `json:"validationError"`
+}
+
+// ForkchoiceUpdatedResponse is the result of a fork choice update, containing the identifier
+// of the payload being built if payload attributes were provided.
+type ForkchoiceUpdatedResponse struct {
+	PayloadStatus *PayloadStatus `json:"payloadStatus"`
+	PayloadID     *PayloadID     `json:"payloadId"`
+}
+
+// PayloadID identifies a payload being built by the execution engine.
+type PayloadID [8]byte
+
+// MarshalText encodes the payload ID as a 0x prefixed hex string.
+func (b PayloadID) MarshalText() ([]byte, error) {
+	return hexutil.Bytes(b[:]).MarshalText()
+}
+
+// UnmarshalText decodes the payload ID from a 0x prefixed hex string.
+func (b *PayloadID) UnmarshalText(input []byte) error {
+	return hexutil.UnmarshalFixedText("PayloadID", input, b[:])
+}
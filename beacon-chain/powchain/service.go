@@ -24,6 +24,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
@@ -34,6 +35,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
 	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
 	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/clientstats"
@@ -143,6 +145,7 @@ type Service struct {
 	latestEth1Data          *protodb.LatestETH1Data
 	depositContractCaller   *contracts.DepositContractCaller
 	depositTrie             *trieutil.SparseMerkleTrie
+	depositSnapshot         *protodb.DepositSnapshot // The snapshot the deposit trie was created from, if any.
 	chainStartData          *protodb.ChainStartData
 	lastReceivedMerkleIndex int64 // Keeps track of the last received index to prevent log spam.
 	runError                error
@@ -618,10 +621,15 @@ func (s *Service) initDepositCaches(ctx context.Context, ctrs []*protodb.Deposit
 		currIndex = fState.Eth1DepositIndex()
 	}
 	validDepositsCount.Add(float64(currIndex))
+	// The containers start after the deposit snapshot, if the deposit trie was created from one.
+	offset := s.depositSnapshot.GetDepositCount()
+	if currIndex < offset {
+		currIndex = offset
+	}
 	// Only add pending deposits if the container slice length
 	// is more than the current index in state.
-	if uint64(len(ctrs)) > currIndex {
-		for _, c := range ctrs[currIndex:] {
+	if uint64(len(ctrs))+offset > currIndex {
+		for _, c := range ctrs[currIndex-offset:] {
 			s.cfg.DepositCache.InsertPendingDeposit(ctx, c.Deposit, c.Eth1BlockHeight, c.Index, bytesutil.ToBytes32(c.DepositRoot))
 		}
 	}
@@ -795,6 +803,10 @@ func (s *Service) run(done <-chan struct{}) {
 	chainstartTicker := time.NewTicker(logPeriod)
 	defer chainstartTicker.Stop()
 
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+
 	for {
 		select {
 		case <-done:
@@ -824,6 +836,17 @@ func (s *Service) run(done <-chan struct{}) {
 				continue
 			}
 			s.logTillChainStart()
+		case ev := <-stateChannel:
+			if ev.Type != statefeed.FinalizedCheckpoint {
+				continue
+			}
+			data, ok := ev.Data.(*ethpbv1.EventFinalizedCheckpoint)
+			if !ok {
+				continue
+			}
+			if err := s.saveDepositSnapshot(s.ctx, bytesutil.ToBytes32(data.Block)); err != nil {
+				log.WithError(err).Error("Could not save deposit snapshot")
+			}
 		}
 	}
 }
@@ -966,6 +989,7 @@ func (s *Service) initializeEth1Data(ctx context.Context, eth1DataInDB *protodb.
 		return nil
 	}
 	s.depositTrie = trieutil.CreateTrieFromProto(eth1DataInDB.Trie)
+	s.depositSnapshot = eth1DataInDB.DepositSnapshot
 	s.chainStartData = eth1DataInDB.ChainstartData
 	var err error
 	if !reflect.ValueOf(eth1DataInDB.BeaconState).IsZero() {
@@ -977,6 +1001,11 @@ func (s *Service) initializeEth1Data(ctx context.Context, eth1DataInDB *protodb.
 	s.latestEth1Data = eth1DataInDB.CurrentEth1Data
 	numOfItems := s.depositTrie.NumOfItems()
 	s.lastReceivedMerkleIndex = int64(numOfItems - 1)
+	if s.depositSnapshot != nil {
+		if err := s.cfg.DepositCache.InsertSnapshot(ctx, s.depositSnapshot); err != nil {
+			return errors.Wrap(err, "could not initialize deposit cache from snapshot")
+		}
+	}
 	if err := s.initDepositCaches(ctx, eth1DataInDB.DepositContainers); err != nil {
 		return errors.Wrap(err, "could not initialize caches")
 	}
@@ -984,8 +1013,8 @@ func (s *Service) initializeEth1Data(ctx context.Context, eth1DataInDB *protodb.
 }
 
 // validates that all deposit containers are valid and have their relevant indices
-// in order.
-func (s *Service) validateDepositContainers(ctrs []*protodb.DepositContainer) bool {
+// in order, starting from the given index.
+func (s *Service) validateDepositContainers(ctrs []*protodb.DepositContainer, startIndex int64) bool {
 	ctrLen := len(ctrs)
 	// Exit for empty containers.
 	if ctrLen == 0 {
@@ -995,7 +1024,6 @@ func (s *Service) validateDepositContainers(ctrs []*protodb.DepositContainer) bo
 	sort.Slice(ctrs, func(i, j int) bool {
 		return ctrs[i].Index < ctrs[j].Index
 	})
-	startIndex := int64(0)
 	for _, c := range ctrs {
 		if c.Index != startIndex {
 			log.Info("Recovering missing deposit containers, node is re-requesting missing deposit data")
@@ -1021,7 +1049,8 @@ func (s *Service) ensureValidPowchainData(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "unable to retrieve eth1 data")
 	}
-	if eth1Data == nil || !eth1Data.ChainstartData.Chainstarted || !s.validateDepositContainers(eth1Data.DepositContainers) {
+	if eth1Data == nil || !eth1Data.ChainstartData.Chainstarted ||
+		!s.validateDepositContainers(eth1Data.DepositContainers, int64(eth1Data.DepositSnapshot.GetDepositCount())) {
 		pbState, err := v1.ProtobufBeaconState(s.preGenesisState.InnerStateUnsafe())
 		if err != nil {
 			return err
@@ -1033,12 +1062,18 @@ func (s *Service) ensureValidPowchainData(ctx context.Context) error {
 			Eth1Data:           genState.Eth1Data(),
 			ChainstartDeposits: make([]*ethpb.Deposit, 0),
 		}
+		// Follow the deposit logs from the finalized deposit snapshot if there is one, instead of
+		// replaying all of them.
+		if err := s.initializeFromDepositSnapshot(ctx); err != nil {
+			return err
+		}
 		eth1Data = &protodb.ETH1ChainData{
 			CurrentEth1Data:   s.latestEth1Data,
 			ChainstartData:    s.chainStartData,
 			BeaconState:       pbState,
 			Trie:              s.depositTrie.ToProto(),
 			DepositContainers: s.cfg.DepositCache.AllDepositContainers(ctx),
+			DepositSnapshot:   s.depositSnapshot,
 		}
 		return s.cfg.BeaconDB.SavePowchainData(ctx, eth1Data)
 	}
@@ -423,6 +423,7 @@ func TestLogTillGenesis_OK(t *testing.T) {
 		testAcc.Backend.Commit()
 	}
 	web3Service.latestEth1Data = &protodb.LatestETH1Data{LastRequestedBlock: 0}
+	web3Service.cfg.StateNotifier = &goodNotifier{}
 	// Spin off to a separate routine
 	go web3Service.run(web3Service.ctx.Done())
 	// Wait for 2 seconds so that the
@@ -704,6 +705,7 @@ func TestService_ValidateDepositContainers(t *testing.T) {
 	var tt = []struct {
 		name        string
 		ctrsFunc    func() []*protodb.DepositContainer
+		startIndex  int64
 		expectedRes bool
 	}{
 		{
@@ -749,10 +751,34 @@ func TestService_ValidateDepositContainers(t *testing.T) {
 			},
 			expectedRes: false,
 		},
+		{
+			name: "containers after snapshot",
+			ctrsFunc: func() []*protodb.DepositContainer {
+				ctrs := make([]*protodb.DepositContainer, 0)
+				for i := 5; i < 10; i++ {
+					ctrs = append(ctrs, &protodb.DepositContainer{Index: int64(i), Eth1BlockHeight: uint64(i + 10)})
+				}
+				return ctrs
+			},
+			startIndex:  5,
+			expectedRes: true,
+		},
+		{
+			name: "containers before snapshot",
+			ctrsFunc: func() []*protodb.DepositContainer {
+				ctrs := make([]*protodb.DepositContainer, 0)
+				for i := 0; i < 10; i++ {
+					ctrs = append(ctrs, &protodb.DepositContainer{Index: int64(i), Eth1BlockHeight: uint64(i + 10)})
+				}
+				return ctrs
+			},
+			startIndex:  5,
+			expectedRes: false,
+		},
 	}
 
 	for _, test := range tt {
-		assert.Equal(t, test.expectedRes, s1.validateDepositContainers(test.ctrsFunc()))
+		assert.Equal(t, test.expectedRes, s1.validateDepositContainers(test.ctrsFunc(), test.startIndex), test.name)
 	}
 }
 
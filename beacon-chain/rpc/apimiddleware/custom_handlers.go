@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math"
+	"mime"
 	"net/http"
 	"strconv"
 	"strings"
@@ -17,6 +19,11 @@ import (
 	"github.com/r3labs/sse"
 )
 
+const (
+	sszContentType  = "application/octet-stream"
+	jsonContentType = "application/json"
+)
+
 type sszConfig struct {
 	sszPath      string
 	fileName     string
@@ -41,6 +48,19 @@ func handleGetBeaconBlockSSZ(m *gateway.ApiProxyMiddleware, endpoint gateway.End
 	return handleGetSSZ(m, endpoint, w, req, config)
 }
 
+func handleProduceBlockSSZ(m *gateway.ApiProxyMiddleware, endpoint gateway.Endpoint, w http.ResponseWriter, req *http.Request) (handled bool) {
+	config := sszConfig{
+		sszPath:      "/eth/v1/validator/blocks/{slot}/ssz",
+		fileName:     "produce_block.ssz",
+		responseJson: &produceBlockResponseSSZJson{},
+	}
+	return handleGetSSZ(m, endpoint, w, req, config)
+}
+
+func handleSubmitBlockSSZ(m *gateway.ApiProxyMiddleware, endpoint gateway.Endpoint, w http.ResponseWriter, req *http.Request) (handled bool) {
+	return handlePostSSZ(m, endpoint, w, req, "/eth/v1/beacon/blocks/ssz")
+}
+
 func handleGetSSZ(
 	m *gateway.ApiProxyMiddleware,
 	endpoint gateway.Endpoint,
@@ -95,17 +115,111 @@ func handleGetSSZ(
 	return true
 }
 
+// handlePostSSZ proxies a POST request with an ssz encoded body to the ssz variant of the endpoint,
+// which receives the ssz bytes wrapped in a JSON container.
+func handlePostSSZ(
+	m *gateway.ApiProxyMiddleware,
+	endpoint gateway.Endpoint,
+	w http.ResponseWriter,
+	req *http.Request,
+	sszPath string,
+) (handled bool) {
+	if req.Method != "POST" || !sszPosted(req) {
+		return false
+	}
+
+	if errJson := setSSZRequestBody(req); errJson != nil {
+		gateway.WriteError(w, errJson, nil)
+		return true
+	}
+	if errJson := prepareSSZRequestForProxying(m, endpoint, req, sszPath); errJson != nil {
+		gateway.WriteError(w, errJson, nil)
+		return true
+	}
+	grpcResponse, errJson := gateway.ProxyRequest(req)
+	if errJson != nil {
+		gateway.WriteError(w, errJson, nil)
+		return true
+	}
+	grpcResponseBody, errJson := gateway.ReadGrpcResponseBody(grpcResponse.Body)
+	if errJson != nil {
+		gateway.WriteError(w, errJson, nil)
+		return true
+	}
+	if errJson := gateway.DeserializeGrpcResponseBodyIntoErrorJson(endpoint.Err, grpcResponseBody); errJson != nil {
+		gateway.WriteError(w, errJson, nil)
+		return true
+	}
+	if endpoint.Err.Msg() != "" {
+		gateway.HandleGrpcResponseError(endpoint.Err, grpcResponse, w)
+		return true
+	}
+	if errJson := gateway.WriteMiddlewareResponseHeadersAndBody(req, grpcResponse, nil, w); errJson != nil {
+		gateway.WriteError(w, errJson, nil)
+		return true
+	}
+	if errJson := gateway.Cleanup(grpcResponse.Body); errJson != nil {
+		gateway.WriteError(w, errJson, nil)
+		return true
+	}
+
+	return true
+}
+
+// sszRequested checks whether the client prefers an ssz response over a JSON one, according to the
+// quality values of the Accept header. JSON is preferred when both are equally acceptable only if
+// ssz is not listed explicitly.
 func sszRequested(req *http.Request) bool {
 	accept, ok := req.Header["Accept"]
 	if !ok {
 		return false
 	}
-	for _, v := range accept {
-		if v == "application/octet-stream" {
-			return true
+	var sszQuality, jsonQuality float64
+	for _, header := range accept {
+		for _, v := range strings.Split(header, ",") {
+			mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(v))
+			if err != nil {
+				continue
+			}
+			quality := 1.0
+			if q, ok := params["q"]; ok {
+				if quality, err = strconv.ParseFloat(q, 64); err != nil {
+					continue
+				}
+			}
+			switch mediaType {
+			case sszContentType:
+				sszQuality = math.Max(sszQuality, quality)
+			case jsonContentType, "application/*", "*/*":
+				jsonQuality = math.Max(jsonQuality, quality)
+			}
 		}
 	}
-	return false
+	return sszQuality > 0 && sszQuality >= jsonQuality
+}
+
+// sszPosted checks whether the body of the request is ssz encoded.
+func sszPosted(req *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	return err == nil && mediaType == sszContentType
+}
+
+// setSSZRequestBody replaces the ssz encoded body of the request with the JSON container expected by
+// the ssz variant of the endpoint.
+func setSSZRequestBody(req *http.Request) gateway.ErrorJson {
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return gateway.InternalServerErrorWithMessage(err, "could not read request body")
+	}
+	j, err := json.Marshal(&sszRequestJson{Data: base64.StdEncoding.EncodeToString(body)})
+	if err != nil {
+		return gateway.InternalServerErrorWithMessage(err, "could not marshal request")
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(j))
+	req.Header.Set("Content-Type", jsonContentType)
+	req.Header.Set("Content-Length", strconv.Itoa(len(j)))
+	req.ContentLength = int64(len(j))
+	return nil
 }
 
 func prepareSSZRequestForProxying(m *gateway.ApiProxyMiddleware, endpoint gateway.Endpoint, req *http.Request, sszPath string) gateway.ErrorJson {
@@ -113,7 +227,10 @@ func prepareSSZRequestForProxying(m *gateway.ApiProxyMiddleware, endpoint gatewa
 	req.URL.Host = m.GatewayAddress
 	req.RequestURI = ""
 	req.URL.Path = sszPath
-	return gateway.HandleURLParameters(endpoint.Path, req, []string{})
+	if errJson := gateway.HandleURLParameters(endpoint.Path, req, endpoint.GetRequestURLLiterals); errJson != nil {
+		return errJson
+	}
+	return gateway.HandleQueryParameters(req, endpoint.GetRequestQueryParams)
 }
 
 func serializeMiddlewareResponseIntoSSZ(data string) (sszResponse []byte, errJson gateway.ErrorJson) {
@@ -139,6 +256,10 @@ func writeSSZResponseHeaderAndBody(grpcResp *http.Response, w http.ResponseWrite
 			}
 		}
 	}
+	// Headers have to be set before the status code is written, or they are not sent.
+	w.Header().Set("Content-Length", strconv.Itoa(len(responseSsz)))
+	w.Header().Set("Content-Type", sszContentType)
+	w.Header().Set("Content-Disposition", "attachment; filename="+fileName)
 	if statusCodeHeader != "" {
 		code, err := strconv.Atoi(statusCodeHeader)
 		if err != nil {
@@ -148,10 +269,6 @@ func writeSSZResponseHeaderAndBody(grpcResp *http.Response, w http.ResponseWrite
 	} else {
 		w.WriteHeader(grpcResp.StatusCode)
 	}
-	w.Header().Set("Content-Length", strconv.Itoa(len(responseSsz)))
-	w.Header().Set("Content-Type", "application/octet-stream")
-	w.Header().Set("Content-Disposition", "attachment; filename="+fileName)
-	w.WriteHeader(grpcResp.StatusCode)
 	if _, err := io.Copy(w, ioutil.NopCloser(bytes.NewReader(responseSsz))); err != nil {
 		return gateway.InternalServerErrorWithMessage(err, "could not write response message")
 	}
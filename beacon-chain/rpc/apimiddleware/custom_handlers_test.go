@@ -4,12 +4,14 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/gorilla/mux"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eth/v1/events"
 	"github.com/prysmaticlabs/prysm/shared/gateway"
 	"github.com/prysmaticlabs/prysm/shared/grpcutils"
@@ -45,6 +47,67 @@ func TestSSZRequested(t *testing.T) {
 		result := sszRequested(request)
 		assert.Equal(t, false, result)
 	})
+
+	t.Run("json_preferred", func(t *testing.T) {
+		request := httptest.NewRequest("GET", "http://foo.example", nil)
+		request.Header["Accept"] = []string{"application/octet-stream;q=0.5,application/json"}
+		result := sszRequested(request)
+		assert.Equal(t, false, result)
+	})
+
+	t.Run("ssz_preferred", func(t *testing.T) {
+		request := httptest.NewRequest("GET", "http://foo.example", nil)
+		request.Header["Accept"] = []string{"application/octet-stream;q=0.9,*/*;q=0.5"}
+		result := sszRequested(request)
+		assert.Equal(t, true, result)
+	})
+
+	t.Run("ssz_not_acceptable", func(t *testing.T) {
+		request := httptest.NewRequest("GET", "http://foo.example", nil)
+		request.Header["Accept"] = []string{"application/octet-stream;q=0"}
+		result := sszRequested(request)
+		assert.Equal(t, false, result)
+	})
+
+	t.Run("wildcard", func(t *testing.T) {
+		request := httptest.NewRequest("GET", "http://foo.example", nil)
+		request.Header["Accept"] = []string{"*/*"}
+		result := sszRequested(request)
+		assert.Equal(t, false, result)
+	})
+}
+
+func TestSSZPosted(t *testing.T) {
+	t.Run("ssz_posted", func(t *testing.T) {
+		request := httptest.NewRequest("POST", "http://foo.example", nil)
+		request.Header.Set("Content-Type", "application/octet-stream")
+		assert.Equal(t, true, sszPosted(request))
+	})
+
+	t.Run("json_posted", func(t *testing.T) {
+		request := httptest.NewRequest("POST", "http://foo.example", nil)
+		request.Header.Set("Content-Type", "application/json")
+		assert.Equal(t, false, sszPosted(request))
+	})
+
+	t.Run("no_header", func(t *testing.T) {
+		request := httptest.NewRequest("POST", "http://foo.example", nil)
+		assert.Equal(t, false, sszPosted(request))
+	})
+}
+
+func TestSetSSZRequestBody(t *testing.T) {
+	request := httptest.NewRequest("POST", "http://foo.example", bytes.NewReader([]byte("ssz")))
+	request.Header.Set("Content-Type", "application/octet-stream")
+
+	errJson := setSSZRequestBody(request)
+	require.Equal(t, true, errJson == nil)
+	body, err := ioutil.ReadAll(request.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "{\"data\":\"c3N6\"}", string(body))
+	assert.Equal(t, "application/json", request.Header.Get("Content-Type"))
+	assert.Equal(t, "15", request.Header.Get("Content-Length"))
+	assert.Equal(t, int64(15), request.ContentLength)
 }
 
 func TestPrepareSSZRequestForProxying(t *testing.T) {
@@ -108,6 +171,9 @@ func TestWriteSSZResponseHeaderAndBody(t *testing.T) {
 		require.Equal(t, 1, len(v), "wrong number of header values")
 		assert.Equal(t, "attachment; filename=test.ssz", v[0])
 		assert.Equal(t, 204, writer.Code)
+		// Only headers set before the status code was written are sent to the client.
+		assert.Equal(t, "application/octet-stream", writer.Result().Header.Get("Content-Type"))
+		assert.Equal(t, "3", writer.Result().Header.Get("Content-Length"))
 	})
 
 	t.Run("no_grpc_status_code_header", func(t *testing.T) {
@@ -142,6 +208,135 @@ func TestWriteSSZResponseHeaderAndBody(t *testing.T) {
 	})
 }
 
+// sszGateway returns a middleware proxying requests to a test server which stands in for the
+// grpc-gateway, along with the paths and bodies of the requests received by the server.
+func sszGateway(t *testing.T, code int, body string) (*gateway.ApiProxyMiddleware, *[]string, *[]string) {
+	var paths, bodies []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		paths = append(paths, r.URL.Path)
+		bodies = append(bodies, string(b))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		_, err = w.Write([]byte(body))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(srv.Close)
+	return &gateway.ApiProxyMiddleware{GatewayAddress: srv.Listener.Addr().String()}, &paths, &bodies
+}
+
+func TestHandleGetSSZ(t *testing.T) {
+	tests := []struct {
+		name     string
+		handler  gateway.CustomHandler
+		path     string
+		literals []string
+		vars     map[string]string
+		wantPath string
+		fileName string
+	}{
+		{
+			name:     "block",
+			handler:  handleGetBeaconBlockSSZ,
+			path:     "/eth/v1/beacon/blocks/{block_id}",
+			vars:     map[string]string{"block_id": "head"},
+			wantPath: "/eth/v1/beacon/blocks/aGVhZA==/ssz",
+			fileName: "beacon_block.ssz",
+		},
+		{
+			name:     "state",
+			handler:  handleGetBeaconStateSSZ,
+			path:     "/eth/v1/debug/beacon/states/{state_id}",
+			vars:     map[string]string{"state_id": "head"},
+			wantPath: "/eth/v1/debug/beacon/states/aGVhZA==/ssz",
+			fileName: "beacon_state.ssz",
+		},
+		{
+			name:     "produce block",
+			handler:  handleProduceBlockSSZ,
+			path:     "/eth/v1/validator/blocks/{slot}",
+			literals: []string{"slot"},
+			vars:     map[string]string{"slot": "1"},
+			wantPath: "/eth/v1/validator/blocks/{slot}/ssz",
+			fileName: "produce_block.ssz",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, paths, _ := sszGateway(t, http.StatusOK, "{\"data\":\"c3N6\"}")
+			endpoint := gateway.Endpoint{Path: tt.path, GetRequestURLLiterals: tt.literals, Err: &gateway.DefaultErrorJson{}}
+			request := mux.SetURLVars(httptest.NewRequest("GET", "http://foo.example"+tt.path, nil), tt.vars)
+			request.Header.Set("Accept", "application/octet-stream")
+			writer := httptest.NewRecorder()
+
+			assert.Equal(t, true, tt.handler(m, endpoint, writer, request))
+			require.Equal(t, 1, len(*paths))
+			assert.Equal(t, tt.wantPath, (*paths)[0])
+			assert.Equal(t, http.StatusOK, writer.Code)
+			assert.Equal(t, "ssz", writer.Body.String())
+			assert.Equal(t, "application/octet-stream", writer.Header().Get("Content-Type"))
+			assert.Equal(t, "attachment; filename="+tt.fileName, writer.Header().Get("Content-Disposition"))
+		})
+	}
+
+	t.Run("json_requested", func(t *testing.T) {
+		m, paths, _ := sszGateway(t, http.StatusOK, "{\"data\":\"c3N6\"}")
+		endpoint := gateway.Endpoint{Path: "/eth/v1/beacon/blocks/{block_id}", Err: &gateway.DefaultErrorJson{}}
+		request := httptest.NewRequest("GET", "http://foo.example/eth/v1/beacon/blocks/head", nil)
+		request.Header.Set("Accept", "application/json")
+		writer := httptest.NewRecorder()
+
+		assert.Equal(t, false, handleGetBeaconBlockSSZ(m, endpoint, writer, request))
+		assert.Equal(t, 0, len(*paths))
+	})
+
+	t.Run("grpc_error", func(t *testing.T) {
+		m, _, _ := sszGateway(t, http.StatusNotFound, "{\"message\":\"block not found\",\"code\":5}")
+		endpoint := gateway.Endpoint{Path: "/eth/v1/beacon/blocks/{block_id}", Err: &gateway.DefaultErrorJson{}}
+		request := mux.SetURLVars(
+			httptest.NewRequest("GET", "http://foo.example/eth/v1/beacon/blocks/head", nil),
+			map[string]string{"block_id": "head"},
+		)
+		request.Header.Set("Accept", "application/octet-stream")
+		writer := httptest.NewRecorder()
+
+		assert.Equal(t, true, handleGetBeaconBlockSSZ(m, endpoint, writer, request))
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+		errJson := &gateway.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), errJson))
+		assert.Equal(t, "block not found", errJson.Message)
+		assert.Equal(t, http.StatusNotFound, errJson.Code)
+	})
+}
+
+func TestHandleSubmitBlockSSZ(t *testing.T) {
+	t.Run("ssz_posted", func(t *testing.T) {
+		m, paths, bodies := sszGateway(t, http.StatusOK, "{}")
+		endpoint := gateway.Endpoint{Path: "/eth/v1/beacon/blocks", Err: &gateway.DefaultErrorJson{}}
+		request := httptest.NewRequest("POST", "http://foo.example/eth/v1/beacon/blocks", bytes.NewReader([]byte("ssz")))
+		request.Header.Set("Content-Type", "application/octet-stream")
+		writer := httptest.NewRecorder()
+
+		assert.Equal(t, true, handleSubmitBlockSSZ(m, endpoint, writer, request))
+		assert.Equal(t, http.StatusOK, writer.Code)
+		require.Equal(t, 1, len(*paths))
+		assert.Equal(t, "/eth/v1/beacon/blocks/ssz", (*paths)[0])
+		assert.Equal(t, "{\"data\":\"c3N6\"}", (*bodies)[0])
+	})
+
+	t.Run("json_posted", func(t *testing.T) {
+		m, paths, _ := sszGateway(t, http.StatusOK, "{}")
+		endpoint := gateway.Endpoint{Path: "/eth/v1/beacon/blocks", Err: &gateway.DefaultErrorJson{}}
+		request := httptest.NewRequest("POST", "http://foo.example/eth/v1/beacon/blocks", bytes.NewReader([]byte("{}")))
+		request.Header.Set("Content-Type", "application/json")
+		writer := httptest.NewRecorder()
+
+		assert.Equal(t, false, handleSubmitBlockSSZ(m, endpoint, writer, request))
+		assert.Equal(t, 0, len(*paths))
+	})
+}
+
 func TestReceiveEvents(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	ch := make(chan *sse.Event)
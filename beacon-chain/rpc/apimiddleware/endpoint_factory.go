@@ -34,6 +34,9 @@ func (f *BeaconEndpointFactory) Paths() []string {
 		"/eth/v1/beacon/pool/attester_slashings",
 		"/eth/v1/beacon/pool/proposer_slashings",
 		"/eth/v1/beacon/pool/voluntary_exits",
+		"/eth/v1/beacon/deposit_snapshot",
+		"/eth/v1/beacon/rewards/attestations/{epoch}",
+		"/eth/v1/beacon/rewards/blocks/{block_id}",
 		"/eth/v1/node/identity",
 		"/eth/v1/node/peers",
 		"/eth/v1/node/peers/{peer_id}",
@@ -43,10 +46,18 @@ func (f *BeaconEndpointFactory) Paths() []string {
 		"/eth/v1/node/health",
 		"/eth/v1/debug/beacon/states/{state_id}",
 		"/eth/v1/debug/beacon/heads",
+		"/eth/v1/debug/fork_choice",
 		"/eth/v1/config/fork_schedule",
 		"/eth/v1/config/deposit_contract",
 		"/eth/v1/config/spec",
 		"/eth/v1/events",
+		"/eth/v1/validator/duties/attester/{epoch}",
+		"/eth/v1/validator/duties/proposer/{epoch}",
+		"/eth/v1/validator/blocks/{slot}",
+		"/eth/v1/validator/attestation_data",
+		"/eth/v1/validator/aggregate_attestation",
+		"/eth/v1/validator/aggregate_and_proofs",
+		"/eth/v1/validator/beacon_committee_subscriptions",
 	}
 }
 
@@ -113,6 +124,7 @@ func (f *BeaconEndpointFactory) Create(path string) (*gateway.Endpoint, error) {
 			PostRequest: &beaconBlockContainerJson{},
 			Err:         &gateway.DefaultErrorJson{},
 			Hooks: gateway.HookCollection{
+				CustomHandlers: []gateway.CustomHandler{handleSubmitBlockSSZ},
 				OnPostDeserializeRequestBodyIntoContainer: []gateway.Hook{prepareGraffiti},
 			},
 		}
@@ -162,6 +174,22 @@ func (f *BeaconEndpointFactory) Create(path string) (*gateway.Endpoint, error) {
 			GetResponse: &voluntaryExitsPoolResponseJson{},
 			Err:         &gateway.DefaultErrorJson{},
 		}
+	case "/eth/v1/beacon/deposit_snapshot":
+		endpoint = gateway.Endpoint{
+			GetResponse: &depositSnapshotResponseJson{},
+			Err:         &gateway.DefaultErrorJson{},
+		}
+	case "/eth/v1/beacon/rewards/attestations/{epoch}":
+		endpoint = gateway.Endpoint{
+			GetRequestQueryParams: []gateway.QueryParam{{Name: "id", Hex: true}},
+			GetResponse:           &attestationRewardsResponseJson{},
+			Err:                   &gateway.DefaultErrorJson{},
+		}
+	case "/eth/v1/beacon/rewards/blocks/{block_id}":
+		endpoint = gateway.Endpoint{
+			GetResponse: &blockRewardsResponseJson{},
+			Err:         &gateway.DefaultErrorJson{},
+		}
 	case "/eth/v1/node/identity":
 		endpoint = gateway.Endpoint{
 			GetResponse: &identityResponseJson{},
@@ -211,6 +239,11 @@ func (f *BeaconEndpointFactory) Create(path string) (*gateway.Endpoint, error) {
 			GetResponse: &forkChoiceHeadsResponseJson{},
 			Err:         &gateway.DefaultErrorJson{},
 		}
+	case "/eth/v1/debug/fork_choice":
+		endpoint = gateway.Endpoint{
+			GetResponse: &forkChoiceResponseJson{},
+			Err:         &gateway.DefaultErrorJson{},
+		}
 	case "/eth/v1/config/fork_schedule":
 		endpoint = gateway.Endpoint{
 			GetResponse: &forkScheduleResponseJson{},
@@ -233,6 +266,58 @@ func (f *BeaconEndpointFactory) Create(path string) (*gateway.Endpoint, error) {
 				CustomHandlers: []gateway.CustomHandler{handleEvents},
 			},
 		}
+	case "/eth/v1/validator/duties/attester/{epoch}":
+		endpoint = gateway.Endpoint{
+			PostRequest: &attesterDutiesRequestJson{},
+			GetResponse: &attesterDutiesResponseJson{},
+			Err:         &gateway.DefaultErrorJson{},
+			Hooks: gateway.HookCollection{
+				OnPostStart: []gateway.Hook{wrapValidatorIndicesArray},
+			},
+		}
+	case "/eth/v1/validator/duties/proposer/{epoch}":
+		endpoint = gateway.Endpoint{
+			GetResponse: &proposerDutiesResponseJson{},
+			Err:         &gateway.DefaultErrorJson{},
+		}
+	case "/eth/v1/validator/blocks/{slot}":
+		endpoint = gateway.Endpoint{
+			GetRequestURLLiterals: []string{"slot"},
+			GetRequestQueryParams: []gateway.QueryParam{{Name: "randao_reveal", Hex: true}, {Name: "graffiti", Hex: true}},
+			GetResponse:           &produceBlockResponseJson{},
+			Err:                   &gateway.DefaultErrorJson{},
+			Hooks: gateway.HookCollection{
+				CustomHandlers: []gateway.CustomHandler{handleProduceBlockSSZ},
+			},
+		}
+	case "/eth/v1/validator/attestation_data":
+		endpoint = gateway.Endpoint{
+			GetRequestQueryParams: []gateway.QueryParam{{Name: "slot"}, {Name: "committee_index"}},
+			GetResponse:           &produceAttestationDataResponseJson{},
+			Err:                   &gateway.DefaultErrorJson{},
+		}
+	case "/eth/v1/validator/aggregate_attestation":
+		endpoint = gateway.Endpoint{
+			GetRequestQueryParams: []gateway.QueryParam{{Name: "attestation_data_root", Hex: true}, {Name: "slot"}},
+			GetResponse:           &aggregateAttestationResponseJson{},
+			Err:                   &gateway.DefaultErrorJson{},
+		}
+	case "/eth/v1/validator/aggregate_and_proofs":
+		endpoint = gateway.Endpoint{
+			PostRequest: &submitAggregateAndProofsRequestJson{},
+			Err:         &gateway.DefaultErrorJson{},
+			Hooks: gateway.HookCollection{
+				OnPostStart: []gateway.Hook{wrapSignedAggregateAndProofArray},
+			},
+		}
+	case "/eth/v1/validator/beacon_committee_subscriptions":
+		endpoint = gateway.Endpoint{
+			PostRequest: &submitBeaconCommitteeSubscriptionsRequestJson{},
+			Err:         &gateway.DefaultErrorJson{},
+			Hooks: gateway.HookCollection{
+				OnPostStart: []gateway.Hook{wrapBeaconCommitteeSubscriptionsArray},
+			},
+		}
 	default:
 		return nil, errors.New("invalid path")
 	}
@@ -111,6 +111,21 @@ type voluntaryExitsPoolResponseJson struct {
 	Data []*signedVoluntaryExitJson `json:"data"`
 }
 
+// depositSnapshotResponseJson is used in /beacon/deposit_snapshot API endpoint.
+type depositSnapshotResponseJson struct {
+	Data *depositSnapshotJson `json:"data"`
+}
+
+// attestationRewardsResponseJson is used in /beacon/rewards/attestations/{epoch} API endpoint.
+type attestationRewardsResponseJson struct {
+	Data []*attestationRewardsJson `json:"data"`
+}
+
+// blockRewardsResponseJson is used in /beacon/rewards/blocks/{block_id} API endpoint.
+type blockRewardsResponseJson struct {
+	Data *blockRewardsJson `json:"data"`
+}
+
 // identityResponseJson is used in /node/identity API endpoint.
 type identityResponseJson struct {
 	Data *identityJson `json:"data"`
@@ -159,6 +174,11 @@ type forkChoiceHeadsResponseJson struct {
 	Data []*forkChoiceHeadJson `json:"data"`
 }
 
+// forkChoiceResponseJson is used in /debug/fork_choice API endpoint.
+type forkChoiceResponseJson struct {
+	Data *forkChoiceJson `json:"data"`
+}
+
 // forkScheduleResponseJson is used in /config/fork_schedule API endpoint.
 type forkScheduleResponseJson struct {
 	Data []*forkJson `json:"data"`
@@ -174,6 +194,48 @@ type specResponseJson struct {
 	Data interface{} `json:"data"`
 }
 
+// attesterDutiesRequestJson is used in /validator/duties/attester/{epoch} API endpoint.
+type attesterDutiesRequestJson struct {
+	Index []string `json:"index"`
+}
+
+// attesterDutiesResponseJson is used in /validator/duties/attester/{epoch} API endpoint.
+type attesterDutiesResponseJson struct {
+	DependentRoot string              `json:"dependent_root" hex:"true"`
+	Data          []*attesterDutyJson `json:"data"`
+}
+
+// proposerDutiesResponseJson is used in /validator/duties/proposer/{epoch} API endpoint.
+type proposerDutiesResponseJson struct {
+	DependentRoot string              `json:"dependent_root" hex:"true"`
+	Data          []*proposerDutyJson `json:"data"`
+}
+
+// produceBlockResponseJson is used in /validator/blocks/{slot} API endpoint.
+type produceBlockResponseJson struct {
+	Data *beaconBlockJson `json:"data"`
+}
+
+// produceAttestationDataResponseJson is used in /validator/attestation_data API endpoint.
+type produceAttestationDataResponseJson struct {
+	Data *attestationDataJson `json:"data"`
+}
+
+// aggregateAttestationResponseJson is used in /validator/aggregate_attestation API endpoint.
+type aggregateAttestationResponseJson struct {
+	Data *attestationJson `json:"data"`
+}
+
+// submitBeaconCommitteeSubscriptionsRequestJson is used in /validator/beacon_committee_subscriptions API endpoint.
+type submitBeaconCommitteeSubscriptionsRequestJson struct {
+	Data []*beaconCommitteeSubscribeJson `json:"data"`
+}
+
+// submitAggregateAndProofsRequestJson is used in /validator/aggregate_and_proofs API endpoint.
+type submitAggregateAndProofsRequestJson struct {
+	Data []*signedAggregateAttestationAndProofJson `json:"data"`
+}
+
 //----------------
 // Reusable types.
 //----------------
@@ -292,6 +354,38 @@ type depositJson struct {
 	Data  *deposit_DataJson `json:"data"`
 }
 
+// depositSnapshotJson is a JSON representation of a snapshot of the finalized deposit tree.
+type depositSnapshotJson struct {
+	Finalized            []string `json:"finalized" hex:"true"`
+	DepositRoot          string   `json:"deposit_root" hex:"true"`
+	DepositCount         string   `json:"deposit_count"`
+	ExecutionBlockHash   string   `json:"execution_block_hash" hex:"true"`
+	ExecutionBlockHeight string   `json:"execution_block_height"`
+}
+
+// attestationRewardsJson is a JSON representation of the attestation rewards of a validator.
+type attestationRewardsJson struct {
+	ValidatorIndex       string `json:"validator_index"`
+	SourceReward         string `json:"source_reward"`
+	SourcePenalty        string `json:"source_penalty"`
+	TargetReward         string `json:"target_reward"`
+	TargetPenalty        string `json:"target_penalty"`
+	HeadReward           string `json:"head_reward"`
+	HeadPenalty          string `json:"head_penalty"`
+	InclusionDelayReward string `json:"inclusion_delay_reward"`
+	InactivityPenalty    string `json:"inactivity_penalty"`
+}
+
+// blockRewardsJson is a JSON representation of the rewards of a block proposer.
+type blockRewardsJson struct {
+	ProposerIndex     string `json:"proposer_index"`
+	Total             string `json:"total"`
+	Attestations      string `json:"attestations"`
+	ProposerSlashings string `json:"proposer_slashings"`
+	AttesterSlashings string `json:"attester_slashings"`
+	SyncAggregate     string `json:"sync_aggregate"`
+}
+
 // deposit_DataJson is a JSON representation of deposit data.
 type deposit_DataJson struct {
 	PublicKey             string `json:"pubkey" hex:"true"`
@@ -420,6 +514,76 @@ type forkChoiceHeadJson struct {
 	Slot string `json:"slot"`
 }
 
+// forkChoiceJson is a JSON representation of the fork choice store.
+type forkChoiceJson struct {
+	JustifiedEpoch string                `json:"justified_epoch"`
+	FinalizedEpoch string                `json:"finalized_epoch"`
+	PruneThreshold string                `json:"prune_threshold"`
+	Nodes          []*forkChoiceNodeJson `json:"nodes"`
+	Votes          []*forkChoiceVoteJson `json:"votes"`
+}
+
+// forkChoiceNodeJson is a JSON representation of a fork choice node.
+type forkChoiceNodeJson struct {
+	Slot           string `json:"slot"`
+	Root           string `json:"root" hex:"true"`
+	ParentRoot     string `json:"parent_root" hex:"true"`
+	JustifiedEpoch string `json:"justified_epoch"`
+	FinalizedEpoch string `json:"finalized_epoch"`
+	Weight         string `json:"weight"`
+	BestChild      string `json:"best_child" hex:"true"`
+	BestDescendant string `json:"best_descendant" hex:"true"`
+	Canonical      bool   `json:"canonical"`
+}
+
+// forkChoiceVoteJson is a JSON representation of a validator's latest fork choice vote.
+type forkChoiceVoteJson struct {
+	ValidatorIndex string `json:"validator_index"`
+	CurrentRoot    string `json:"current_root" hex:"true"`
+	NextRoot       string `json:"next_root" hex:"true"`
+	NextEpoch      string `json:"next_epoch"`
+}
+
+// attesterDutyJson is a JSON representation of an attester duty.
+type attesterDutyJson struct {
+	Pubkey                  string `json:"pubkey" hex:"true"`
+	ValidatorIndex          string `json:"validator_index"`
+	CommitteeIndex          string `json:"committee_index"`
+	CommitteeLength         string `json:"committee_length"`
+	CommitteesAtSlot        string `json:"committees_at_slot"`
+	ValidatorCommitteeIndex string `json:"validator_committee_index"`
+	Slot                    string `json:"slot"`
+}
+
+// proposerDutyJson is a JSON representation of a proposer duty.
+type proposerDutyJson struct {
+	Pubkey         string `json:"pubkey" hex:"true"`
+	ValidatorIndex string `json:"validator_index"`
+	Slot           string `json:"slot"`
+}
+
+// signedAggregateAttestationAndProofJson is a JSON representation of a signed aggregate attestation and proof.
+type signedAggregateAttestationAndProofJson struct {
+	Message   *aggregateAttestationAndProofJson `json:"message"`
+	Signature string                            `json:"signature" hex:"true"`
+}
+
+// aggregateAttestationAndProofJson is a JSON representation of an aggregate attestation and proof.
+type aggregateAttestationAndProofJson struct {
+	AggregatorIndex string           `json:"aggregator_index"`
+	Aggregate       *attestationJson `json:"aggregate"`
+	SelectionProof  string           `json:"selection_proof" hex:"true"`
+}
+
+// beaconCommitteeSubscribeJson is a JSON representation of a beacon committee subscription.
+type beaconCommitteeSubscribeJson struct {
+	ValidatorIndex   string `json:"validator_index"`
+	CommitteeIndex   string `json:"committee_index"`
+	CommitteesAtSlot string `json:"committees_at_slot"`
+	Slot             string `json:"slot"`
+	IsAggregator     bool   `json:"is_aggregator"`
+}
+
 // depositContractJson is a JSON representation of the deposit contract.
 type depositContractJson struct {
 	ChainId string `json:"chain_id"`
@@ -460,6 +624,20 @@ func (ssz *beaconStateSSZResponseJson) SSZData() string {
 	return ssz.Data
 }
 
+// produceBlockResponseSSZJson is used in /validator/blocks/{slot} API endpoint.
+type produceBlockResponseSSZJson struct {
+	Data string `json:"data"`
+}
+
+func (ssz *produceBlockResponseSSZJson) SSZData() string {
+	return ssz.Data
+}
+
+// sszRequestJson is used in POST endpoints receiving an ssz encoded body.
+type sszRequestJson struct {
+	Data string `json:"data"`
+}
+
 // TODO: Documentation
 // ---------------
 // Events.
@@ -193,6 +193,19 @@ func (bs *Server) SubmitBlock(ctx context.Context, req *ethpb.BeaconBlockContain
 	return &emptypb.Empty{}, nil
 }
 
+// SubmitBlockSSZ instructs the beacon node to broadcast a newly signed ssz encoded beacon block to the
+// beacon network, to be included in the beacon chain.
+func (bs *Server) SubmitBlockSSZ(ctx context.Context, req *ethpb.SSZContainer) (*emptypb.Empty, error) {
+	ctx, span := trace.StartSpan(ctx, "beaconv1.SubmitBlockSSZ")
+	defer span.End()
+
+	block := &ethpb.SignedBeaconBlock{}
+	if err := block.UnmarshalSSZ(req.Data); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not unmarshal block from SSZ: %v", err)
+	}
+	return bs.SubmitBlock(ctx, &ethpb.BeaconBlockContainer{Message: block.Block, Signature: block.Signature})
+}
+
 // GetBlock retrieves block details for given block ID.
 func (bs *Server) GetBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb.BlockResponse, error) {
 	ctx, span := trace.StartSpan(ctx, "beaconv1.GetBlock")
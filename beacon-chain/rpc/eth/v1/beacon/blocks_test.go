@@ -279,6 +279,36 @@ func TestServer_ProposeBlock_OK(t *testing.T) {
 	assert.NoError(t, err, "Could not propose block correctly")
 }
 
+func TestServer_SubmitBlockSSZ(t *testing.T) {
+	ctx := context.Background()
+	beaconState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	parentRoot := bytesutil.PadTo([]byte("parent"), 32)
+	c := &mock.ChainService{Root: parentRoot, State: beaconState}
+	beaconChainServer := &Server{
+		BeaconDB:         dbTest.SetupDB(t),
+		BlockReceiver:    c,
+		ChainInfoFetcher: c,
+		BlockNotifier:    c.BlockNotifier(),
+		Broadcaster:      mockp2p.NewTestP2P(t),
+	}
+
+	_, err = beaconChainServer.SubmitBlockSSZ(ctx, &ethpb.SSZContainer{Data: []byte("foo")})
+	assert.ErrorContains(t, "Could not unmarshal block from SSZ", err)
+
+	req := testutil.NewBeaconBlock()
+	req.Block.Slot = 5
+	req.Block.ParentRoot = parentRoot
+	v1Block, err := migration.V1Alpha1ToV1Block(req)
+	require.NoError(t, err)
+	sszBlock, err := v1Block.MarshalSSZ()
+	require.NoError(t, err)
+	_, err = beaconChainServer.SubmitBlockSSZ(ctx, &ethpb.SSZContainer{Data: sszBlock})
+	require.NoError(t, err, "Could not propose block correctly")
+	require.Equal(t, 1, len(c.BlocksReceived))
+	assert.Equal(t, types.Slot(5), c.BlocksReceived[0].Block().Slot())
+}
+
 func TestServer_GetBlock(t *testing.T) {
 	beaconDB := dbTest.SetupDB(t)
 	ctx := context.Background()
@@ -68,6 +68,8 @@ func TestGetSpec(t *testing.T) {
 	config.MaxAttestations = 47
 	config.MaxDeposits = 48
 	config.MaxVoluntaryExits = 49
+	config.IntervalsPerSlot = 50
+	config.ProposerScoreBoost = 51
 
 	var dbp [4]byte
 	copy(dbp[:], []byte{'0', '0', '0', '1'})
@@ -97,7 +99,7 @@ func TestGetSpec(t *testing.T) {
 	resp, err := server.GetSpec(context.Background(), &emptypb.Empty{})
 	require.NoError(t, err)
 
-	assert.Equal(t, 60, len(resp.Data))
+	assert.Equal(t, 62, len(resp.Data))
 	for k, v := range resp.Data {
 		switch k {
 		case "CONFIG_NAME":
@@ -206,6 +208,10 @@ func TestGetSpec(t *testing.T) {
 			assert.Equal(t, "48", v)
 		case "MAX_VOLUNTARY_EXITS":
 			assert.Equal(t, "49", v)
+		case "INTERVALS_PER_SLOT":
+			assert.Equal(t, "50", v)
+		case "PROPOSER_SCORE_BOOST":
+			assert.Equal(t, "51", v)
 		case "DOMAIN_BEACON_PROPOSER":
 			assert.Equal(t, "0x30303031", v)
 		case "DOMAIN_BEACON_ATTESTER":
@@ -0,0 +1,36 @@
+package beacon
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// GetDepositSnapshot retrieves the snapshot of the finalized deposit tree of the node, from which
+// another node can follow the deposit contract logs without processing the ones before it.
+func (bs *Server) GetDepositSnapshot(ctx context.Context, _ *emptypb.Empty) (*ethpb.DepositSnapshotResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "beaconv1.GetDepositSnapshot")
+	defer span.End()
+
+	snapshot, err := bs.BeaconDB.DepositSnapshot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve deposit snapshot: %v", err)
+	}
+	if snapshot == nil {
+		return nil, status.Errorf(codes.NotFound, "Could not find deposit snapshot")
+	}
+
+	return &ethpb.DepositSnapshotResponse{
+		Data: &ethpb.DepositSnapshot{
+			Finalized:            snapshot.Finalized,
+			DepositRoot:          snapshot.DepositRoot,
+			DepositCount:         snapshot.DepositCount,
+			ExecutionBlockHash:   snapshot.ExecutionBlockHash,
+			ExecutionBlockHeight: snapshot.ExecutionBlockHeight,
+		},
+	}, nil
+}
@@ -0,0 +1,39 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestGetDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	s := &Server{BeaconDB: beaconDB}
+
+	_, err := s.GetDepositSnapshot(ctx, &emptypb.Empty{})
+	assert.ErrorContains(t, "Could not find deposit snapshot", err)
+
+	snapshot := &protodb.DepositSnapshot{
+		Finalized:            [][]byte{bytesutil.PadTo([]byte("a"), 32), bytesutil.PadTo([]byte("b"), 32)},
+		DepositRoot:          bytesutil.PadTo([]byte("root"), 32),
+		DepositCount:         3,
+		ExecutionBlockHash:   bytesutil.PadTo([]byte("hash"), 32),
+		ExecutionBlockHeight: 100,
+	}
+	require.NoError(t, beaconDB.SaveDepositSnapshot(ctx, snapshot))
+
+	resp, err := s.GetDepositSnapshot(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, snapshot.Finalized, resp.Data.Finalized)
+	assert.DeepEqual(t, snapshot.DepositRoot, resp.Data.DepositRoot)
+	assert.Equal(t, uint64(3), resp.Data.DepositCount)
+	assert.DeepEqual(t, snapshot.ExecutionBlockHash, resp.Data.ExecutionBlockHash)
+	assert.Equal(t, uint64(100), resp.Data.ExecutionBlockHeight)
+}
@@ -0,0 +1,273 @@
+package beacon
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListAttestationRewards returns the components of the attestation rewards and penalties of the requested
+// validators for the given epoch. The rewards of an epoch are applied at the end of the following epoch,
+// so the state at the end of the following epoch is regenerated to compute them.
+func (bs *Server) ListAttestationRewards(ctx context.Context, req *ethpb.AttestationRewardsRequest) (*ethpb.AttestationRewardsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "beaconv1.ListAttestationRewards")
+	defer span.End()
+
+	epoch := types.Epoch(req.Epoch)
+	currentEpoch := helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+	if !attestationRewardsAvailable(epoch, currentEpoch) {
+		return nil, status.Errorf(codes.InvalidArgument, "Attestation rewards of epoch %d are not available before epoch %d", epoch, epoch+2)
+	}
+	st, err := bs.epochEndState(ctx, epoch+1)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get state: %v", err)
+	}
+	deltas, err := attestationDeltas(ctx, st)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute attestation rewards: %v", err)
+	}
+
+	valContainers, err := valContainersByRequestIds(st, req.Id)
+	if err != nil {
+		return nil, handleValContainerErr(err)
+	}
+	rewards := make([]*ethpb.AttestationRewards, len(valContainers))
+	for i, vc := range valContainers {
+		d := deltas[vc.Index]
+		rewards[i] = &ethpb.AttestationRewards{
+			ValidatorIndex:       uint64(vc.Index),
+			SourceReward:         d.SourceReward,
+			SourcePenalty:        d.SourcePenalty,
+			TargetReward:         d.TargetReward,
+			TargetPenalty:        d.TargetPenalty,
+			HeadReward:           d.HeadReward,
+			HeadPenalty:          d.HeadPenalty,
+			InclusionDelayReward: d.InclusionDelayReward,
+			InactivityPenalty:    d.InactivityPenalty,
+		}
+	}
+	return &ethpb.AttestationRewardsResponse{Data: rewards}, nil
+}
+
+// GetBlockRewards returns the reward of the proposer of the given block, broken down by attestations,
+// slashings and sync aggregate. The block is replayed on top of its parent state to compute them.
+func (bs *Server) GetBlockRewards(ctx context.Context, req *ethpb.BlockRewardsRequest) (*ethpb.BlockRewardsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "beaconv1.GetBlockRewards")
+	defer span.End()
+
+	blk, err := bs.blockFromBlockID(ctx, req.BlockId)
+	if invalidBlockIdErr, ok := err.(*blockIdParseError); ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid block ID: %v", invalidBlockIdErr)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get block from block ID: %v", err)
+	}
+	if blk == nil || blk.IsNil() {
+		return nil, status.Errorf(codes.NotFound, "Could not find requested block")
+	}
+	rewards := &ethpb.BlockRewards{ProposerIndex: uint64(blk.Block().ProposerIndex())}
+	// The genesis block has no parent state and no operations.
+	if blk.Block().Slot() == params.BeaconConfig().GenesisSlot {
+		return &ethpb.BlockRewardsResponse{Data: rewards}, nil
+	}
+
+	st, err := bs.StateGenService.StateByRoot(ctx, bytesutil.ToBytes32(blk.Block().ParentRoot()))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get parent state: %v", err)
+	}
+	// The state may be shared with the state caches, copy it before advancing it.
+	st, err = state.ProcessSlots(ctx, st.Copy(), blk.Block().Slot())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not process slots: %v", err)
+	}
+	if err := bs.blockRewards(ctx, st, blk, rewards); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute block rewards: %v", err)
+	}
+	rewards.Total = rewards.Attestations + rewards.ProposerSlashings + rewards.AttesterSlashings + rewards.SyncAggregate
+	return &ethpb.BlockRewardsResponse{Data: rewards}, nil
+}
+
+// blockRewards fills in the reward components of the block proposer. The state must be advanced to the
+// slot of the block.
+func (bs *Server) blockRewards(ctx context.Context, st iface.BeaconState, blk interfaces.SignedBeaconBlock, rewards *ethpb.BlockRewards) error {
+	body := blk.Block().Body()
+	var err error
+	st, err = blocks.ProcessProposerSlashings(ctx, st, body.ProposerSlashings(), slashValidatorWithReward(&rewards.ProposerSlashings))
+	if err != nil {
+		return errors.Wrap(err, "could not process proposer slashings")
+	}
+	st, err = blocks.ProcessAttesterSlashings(ctx, st, body.AttesterSlashings(), slashValidatorWithReward(&rewards.AttesterSlashings))
+	if err != nil {
+		return errors.Wrap(err, "could not process attester slashings")
+	}
+
+	switch blk.Version() {
+	case version.Phase0:
+		rewards.Attestations, err = bs.phase0AttestationsReward(ctx, blk)
+		if err != nil {
+			return err
+		}
+	case version.Altair:
+		// The proposer reward of attestations is applied when they are processed post Altair.
+		proposerIndex := blk.Block().ProposerIndex()
+		before, err := st.BalanceAtIndex(proposerIndex)
+		if err != nil {
+			return err
+		}
+		st, err = altair.ProcessAttestationsNoVerifySignature(ctx, st, blk)
+		if err != nil {
+			return errors.Wrap(err, "could not process attestations")
+		}
+		after, err := st.BalanceAtIndex(proposerIndex)
+		if err != nil {
+			return err
+		}
+		rewards.Attestations = after - before
+
+		sa, err := body.SyncAggregate()
+		if err != nil {
+			return err
+		}
+		activeBalance, err := helpers.TotalActiveBalance(st)
+		if err != nil {
+			return err
+		}
+		_, proposerReward := altair.SyncRewards(activeBalance)
+		rewards.SyncAggregate = proposerReward * sa.SyncCommitteeBits.Count()
+	default:
+		return errors.Errorf("unsupported block version %d", blk.Version())
+	}
+	return nil
+}
+
+// phase0AttestationsReward returns the proposer reward of the attestations included in a phase 0 block.
+// These rewards are applied at the end of the epoch following the epoch of the attestations, so only the
+// rewards of the attestations of the epochs which have already been processed are returned.
+func (bs *Server) phase0AttestationsReward(ctx context.Context, blk interfaces.SignedBeaconBlock) (uint64, error) {
+	blkEpoch := helpers.SlotToEpoch(blk.Block().Slot())
+	currentEpoch := helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+	// A block includes attestations of its epoch and of the previous epoch.
+	epochs := []types.Epoch{blkEpoch}
+	if blkEpoch > 0 {
+		epochs = append(epochs, blkEpoch-1)
+	}
+	var reward uint64
+	for _, epoch := range epochs {
+		if !attestationRewardsAvailable(epoch, currentEpoch) {
+			continue
+		}
+		st, err := bs.epochEndState(ctx, epoch+1)
+		if err != nil {
+			return 0, err
+		}
+		// The attestations of the epoch before the Altair fork are rewarded by the Altair epoch processing,
+		// which has no proposer inclusion reward.
+		if st.Version() != version.Phase0 {
+			continue
+		}
+		vp, bp, err := precompute.New(ctx, st)
+		if err != nil {
+			return 0, err
+		}
+		vp, bp, err = precompute.ProcessAttestations(ctx, st, vp, bp)
+		if err != nil {
+			return 0, err
+		}
+		for _, v := range vp {
+			if v.InclusionSlot == blk.Block().Slot() && v.ProposerIndex == blk.Block().ProposerIndex() {
+				reward += precompute.ProposerInclusionReward(bp, v)
+			}
+		}
+	}
+	return reward, nil
+}
+
+// attestationRewardsAvailable returns true if the rewards of the attestations of the given epoch have been
+// applied by the epoch processing at the end of the following epoch.
+func attestationRewardsAvailable(epoch, currentEpoch types.Epoch) bool {
+	return epoch < currentEpoch && currentEpoch-epoch >= 2
+}
+
+// epochEndState returns a copy of the state at the last slot of the given epoch, before the epoch processing.
+func (bs *Server) epochEndState(ctx context.Context, epoch types.Epoch) (iface.BeaconState, error) {
+	slot, err := helpers.EndSlot(epoch)
+	if err != nil {
+		return nil, err
+	}
+	st, err := bs.StateGenService.StateBySlot(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	return st.Copy(), nil
+}
+
+// attestationDeltas returns the attestation rewards and penalties applied by the epoch processing of the
+// given state, which are the rewards and penalties of the previous epoch.
+func attestationDeltas(ctx context.Context, st iface.BeaconState) ([]*precompute.AttestationDelta, error) {
+	switch st.Version() {
+	case version.Phase0:
+		vp, bp, err := precompute.New(ctx, st)
+		if err != nil {
+			return nil, err
+		}
+		vp, bp, err = precompute.ProcessAttestations(ctx, st, vp, bp)
+		if err != nil {
+			return nil, err
+		}
+		// Justification and finalization are processed before the rewards, which depend on the finalized epoch.
+		st, err = precompute.ProcessJustificationAndFinalizationPreCompute(st, bp)
+		if err != nil {
+			return nil, err
+		}
+		return precompute.AttestationDeltas(st, bp, vp)
+	case version.Altair:
+		vp, bp, err := altair.InitializeEpochValidators(ctx, st)
+		if err != nil {
+			return nil, err
+		}
+		vp, bp, err = altair.ProcessEpochParticipation(ctx, st, bp, vp)
+		if err != nil {
+			return nil, err
+		}
+		st, err = precompute.ProcessJustificationAndFinalizationPreCompute(st, bp)
+		if err != nil {
+			return nil, err
+		}
+		st, vp, err = altair.ProcessInactivityScores(ctx, st, vp)
+		if err != nil {
+			return nil, err
+		}
+		return altair.AttestationDeltas(st, bp, vp)
+	default:
+		return nil, errors.Errorf("unsupported state version %d", st.Version())
+	}
+}
+
+// slashValidatorWithReward slashes a validator and adds the whistleblower reward, which goes to the
+// proposer in full, to the given reward.
+func slashValidatorWithReward(reward *uint64) func(iface.BeaconState, types.ValidatorIndex) (iface.BeaconState, error) {
+	return func(st iface.BeaconState, idx types.ValidatorIndex) (iface.BeaconState, error) {
+		val, err := st.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			return nil, err
+		}
+		*reward += val.EffectiveBalance() / params.BeaconConfig().WhistleBlowerRewardQuotient
+		return validators.SlashValidator(st, idx)
+	}
+}
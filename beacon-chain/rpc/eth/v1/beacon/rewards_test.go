@@ -0,0 +1,318 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	stateAltair "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	eth "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/mathutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	sharedtestutil "github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func rewardsTestValidators(n int) ([]*eth.Validator, []uint64) {
+	validators := make([]*eth.Validator, n)
+	balances := make([]uint64, n)
+	for i := range validators {
+		validators[i] = &eth.Validator{
+			PublicKey:             make([]byte, params.BeaconConfig().BLSPubkeyLength),
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      params.BeaconConfig().MaxEffectiveBalance,
+			ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+			WithdrawableEpoch:     params.BeaconConfig().FarFutureEpoch,
+		}
+		balances[i] = params.BeaconConfig().MaxEffectiveBalance
+	}
+	return validators, balances
+}
+
+func TestServer_ListAttestationRewards(t *testing.T) {
+	ctx := context.Background()
+	cfg := params.BeaconConfig()
+	endSlot, err := helpers.EndSlot(1)
+	require.NoError(t, err)
+	currentSlot, err := helpers.StartSlot(2)
+	require.NoError(t, err)
+
+	t.Run("Phase 0", func(t *testing.T) {
+		validators, balances := rewardsTestValidators(4)
+		st, err := sharedtestutil.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(endSlot))
+		require.NoError(t, st.SetValidators(validators))
+		require.NoError(t, st.SetBalances(balances))
+		stateGen := stategen.NewMockService()
+		stateGen.AddStateForSlot(st, endSlot)
+		s := &Server{
+			GenesisTimeFetcher: &chainMock.ChainService{Slot: &currentSlot},
+			StateGenService:    stateGen,
+		}
+
+		resp, err := s.ListAttestationRewards(ctx, &ethpb.AttestationRewardsRequest{Epoch: 0})
+		require.NoError(t, err)
+		require.Equal(t, len(validators), len(resp.Data))
+		// No validator attested, every validator is penalized for missing the source, target and head.
+		for i, r := range resp.Data {
+			assert.Equal(t, uint64(i), r.ValidatorIndex)
+			assert.NotEqual(t, uint64(0), r.SourcePenalty)
+			assert.Equal(t, r.SourcePenalty, r.TargetPenalty)
+			assert.Equal(t, r.SourcePenalty, r.HeadPenalty)
+			assert.Equal(t, uint64(0), r.SourceReward+r.TargetReward+r.HeadReward+r.InclusionDelayReward)
+		}
+	})
+
+	t.Run("Altair", func(t *testing.T) {
+		validators, balances := rewardsTestValidators(4)
+		allFlags := byte(1<<cfg.TimelySourceFlagIndex | 1<<cfg.TimelyTargetFlagIndex | 1<<cfg.TimelyHeadFlagIndex)
+		st, err := stateAltair.InitializeFromProto(&pb.BeaconStateAltair{
+			Slot:                       endSlot,
+			Validators:                 validators,
+			Balances:                   balances,
+			PreviousEpochParticipation: []byte{allFlags, 0, allFlags, allFlags},
+			CurrentEpochParticipation:  make([]byte, len(validators)),
+			InactivityScores:           []uint64{0, 100, 0, 0},
+			FinalizedCheckpoint:        &eth.Checkpoint{Root: make([]byte, 32)},
+		})
+		require.NoError(t, err)
+		stateGen := stategen.NewMockService()
+		stateGen.AddStateForSlot(st, endSlot)
+		s := &Server{
+			GenesisTimeFetcher: &chainMock.ChainService{Slot: &currentSlot},
+			StateGenService:    stateGen,
+		}
+
+		resp, err := s.ListAttestationRewards(ctx, &ethpb.AttestationRewardsRequest{Epoch: 0, Id: [][]byte{[]byte("0"), []byte("1")}})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, uint64(0), resp.Data[0].ValidatorIndex)
+		assert.NotEqual(t, uint64(0), resp.Data[0].SourceReward)
+		assert.NotEqual(t, uint64(0), resp.Data[0].TargetReward)
+		assert.NotEqual(t, uint64(0), resp.Data[0].HeadReward)
+		assert.Equal(t, uint64(0), resp.Data[0].SourcePenalty+resp.Data[0].TargetPenalty+resp.Data[0].InactivityPenalty)
+		assert.Equal(t, uint64(1), resp.Data[1].ValidatorIndex)
+		assert.NotEqual(t, uint64(0), resp.Data[1].SourcePenalty)
+		assert.NotEqual(t, uint64(0), resp.Data[1].TargetPenalty)
+		assert.NotEqual(t, uint64(0), resp.Data[1].InactivityPenalty)
+		assert.Equal(t, uint64(0), resp.Data[1].HeadPenalty)
+		// The requested state must not be modified.
+		scores, err := st.InactivityScores()
+		require.NoError(t, err)
+		assert.DeepEqual(t, []uint64{0, 100, 0, 0}, scores)
+	})
+
+	t.Run("Epoch not processed yet", func(t *testing.T) {
+		s := &Server{
+			GenesisTimeFetcher: &chainMock.ChainService{Slot: &currentSlot},
+		}
+		_, err := s.ListAttestationRewards(ctx, &ethpb.AttestationRewardsRequest{Epoch: 1})
+		assert.ErrorContains(t, "Attestation rewards of epoch 1 are not available before epoch 3", err)
+	})
+
+	t.Run("Invalid validator ID", func(t *testing.T) {
+		validators, balances := rewardsTestValidators(4)
+		st, err := sharedtestutil.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(endSlot))
+		require.NoError(t, st.SetValidators(validators))
+		require.NoError(t, st.SetBalances(balances))
+		stateGen := stategen.NewMockService()
+		stateGen.AddStateForSlot(st, endSlot)
+		s := &Server{
+			GenesisTimeFetcher: &chainMock.ChainService{Slot: &currentSlot},
+			StateGenService:    stateGen,
+		}
+		_, err = s.ListAttestationRewards(ctx, &ethpb.AttestationRewardsRequest{Epoch: 0, Id: [][]byte{[]byte("foo")}})
+		assert.ErrorContains(t, "Invalid validator ID", err)
+	})
+}
+
+func TestServer_GetBlockRewards_Genesis(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	genesis := sharedtestutil.NewBeaconBlock()
+	require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(genesis)))
+	root, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, root))
+	s := &Server{BeaconDB: beaconDB}
+
+	resp, err := s.GetBlockRewards(ctx, &ethpb.BlockRewardsRequest{BlockId: []byte("genesis")})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), resp.Data.Total)
+
+	_, err = s.GetBlockRewards(ctx, &ethpb.BlockRewardsRequest{BlockId: []byte("foo")})
+	assert.ErrorContains(t, "Invalid block ID", err)
+}
+
+func TestServer_BlockRewards_Altair(t *testing.T) {
+	ctx := context.Background()
+	validators, balances := rewardsTestValidators(8)
+	st, err := stateAltair.InitializeFromProto(&pb.BeaconStateAltair{
+		Slot:                       1,
+		Validators:                 validators,
+		Balances:                   balances,
+		PreviousEpochParticipation: make([]byte, len(validators)),
+		CurrentEpochParticipation:  make([]byte, len(validators)),
+		InactivityScores:           make([]uint64, len(validators)),
+	})
+	require.NoError(t, err)
+
+	syncBits := bitfield.NewBitvector512()
+	syncBits.SetBitAt(0, true)
+	syncBits.SetBitAt(3, true)
+	syncBits.SetBitAt(5, true)
+	blk, err := wrapper.WrappedAltairSignedBeaconBlock(&prysmv2.SignedBeaconBlockAltair{
+		Block: &prysmv2.BeaconBlockAltair{
+			Slot: 1,
+			Body: &prysmv2.BeaconBlockBodyAltair{
+				SyncAggregate: &prysmv2.SyncAggregate{SyncCommitteeBits: syncBits},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	s := &Server{}
+	rewards := &ethpb.BlockRewards{}
+	require.NoError(t, s.blockRewards(ctx, st, blk, rewards))
+	activeBalance, err := helpers.TotalActiveBalance(st)
+	require.NoError(t, err)
+	_, proposerReward := altair.SyncRewards(activeBalance)
+	assert.Equal(t, 3*proposerReward, rewards.SyncAggregate)
+	assert.Equal(t, uint64(0), rewards.Attestations)
+	assert.Equal(t, uint64(0), rewards.ProposerSlashings)
+	assert.Equal(t, uint64(0), rewards.AttesterSlashings)
+}
+
+func TestSlashValidatorWithReward(t *testing.T) {
+	validators, balances := rewardsTestValidators(4)
+	st, err := sharedtestutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetValidators(validators))
+	require.NoError(t, st.SetBalances(balances))
+
+	var reward uint64
+	slash := slashValidatorWithReward(&reward)
+	st2, err := slash(st, 2)
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance/params.BeaconConfig().WhistleBlowerRewardQuotient, reward)
+	v, err := st2.ValidatorAtIndexReadOnly(2)
+	require.NoError(t, err)
+	assert.Equal(t, true, v.Slashed())
+}
+
+func TestServer_GetBlockRewards_Phase0(t *testing.T) {
+	ctx := context.Background()
+	cfg := params.BeaconConfig()
+	genesisState, privKeys := sharedtestutil.DeterministicGenesisState(t, 64)
+	blkSlot, err := helpers.StartSlot(1)
+	require.NoError(t, err)
+	blkSlot++
+	proposerIndex := types.ValidatorIndex(7)
+
+	// The attestations of epoch 0 are found in the state at the end of epoch 1.
+	prevEndSlot, err := helpers.EndSlot(1)
+	require.NoError(t, err)
+	prevEndState := genesisState.Copy()
+	require.NoError(t, prevEndState.SetSlot(prevEndSlot))
+	pendingAtt := func(slot, inclusionSlot types.Slot, proposer types.ValidatorIndex) *pb.PendingAttestation {
+		committee, err := helpers.BeaconCommitteeFromState(prevEndState, slot, 0)
+		require.NoError(t, err)
+		bits := bitfield.NewBitlist(uint64(len(committee)))
+		for i := range committee {
+			bits.SetBitAt(uint64(i), true)
+		}
+		return &pb.PendingAttestation{
+			AggregationBits: bits,
+			Data: &eth.AttestationData{
+				Slot:            slot,
+				BeaconBlockRoot: make([]byte, 32),
+				Source:          &eth.Checkpoint{Root: make([]byte, 32)},
+				Target:          &eth.Checkpoint{Root: make([]byte, 32)},
+			},
+			InclusionDelay: inclusionSlot - slot,
+			ProposerIndex:  proposer,
+		}
+	}
+	require.NoError(t, prevEndState.AppendPreviousEpochAttestations(pendingAtt(1, blkSlot, proposerIndex)))
+	// Included in another block, or by another proposer.
+	require.NoError(t, prevEndState.AppendPreviousEpochAttestations(pendingAtt(2, blkSlot+1, proposerIndex)))
+	require.NoError(t, prevEndState.AppendPreviousEpochAttestations(pendingAtt(3, blkSlot, proposerIndex+1)))
+	endSlot, err := helpers.EndSlot(2)
+	require.NoError(t, err)
+	endState := genesisState.Copy()
+	require.NoError(t, endState.SetSlot(endSlot))
+
+	slashing, err := sharedtestutil.GenerateProposerSlashingForValidator(genesisState, privKeys[5], 5)
+	require.NoError(t, err)
+	blk := sharedtestutil.NewBeaconBlock()
+	blk.Block.Slot = blkSlot
+	blk.Block.ProposerIndex = proposerIndex
+	blk.Block.Body.ProposerSlashings = []*eth.ProposerSlashing{slashing}
+	parentRoot := bytesutil.ToBytes32(blk.Block.ParentRoot)
+	beaconDB := dbTest.SetupDB(t)
+	require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(blk)))
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	stateGen := stategen.NewMockService()
+	stateGen.AddStateForRoot(genesisState, parentRoot)
+	stateGen.AddStateForSlot(prevEndState, prevEndSlot)
+	stateGen.AddStateForSlot(endState, endSlot)
+
+	committee, err := helpers.BeaconCommitteeFromState(prevEndState, 1, 0)
+	require.NoError(t, err)
+	activeBalance := uint64(len(genesisState.Validators())) * cfg.MaxEffectiveBalance
+	baseReward := cfg.MaxEffectiveBalance * cfg.BaseRewardFactor / mathutil.IntegerSquareRoot(activeBalance) / cfg.BaseRewardsPerEpoch
+	attestationsReward := uint64(len(committee)) * (baseReward / cfg.ProposerRewardQuotient)
+	slashingReward := cfg.MaxEffectiveBalance / cfg.WhistleBlowerRewardQuotient
+
+	t.Run("Attestations processed", func(t *testing.T) {
+		currentSlot, err := helpers.StartSlot(3)
+		require.NoError(t, err)
+		s := &Server{
+			BeaconDB:           beaconDB,
+			GenesisTimeFetcher: &chainMock.ChainService{Slot: &currentSlot},
+			StateGenService:    stateGen,
+		}
+
+		resp, err := s.GetBlockRewards(ctx, &ethpb.BlockRewardsRequest{BlockId: root[:]})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(proposerIndex), resp.Data.ProposerIndex)
+		assert.NotEqual(t, uint64(0), attestationsReward)
+		assert.Equal(t, attestationsReward, resp.Data.Attestations)
+		assert.Equal(t, slashingReward, resp.Data.ProposerSlashings)
+		assert.Equal(t, uint64(0), resp.Data.AttesterSlashings)
+		assert.Equal(t, attestationsReward+slashingReward, resp.Data.Total)
+	})
+
+	t.Run("Attestations not processed yet", func(t *testing.T) {
+		currentSlot, err := helpers.StartSlot(1)
+		require.NoError(t, err)
+		s := &Server{
+			BeaconDB:           beaconDB,
+			GenesisTimeFetcher: &chainMock.ChainService{Slot: &currentSlot},
+			StateGenService:    stateGen,
+		}
+
+		resp, err := s.GetBlockRewards(ctx, &ethpb.BlockRewardsRequest{BlockId: root[:]})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(0), resp.Data.Attestations)
+		assert.Equal(t, slashingReward, resp.Data.ProposerSlashings)
+		assert.Equal(t, slashingReward, resp.Data.Total)
+	})
+}
@@ -3,8 +3,10 @@ package debug
 import (
 	"context"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -77,3 +79,65 @@ func (ds *Server) ListForkChoiceHeads(ctx context.Context, _ *emptypb.Empty) (*e
 
 	return resp, nil
 }
+
+// GetForkChoice returns the full fork choice store, including the latest votes of the validators.
+func (ds *Server) GetForkChoice(ctx context.Context, _ *emptypb.Empty) (*ethpb.ForkChoiceResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "debugv1.GetForkChoice")
+	defer span.End()
+
+	fc := ds.HeadFetcher.ForkChoiceGetter()
+	if fc == nil {
+		return nil, status.Error(codes.Internal, "Fork choice store is not available")
+	}
+	store := fc.Store()
+	nodes := fc.Nodes()
+
+	nodeRoot := func(index uint64) []byte {
+		if index == protoarray.NonExistentNode || index >= uint64(len(nodes)) {
+			return []byte{}
+		}
+		r := nodes[index].Root()
+		return r[:]
+	}
+	respNodes := make([]*ethpb.ForkChoiceNode, len(nodes))
+	for i, n := range nodes {
+		r := n.Root()
+		respNodes[i] = &ethpb.ForkChoiceNode{
+			Slot:           uint64(n.Slot()),
+			Root:           r[:],
+			ParentRoot:     nodeRoot(n.Parent()),
+			JustifiedEpoch: uint64(n.JustifiedEpoch()),
+			FinalizedEpoch: uint64(n.FinalizedEpoch()),
+			Weight:         n.Weight(),
+			BestChild:      nodeRoot(n.BestChild()),
+			BestDescendant: nodeRoot(n.BestDescendant()),
+			Canonical:      fc.IsCanonical(r),
+		}
+	}
+
+	votes := fc.Votes()
+	respVotes := make([]*ethpb.ForkChoiceVote, 0, len(votes))
+	for i, v := range votes {
+		current, next := v.CurrentRoot(), v.NextRoot()
+		// Skip validators which have not voted yet.
+		if current == params.BeaconConfig().ZeroHash && next == params.BeaconConfig().ZeroHash {
+			continue
+		}
+		respVotes = append(respVotes, &ethpb.ForkChoiceVote{
+			ValidatorIndex: uint64(i),
+			CurrentRoot:    current[:],
+			NextRoot:       next[:],
+			NextEpoch:      uint64(v.NextEpoch()),
+		})
+	}
+
+	return &ethpb.ForkChoiceResponse{
+		Data: &ethpb.ForkChoice{
+			JustifiedEpoch: uint64(store.JustifiedEpoch()),
+			FinalizedEpoch: uint64(store.FinalizedEpoch()),
+			PruneThreshold: store.PruneThreshold(),
+			Nodes:          respNodes,
+			Votes:          respVotes,
+		},
+	}, nil
+}
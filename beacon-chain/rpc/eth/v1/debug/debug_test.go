@@ -6,6 +6,7 @@ import (
 
 	types "github.com/prysmaticlabs/eth2-types"
 	blockchainmock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/testutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -81,3 +82,52 @@ func TestListForkChoiceHeads(t *testing.T) {
 		assert.Equal(t, true, found, "Expected head not found")
 	}
 }
+
+func TestGetForkChoice(t *testing.T) {
+	ctx := context.Background()
+
+	fc := protoarray.New(0, 0, [32]byte{})
+	rootA := bytesutil.ToBytes32(bytesutil.PadTo([]byte("a"), 32))
+	rootB := bytesutil.ToBytes32(bytesutil.PadTo([]byte("b"), 32))
+	require.NoError(t, fc.ProcessBlock(ctx, 1, rootA, [32]byte{}, [32]byte{}, 0, 0))
+	require.NoError(t, fc.ProcessBlock(ctx, 2, rootB, rootA, [32]byte{}, 0, 0))
+	fc.ProcessAttestation(ctx, []uint64{1}, rootB, 1)
+	_, err := fc.Head(ctx, 0, rootA, []uint64{10, 10}, 0)
+	require.NoError(t, err)
+
+	server := &Server{
+		HeadFetcher: &blockchainmock.ChainService{ForkChoice: fc},
+	}
+	resp, err := server.GetForkChoice(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Data.Nodes))
+	a, b := resp.Data.Nodes[0], resp.Data.Nodes[1]
+	assert.Equal(t, uint64(1), a.Slot)
+	assert.DeepEqual(t, rootA[:], a.Root)
+	assert.DeepEqual(t, []byte{}, a.ParentRoot)
+	assert.DeepEqual(t, rootB[:], a.BestChild)
+	assert.DeepEqual(t, rootB[:], a.BestDescendant)
+	assert.Equal(t, uint64(10), a.Weight)
+	assert.Equal(t, true, a.Canonical)
+	assert.Equal(t, uint64(2), b.Slot)
+	assert.DeepEqual(t, rootA[:], b.ParentRoot)
+	assert.DeepEqual(t, []byte{}, b.BestChild)
+	assert.Equal(t, uint64(10), b.Weight)
+	assert.Equal(t, true, b.Canonical)
+
+	// Only the validator which has voted is returned.
+	require.Equal(t, 1, len(resp.Data.Votes))
+	v := resp.Data.Votes[0]
+	assert.Equal(t, uint64(1), v.ValidatorIndex)
+	assert.DeepEqual(t, rootB[:], v.CurrentRoot)
+	assert.DeepEqual(t, rootB[:], v.NextRoot)
+	assert.Equal(t, uint64(1), v.NextEpoch)
+}
+
+func TestGetForkChoice_NoStore(t *testing.T) {
+	server := &Server{
+		HeadFetcher: &blockchainmock.ChainService{},
+	}
+	_, err := server.GetForkChoice(context.Background(), &emptypb.Empty{})
+	assert.ErrorContains(t, "Fork choice store is not available", err)
+}
@@ -1,47 +1,417 @@
 package validator
 
 import (
+	"bytes"
 	"context"
 
 	emptypb "github.com/golang/protobuf/ptypes/empty"
-	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	v1alpha1validator "github.com/prysmaticlabs/prysm/beacon-chain/rpc/prysm/v1alpha1/validator"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	statev1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbalpha "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // GetAttesterDuties requests the beacon node to provide a set of attestation duties,
 // which should be performed by validators, for a particular epoch.
 func (vs *Server) GetAttesterDuties(ctx context.Context, req *ethpb.AttesterDutiesRequest) (*ethpb.AttesterDutiesResponse, error) {
-	return nil, errors.New("Unimplemented")
+	ctx, span := trace.StartSpan(ctx, "validatorv1.GetAttesterDuties")
+	defer span.End()
+
+	if vs.SyncChecker.Syncing() {
+		return nil, status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+
+	cs := vs.TimeFetcher.CurrentSlot()
+	currentEpoch := helpers.SlotToEpoch(cs)
+	if req.Epoch > currentEpoch+1 {
+		return nil, status.Errorf(codes.InvalidArgument, "Request epoch %d can not be greater than next epoch %d", req.Epoch, currentEpoch+1)
+	}
+
+	s, err := vs.advancedHeadState(ctx, req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	// The dependent root has to be looked up before computing assignments, which move the state slot.
+	root, err := vs.attestationDependentRoot(ctx, s, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get dependent root: %v", err)
+	}
+
+	committeeAssignments, _, err := helpers.CommitteeAssignments(s, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute committee assignments: %v", err)
+	}
+	activeValidatorCount, err := helpers.ActiveValidatorCount(s, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get active validator count: %v", err)
+	}
+	committeesAtSlot := helpers.SlotCommitteeCount(activeValidatorCount)
+
+	duties := make([]*ethpb.AttesterDuty, 0, len(req.Index))
+	for _, index := range req.Index {
+		pubkey := s.PubkeyAtIndex(index)
+		zeroPubkey := [48]byte{}
+		if bytes.Equal(pubkey[:], zeroPubkey[:]) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid validator index %d", index)
+		}
+		committee := committeeAssignments[index]
+		if committee == nil {
+			continue
+		}
+		var valIndexInCommittee types.CommitteeIndex
+		// valIndexInCommittee will be 0 in case we don't get a match. This is a potential false positive,
+		// however it's an impossible condition because every validator must be assigned to a committee.
+		for cIndex, vIndex := range committee.Committee {
+			if vIndex == index {
+				valIndexInCommittee = types.CommitteeIndex(uint64(cIndex))
+				break
+			}
+		}
+		duties = append(duties, &ethpb.AttesterDuty{
+			Pubkey:                  pubkey[:],
+			ValidatorIndex:          index,
+			CommitteeIndex:          committee.CommitteeIndex,
+			CommitteeLength:         uint64(len(committee.Committee)),
+			CommitteesAtSlot:        committeesAtSlot,
+			ValidatorCommitteeIndex: valIndexInCommittee,
+			Slot:                    committee.AttesterSlot,
+		})
+	}
+
+	return &ethpb.AttesterDutiesResponse{
+		DependentRoot: root,
+		Data:          duties,
+	}, nil
 }
 
 // GetProposerDuties requests beacon node to provide all validators that are scheduled to propose a block in the given epoch.
 func (vs *Server) GetProposerDuties(ctx context.Context, req *ethpb.ProposerDutiesRequest) (*ethpb.ProposerDutiesResponse, error) {
-	return nil, errors.New("Unimplemented")
+	ctx, span := trace.StartSpan(ctx, "validatorv1.GetProposerDuties")
+	defer span.End()
+
+	if vs.SyncChecker.Syncing() {
+		return nil, status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+
+	cs := vs.TimeFetcher.CurrentSlot()
+	currentEpoch := helpers.SlotToEpoch(cs)
+	if req.Epoch > currentEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "Request epoch %d can not be greater than current epoch %d", req.Epoch, currentEpoch)
+	}
+
+	s, err := vs.advancedHeadState(ctx, req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	// The dependent root has to be looked up before computing assignments, which move the state slot.
+	root, err := vs.proposalDependentRoot(ctx, s, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get dependent root: %v", err)
+	}
+
+	_, proposals, err := helpers.CommitteeAssignments(s, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute committee assignments: %v", err)
+	}
+
+	duties := make([]*ethpb.ProposerDuty, 0)
+	for index, slots := range proposals {
+		pubkey := s.PubkeyAtIndex(index)
+		for _, slot := range slots {
+			duties = append(duties, &ethpb.ProposerDuty{
+				Pubkey:         pubkey[:],
+				ValidatorIndex: index,
+				Slot:           slot,
+			})
+		}
+	}
+
+	return &ethpb.ProposerDutiesResponse{
+		DependentRoot: root,
+		Data:          duties,
+	}, nil
 }
 
 // ProduceBlock requests the beacon node to produce a valid unsigned beacon block, which can then be signed by a proposer and submitted.
 func (vs *Server) ProduceBlock(ctx context.Context, req *ethpb.ProduceBlockRequest) (*ethpb.ProduceBlockResponse, error) {
-	return nil, errors.New("Unimplemented")
+	ctx, span := trace.StartSpan(ctx, "validatorv1.ProduceBlock")
+	defer span.End()
+
+	v1alpha1req := &ethpbalpha.BlockRequest{
+		Slot:         req.Slot,
+		RandaoReveal: req.RandaoReveal,
+		Graffiti:     req.Graffiti,
+	}
+	v1alpha1resp, err := vs.V1Alpha1Server.GetBlock(ctx, v1alpha1req)
+	if err != nil {
+		// We simply return err because it's already of a gRPC error type.
+		return nil, err
+	}
+	block, err := migration.V1Alpha1ToV1Block(&ethpbalpha.SignedBeaconBlock{Block: v1alpha1resp})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not prepare beacon block: %v", err)
+	}
+	return &ethpb.ProduceBlockResponse{Data: block.Block}, nil
+}
+
+// ProduceBlockSSZ requests the beacon node to produce a valid unsigned beacon block, which can then be
+// signed by a proposer and submitted. The block is returned ssz encoded.
+func (vs *Server) ProduceBlockSSZ(ctx context.Context, req *ethpb.ProduceBlockRequest) (*ethpb.SSZContainer, error) {
+	ctx, span := trace.StartSpan(ctx, "validatorv1.ProduceBlockSSZ")
+	defer span.End()
+
+	resp, err := vs.ProduceBlock(ctx, req)
+	if err != nil {
+		// We simply return err because it's already of a gRPC error type.
+		return nil, err
+	}
+	sszBlock, err := resp.Data.MarshalSSZ()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not marshal block into SSZ: %v", err)
+	}
+	return &ethpb.SSZContainer{Data: sszBlock}, nil
 }
 
 // ProduceAttestationData requests that the beacon node produces attestation data for
 // the requested committee index and slot based on the nodes current head.
 func (vs *Server) ProduceAttestationData(ctx context.Context, req *ethpb.ProduceAttestationDataRequest) (*ethpb.ProduceAttestationDataResponse, error) {
-	return nil, errors.New("Unimplemented")
+	ctx, span := trace.StartSpan(ctx, "validatorv1.ProduceAttestationData")
+	defer span.End()
+
+	v1alpha1req := &ethpbalpha.AttestationDataRequest{
+		Slot:           req.Slot,
+		CommitteeIndex: req.CommitteeIndex,
+	}
+	v1alpha1resp, err := vs.V1Alpha1Server.GetAttestationData(ctx, v1alpha1req)
+	if err != nil {
+		// We simply return err because it's already of a gRPC error type.
+		return nil, err
+	}
+	attData := migration.V1Alpha1AttDataToV1(v1alpha1resp)
+
+	return &ethpb.ProduceAttestationDataResponse{Data: attData}, nil
 }
 
 // GetAggregateAttestation aggregates all attestations matching the given attestation data root and slot, returning the aggregated result.
 func (vs *Server) GetAggregateAttestation(ctx context.Context, req *ethpb.AggregateAttestationRequest) (*ethpb.AggregateAttestationResponse, error) {
-	return nil, errors.New("Unimplemented")
+	ctx, span := trace.StartSpan(ctx, "validatorv1.GetAggregateAttestation")
+	defer span.End()
+
+	if err := vs.AttestationsPool.AggregateUnaggregatedAttestations(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not aggregate unaggregated attestations: %v", err)
+	}
+	allAtts := vs.AttestationsPool.AggregatedAttestations()
+	unaggAtts, err := vs.AttestationsPool.UnaggregatedAttestations()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get unaggregated attestations: %v", err)
+	}
+	allAtts = append(allAtts, unaggAtts...)
+
+	var bestMatchingAtt *ethpbalpha.Attestation
+	for _, att := range allAtts {
+		if att.Data.Slot != req.Slot {
+			continue
+		}
+		root, err := att.Data.HashTreeRoot()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get attestation data root: %v", err)
+		}
+		if !bytes.Equal(root[:], req.AttestationDataRoot) {
+			continue
+		}
+		if bestMatchingAtt == nil || att.AggregationBits.Count() > bestMatchingAtt.AggregationBits.Count() {
+			bestMatchingAtt = att
+		}
+	}
+	if bestMatchingAtt == nil {
+		return nil, status.Error(codes.InvalidArgument, "No matching attestation found")
+	}
+	return &ethpb.AggregateAttestationResponse{Data: migration.V1Alpha1AttestationToV1(bestMatchingAtt)}, nil
 }
 
 // SubmitAggregateAndProofs verifies given aggregate and proofs and publishes them on appropriate gossipsub topic.
 func (vs *Server) SubmitAggregateAndProofs(ctx context.Context, req *ethpb.SubmitAggregateAndProofsRequest) (*emptypb.Empty, error) {
-	return nil, errors.New("Unimplemented")
+	ctx, span := trace.StartSpan(ctx, "validatorv1.SubmitAggregateAndProofs")
+	defer span.End()
+
+	sigLen := params.BeaconConfig().BLSSignatureLength
+	emptySig := make([]byte, sigLen)
+	for _, agg := range req.Data {
+		if agg == nil || agg.Message == nil || agg.Message.Aggregate == nil || agg.Message.Aggregate.Data == nil {
+			return nil, status.Error(codes.InvalidArgument, "Signed aggregate request can't be nil")
+		}
+		if bytes.Equal(agg.Signature, emptySig) || bytes.Equal(agg.Message.SelectionProof, emptySig) {
+			return nil, status.Error(codes.InvalidArgument, "Signed signatures can't be zero hashes")
+		}
+		if len(agg.Signature) != sigLen || len(agg.Message.SelectionProof) != sigLen {
+			return nil, status.Errorf(codes.InvalidArgument, "Incorrect signature length. Expected %d bytes", sigLen)
+		}
+
+		// As a preventive measure, a beacon node shouldn't broadcast an attestation whose slot is out of range.
+		if err := helpers.ValidateAttestationTime(agg.Message.Aggregate.Data.Slot,
+			vs.TimeFetcher.GenesisTime(), params.BeaconNetworkConfig().MaximumGossipClockDisparity); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Attestation slot is no longer valid from current time")
+		}
+	}
+
+	broadcastFailed := false
+	for _, agg := range req.Data {
+		if err := vs.Broadcaster.Broadcast(ctx, migration.V1SignedAggregateAttAndProofToV1Alpha1(agg)); err != nil {
+			broadcastFailed = true
+			continue
+		}
+
+		log.WithFields(logrus.Fields{
+			"slot":            agg.Message.Aggregate.Data.Slot,
+			"committeeIndex":  agg.Message.Aggregate.Data.Index,
+			"validatorIndex":  agg.Message.AggregatorIndex,
+			"aggregatedCount": agg.Message.Aggregate.AggregationBits.Count(),
+		}).Debug("Broadcasting aggregated attestation and proof")
+	}
+
+	if broadcastFailed {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Could not broadcast one or more signed aggregated attestations")
+	}
+
+	return &emptypb.Empty{}, nil
 }
 
 // SubmitBeaconCommitteeSubscription searches using discv5 for peers related to the provided subnet information
 // and replaces current peers with those ones if necessary.
 func (vs *Server) SubmitBeaconCommitteeSubscription(ctx context.Context, req *ethpb.SubmitBeaconCommitteeSubscriptionsRequest) (*emptypb.Empty, error) {
-	return nil, errors.New("Unimplemented")
+	ctx, span := trace.StartSpan(ctx, "validatorv1.SubmitBeaconCommitteeSubscription")
+	defer span.End()
+
+	if vs.SyncChecker.Syncing() {
+		return nil, status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+	if len(req.Data) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No subscriptions provided")
+	}
+
+	s, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+
+	// Verify validators at the beginning to return early if request is invalid.
+	validators := make([]iface.ReadOnlyValidator, len(req.Data))
+	for i, sub := range req.Data {
+		val, err := s.ValidatorAtIndexReadOnly(sub.ValidatorIndex)
+		if outOfRangeErr, ok := err.(*statev1.ValidatorIndexOutOfRangeError); ok {
+			return nil, status.Errorf(codes.InvalidArgument, "Could not get validator: %v", outOfRangeErr)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get validator: %v", err)
+		}
+		validators[i] = val
+	}
+
+	fetchValsLen := func(slot types.Slot) (uint64, error) {
+		wantedEpoch := helpers.SlotToEpoch(slot)
+		vals, err := vs.HeadFetcher.HeadValidatorsIndices(ctx, wantedEpoch)
+		if err != nil {
+			return 0, err
+		}
+		return uint64(len(vals)), nil
+	}
+
+	// Request the head validator indices of epoch represented by the first requested slot.
+	currValsLen, err := fetchValsLen(req.Data[0].Slot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve head validator length: %v", err)
+	}
+	currEpoch := helpers.SlotToEpoch(req.Data[0].Slot)
+
+	for i, sub := range req.Data {
+		// If epoch has changed, re-request active validators length.
+		if currEpoch != helpers.SlotToEpoch(sub.Slot) {
+			currValsLen, err = fetchValsLen(sub.Slot)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not retrieve head validator length: %v", err)
+			}
+			currEpoch = helpers.SlotToEpoch(sub.Slot)
+		}
+		subnet := helpers.ComputeSubnetFromCommitteeAndSlot(currValsLen, sub.CommitteeIndex, sub.Slot)
+		cache.SubnetIDs.AddAttesterSubnetID(sub.Slot, subnet)
+		if sub.IsAggregator {
+			cache.SubnetIDs.AddAggregatorSubnetID(sub.Slot, subnet)
+		}
+		if helpers.IsActiveValidatorUsingTrie(validators[i], currEpoch) {
+			pubkey := validators[i].PublicKey()
+			v1alpha1validator.AssignValidatorToSubnet(pubkey[:], ethpbalpha.ValidatorStatus_ACTIVE)
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// advancedHeadState returns the head state advanced with empty slots up to the start slot
+// of the requested epoch, if the head is behind it.
+func (vs *Server) advancedHeadState(ctx context.Context, epoch types.Epoch) (iface.BeaconState, error) {
+	s, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	epochStartSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get start slot from epoch %d: %v", epoch, err)
+	}
+	if s.Slot() < epochStartSlot {
+		s, err = state.ProcessSlots(ctx, s, epochStartSlot)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not process slots up to %d: %v", epochStartSlot, err)
+		}
+	}
+	return s, nil
+}
+
+// attestationDependentRoot is get_block_root_at_slot(state, compute_start_slot_at_epoch(epoch - 1) - 1)
+// or the genesis block root in the case of underflow.
+func (vs *Server) attestationDependentRoot(ctx context.Context, s iface.BeaconState, epoch types.Epoch) ([]byte, error) {
+	var dependentRootSlot types.Slot
+	if epoch > 1 {
+		prevEpochStartSlot, err := helpers.StartSlot(epoch.Sub(1))
+		if err != nil {
+			return nil, err
+		}
+		dependentRootSlot = prevEpochStartSlot.Sub(1)
+	}
+	return vs.blockRootAtSlot(ctx, s, dependentRootSlot)
+}
+
+// proposalDependentRoot is get_block_root_at_slot(state, compute_start_slot_at_epoch(epoch) - 1)
+// or the genesis block root in the case of underflow.
+func (vs *Server) proposalDependentRoot(ctx context.Context, s iface.BeaconState, epoch types.Epoch) ([]byte, error) {
+	var dependentRootSlot types.Slot
+	if epoch > 0 {
+		epochStartSlot, err := helpers.StartSlot(epoch)
+		if err != nil {
+			return nil, err
+		}
+		dependentRootSlot = epochStartSlot.Sub(1)
+	}
+	return vs.blockRootAtSlot(ctx, s, dependentRootSlot)
+}
+
+// blockRootAtSlot returns the block root at the given slot from the state's history. When the state has
+// not advanced past the slot yet, the latest block at that slot is the current head block.
+func (vs *Server) blockRootAtSlot(ctx context.Context, s iface.BeaconState, slot types.Slot) ([]byte, error) {
+	if s.Slot() <= slot {
+		return vs.HeadFetcher.HeadRoot(ctx)
+	}
+	return helpers.BlockRootAtSlot(s, slot)
 }
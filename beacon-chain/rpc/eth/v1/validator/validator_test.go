@@ -0,0 +1,584 @@
+package validator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	p2pmock "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	v1alpha1validator "github.com/prysmaticlabs/prysm/beacon-chain/rpc/prysm/v1alpha1/validator"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbalpha "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestGetAttesterDuties(t *testing.T) {
+	ctx := context.Background()
+	bs, _ := testutil.DeterministicGenesisState(t, 64)
+	// The genesis block root is the root of the genesis state's latest block header,
+	// once its state root has been filled in.
+	stateRoot, err := bs.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	header := bs.LatestBlockHeader()
+	header.StateRoot = stateRoot[:]
+	genesisRoot, err := header.HashTreeRoot()
+	require.NoError(t, err, "Could not get genesis root")
+
+	// Computing assignments moves the slot of the head state, so every call gets its own copy.
+	newServer := func() *Server {
+		chain := &mockChain.ChainService{
+			State: bs.Copy(), Root: genesisRoot[:], Genesis: time.Now(),
+		}
+		return &Server{
+			HeadFetcher: chain,
+			TimeFetcher: chain,
+			SyncChecker: &mockSync.Sync{IsSyncing: false},
+		}
+	}
+
+	t.Run("Single validator", func(t *testing.T) {
+		req := &ethpb.AttesterDutiesRequest{
+			Epoch: 0,
+			Index: []types.ValidatorIndex{0},
+		}
+		resp, err := newServer().GetAttesterDuties(ctx, req)
+		require.NoError(t, err)
+		assert.DeepEqual(t, genesisRoot[:], resp.DependentRoot)
+		require.Equal(t, 1, len(resp.Data))
+		duty := resp.Data[0]
+		pubkey := bs.PubkeyAtIndex(0)
+		assert.DeepEqual(t, pubkey[:], duty.Pubkey)
+		assert.Equal(t, types.ValidatorIndex(0), duty.ValidatorIndex)
+		assert.Equal(t, uint64(1), duty.CommitteesAtSlot)
+		assert.Equal(t, uint64(2), duty.CommitteeLength)
+		assert.Equal(t, true, duty.Slot < params.BeaconConfig().SlotsPerEpoch)
+	})
+
+	t.Run("Multiple validators", func(t *testing.T) {
+		req := &ethpb.AttesterDutiesRequest{
+			Epoch: 0,
+			Index: []types.ValidatorIndex{0, 1},
+		}
+		resp, err := newServer().GetAttesterDuties(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, 2, len(resp.Data))
+	})
+
+	t.Run("Next epoch", func(t *testing.T) {
+		req := &ethpb.AttesterDutiesRequest{
+			Epoch: helpers.SlotToEpoch(bs.Slot()) + 1,
+			Index: []types.ValidatorIndex{0},
+		}
+		resp, err := newServer().GetAttesterDuties(ctx, req)
+		require.NoError(t, err)
+		assert.DeepEqual(t, genesisRoot[:], resp.DependentRoot)
+		require.Equal(t, 1, len(resp.Data))
+		duty := resp.Data[0]
+		assert.Equal(t, true, duty.Slot >= params.BeaconConfig().SlotsPerEpoch)
+		assert.Equal(t, true, duty.Slot < 2*params.BeaconConfig().SlotsPerEpoch)
+	})
+
+	t.Run("Epoch out of bound", func(t *testing.T) {
+		currentEpoch := helpers.SlotToEpoch(bs.Slot())
+		req := &ethpb.AttesterDutiesRequest{
+			Epoch: currentEpoch + 2,
+			Index: []types.ValidatorIndex{0},
+		}
+		_, err := newServer().GetAttesterDuties(ctx, req)
+		require.ErrorContains(t, "can not be greater than next epoch", err)
+	})
+
+	t.Run("Validator index out of bound", func(t *testing.T) {
+		req := &ethpb.AttesterDutiesRequest{
+			Epoch: 0,
+			Index: []types.ValidatorIndex{types.ValidatorIndex(len(bs.Validators()))},
+		}
+		_, err := newServer().GetAttesterDuties(ctx, req)
+		require.ErrorContains(t, "Invalid validator index", err)
+	})
+}
+
+func TestGetAttesterDuties_SyncNotReady(t *testing.T) {
+	vs := &Server{
+		SyncChecker: &mockSync.Sync{IsSyncing: true},
+	}
+	_, err := vs.GetAttesterDuties(context.Background(), &ethpb.AttesterDutiesRequest{})
+	assert.ErrorContains(t, "Syncing to latest head, not ready to respond", err)
+}
+
+func TestGetProposerDuties(t *testing.T) {
+	ctx := context.Background()
+	genesis := testutil.NewBeaconBlock()
+	bs, _ := testutil.DeterministicGenesisState(t, 64)
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err, "Could not get genesis root")
+
+	chain := &mockChain.ChainService{
+		State: bs, Root: genesisRoot[:], Genesis: time.Now(),
+	}
+	vs := &Server{
+		HeadFetcher: chain,
+		TimeFetcher: chain,
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+
+	t.Run("Ok", func(t *testing.T) {
+		req := &ethpb.ProposerDutiesRequest{
+			Epoch: 0,
+		}
+		resp, err := vs.GetProposerDuties(ctx, req)
+		require.NoError(t, err)
+		assert.DeepEqual(t, genesisRoot[:], resp.DependentRoot)
+		// We skip the genesis slot.
+		assert.Equal(t, int(params.BeaconConfig().SlotsPerEpoch)-1, len(resp.Data))
+		for _, duty := range resp.Data {
+			pubkey := bs.PubkeyAtIndex(duty.ValidatorIndex)
+			assert.DeepEqual(t, pubkey[:], duty.Pubkey)
+			assert.NotEqual(t, types.Slot(0), duty.Slot)
+		}
+	})
+
+	t.Run("Epoch out of bound", func(t *testing.T) {
+		currentEpoch := helpers.SlotToEpoch(bs.Slot())
+		req := &ethpb.ProposerDutiesRequest{
+			Epoch: currentEpoch + 1,
+		}
+		_, err := vs.GetProposerDuties(ctx, req)
+		require.ErrorContains(t, "can not be greater than current epoch", err)
+	})
+}
+
+func TestGetProposerDuties_SyncNotReady(t *testing.T) {
+	vs := &Server{
+		SyncChecker: &mockSync.Sync{IsSyncing: true},
+	}
+	_, err := vs.GetProposerDuties(context.Background(), &ethpb.ProposerDutiesRequest{})
+	assert.ErrorContains(t, "Syncing to latest head, not ready to respond", err)
+}
+
+func TestProduceAttestationData(t *testing.T) {
+	block := testutil.NewBeaconBlock()
+	block.Block.Slot = 3*params.BeaconConfig().SlotsPerEpoch + 1
+	targetBlock := testutil.NewBeaconBlock()
+	targetBlock.Block.Slot = 1 * params.BeaconConfig().SlotsPerEpoch
+	justifiedBlock := testutil.NewBeaconBlock()
+	justifiedBlock.Block.Slot = 2 * params.BeaconConfig().SlotsPerEpoch
+	blockRoot, err := block.Block.HashTreeRoot()
+	require.NoError(t, err, "Could not hash beacon block")
+	justifiedRoot, err := justifiedBlock.Block.HashTreeRoot()
+	require.NoError(t, err, "Could not get signing root for justified block")
+	targetRoot, err := targetBlock.Block.HashTreeRoot()
+	require.NoError(t, err, "Could not get signing root for target block")
+	slot := 3*params.BeaconConfig().SlotsPerEpoch + 1
+	beaconState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, beaconState.SetSlot(slot))
+	err = beaconState.SetCurrentJustifiedCheckpoint(&ethpbalpha.Checkpoint{
+		Epoch: 2,
+		Root:  justifiedRoot[:],
+	})
+	require.NoError(t, err)
+
+	blockRoots := beaconState.BlockRoots()
+	blockRoots[1] = blockRoot[:]
+	blockRoots[1*params.BeaconConfig().SlotsPerEpoch] = targetRoot[:]
+	blockRoots[2*params.BeaconConfig().SlotsPerEpoch] = justifiedRoot[:]
+	require.NoError(t, beaconState.SetBlockRoots(blockRoots))
+	chainService := &mockChain.ChainService{
+		Genesis: time.Now(),
+	}
+	offset := int64(slot.Mul(params.BeaconConfig().SecondsPerSlot))
+	v1Alpha1Server := &v1alpha1validator.Server{
+		P2P:              &p2pmock.MockBroadcaster{},
+		SyncChecker:      &mockSync.Sync{IsSyncing: false},
+		AttestationCache: cache.NewAttestationCache(),
+		HeadFetcher: &mockChain.ChainService{
+			State: beaconState, Root: blockRoot[:],
+		},
+		FinalizationFetcher: &mockChain.ChainService{
+			CurrentJustifiedCheckPoint: beaconState.CurrentJustifiedCheckpoint(),
+		},
+		TimeFetcher: &mockChain.ChainService{
+			Genesis: time.Now().Add(time.Duration(-1*offset) * time.Second),
+		},
+		StateNotifier: chainService.StateNotifier(),
+	}
+	v1Server := &Server{
+		V1Alpha1Server: v1Alpha1Server,
+	}
+
+	req := &ethpb.ProduceAttestationDataRequest{
+		CommitteeIndex: 0,
+		Slot:           3*params.BeaconConfig().SlotsPerEpoch + 1,
+	}
+	res, err := v1Server.ProduceAttestationData(context.Background(), req)
+	require.NoError(t, err, "Could not get attestation info at slot")
+
+	assert.DeepEqual(t, slot, res.Data.Slot)
+	assert.Equal(t, types.CommitteeIndex(0), res.Data.Index)
+	assert.DeepEqual(t, blockRoot[:], res.Data.BeaconBlockRoot)
+	assert.Equal(t, types.Epoch(2), res.Data.Source.Epoch)
+	assert.DeepEqual(t, justifiedRoot[:], res.Data.Source.Root)
+	assert.Equal(t, types.Epoch(3), res.Data.Target.Epoch)
+	assert.DeepEqual(t, blockRoot[:], res.Data.Target.Root)
+}
+
+func TestGetAggregateAttestation(t *testing.T) {
+	ctx := context.Background()
+	root1 := bytesutil.PadTo([]byte("root1"), 32)
+	sig1 := bytesutil.PadTo([]byte("sig1"), params.BeaconConfig().BLSSignatureLength)
+	attSlot1 := &ethpbalpha.Attestation{
+		AggregationBits: []byte{0, 1},
+		Data: &ethpbalpha.AttestationData{
+			Slot:            1,
+			CommitteeIndex:  1,
+			BeaconBlockRoot: root1,
+			Source: &ethpbalpha.Checkpoint{
+				Epoch: 1,
+				Root:  root1,
+			},
+			Target: &ethpbalpha.Checkpoint{
+				Epoch: 1,
+				Root:  root1,
+			},
+		},
+		Signature: sig1,
+	}
+	root21 := bytesutil.PadTo([]byte("root2_1"), 32)
+	sig21 := bytesutil.PadTo([]byte("sig2_1"), params.BeaconConfig().BLSSignatureLength)
+	attslot21 := &ethpbalpha.Attestation{
+		AggregationBits: []byte{0, 1, 1},
+		Data: &ethpbalpha.AttestationData{
+			Slot:            2,
+			CommitteeIndex:  2,
+			BeaconBlockRoot: root21,
+			Source: &ethpbalpha.Checkpoint{
+				Epoch: 1,
+				Root:  root21,
+			},
+			Target: &ethpbalpha.Checkpoint{
+				Epoch: 1,
+				Root:  root21,
+			},
+		},
+		Signature: sig21,
+	}
+	root22 := bytesutil.PadTo([]byte("root2_2"), 32)
+	sig22 := bytesutil.PadTo([]byte("sig2_2"), params.BeaconConfig().BLSSignatureLength)
+	attslot22 := &ethpbalpha.Attestation{
+		AggregationBits: []byte{0, 1, 1, 1},
+		Data: &ethpbalpha.AttestationData{
+			Slot:            2,
+			CommitteeIndex:  3,
+			BeaconBlockRoot: root22,
+			Source: &ethpbalpha.Checkpoint{
+				Epoch: 1,
+				Root:  root22,
+			},
+			Target: &ethpbalpha.Checkpoint{
+				Epoch: 1,
+				Root:  root22,
+			},
+		},
+		Signature: sig22,
+	}
+	pool := attestations.NewPool()
+	require.NoError(t, pool.SaveAggregatedAttestations([]*ethpbalpha.Attestation{attSlot1, attslot21, attslot22}))
+	vs := &Server{
+		AttestationsPool: pool,
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		reqRoot, err := attslot22.Data.HashTreeRoot()
+		require.NoError(t, err)
+		req := &ethpb.AggregateAttestationRequest{
+			AttestationDataRoot: reqRoot[:],
+			Slot:                2,
+		}
+		att, err := vs.GetAggregateAttestation(ctx, req)
+		require.NoError(t, err)
+		require.NotNil(t, att)
+		require.NotNil(t, att.Data)
+		assert.DeepEqual(t, bitfield.Bitlist{0, 1, 1, 1}, att.Data.AggregationBits)
+		assert.DeepEqual(t, sig22, att.Data.Signature)
+		assert.Equal(t, types.Slot(2), att.Data.Data.Slot)
+		assert.Equal(t, types.CommitteeIndex(3), att.Data.Data.Index)
+		assert.DeepEqual(t, root22, att.Data.Data.BeaconBlockRoot)
+	})
+
+	t.Run("No matching attestation", func(t *testing.T) {
+		req := &ethpb.AggregateAttestationRequest{
+			AttestationDataRoot: []byte("foo"),
+			Slot:                2,
+		}
+		_, err := vs.GetAggregateAttestation(ctx, req)
+		assert.ErrorContains(t, "No matching attestation found", err)
+	})
+}
+
+func TestSubmitAggregateAndProofs(t *testing.T) {
+	ctx := context.Background()
+	params.SetupTestConfigCleanup(t)
+	c := params.BeaconNetworkConfig()
+	c.MaximumGossipClockDisparity = time.Hour
+	params.OverrideBeaconNetworkConfig(c)
+	root := bytesutil.PadTo([]byte("root"), 32)
+	sig := bytesutil.PadTo([]byte("sig"), params.BeaconConfig().BLSSignatureLength)
+	proof := bytesutil.PadTo([]byte("proof"), params.BeaconConfig().BLSSignatureLength)
+	att := &ethpb.Attestation{
+		AggregationBits: []byte{0, 1},
+		Data: &ethpb.AttestationData{
+			Slot:            1,
+			Index:           1,
+			BeaconBlockRoot: root,
+			Source: &ethpb.Checkpoint{
+				Epoch: 1,
+				Root:  root,
+			},
+			Target: &ethpb.Checkpoint{
+				Epoch: 1,
+				Root:  root,
+			},
+		},
+		Signature: sig,
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		chainSlot := types.Slot(0)
+		chain := &mockChain.ChainService{
+			Genesis: time.Now(), Slot: &chainSlot,
+		}
+		broadcaster := &p2pmock.MockBroadcaster{}
+		vs := Server{
+			TimeFetcher: chain,
+			Broadcaster: broadcaster,
+		}
+
+		req := &ethpb.SubmitAggregateAndProofsRequest{
+			Data: []*ethpb.SignedAggregateAttestationAndProof{
+				{
+					Message: &ethpb.AggregateAttestationAndProof{
+						AggregatorIndex: 1,
+						Aggregate:       att,
+						SelectionProof:  proof,
+					},
+					Signature: sig,
+				},
+			},
+		}
+
+		_, err := vs.SubmitAggregateAndProofs(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, true, broadcaster.BroadcastCalled)
+	})
+
+	t.Run("nil aggregate", func(t *testing.T) {
+		broadcaster := &p2pmock.MockBroadcaster{}
+		vs := Server{
+			Broadcaster: broadcaster,
+		}
+
+		req := &ethpb.SubmitAggregateAndProofsRequest{
+			Data: []*ethpb.SignedAggregateAttestationAndProof{nil},
+		}
+		_, err := vs.SubmitAggregateAndProofs(ctx, req)
+		require.ErrorContains(t, "Signed aggregate request can't be nil", err)
+		assert.Equal(t, false, broadcaster.BroadcastCalled)
+	})
+
+	t.Run("zero signature", func(t *testing.T) {
+		broadcaster := &p2pmock.MockBroadcaster{}
+		vs := Server{
+			Broadcaster: broadcaster,
+		}
+		req := &ethpb.SubmitAggregateAndProofsRequest{
+			Data: []*ethpb.SignedAggregateAttestationAndProof{
+				{
+					Message: &ethpb.AggregateAttestationAndProof{
+						AggregatorIndex: 1,
+						Aggregate:       att,
+						SelectionProof:  proof,
+					},
+					Signature: make([]byte, params.BeaconConfig().BLSSignatureLength),
+				},
+			},
+		}
+		_, err := vs.SubmitAggregateAndProofs(ctx, req)
+		require.ErrorContains(t, "Signed signatures can't be zero hashes", err)
+		assert.Equal(t, false, broadcaster.BroadcastCalled)
+	})
+
+	t.Run("wrong signature length", func(t *testing.T) {
+		broadcaster := &p2pmock.MockBroadcaster{}
+		vs := Server{
+			Broadcaster: broadcaster,
+		}
+		req := &ethpb.SubmitAggregateAndProofsRequest{
+			Data: []*ethpb.SignedAggregateAttestationAndProof{
+				{
+					Message: &ethpb.AggregateAttestationAndProof{
+						AggregatorIndex: 1,
+						Aggregate:       att,
+						SelectionProof:  proof,
+					},
+					Signature: []byte("sig"),
+				},
+			},
+		}
+		_, err := vs.SubmitAggregateAndProofs(ctx, req)
+		require.ErrorContains(t, "Incorrect signature length. Expected 96 bytes", err)
+		assert.Equal(t, false, broadcaster.BroadcastCalled)
+	})
+
+	t.Run("invalid attestation time", func(t *testing.T) {
+		chainSlot := types.Slot(0)
+		chain := &mockChain.ChainService{
+			Genesis: time.Now().Add(time.Hour * 2), Slot: &chainSlot,
+		}
+		broadcaster := &p2pmock.MockBroadcaster{}
+		vs := Server{
+			TimeFetcher: chain,
+			Broadcaster: broadcaster,
+		}
+		req := &ethpb.SubmitAggregateAndProofsRequest{
+			Data: []*ethpb.SignedAggregateAttestationAndProof{
+				{
+					Message: &ethpb.AggregateAttestationAndProof{
+						AggregatorIndex: 1,
+						Aggregate:       att,
+						SelectionProof:  proof,
+					},
+					Signature: sig,
+				},
+			},
+		}
+		_, err := vs.SubmitAggregateAndProofs(ctx, req)
+		require.ErrorContains(t, "Attestation slot is no longer valid from current time", err)
+		assert.Equal(t, false, broadcaster.BroadcastCalled)
+	})
+}
+
+func TestSubmitBeaconCommitteeSubscription(t *testing.T) {
+	ctx := context.Background()
+	genesis := testutil.NewBeaconBlock()
+	bs, _ := testutil.DeterministicGenesisState(t, 64)
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err, "Could not get genesis root")
+
+	chain := &mockChain.ChainService{
+		State: bs, Root: genesisRoot[:],
+	}
+	vs := &Server{
+		HeadFetcher: chain,
+		TimeFetcher: chain,
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+
+	t.Run("Single subscription", func(t *testing.T) {
+		cache.SubnetIDs.EmptyAllCaches()
+		req := &ethpb.SubmitBeaconCommitteeSubscriptionsRequest{
+			Data: []*ethpb.BeaconCommitteeSubscribe{
+				{
+					ValidatorIndex: 1,
+					CommitteeIndex: 1,
+					Slot:           1,
+					IsAggregator:   false,
+				},
+			},
+		}
+		_, err = vs.SubmitBeaconCommitteeSubscription(ctx, req)
+		require.NoError(t, err)
+		subnets := cache.SubnetIDs.GetAttesterSubnetIDs(1)
+		require.Equal(t, 1, len(subnets))
+		assert.Equal(t, uint64(2), subnets[0])
+		aggSubnets := cache.SubnetIDs.GetAggregatorSubnetIDs(1)
+		assert.Equal(t, 0, len(aggSubnets))
+		pubkey := bs.PubkeyAtIndex(1)
+		_, ok, _ := cache.SubnetIDs.GetPersistentSubnets(pubkey[:])
+		assert.Equal(t, true, ok)
+	})
+
+	t.Run("Is aggregator", func(t *testing.T) {
+		cache.SubnetIDs.EmptyAllCaches()
+		req := &ethpb.SubmitBeaconCommitteeSubscriptionsRequest{
+			Data: []*ethpb.BeaconCommitteeSubscribe{
+				{
+					ValidatorIndex: 1,
+					CommitteeIndex: 1,
+					Slot:           1,
+					IsAggregator:   true,
+				},
+			},
+		}
+		_, err = vs.SubmitBeaconCommitteeSubscription(ctx, req)
+		require.NoError(t, err)
+		ids := cache.SubnetIDs.GetAggregatorSubnetIDs(types.Slot(1))
+		assert.Equal(t, 1, len(ids))
+	})
+
+	t.Run("Validators assigned to subnet", func(t *testing.T) {
+		cache.SubnetIDs.EmptyAllCaches()
+		req := &ethpb.SubmitBeaconCommitteeSubscriptionsRequest{
+			Data: []*ethpb.BeaconCommitteeSubscribe{
+				{
+					ValidatorIndex: 1,
+					CommitteeIndex: 1,
+					Slot:           1,
+				},
+				{
+					ValidatorIndex: 2,
+					CommitteeIndex: 1,
+					Slot:           1,
+				},
+			},
+		}
+		_, err = vs.SubmitBeaconCommitteeSubscription(ctx, req)
+		require.NoError(t, err)
+		for _, idx := range []types.ValidatorIndex{1, 2} {
+			pubkey := bs.PubkeyAtIndex(idx)
+			_, ok, _ := cache.SubnetIDs.GetPersistentSubnets(pubkey[:])
+			assert.Equal(t, true, ok)
+		}
+	})
+
+	t.Run("No subscriptions", func(t *testing.T) {
+		req := &ethpb.SubmitBeaconCommitteeSubscriptionsRequest{
+			Data: make([]*ethpb.BeaconCommitteeSubscribe, 0),
+		}
+		_, err = vs.SubmitBeaconCommitteeSubscription(ctx, req)
+		require.ErrorContains(t, "No subscriptions provided", err)
+	})
+
+	t.Run("Validator index out of bound", func(t *testing.T) {
+		req := &ethpb.SubmitBeaconCommitteeSubscriptionsRequest{
+			Data: []*ethpb.BeaconCommitteeSubscribe{
+				{
+					ValidatorIndex: types.ValidatorIndex(len(bs.Validators())),
+					CommitteeIndex: 1,
+					Slot:           1,
+				},
+			},
+		}
+		_, err = vs.SubmitBeaconCommitteeSubscription(ctx, req)
+		require.ErrorContains(t, "Could not get validator", err)
+	})
+}
+
+func TestSubmitBeaconCommitteeSubscription_SyncNotReady(t *testing.T) {
+	vs := &Server{
+		SyncChecker: &mockSync.Sync{IsSyncing: true},
+	}
+	_, err := vs.SubmitBeaconCommitteeSubscription(context.Background(), &ethpb.SubmitBeaconCommitteeSubscriptionsRequest{})
+	assert.ErrorContains(t, "Syncing to latest head, not ready to respond", err)
+}
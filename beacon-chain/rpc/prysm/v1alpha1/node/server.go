@@ -13,6 +13,8 @@ import (
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
@@ -44,13 +46,39 @@ type Server struct {
 	BeaconMonitoringPort int
 }
 
-// GetSyncStatus checks the current network sync status of the node.
-func (ns *Server) GetSyncStatus(_ context.Context, _ *empty.Empty) (*ethpb.SyncStatus, error) {
+// GetSyncStatus checks the current network sync status of the node, along with the
+// lowest slot from which blocks are available in the database.
+func (ns *Server) GetSyncStatus(ctx context.Context, _ *empty.Empty) (*ethpb.SyncStatus, error) {
+	lowest, err := ns.lowestAvailableSlot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve lowest available slot: %v", err)
+	}
 	return &ethpb.SyncStatus{
-		Syncing: ns.SyncChecker.Syncing(),
+		Syncing:             ns.SyncChecker.Syncing(),
+		LowestAvailableSlot: lowest,
 	}, nil
 }
 
+// lowestAvailableSlot is the slot of the lowest block blocks have been backfilled down to for
+// a node started from a checkpoint, and 0 for a node synced from genesis.
+func (ns *Server) lowestAvailableSlot(ctx context.Context) (types.Slot, error) {
+	root, err := ns.BeaconDB.BackfillBlockRoot(ctx)
+	if errors.Is(err, db.ErrNotFoundBackfillBlockRoot) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	blk, err := ns.BeaconDB.Block(ctx, root)
+	if err != nil {
+		return 0, err
+	}
+	if blk == nil || blk.IsNil() {
+		return 0, fmt.Errorf("backfill block %#x not found in db", root)
+	}
+	return blk.Block().Slot(), nil
+}
+
 // GetGenesis fetches genesis chain information of Ethereum. Returns unix timestamp 0
 // if a genesis time has yet to be determined.
 func (ns *Server) GetGenesis(ctx context.Context, _ *empty.Empty) (*ethpb.Genesis, error) {
@@ -8,12 +8,14 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/p2p/enode"
+	types "github.com/prysmaticlabs/eth2-types"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
@@ -29,16 +31,39 @@ func TestNodeServer_GetSyncStatus(t *testing.T) {
 	mSync := &mockSync.Sync{IsSyncing: false}
 	ns := &Server{
 		SyncChecker: mSync,
+		BeaconDB:    dbutil.SetupDB(t),
 	}
 	res, err := ns.GetSyncStatus(context.Background(), &emptypb.Empty{})
 	require.NoError(t, err)
 	assert.Equal(t, false, res.Syncing)
+	assert.Equal(t, types.Slot(0), res.LowestAvailableSlot)
 	ns.SyncChecker = &mockSync.Sync{IsSyncing: true}
 	res, err = ns.GetSyncStatus(context.Background(), &emptypb.Empty{})
 	require.NoError(t, err)
 	assert.Equal(t, true, res.Syncing)
 }
 
+func TestNodeServer_GetSyncStatus_Backfill(t *testing.T) {
+	ctx := context.Background()
+	db := dbutil.SetupDB(t)
+	ns := &Server{
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+		BeaconDB:    db,
+	}
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 100
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBackfillBlockRoot(ctx, root))
+	_, err = ns.GetSyncStatus(ctx, &emptypb.Empty{})
+	assert.ErrorContains(t, "not found in db", err)
+
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(blk)))
+	res, err := ns.GetSyncStatus(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(100), res.LowestAvailableSlot)
+}
+
 func TestNodeServer_GetGenesis(t *testing.T) {
 	db := dbutil.SetupDB(t)
 	ctx := context.Background()
@@ -2,10 +2,72 @@ package validator
 
 import (
 	"bytes"
+	"context"
 
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
 	eth "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
 )
 
+// getSyncAggregate retrieves the sync contributions from the pool to construct the sync aggregate object.
+// The contributions are filtered based on matching of the input root and slot then profitability.
+func (vs *Server) getSyncAggregate(ctx context.Context, slot types.Slot, root [32]byte) (*eth.SyncAggregate, error) {
+	_, span := trace.StartSpan(ctx, "ProposerServer.getSyncAggregate")
+	defer span.End()
+
+	// Contributions have to match the input root
+	contributions, err := vs.SyncCommitteePool.SyncCommitteeContributions(slot)
+	if err != nil {
+		return nil, err
+	}
+	proposerContributions := proposerSyncContributions(contributions).filterByBlockRoot(root)
+
+	// Each sync subcommittee is 128 bits and the sync committee is 512 bits for mainnet.
+	bitsHolder := [][]byte{}
+	for i := uint64(0); i < params.BeaconConfig().SyncCommitteeSubnetCount; i++ {
+		bitsHolder = append(bitsHolder, eth.NewSyncCommitteeAggregationBits())
+	}
+	sigsHolder := make([]bls.Signature, 0, params.BeaconConfig().SyncCommitteeSubnetCount)
+
+	for i := uint64(0); i < params.BeaconConfig().SyncCommitteeSubnetCount; i++ {
+		cs := proposerContributions.filterBySubIndex(i)
+		aggregates, err := cs.dedup()
+		if err != nil {
+			return nil, err
+		}
+		bestContribution := aggregates.mostProfitable()
+		if bestContribution == nil {
+			continue
+		}
+		bitsHolder[i] = bestContribution.AggregationBits
+		sig, err := bls.SignatureFromBytes(bestContribution.Signature)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get signature from contribution")
+		}
+		sigsHolder = append(sigsHolder, sig)
+	}
+
+	// Aggregate all the contribution bits and signatures.
+	var syncBits []byte
+	for _, b := range bitsHolder {
+		syncBits = append(syncBits, b...)
+	}
+	syncSigBytes := make([]byte, params.BeaconConfig().BLSSignatureLength)
+	if len(sigsHolder) == 0 {
+		syncSigBytes[0] = 0xC0 // Infinity signature when there are no contributions.
+	} else {
+		syncSigBytes = bls.AggregateSignatures(sigsHolder).Marshal()
+	}
+
+	return &eth.SyncAggregate{
+		SyncCommitteeBits:      syncBits,
+		SyncCommitteeSignature: syncSigBytes,
+	}, nil
+}
+
 type proposerSyncContributions []*eth.SyncCommitteeContribution
 
 // filterByBlockRoot separates sync aggregate list into a valid group.
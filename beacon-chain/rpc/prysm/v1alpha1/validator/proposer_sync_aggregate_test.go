@@ -2,12 +2,17 @@ package validator
 
 import (
 	"bytes"
+	"context"
 	"sort"
 	"testing"
 
 	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
 	v2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
 
 func TestProposerSyncContributions_FilterByBlockRoot(t *testing.T) {
@@ -390,3 +395,62 @@ func TestProposerSyncContributions_MostProfitable(t *testing.T) {
 		})
 	}
 }
+
+func TestProposer_GetSyncAggregate_EmptyPool(t *testing.T) {
+	vs := &Server{
+		SyncCommitteePool: synccommittee.NewStore(),
+	}
+	aggregate, err := vs.getSyncAggregate(context.Background(), 1, [32]byte{'a'})
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconConfig().SyncCommitteeSubnetCount*v2.NewSyncCommitteeAggregationBits().Len(), aggregate.SyncCommitteeBits.Len())
+	assert.Equal(t, uint64(0), aggregate.SyncCommitteeBits.Count())
+	infiniteSig := make([]byte, params.BeaconConfig().BLSSignatureLength)
+	infiniteSig[0] = 0xC0
+	assert.DeepEqual(t, infiniteSig, aggregate.SyncCommitteeSignature)
+}
+
+func TestProposer_GetSyncAggregate_NonEmptyPool(t *testing.T) {
+	root := [32]byte{'a'}
+	otherRoot := [32]byte{'b'}
+	signature := func() []byte {
+		priv, err := bls.RandKey()
+		require.NoError(t, err)
+		return priv.Sign(root[:]).Marshal()
+	}
+	bits := func(set ...uint64) bitfield.Bitvector128 {
+		b := bitfield.NewBitvector128()
+		for _, i := range set {
+			b.SetBitAt(i, true)
+		}
+		return b
+	}
+	contributions := []*v2.SyncCommitteeContribution{
+		{Slot: 1, BlockRoot: root[:], SubcommitteeIndex: 0, AggregationBits: bits(0), Signature: signature()},
+		// The most profitable contribution of the first subcommittee.
+		{Slot: 1, BlockRoot: root[:], SubcommitteeIndex: 0, AggregationBits: bits(0, 1, 2), Signature: signature()},
+		{Slot: 1, BlockRoot: root[:], SubcommitteeIndex: 2, AggregationBits: bits(3), Signature: signature()},
+		// Contributions for another block root or slot are not included.
+		{Slot: 1, BlockRoot: otherRoot[:], SubcommitteeIndex: 1, AggregationBits: bits(0, 1, 2, 3), Signature: signature()},
+		{Slot: 2, BlockRoot: root[:], SubcommitteeIndex: 3, AggregationBits: bits(0, 1, 2, 3), Signature: signature()},
+	}
+	vs := &Server{
+		SyncCommitteePool: synccommittee.NewStore(),
+	}
+	for _, c := range contributions {
+		require.NoError(t, vs.SyncCommitteePool.SaveSyncCommitteeContribution(c))
+	}
+
+	aggregate, err := vs.getSyncAggregate(context.Background(), 1, root)
+	require.NoError(t, err)
+	subcommitteeSize := v2.NewSyncCommitteeAggregationBits().Len()
+	assert.Equal(t, params.BeaconConfig().SyncCommitteeSubnetCount*subcommitteeSize, aggregate.SyncCommitteeBits.Len())
+	assert.Equal(t, uint64(4), aggregate.SyncCommitteeBits.Count())
+	for _, i := range []uint64{0, 1, 2, 2*subcommitteeSize + 3} {
+		assert.Equal(t, true, aggregate.SyncCommitteeBits.BitAt(i), "bit %d is not set", i)
+	}
+	sig1, err := bls.SignatureFromBytes(contributions[1].Signature)
+	require.NoError(t, err)
+	sig2, err := bls.SignatureFromBytes(contributions[2].Signature)
+	require.NoError(t, err)
+	assert.DeepEqual(t, bls.AggregateSignatures([]bls.Signature{sig1, sig2}).Marshal(), aggregate.SyncCommitteeSignature)
+}
@@ -9,11 +9,13 @@ import (
 	"github.com/prysmaticlabs/go-bitfield"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	mockp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
@@ -24,6 +26,7 @@ import (
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	attaggregation "github.com/prysmaticlabs/prysm/shared/aggregation/attestations"
 	"github.com/prysmaticlabs/prysm/shared/attestationutil"
 	"github.com/prysmaticlabs/prysm/shared/bls"
@@ -205,6 +208,99 @@ func TestProposer_GetBlock_AddsUnaggregatedAtts(t *testing.T) {
 	assert.Equal(t, false, hasUnaggregatedAtt, "Expected block to not have unaggregated attestation")
 }
 
+func TestProposer_GetBlockAltair_OK(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	ctx := context.Background()
+
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	genesisState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	beaconState, err := altair.UpgradeToAltair(ctx, genesisState)
+	require.NoError(t, err)
+
+	stateRoot, err := beaconState.HashTreeRoot(ctx)
+	require.NoError(t, err, "Could not hash genesis state")
+	genesis := b.NewGenesisBlock(stateRoot[:])
+	parentRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err, "Could not get signing root")
+	stateGen := stategen.NewMockService()
+	stateGen.AddStateForRoot(beaconState.Copy(), parentRoot)
+
+	proposerServer := &Server{
+		BeaconDB:          db,
+		HeadFetcher:       &mock.ChainService{State: beaconState, Root: parentRoot[:]},
+		SyncChecker:       &mockSync.Sync{IsSyncing: false},
+		BlockReceiver:     &mock.ChainService{},
+		ChainStartFetcher: &mockPOW.POWChain{},
+		Eth1InfoFetcher:   &mockPOW.POWChain{},
+		Eth1BlockFetcher:  &mockPOW.POWChain{},
+		MockEth1Votes:     true,
+		AttPool:           attestations.NewPool(),
+		SlashingsPool:     slashings.NewPool(),
+		ExitPool:          voluntaryexits.NewPool(),
+		SyncCommitteePool: synccommittee.NewStore(),
+		StateGen:          stateGen,
+	}
+
+	// Members of the first sync subcommittee sign the parent block root at the previous slot.
+	committee, err := beaconState.CurrentSyncCommittee()
+	require.NoError(t, err)
+	d, err := helpers.Domain(beaconState.Fork(), 0, params.BeaconConfig().DomainSyncCommittee, beaconState.GenesisValidatorRoot())
+	require.NoError(t, err)
+	signingRoot, err := (&pbp2p.SigningData{ObjectRoot: parentRoot[:], Domain: d}).HashTreeRoot()
+	require.NoError(t, err)
+	bits := prysmv2.NewSyncCommitteeAggregationBits()
+	sigs := make([]bls.Signature, 0, 4)
+	for i := uint64(0); i < 4; i++ {
+		idx, ok := beaconState.ValidatorIndexByPubkey(bytesutil.ToBytes48(committee.Pubkeys[i]))
+		require.Equal(t, true, ok)
+		bits.SetBitAt(i, true)
+		sigs = append(sigs, privKeys[idx].Sign(signingRoot[:]))
+	}
+	aggregateSig := bls.AggregateSignatures(sigs).Marshal()
+	require.NoError(t, proposerServer.SyncCommitteePool.SaveSyncCommitteeContribution(&prysmv2.SyncCommitteeContribution{
+		Slot:              0,
+		BlockRoot:         parentRoot[:],
+		SubcommitteeIndex: 0,
+		AggregationBits:   bits,
+		Signature:         aggregateSig,
+	}))
+	// A contribution for another block root is not included.
+	otherRoot := [32]byte{'a'}
+	require.NoError(t, proposerServer.SyncCommitteePool.SaveSyncCommitteeContribution(&prysmv2.SyncCommitteeContribution{
+		Slot:              0,
+		BlockRoot:         otherRoot[:],
+		SubcommitteeIndex: 1,
+		AggregationBits:   bits,
+		Signature:         aggregateSig,
+	}))
+
+	randaoReveal, err := testutil.RandaoReveal(beaconState, 0, privKeys)
+	require.NoError(t, err)
+	graffiti := bytesutil.ToBytes32([]byte("eth2"))
+	req := &ethpb.BlockRequest{
+		Slot:         1,
+		RandaoReveal: randaoReveal,
+		Graffiti:     graffiti[:],
+	}
+	block, err := proposerServer.GetBlockAltair(ctx, req)
+	require.NoError(t, err)
+
+	assert.Equal(t, req.Slot, block.Slot, "Expected block to have slot of 1")
+	assert.DeepEqual(t, parentRoot[:], block.ParentRoot, "Expected block to have correct parent root")
+	assert.DeepEqual(t, randaoReveal, block.Body.RandaoReveal, "Expected block to have correct randao reveal")
+	assert.DeepEqual(t, req.Graffiti, block.Body.Graffiti, "Expected block to have correct graffiti")
+	assert.Equal(t, uint64(4), block.Body.SyncAggregate.SyncCommitteeBits.Count())
+	for i := uint64(0); i < 4; i++ {
+		assert.Equal(t, true, block.Body.SyncAggregate.SyncCommitteeBits.BitAt(i))
+	}
+	assert.DeepEqual(t, aggregateSig, block.Body.SyncAggregate.SyncCommitteeSignature)
+	assert.NotEqual(t, params.BeaconConfig().ZeroHash, bytesutil.ToBytes32(block.StateRoot))
+
+	_, err = proposerServer.GetBlockAltair(ctx, &ethpb.BlockRequest{Slot: 0})
+	assert.ErrorContains(t, "Cannot propose a block at the genesis slot", err)
+}
+
 func TestProposer_ProposeBlock_OK(t *testing.T) {
 	db := dbutil.SetupDB(t)
 	ctx := context.Background()
@@ -0,0 +1,126 @@
+package slasher
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+)
+
+// spanChunks holds the min or max span chunks of all validators belonging to a single
+// validator chunk index. Chunks are lazily loaded from the slasher database as epochs
+// are accessed, and only the chunks which were modified are written back on flush.
+//
+// A min span for a validator at epoch e holds the smallest distance (target - e) of an
+// attestation by the validator with a source epoch greater than e. Conversely, a max span
+// holds the largest distance (target - e) of an attestation with a source epoch smaller
+// than e. Epochs with no such attestation hold the neutral element of the span kind.
+type spanChunks struct {
+	kind                slashertypes.ChunkKind
+	params              *Parameters
+	slasherDB           db.SlasherDatabase
+	validatorChunkIndex uint64
+	chunks              map[uint64][]uint16
+	updated             map[uint64]bool
+}
+
+func newSpanChunks(
+	kind slashertypes.ChunkKind, params *Parameters, slasherDB db.SlasherDatabase, validatorChunkIndex uint64,
+) *spanChunks {
+	return &spanChunks{
+		kind:                kind,
+		params:              params,
+		slasherDB:           slasherDB,
+		validatorChunkIndex: validatorChunkIndex,
+		chunks:              make(map[uint64][]uint16),
+		updated:             make(map[uint64]bool),
+	}
+}
+
+// neutralElement of a span kind, denoting that no attestation has been
+// recorded for a validator at an epoch.
+func (c *spanChunks) neutralElement() uint16 {
+	if c.kind == slashertypes.MinSpan {
+		return math.MaxUint16
+	}
+	return 0
+}
+
+// emptyChunk returns a chunk filled with the neutral element of the span kind.
+func (c *spanChunks) emptyChunk() []uint16 {
+	chunk := make([]uint16, c.params.chunkSize*c.params.validatorChunkSize)
+	neutral := c.neutralElement()
+	for i := range chunk {
+		chunk[i] = neutral
+	}
+	return chunk
+}
+
+// chunk retrieves the chunk at the given chunk index, loading it from the
+// database the first time it is accessed.
+func (c *spanChunks) chunk(ctx context.Context, chunkIdx uint64) ([]uint16, error) {
+	if chunk, ok := c.chunks[chunkIdx]; ok {
+		return chunk, nil
+	}
+	key := c.params.flatSliceID(c.validatorChunkIndex, chunkIdx)
+	chunks, exists, err := c.slasherDB.LoadSlasherChunks(ctx, c.kind, [][]byte{key})
+	if err != nil {
+		return nil, err
+	}
+	chunk := c.emptyChunk()
+	if len(exists) == 1 && exists[0] {
+		if uint64(len(chunks[0])) != uint64(len(chunk)) {
+			return nil, fmt.Errorf(
+				"chunk %d for validator chunk index %d has length %d, wanted %d",
+				chunkIdx, c.validatorChunkIndex, len(chunks[0]), len(chunk),
+			)
+		}
+		chunk = chunks[0]
+	}
+	c.chunks[chunkIdx] = chunk
+	return chunk, nil
+}
+
+// get returns the span value of a validator at an epoch.
+func (c *spanChunks) get(ctx context.Context, validatorIdx types.ValidatorIndex, epoch types.Epoch) (uint16, error) {
+	chunk, err := c.chunk(ctx, c.params.chunkIndex(epoch))
+	if err != nil {
+		return 0, err
+	}
+	return chunk[c.params.cellIndex(validatorIdx, epoch)], nil
+}
+
+// set updates the span value of a validator at an epoch.
+func (c *spanChunks) set(
+	ctx context.Context, validatorIdx types.ValidatorIndex, epoch types.Epoch, value uint16,
+) error {
+	chunkIdx := c.params.chunkIndex(epoch)
+	chunk, err := c.chunk(ctx, chunkIdx)
+	if err != nil {
+		return err
+	}
+	chunk[c.params.cellIndex(validatorIdx, epoch)] = value
+	c.updated[chunkIdx] = true
+	return nil
+}
+
+// flush persists all updated chunks to the slasher database.
+func (c *spanChunks) flush(ctx context.Context) error {
+	if len(c.updated) == 0 {
+		return nil
+	}
+	keys := make([][]byte, 0, len(c.updated))
+	chunks := make([][]uint16, 0, len(c.updated))
+	for chunkIdx := range c.updated {
+		keys = append(keys, c.params.flatSliceID(c.validatorChunkIndex, chunkIdx))
+		chunks = append(chunks, c.chunks[chunkIdx])
+	}
+	if err := c.slasherDB.SaveSlasherChunks(ctx, c.kind, keys, chunks); err != nil {
+		return err
+	}
+	c.updated = make(map[uint64]bool)
+	return nil
+}
@@ -0,0 +1,347 @@
+package slasher
+
+import (
+	"context"
+	"math"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// Given a list of attestations which have been filtered to be within the bounds of the
+// history length, detects any double votes and surround votes among them and the attestation
+// history stored in the slasher database. The attestation records and min-max spans of
+// the attesting validators are updated along the way.
+func (s *Service) checkSlashableAttestations(
+	ctx context.Context, currentEpoch types.Epoch, atts []*slashertypes.IndexedAttestationWrapper,
+) ([]*ethpb.AttesterSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "Slasher.checkSlashableAttestations")
+	defer span.End()
+	if len(atts) == 0 {
+		return nil, nil
+	}
+	found := newAttesterSlashingSet()
+
+	doubleVotes, err := s.checkDoubleVotes(ctx, atts)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check attester double votes")
+	}
+	for _, doubleVote := range doubleVotes {
+		if err := found.add(&ethpb.AttesterSlashing{
+			Attestation_1: doubleVote.PrevAttestationWrapper.IndexedAttestation,
+			Attestation_2: doubleVote.AttestationWrapper.IndexedAttestation,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	// Attestation records are saved before surround votes are detected, so that the
+	// surrounded or surrounding attestation can be retrieved if it is part of the batch.
+	if err := s.serviceCfg.Database.SaveAttestationRecordsForValidators(ctx, atts); err != nil {
+		return nil, errors.Wrap(err, "could not save attestation records")
+	}
+
+	for validatorChunkIdx, validatorIndices := range s.groupByValidatorChunkIndex(atts) {
+		surroundVotes, err := s.detectSurroundVotes(ctx, validatorChunkIdx, validatorIndices, currentEpoch, atts)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not detect surround votes in validator chunk %d", validatorChunkIdx)
+		}
+		for _, slashing := range surroundVotes {
+			if err := found.add(slashing); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return found.slashings, nil
+}
+
+// Detects double votes of the given attestations against the slasher database, as well
+// as double votes among the attestations of the batch itself.
+func (s *Service) checkDoubleVotes(
+	ctx context.Context, atts []*slashertypes.IndexedAttestationWrapper,
+) ([]*slashertypes.AttesterDoubleVote, error) {
+	type validatorTarget struct {
+		validatorIdx types.ValidatorIndex
+		target       types.Epoch
+	}
+	doubleVotes := make([]*slashertypes.AttesterDoubleVote, 0)
+	seen := make(map[validatorTarget]*slashertypes.IndexedAttestationWrapper)
+	for _, att := range atts {
+		for _, valIdx := range att.IndexedAttestation.AttestingIndices {
+			key := validatorTarget{
+				validatorIdx: types.ValidatorIndex(valIdx),
+				target:       att.IndexedAttestation.Data.Target.Epoch,
+			}
+			existing, ok := seen[key]
+			if !ok {
+				seen[key] = att
+				continue
+			}
+			if existing.SigningRoot != att.SigningRoot {
+				doubleVotes = append(doubleVotes, &slashertypes.AttesterDoubleVote{
+					Target:                 key.target,
+					ValidatorIndex:         key.validatorIdx,
+					PrevAttestationWrapper: existing,
+					AttestationWrapper:     att,
+				})
+			}
+		}
+	}
+	dbDoubleVotes, err := s.serviceCfg.Database.CheckAttesterDoubleVotes(ctx, atts)
+	if err != nil {
+		return nil, err
+	}
+	return append(doubleVotes, dbDoubleVotes...), nil
+}
+
+// Groups the attesting validators of a list of attestations by validator chunk index.
+func (s *Service) groupByValidatorChunkIndex(
+	atts []*slashertypes.IndexedAttestationWrapper,
+) map[uint64]map[types.ValidatorIndex]bool {
+	groups := make(map[uint64]map[types.ValidatorIndex]bool)
+	for _, att := range atts {
+		for _, valIdx := range att.IndexedAttestation.AttestingIndices {
+			validatorIdx := types.ValidatorIndex(valIdx)
+			chunkIdx := s.params.validatorChunkIndex(validatorIdx)
+			if _, ok := groups[chunkIdx]; !ok {
+				groups[chunkIdx] = make(map[types.ValidatorIndex]bool)
+			}
+			groups[chunkIdx][validatorIdx] = true
+		}
+	}
+	return groups
+}
+
+// Detects surround votes for the validators of a validator chunk index by checking every
+// attestation against the min-max spans of its attesting validators, then updating the spans
+// with the attestation. Stale span values left over from a previous cycle of the circular
+// history are reset before any attestation is checked.
+func (s *Service) detectSurroundVotes(
+	ctx context.Context,
+	validatorChunkIdx uint64,
+	validatorIndices map[types.ValidatorIndex]bool,
+	currentEpoch types.Epoch,
+	atts []*slashertypes.IndexedAttestationWrapper,
+) ([]*ethpb.AttesterSlashing, error) {
+	minSpans := newSpanChunks(slashertypes.MinSpan, s.params, s.serviceCfg.Database, validatorChunkIdx)
+	maxSpans := newSpanChunks(slashertypes.MaxSpan, s.params, s.serviceCfg.Database, validatorChunkIdx)
+	if err := s.resetStaleEpochs(ctx, minSpans, maxSpans, validatorIndices, currentEpoch); err != nil {
+		return nil, errors.Wrap(err, "could not reset stale epochs")
+	}
+
+	slashings := make([]*ethpb.AttesterSlashing, 0)
+	for _, att := range atts {
+		for _, valIdx := range att.IndexedAttestation.AttestingIndices {
+			validatorIdx := types.ValidatorIndex(valIdx)
+			if !validatorIndices[validatorIdx] {
+				continue
+			}
+			slashing, err := s.checkSurroundVote(ctx, minSpans, maxSpans, validatorIdx, att)
+			if err != nil {
+				return nil, err
+			}
+			if slashing != nil {
+				slashings = append(slashings, slashing)
+			}
+			if err := s.updateSpans(ctx, minSpans, maxSpans, validatorIdx, att, currentEpoch); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := minSpans.flush(ctx); err != nil {
+		return nil, errors.Wrap(err, "could not save min span chunks")
+	}
+	if err := maxSpans.flush(ctx); err != nil {
+		return nil, errors.Wrap(err, "could not save max span chunks")
+	}
+	return slashings, nil
+}
+
+// Spans are stored in a circular fashion over the history length, so the cells of the epochs
+// since the last epoch a validator's spans were written still hold values of epochs which are
+// a full history length in the past. These cells are reset to the neutral element.
+func (s *Service) resetStaleEpochs(
+	ctx context.Context,
+	minSpans, maxSpans *spanChunks,
+	validatorIndices map[types.ValidatorIndex]bool,
+	currentEpoch types.Epoch,
+) error {
+	indices := make([]types.ValidatorIndex, 0, len(validatorIndices))
+	for validatorIdx := range validatorIndices {
+		indices = append(indices, validatorIdx)
+	}
+	attestedEpochs, err := s.serviceCfg.Database.LastEpochWrittenForValidators(ctx, indices)
+	if err != nil {
+		return err
+	}
+	lowestEpoch := s.lowestEpochInHistory(currentEpoch)
+	for _, attested := range attestedEpochs {
+		if attested.Epoch >= currentEpoch {
+			continue
+		}
+		start := attested.Epoch + 1
+		if start < lowestEpoch {
+			start = lowestEpoch
+		}
+		for epoch := start; epoch <= currentEpoch; epoch++ {
+			if err := minSpans.set(ctx, attested.ValidatorIndex, epoch, minSpans.neutralElement()); err != nil {
+				return err
+			}
+			if err := maxSpans.set(ctx, attested.ValidatorIndex, epoch, maxSpans.neutralElement()); err != nil {
+				return err
+			}
+		}
+	}
+	return s.serviceCfg.Database.SaveLastEpochWrittenForValidators(ctx, indices, currentEpoch)
+}
+
+// Checks whether an attestation by a validator surrounds, or is surrounded by, a previous
+// attestation of the validator. If so, the previous attestation is retrieved from the
+// slasher database to build an attester slashing.
+func (s *Service) checkSurroundVote(
+	ctx context.Context,
+	minSpans, maxSpans *spanChunks,
+	validatorIdx types.ValidatorIndex,
+	att *slashertypes.IndexedAttestationWrapper,
+) (*ethpb.AttesterSlashing, error) {
+	source := att.IndexedAttestation.Data.Source.Epoch
+	target := att.IndexedAttestation.Data.Target.Epoch
+
+	minSpan, err := minSpans.get(ctx, validatorIdx, source)
+	if err != nil {
+		return nil, err
+	}
+	if minSpan != minSpans.neutralElement() {
+		existingTarget := source + types.Epoch(minSpan)
+		if existingTarget < target {
+			existing, err := s.serviceCfg.Database.AttestationRecordForValidator(ctx, validatorIdx, existingTarget)
+			if err != nil {
+				return nil, err
+			}
+			if existing != nil && isSurrounding(att.IndexedAttestation, existing.IndexedAttestation) {
+				return &ethpb.AttesterSlashing{
+					Attestation_1: att.IndexedAttestation,
+					Attestation_2: existing.IndexedAttestation,
+				}, nil
+			}
+		}
+	}
+
+	maxSpan, err := maxSpans.get(ctx, validatorIdx, source)
+	if err != nil {
+		return nil, err
+	}
+	if maxSpan != maxSpans.neutralElement() {
+		existingTarget := source + types.Epoch(maxSpan)
+		if existingTarget > target {
+			existing, err := s.serviceCfg.Database.AttestationRecordForValidator(ctx, validatorIdx, existingTarget)
+			if err != nil {
+				return nil, err
+			}
+			if existing != nil && isSurrounding(existing.IndexedAttestation, att.IndexedAttestation) {
+				return &ethpb.AttesterSlashing{
+					Attestation_1: existing.IndexedAttestation,
+					Attestation_2: att.IndexedAttestation,
+				}, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// Updates the min spans of the epochs before the source epoch of an attestation, and the max
+// spans of the epochs between its source and target epochs. Updates stop at the first epoch
+// which already holds a smaller min span or a larger max span, as every earlier (or later)
+// epoch is then guaranteed to be at least as tight.
+func (s *Service) updateSpans(
+	ctx context.Context,
+	minSpans, maxSpans *spanChunks,
+	validatorIdx types.ValidatorIndex,
+	att *slashertypes.IndexedAttestationWrapper,
+	currentEpoch types.Epoch,
+) error {
+	source := att.IndexedAttestation.Data.Source.Epoch
+	target := att.IndexedAttestation.Data.Target.Epoch
+
+	lowestEpoch := s.lowestEpochInHistory(currentEpoch)
+	for epoch := source; epoch > lowestEpoch; {
+		epoch--
+		distance := uint64(target - epoch)
+		if distance > math.MaxUint16 {
+			break
+		}
+		existing, err := minSpans.get(ctx, validatorIdx, epoch)
+		if err != nil {
+			return err
+		}
+		if uint16(distance) >= existing {
+			break
+		}
+		if err := minSpans.set(ctx, validatorIdx, epoch, uint16(distance)); err != nil {
+			return err
+		}
+	}
+
+	for epoch := source + 1; epoch < target; epoch++ {
+		distance := uint64(target - epoch)
+		if distance > math.MaxUint16 {
+			continue
+		}
+		existing, err := maxSpans.get(ctx, validatorIdx, epoch)
+		if err != nil {
+			return err
+		}
+		if uint16(distance) <= existing {
+			break
+		}
+		if err := maxSpans.set(ctx, validatorIdx, epoch, uint16(distance)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// The lowest epoch for which spans are kept given the current epoch.
+func (s *Service) lowestEpochInHistory(currentEpoch types.Epoch) types.Epoch {
+	if currentEpoch < s.params.historyLength {
+		return 0
+	}
+	return currentEpoch - s.params.historyLength + 1
+}
+
+// Returns true if the first attestation surrounds the second one.
+func isSurrounding(att1, att2 *ethpb.IndexedAttestation) bool {
+	return att1.Data.Source.Epoch < att2.Data.Source.Epoch && att2.Data.Target.Epoch < att1.Data.Target.Epoch
+}
+
+// attesterSlashingSet collects attester slashings, ignoring duplicates found for
+// several validators attesting to the same pair of attestations.
+type attesterSlashingSet struct {
+	seen      map[[32]byte]bool
+	slashings []*ethpb.AttesterSlashing
+}
+
+func newAttesterSlashingSet() *attesterSlashingSet {
+	return &attesterSlashingSet{
+		seen:      make(map[[32]byte]bool),
+		slashings: make([]*ethpb.AttesterSlashing, 0),
+	}
+}
+
+func (a *attesterSlashingSet) add(slashing *ethpb.AttesterSlashing) error {
+	root, err := slashing.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not hash attester slashing")
+	}
+	if a.seen[root] {
+		return nil
+	}
+	a.seen[root] = true
+	a.slashings = append(a.slashings, slashing)
+	return nil
+}
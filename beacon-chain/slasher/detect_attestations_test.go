@@ -0,0 +1,204 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_checkSlashableAttestations(t *testing.T) {
+	tests := []struct {
+		name          string
+		existing      []*slashertypes.IndexedAttestationWrapper
+		incoming      []*slashertypes.IndexedAttestationWrapper
+		wantSlashings int
+	}{
+		{
+			name:     "no slashing for distinct attestations",
+			existing: []*slashertypes.IndexedAttestationWrapper{createAttestationWrapper(1, 2, []uint64{1, 2}, 1)},
+			incoming: []*slashertypes.IndexedAttestationWrapper{createAttestationWrapper(2, 3, []uint64{1, 2}, 2)},
+		},
+		{
+			name:          "double vote against the database",
+			existing:      []*slashertypes.IndexedAttestationWrapper{createAttestationWrapper(1, 2, []uint64{1, 2}, 1)},
+			incoming:      []*slashertypes.IndexedAttestationWrapper{createAttestationWrapper(0, 2, []uint64{2, 3}, 2)},
+			wantSlashings: 1,
+		},
+		{
+			name: "double vote within a batch",
+			incoming: []*slashertypes.IndexedAttestationWrapper{
+				createAttestationWrapper(1, 2, []uint64{1}, 1),
+				createAttestationWrapper(1, 2, []uint64{1}, 2),
+			},
+			wantSlashings: 1,
+		},
+		{
+			name:     "same attestation received twice is not slashable",
+			existing: []*slashertypes.IndexedAttestationWrapper{createAttestationWrapper(1, 2, []uint64{1}, 1)},
+			incoming: []*slashertypes.IndexedAttestationWrapper{
+				createAttestationWrapper(1, 2, []uint64{1}, 1),
+				createAttestationWrapper(1, 2, []uint64{1}, 1),
+			},
+		},
+		{
+			name:          "new attestation surrounds an existing one",
+			existing:      []*slashertypes.IndexedAttestationWrapper{createAttestationWrapper(3, 4, []uint64{1, 2}, 1)},
+			incoming:      []*slashertypes.IndexedAttestationWrapper{createAttestationWrapper(2, 5, []uint64{1, 2}, 2)},
+			wantSlashings: 1,
+		},
+		{
+			name:          "new attestation surrounded by an existing one",
+			existing:      []*slashertypes.IndexedAttestationWrapper{createAttestationWrapper(1, 6, []uint64{1}, 1)},
+			incoming:      []*slashertypes.IndexedAttestationWrapper{createAttestationWrapper(2, 5, []uint64{1}, 2)},
+			wantSlashings: 1,
+		},
+		{
+			name: "surround vote within a batch",
+			incoming: []*slashertypes.IndexedAttestationWrapper{
+				createAttestationWrapper(3, 4, []uint64{300}, 1),
+				createAttestationWrapper(1, 6, []uint64{300}, 2),
+			},
+			wantSlashings: 1,
+		},
+		{
+			name:     "surround vote by different validators is not slashable",
+			existing: []*slashertypes.IndexedAttestationWrapper{createAttestationWrapper(3, 4, []uint64{1}, 1)},
+			incoming: []*slashertypes.IndexedAttestationWrapper{createAttestationWrapper(2, 5, []uint64{2}, 2)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := &Service{
+				params:     DefaultParams(),
+				serviceCfg: &ServiceConfig{Database: dbtest.SetupSlasherDB(t)},
+			}
+			currentEpoch := types.Epoch(6)
+			if len(tt.existing) > 0 {
+				slashings, err := s.checkSlashableAttestations(ctx, currentEpoch, tt.existing)
+				require.NoError(t, err)
+				require.Equal(t, 0, len(slashings))
+			}
+			slashings, err := s.checkSlashableAttestations(ctx, currentEpoch, tt.incoming)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantSlashings, len(slashings))
+			for _, slashing := range slashings {
+				data1 := slashing.Attestation_1.Data
+				data2 := slashing.Attestation_2.Data
+				isDoubleVote := data1.Target.Epoch == data2.Target.Epoch
+				assert.Equal(t, true, isDoubleVote || isSurrounding(slashing.Attestation_1, slashing.Attestation_2))
+			}
+		})
+	}
+}
+
+func TestService_checkSlashableAttestations_AcrossEpochs(t *testing.T) {
+	ctx := context.Background()
+	s := &Service{
+		params:     DefaultParams(),
+		serviceCfg: &ServiceConfig{Database: dbtest.SetupSlasherDB(t)},
+	}
+	// A validator attests normally for a number of epochs, which is not slashable.
+	for epoch := types.Epoch(1); epoch < 39; epoch++ {
+		att := createAttestationWrapper(epoch-1, epoch, []uint64{5}, byte(epoch))
+		slashings, err := s.checkSlashableAttestations(ctx, epoch, []*slashertypes.IndexedAttestationWrapper{att})
+		require.NoError(t, err)
+		require.Equal(t, 0, len(slashings))
+	}
+	// An attestation surrounding all previous attestations spans several chunks,
+	// and is reported against the closest surrounded attestation.
+	att := createAttestationWrapper(2, 39, []uint64{5}, 100)
+	slashings, err := s.checkSlashableAttestations(ctx, 39, []*slashertypes.IndexedAttestationWrapper{att})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(slashings))
+	assert.Equal(t, types.Epoch(2), slashings[0].Attestation_1.Data.Source.Epoch)
+	assert.Equal(t, types.Epoch(39), slashings[0].Attestation_1.Data.Target.Epoch)
+	assert.Equal(t, types.Epoch(3), slashings[0].Attestation_2.Data.Source.Epoch)
+	assert.Equal(t, types.Epoch(4), slashings[0].Attestation_2.Data.Target.Epoch)
+}
+
+func TestService_resetStaleEpochs(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	s := &Service{
+		params:     &Parameters{chunkSize: 2, validatorChunkSize: 2, historyLength: 4},
+		serviceCfg: &ServiceConfig{Database: slasherDB},
+	}
+	slashings, err := s.checkSlashableAttestations(ctx, 3, []*slashertypes.IndexedAttestationWrapper{
+		createAttestationWrapper(0, 3, []uint64{1}, 1),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(slashings))
+
+	minSpans := newSpanChunks(slashertypes.MinSpan, s.params, slasherDB, 0)
+	maxSpans := newSpanChunks(slashertypes.MaxSpan, s.params, slasherDB, 0)
+	span, err := maxSpans.get(ctx, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint16(2), span)
+
+	// Epoch 5 uses the same cells as epoch 1 in a history of 4 epochs,
+	// so these must be reset once the spans are written at epoch 5.
+	err = s.resetStaleEpochs(ctx, minSpans, maxSpans, map[types.ValidatorIndex]bool{1: true}, 5)
+	require.NoError(t, err)
+	span, err = maxSpans.get(ctx, 1, 5)
+	require.NoError(t, err)
+	assert.Equal(t, maxSpans.neutralElement(), span)
+	span, err = maxSpans.get(ctx, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, uint16(1), span)
+
+	attested, err := slasherDB.LastEpochWrittenForValidators(ctx, []types.ValidatorIndex{1})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(attested))
+	assert.Equal(t, types.Epoch(5), attested[0].Epoch)
+}
+
+func TestService_filterAttestations(t *testing.T) {
+	s := &Service{params: &Parameters{chunkSize: 2, validatorChunkSize: 2, historyLength: 4}}
+	atts := []*slashertypes.IndexedAttestationWrapper{
+		nil,
+		{IndexedAttestation: &ethpb.IndexedAttestation{}},
+		// Source greater than target.
+		createAttestationWrapper(5, 4, []uint64{1}, 0),
+		// Source too old to fit into the history.
+		createAttestationWrapper(2, 6, []uint64{1}, 0),
+		createAttestationWrapper(3, 6, []uint64{1}, 0),
+		createAttestationWrapper(5, 7, []uint64{1}, 0),
+	}
+	valid, validInFuture, numDropped := s.filterAttestations(atts, 6)
+	assert.Equal(t, 4, numDropped)
+	require.Equal(t, 1, len(valid))
+	assert.Equal(t, types.Epoch(3), valid[0].IndexedAttestation.Data.Source.Epoch)
+	require.Equal(t, 1, len(validInFuture))
+	assert.Equal(t, types.Epoch(7), validInFuture[0].IndexedAttestation.Data.Target.Epoch)
+}
+
+func createAttestationWrapper(
+	source, target types.Epoch, indices []uint64, signingRoot byte,
+) *slashertypes.IndexedAttestationWrapper {
+	data := &ethpb.AttestationData{
+		BeaconBlockRoot: make([]byte, 32),
+		Source: &ethpb.Checkpoint{
+			Epoch: source,
+			Root:  make([]byte, 32),
+		},
+		Target: &ethpb.Checkpoint{
+			Epoch: target,
+			Root:  make([]byte, 32),
+		},
+	}
+	return &slashertypes.IndexedAttestationWrapper{
+		IndexedAttestation: &ethpb.IndexedAttestation{
+			AttestingIndices: indices,
+			Data:             data,
+			Signature:        make([]byte, 96),
+		},
+		SigningRoot: [32]byte{signingRoot},
+	}
+}
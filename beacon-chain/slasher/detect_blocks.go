@@ -0,0 +1,56 @@
+package slasher
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// Detects double block proposals among a list of signed block headers, as well as between
+// the headers and the proposals stored in the slasher database. The proposals are then
+// saved to the database.
+func (s *Service) detectProposerSlashings(
+	ctx context.Context, proposedBlocks []*slashertypes.SignedBlockHeaderWrapper,
+) ([]*ethpb.ProposerSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "Slasher.detectProposerSlashings")
+	defer span.End()
+	if len(proposedBlocks) == 0 {
+		return nil, nil
+	}
+	type proposerSlot struct {
+		proposerIdx types.ValidatorIndex
+		slot        types.Slot
+	}
+	slashings := make([]*ethpb.ProposerSlashing, 0)
+	seen := make(map[proposerSlot]*slashertypes.SignedBlockHeaderWrapper)
+	for _, blk := range proposedBlocks {
+		header := blk.SignedBeaconBlockHeader.Header
+		key := proposerSlot{proposerIdx: header.ProposerIndex, slot: header.Slot}
+		existing, ok := seen[key]
+		if !ok {
+			seen[key] = blk
+			continue
+		}
+		if existing.SigningRoot != blk.SigningRoot {
+			slashings = append(slashings, &ethpb.ProposerSlashing{
+				Header_1: existing.SignedBeaconBlockHeader,
+				Header_2: blk.SignedBeaconBlockHeader,
+			})
+		}
+	}
+
+	dbSlashings, err := s.serviceCfg.Database.CheckDoubleBlockProposals(ctx, proposedBlocks)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check double block proposals")
+	}
+	slashings = append(slashings, dbSlashings...)
+
+	if err := s.serviceCfg.Database.SaveBlockProposals(ctx, proposedBlocks); err != nil {
+		return nil, errors.Wrap(err, "could not save block proposals")
+	}
+	return slashings, nil
+}
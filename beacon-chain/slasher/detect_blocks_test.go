@@ -0,0 +1,60 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_detectProposerSlashings(t *testing.T) {
+	ctx := context.Background()
+	s := &Service{
+		params:     DefaultParams(),
+		serviceCfg: &ServiceConfig{Database: dbtest.SetupSlasherDB(t)},
+	}
+	slashings, err := s.detectProposerSlashings(ctx, []*slashertypes.SignedBlockHeaderWrapper{
+		createProposalWrapper(1, 1, 1),
+		createProposalWrapper(2, 1, 1),
+		createProposalWrapper(2, 2, 2),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(slashings))
+
+	// A double proposal within the batch and a double proposal against the database.
+	slashings, err = s.detectProposerSlashings(ctx, []*slashertypes.SignedBlockHeaderWrapper{
+		createProposalWrapper(3, 1, 1),
+		createProposalWrapper(3, 1, 2),
+		createProposalWrapper(2, 2, 3),
+		createProposalWrapper(1, 1, 1),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(slashings))
+	for _, slashing := range slashings {
+		assert.Equal(t, slashing.Header_1.Header.Slot, slashing.Header_2.Header.Slot)
+		assert.Equal(t, slashing.Header_1.Header.ProposerIndex, slashing.Header_2.Header.ProposerIndex)
+		assert.NotEqual(t, slashing.Header_1.Header.BodyRoot[0], slashing.Header_2.Header.BodyRoot[0])
+	}
+}
+
+func createProposalWrapper(slot types.Slot, proposerIndex types.ValidatorIndex, signingRoot byte) *slashertypes.SignedBlockHeaderWrapper {
+	header := &ethpb.BeaconBlockHeader{
+		Slot:          slot,
+		ProposerIndex: proposerIndex,
+		ParentRoot:    make([]byte, 32),
+		StateRoot:     make([]byte, 32),
+		BodyRoot:      []byte{signingRoot, 31: 0},
+	}
+	return &slashertypes.SignedBlockHeaderWrapper{
+		SignedBeaconBlockHeader: &ethpb.SignedBeaconBlockHeader{
+			Header:    header,
+			Signature: make([]byte, 96),
+		},
+		SigningRoot: [32]byte{signingRoot},
+	}
+}
@@ -0,0 +1,6 @@
+// Package slasher implements slashing detection for the beacon node. It receives
+// indexed attestations and signed block headers from the sync and blockchain services,
+// batches them per slot, and checks them for double votes, surround votes and double
+// block proposals using min-max span chunks persisted in the slasher database. Found
+// slashings are submitted to the slashings pool for inclusion in blocks.
+package slasher
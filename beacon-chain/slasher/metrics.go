@@ -0,0 +1,29 @@
+package slasher
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	indexedAttsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_indexed_attestations_received_total",
+		Help: "Total number of indexed attestations received by the slasher",
+	})
+	droppedAttsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_dropped_attestations_total",
+		Help: "Total number of attestations dropped by the slasher for being outside of the history length or invalid",
+	})
+	beaconBlocksTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_beacon_block_headers_received_total",
+		Help: "Total number of block headers received by the slasher",
+	})
+	attesterSlashingsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_attester_slashings_found_total",
+		Help: "Total number of attester slashings found by the slasher",
+	})
+	proposerSlashingsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_proposer_slashings_found_total",
+		Help: "Total number of proposer slashings found by the slasher",
+	})
+)
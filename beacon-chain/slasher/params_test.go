@@ -0,0 +1,44 @@
+package slasher
+
+import (
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestParams_chunkIndex(t *testing.T) {
+	p := &Parameters{chunkSize: 3, validatorChunkSize: 3, historyLength: 12}
+	tests := []struct {
+		epoch types.Epoch
+		want  uint64
+	}{
+		{epoch: 0, want: 0},
+		{epoch: 2, want: 0},
+		{epoch: 3, want: 1},
+		{epoch: 11, want: 3},
+		// Epochs wrap around the history length.
+		{epoch: 12, want: 0},
+		{epoch: 16, want: 1},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, p.chunkIndex(tt.epoch))
+	}
+}
+
+func TestParams_cellIndex(t *testing.T) {
+	p := &Parameters{chunkSize: 3, validatorChunkSize: 3, historyLength: 12}
+	assert.Equal(t, uint64(0), p.cellIndex(0, 0))
+	assert.Equal(t, uint64(7), p.cellIndex(2, 1))
+	// Validator 5 is the third validator of its validator chunk.
+	assert.Equal(t, uint64(7), p.cellIndex(5, 4))
+	assert.Equal(t, uint64(1), p.validatorChunkIndex(5))
+}
+
+func TestParams_flatSliceID(t *testing.T) {
+	p := &Parameters{chunkSize: 3, validatorChunkSize: 3, historyLength: 12}
+	validatorChunkIdx := p.validatorChunkIndex(6)
+	chunkIdx := p.chunkIndex(6)
+	assert.DeepEqual(t, ssz.MarshalUint64(make([]byte, 0), 10), p.flatSliceID(validatorChunkIdx, chunkIdx))
+}
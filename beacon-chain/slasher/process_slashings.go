@@ -0,0 +1,56 @@
+package slasher
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/sirupsen/logrus"
+)
+
+// Submits detected attester slashings to the slashings pool. The pool verifies the
+// slashings against the head state, so slashings of validators which are already
+// slashed or exited are rejected there.
+func (s *Service) processAttesterSlashings(ctx context.Context, slashings []*ethpb.AttesterSlashing) {
+	if len(slashings) == 0 {
+		return
+	}
+	headState, err := s.serviceCfg.HeadStateFetcher.HeadState(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not get head state")
+		return
+	}
+	for _, slashing := range slashings {
+		attesterSlashingsTotal.Inc()
+		log.WithFields(logrus.Fields{
+			"sourceEpoch1": slashing.Attestation_1.Data.Source.Epoch,
+			"targetEpoch1": slashing.Attestation_1.Data.Target.Epoch,
+			"sourceEpoch2": slashing.Attestation_2.Data.Source.Epoch,
+			"targetEpoch2": slashing.Attestation_2.Data.Target.Epoch,
+		}).Info("Attester slashing detected")
+		if err := s.serviceCfg.SlashingPoolInserter.InsertAttesterSlashing(ctx, headState, slashing); err != nil {
+			log.WithError(err).Error("Could not insert attester slashing into operations pool")
+		}
+	}
+}
+
+// Submits detected proposer slashings to the slashings pool.
+func (s *Service) processProposerSlashings(ctx context.Context, slashings []*ethpb.ProposerSlashing) {
+	if len(slashings) == 0 {
+		return
+	}
+	headState, err := s.serviceCfg.HeadStateFetcher.HeadState(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not get head state")
+		return
+	}
+	for _, slashing := range slashings {
+		proposerSlashingsTotal.Inc()
+		log.WithFields(logrus.Fields{
+			"slot":          slashing.Header_1.Header.Slot,
+			"proposerIndex": slashing.Header_1.Header.ProposerIndex,
+		}).Info("Proposer slashing detected")
+		if err := s.serviceCfg.SlashingPoolInserter.InsertProposerSlashing(ctx, headState, slashing); err != nil {
+			log.WithError(err).Error("Could not insert proposer slashing into operations pool")
+		}
+	}
+}
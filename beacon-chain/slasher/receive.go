@@ -0,0 +1,221 @@
+package slasher
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// Receive indexed attestations from some source event feed,
+// validating their integrity before appending them to an attestation queue
+// for batch processing in a separate routine.
+func (s *Service) receiveAttestations(ctx context.Context, indexedAttsChan chan *ethpb.IndexedAttestation) {
+	sub := s.serviceCfg.IndexedAttestationsFeed.Subscribe(indexedAttsChan)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case att := <-indexedAttsChan:
+			if !validateAttestationIntegrity(att) {
+				droppedAttsTotal.Inc()
+				continue
+			}
+			signingRoot, err := att.Data.HashTreeRoot()
+			if err != nil {
+				log.WithError(err).Error("Could not get hash tree root of attestation")
+				continue
+			}
+			indexedAttsTotal.Inc()
+			s.attsQueue.push(&slashertypes.IndexedAttestationWrapper{
+				IndexedAttestation: att,
+				SigningRoot:        signingRoot,
+			})
+		case err := <-sub.Err():
+			log.WithError(err).Debug("Subscriber closed with error")
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Receive beacon block headers from some source event feed,
+// validating their integrity before appending them to a block queue
+// for batch processing in a separate routine.
+func (s *Service) receiveBlocks(ctx context.Context, beaconBlockHeadersChan chan *ethpb.SignedBeaconBlockHeader) {
+	sub := s.serviceCfg.BeaconBlockHeadersFeed.Subscribe(beaconBlockHeadersChan)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case blockHeader := <-beaconBlockHeadersChan:
+			if !validateBlockHeaderIntegrity(blockHeader) {
+				continue
+			}
+			signingRoot, err := blockHeader.Header.HashTreeRoot()
+			if err != nil {
+				log.WithError(err).Error("Could not get hash tree root of signed block header")
+				continue
+			}
+			beaconBlocksTotal.Inc()
+			s.blksQueue.push(&slashertypes.SignedBlockHeaderWrapper{
+				SignedBeaconBlockHeader: blockHeader,
+				SigningRoot:             signingRoot,
+			})
+		case err := <-sub.Err():
+			log.WithError(err).Debug("Subscriber closed with error")
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Process queued attestations every time a slot ticker fires. We retrieve
+// these attestations from a queue, then group them all by validator chunk index.
+// This grouping will allow us to perform detection on batches of attestations
+// per validator chunk index which can be done concurrently.
+func (s *Service) processQueuedAttestations(ctx context.Context, slotTicker <-chan types.Slot) {
+	for {
+		select {
+		case currentSlot := <-slotTicker:
+			attestations := s.attsQueue.dequeue()
+			currentEpoch := helpers.SlotToEpoch(currentSlot)
+			// We take all the attestations in the queue and filter out
+			// those which are valid now and valid in the future.
+			validAtts, validInFuture, numDropped := s.filterAttestations(attestations, currentEpoch)
+
+			droppedAttsTotal.Add(float64(numDropped))
+
+			// We add back those attestations that are valid in the future to the queue.
+			s.attsQueue.extend(validInFuture)
+
+			log.WithFields(logrus.Fields{
+				"currentSlot":     currentSlot,
+				"currentEpoch":    currentEpoch,
+				"numValidAtts":    len(validAtts),
+				"numDeferredAtts": len(validInFuture),
+				"numDroppedAtts":  numDropped,
+			}).Debug("Processing queued attestations for slashing detection")
+
+			slashings, err := s.checkSlashableAttestations(ctx, currentEpoch, validAtts)
+			if err != nil {
+				log.WithError(err).Error("Could not check slashable attestations")
+				continue
+			}
+			s.processAttesterSlashings(ctx, slashings)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Process queued blocks every time a slot ticker fires. We retrieve
+// these blocks from a queue and check them for double proposals.
+func (s *Service) processQueuedBlocks(ctx context.Context, slotTicker <-chan types.Slot) {
+	for {
+		select {
+		case currentSlot := <-slotTicker:
+			blocks := s.blksQueue.dequeue()
+
+			log.WithFields(logrus.Fields{
+				"currentSlot": currentSlot,
+				"numBlocks":   len(blocks),
+			}).Debug("Processing queued blocks for slashing detection")
+
+			slashings, err := s.detectProposerSlashings(ctx, blocks)
+			if err != nil {
+				log.WithError(err).Error("Could not detect proposer slashings")
+				continue
+			}
+			s.processProposerSlashings(ctx, slashings)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Prunes slasher data on each slot tick at the start of an epoch, removing
+// attestation and proposal records which fall out of the history length.
+func (s *Service) pruneSlasherData(ctx context.Context, slotTicker <-chan types.Slot) {
+	for {
+		select {
+		case currentSlot := <-slotTicker:
+			if !helpers.IsEpochStart(currentSlot) {
+				continue
+			}
+			currentEpoch := helpers.SlotToEpoch(currentSlot)
+			if err := s.serviceCfg.Database.PruneAttestations(
+				ctx, currentEpoch, pruningEpochIncrements, s.params.historyLength,
+			); err != nil {
+				log.WithError(err).Error("Could not prune attestations")
+				continue
+			}
+			if err := s.serviceCfg.Database.PruneProposals(
+				ctx, currentEpoch, pruningEpochIncrements, s.params.historyLength,
+			); err != nil {
+				log.WithError(err).Error("Could not prune proposals")
+				continue
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Filter attestations into valid attestations, attestations which are only
+// valid in a future epoch, and the number of attestations dropped for falling
+// outside of the history length kept by the slasher.
+func (s *Service) filterAttestations(
+	atts []*slashertypes.IndexedAttestationWrapper, currentEpoch types.Epoch,
+) (valid, validInFuture []*slashertypes.IndexedAttestationWrapper, numDropped int) {
+	valid = make([]*slashertypes.IndexedAttestationWrapper, 0, len(atts))
+	validInFuture = make([]*slashertypes.IndexedAttestationWrapper, 0)
+
+	for _, attWrapper := range atts {
+		if attWrapper == nil || !validateAttestationIntegrity(attWrapper.IndexedAttestation) {
+			numDropped++
+			continue
+		}
+		source := attWrapper.IndexedAttestation.Data.Source.Epoch
+		target := attWrapper.IndexedAttestation.Data.Target.Epoch
+
+		// If the attestation's source is too old to fit in our history, we drop it.
+		if source+s.params.historyLength <= currentEpoch {
+			numDropped++
+			continue
+		}
+
+		// If the attestation's target epoch is in the future, we defer it.
+		if target > currentEpoch {
+			validInFuture = append(validInFuture, attWrapper)
+		} else {
+			valid = append(valid, attWrapper)
+		}
+	}
+	return
+}
+
+// Validates the attestation data integrity, ensuring we have no nil values for
+// source and target epochs, and that the source epoch of the attestation must
+// be less than or equal to its target epoch.
+func validateAttestationIntegrity(att *ethpb.IndexedAttestation) bool {
+	if att == nil ||
+		att.Data == nil ||
+		att.Data.Source == nil ||
+		att.Data.Target == nil {
+		return false
+	}
+	return att.Data.Source.Epoch <= att.Data.Target.Epoch
+}
+
+// Validates the signed beacon block header integrity, ensuring we have no nil values.
+func validateBlockHeaderIntegrity(header *ethpb.SignedBeaconBlockHeader) bool {
+	if header == nil || header.Header == nil {
+		return false
+	}
+	return len(header.Signature) == params.BeaconConfig().BLSSignatureLength
+}
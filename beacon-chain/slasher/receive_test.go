@@ -0,0 +1,148 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_receiveAttestations(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Service{
+		serviceCfg: &ServiceConfig{IndexedAttestationsFeed: new(event.Feed)},
+		attsQueue:  newAttestationsQueue(),
+	}
+	indexedAttsChan := make(chan *ethpb.IndexedAttestation)
+	exitChan := make(chan struct{})
+	go func() {
+		s.receiveAttestations(ctx, indexedAttsChan)
+		exitChan <- struct{}{}
+	}()
+
+	valid := createAttestationWrapper(1, 2, []uint64{1}, 0).IndexedAttestation
+	invalid := createAttestationWrapper(3, 2, []uint64{1}, 0).IndexedAttestation
+	// Wait for the feed to be subscribed to.
+	for s.serviceCfg.IndexedAttestationsFeed.Send(valid) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	s.serviceCfg.IndexedAttestationsFeed.Send(invalid)
+	s.serviceCfg.IndexedAttestationsFeed.Send(valid)
+	cancel()
+	<-exitChan
+
+	atts := s.attsQueue.dequeue()
+	require.Equal(t, 2, len(atts))
+	root, err := valid.Data.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, root, atts[0].SigningRoot)
+}
+
+func TestService_receiveBlocks(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Service{
+		serviceCfg: &ServiceConfig{BeaconBlockHeadersFeed: new(event.Feed)},
+		blksQueue:  newBlocksQueue(),
+	}
+	beaconBlockHeadersChan := make(chan *ethpb.SignedBeaconBlockHeader)
+	exitChan := make(chan struct{})
+	go func() {
+		s.receiveBlocks(ctx, beaconBlockHeadersChan)
+		exitChan <- struct{}{}
+	}()
+
+	header := createProposalWrapper(1, 1, 1).SignedBeaconBlockHeader
+	// Wait for the feed to be subscribed to.
+	for s.serviceCfg.BeaconBlockHeadersFeed.Send(header) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	s.serviceCfg.BeaconBlockHeadersFeed.Send(&ethpb.SignedBeaconBlockHeader{Header: header.Header})
+	s.serviceCfg.BeaconBlockHeadersFeed.Send(header)
+	cancel()
+	<-exitChan
+
+	blks := s.blksQueue.dequeue()
+	require.Equal(t, 2, len(blks))
+	root, err := header.Header.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, root, blks[0].SigningRoot)
+}
+
+func TestService_processQueuedAttestations(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	beaconState, _ := testutil.DeterministicGenesisState(t, 8)
+	pool := &slashings.PoolMock{}
+	s := &Service{
+		params: DefaultParams(),
+		serviceCfg: &ServiceConfig{
+			Database:             dbtest.SetupSlasherDB(t),
+			HeadStateFetcher:     &mock.ChainService{State: beaconState},
+			SlashingPoolInserter: pool,
+		},
+		attsQueue: newAttestationsQueue(),
+	}
+	s.attsQueue.extend([]*slashertypes.IndexedAttestationWrapper{
+		createAttestationWrapper(0, 1, []uint64{1, 2}, 1),
+		createAttestationWrapper(0, 1, []uint64{2, 3}, 2),
+		// Deferred until its target epoch is reached.
+		createAttestationWrapper(1, 3, []uint64{4}, 3),
+	})
+
+	slotTicker := make(chan types.Slot)
+	exitChan := make(chan struct{})
+	go func() {
+		s.processQueuedAttestations(ctx, slotTicker)
+		exitChan <- struct{}{}
+	}()
+	currentSlot := params.BeaconConfig().SlotsPerEpoch
+	slotTicker <- currentSlot
+	// The second tick is only received once the first batch has been processed.
+	slotTicker <- currentSlot + 1
+	cancel()
+	<-exitChan
+
+	require.Equal(t, 1, len(pool.PendingAttSlashings))
+	assert.Equal(t, 1, s.attsQueue.size())
+}
+
+func TestService_processQueuedBlocks(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	beaconState, _ := testutil.DeterministicGenesisState(t, 8)
+	pool := &slashings.PoolMock{}
+	s := &Service{
+		params: DefaultParams(),
+		serviceCfg: &ServiceConfig{
+			Database:             dbtest.SetupSlasherDB(t),
+			HeadStateFetcher:     &mock.ChainService{State: beaconState},
+			SlashingPoolInserter: pool,
+		},
+		blksQueue: newBlocksQueue(),
+	}
+	s.blksQueue.push(createProposalWrapper(4, 1, 1))
+	s.blksQueue.push(createProposalWrapper(4, 1, 2))
+
+	slotTicker := make(chan types.Slot)
+	exitChan := make(chan struct{})
+	go func() {
+		s.processQueuedBlocks(ctx, slotTicker)
+		exitChan <- struct{}{}
+	}()
+	slotTicker <- 4
+	slotTicker <- 5
+	cancel()
+	<-exitChan
+
+	require.Equal(t, 1, len(pool.PendingPropSlashings))
+	assert.Equal(t, 0, s.blksQueue.size())
+}
@@ -0,0 +1,168 @@
+package slasher
+
+import (
+	"context"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+)
+
+var _ shared.Service = (*Service)(nil)
+
+// The number of epochs pruned from the slasher database at once.
+const pruningEpochIncrements = 10
+
+// ServiceConfig for the slasher service in the beacon node.
+// This struct allows us to specify required dependencies and
+// parameters for slasher to function as needed.
+type ServiceConfig struct {
+	IndexedAttestationsFeed *event.Feed
+	BeaconBlockHeadersFeed  *event.Feed
+	Database                db.SlasherDatabase
+	StateNotifier           statefeed.Notifier
+	HeadStateFetcher        blockchain.HeadFetcher
+	GenesisTimeFetcher      blockchain.TimeFetcher
+	SlashingPoolInserter    slashings.PoolManager
+	SyncChecker             sync.Checker
+}
+
+// Service defining a slasher implementation as part of
+// the beacon node, able to detect eth2 slashable offenses.
+type Service struct {
+	params            *Parameters
+	serviceCfg        *ServiceConfig
+	attsQueue         *attestationsQueue
+	blksQueue         *blocksQueue
+	ctx               context.Context
+	cancel            context.CancelFunc
+	genesisTime       time.Time
+	attsSlotTicker    *slotutil.SlotTicker
+	blocksSlotTicker  *slotutil.SlotTicker
+	pruningSlotTicker *slotutil.SlotTicker
+}
+
+// NewService instantiates a new slasher from configuration values.
+func NewService(ctx context.Context, srvCfg *ServiceConfig) (*Service, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		params:     DefaultParams(),
+		serviceCfg: srvCfg,
+		attsQueue:  newAttestationsQueue(),
+		blksQueue:  newBlocksQueue(),
+		ctx:        ctx,
+		cancel:     cancel,
+	}, nil
+}
+
+// Start listening for received indexed attestations and blocks
+// and perform slashing detection on them.
+func (s *Service) Start() {
+	go s.run()
+}
+
+func (s *Service) run() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.serviceCfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	genesisTime, ok := s.waitForChainInitialization(stateChannel)
+	stateSub.Unsubscribe()
+	if !ok {
+		return
+	}
+	s.genesisTime = genesisTime
+	if !s.waitForSync() {
+		return
+	}
+
+	log.Info("Completed chain sync, starting slashing detection")
+
+	indexedAttsChan := make(chan *ethpb.IndexedAttestation, 1)
+	beaconBlockHeadersChan := make(chan *ethpb.SignedBeaconBlockHeader, 1)
+	go s.receiveAttestations(s.ctx, indexedAttsChan)
+	go s.receiveBlocks(s.ctx, beaconBlockHeadersChan)
+
+	secondsPerSlot := params.BeaconConfig().SecondsPerSlot
+	s.attsSlotTicker = slotutil.NewSlotTicker(s.genesisTime, secondsPerSlot)
+	s.blocksSlotTicker = slotutil.NewSlotTicker(s.genesisTime, secondsPerSlot)
+	s.pruningSlotTicker = slotutil.NewSlotTicker(s.genesisTime, secondsPerSlot)
+	go s.processQueuedAttestations(s.ctx, s.attsSlotTicker.C())
+	go s.processQueuedBlocks(s.ctx, s.blocksSlotTicker.C())
+	go s.pruneSlasherData(s.ctx, s.pruningSlotTicker.C())
+}
+
+// Stop the slasher service.
+func (s *Service) Stop() error {
+	s.cancel()
+	if s.attsSlotTicker != nil {
+		s.attsSlotTicker.Done()
+	}
+	if s.blocksSlotTicker != nil {
+		s.blocksSlotTicker.Done()
+	}
+	if s.pruningSlotTicker != nil {
+		s.pruningSlotTicker.Done()
+	}
+	return nil
+}
+
+// Status of the slasher service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// Waits for the beacon chain to be initialized, returning the genesis time of the chain.
+// Returns false if the service is stopped before that.
+func (s *Service) waitForChainInitialization(stateChannel chan *feed.Event) (time.Time, bool) {
+	if genesisTime := s.serviceCfg.GenesisTimeFetcher.GenesisTime(); !genesisTime.IsZero() {
+		return genesisTime, true
+	}
+	for {
+		select {
+		case stateEvent := <-stateChannel:
+			if stateEvent.Type != statefeed.Initialized {
+				continue
+			}
+			data, ok := stateEvent.Data.(*statefeed.InitializedData)
+			if !ok {
+				log.Error("Could not receive chain start notification, want *statefeed.InitializedData")
+				return time.Time{}, false
+			}
+			log.WithField("genesisTime", data.StartTime).Info("Slasher received chain initialization event")
+			return data.StartTime, true
+		case <-s.ctx.Done():
+			return time.Time{}, false
+		}
+	}
+}
+
+// Waits for the beacon node to be synced to the head of the chain, as slashing detection
+// on historical data received during initial sync is not performed. Returns false if the
+// service is stopped before that.
+func (s *Service) waitForSync() bool {
+	if slotutil.SlotsSinceGenesis(s.genesisTime) < params.BeaconConfig().SlotsPerEpoch || !s.serviceCfg.SyncChecker.Syncing() {
+		return true
+	}
+	slotTicker := slotutil.NewSlotTicker(s.genesisTime, params.BeaconConfig().SecondsPerSlot)
+	defer slotTicker.Done()
+	for {
+		select {
+		case <-slotTicker.C():
+			// If node is still syncing, do not operate slasher.
+			if s.serviceCfg.SyncChecker.Syncing() {
+				continue
+			}
+			return true
+		case <-s.ctx.Done():
+			return false
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_waitForChainInitialization(t *testing.T) {
+	chainService := &mock.ChainService{}
+	srv, err := NewService(context.Background(), &ServiceConfig{
+		StateNotifier:      chainService.StateNotifier(),
+		GenesisTimeFetcher: chainService,
+	})
+	require.NoError(t, err)
+
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := srv.serviceCfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+
+	genesisTime := time.Unix(1606824023, 0)
+	type result struct {
+		genesisTime time.Time
+		ok          bool
+	}
+	resultChan := make(chan result, 1)
+	go func() {
+		genesis, ok := srv.waitForChainInitialization(stateChannel)
+		resultChan <- result{genesisTime: genesis, ok: ok}
+	}()
+	srv.serviceCfg.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.Initialized,
+		Data: &statefeed.InitializedData{StartTime: genesisTime},
+	})
+	res := <-resultChan
+	assert.Equal(t, true, res.ok)
+	assert.Equal(t, genesisTime, res.genesisTime)
+}
+
+func TestService_waitForChainInitialization_GenesisKnown(t *testing.T) {
+	genesisTime := time.Unix(1606824023, 0)
+	chainService := &mock.ChainService{Genesis: genesisTime}
+	srv, err := NewService(context.Background(), &ServiceConfig{
+		StateNotifier:      chainService.StateNotifier(),
+		GenesisTimeFetcher: chainService,
+	})
+	require.NoError(t, err)
+	genesis, ok := srv.waitForChainInitialization(make(chan *feed.Event))
+	assert.Equal(t, true, ok)
+	assert.Equal(t, genesisTime, genesis)
+}
+
+func TestService_waitForSync_Stopped(t *testing.T) {
+	srv, err := NewService(context.Background(), &ServiceConfig{
+		SyncChecker: &mockSync.Sync{IsSyncing: true},
+	})
+	require.NoError(t, err)
+	srv.genesisTime = time.Now().Add(-time.Hour)
+	require.NoError(t, srv.Stop())
+	assert.Equal(t, false, srv.waitForSync())
+
+	srv.serviceCfg.SyncChecker = &mockSync.Sync{IsSyncing: false}
+	assert.Equal(t, true, srv.waitForSync())
+}
@@ -8,6 +8,7 @@ import (
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"go.opencensus.io/trace"
 )
@@ -173,6 +174,15 @@ func (s *State) loadStateByRoot(ctx context.Context, blockRoot [32]byte) (iface.
 	}
 	targetSlot := summary.Slot
 
+	if featureconfig.Get().EnableHierarchicalStateDiffs && s.isColdSlot(targetSlot) && s.beaconDB.IsFinalizedBlock(ctx, blockRoot) {
+		st, err := s.loadColdStateFromDiffs(ctx, targetSlot, blockRoot)
+		// Fall back to replaying blocks if the epoch boundary state was finalized before
+		// hierarchical state diffs were enabled.
+		if !errors.Is(err, errUnknownHierarchicalState) {
+			return st, err
+		}
+	}
+
 	// Since the requested state is not in caches, start replaying using the last available ancestor state which is
 	// retrieved using input block's parent root.
 	startState, err := s.lastAncestorState(ctx, blockRoot)
@@ -207,6 +217,9 @@ func (s *State) loadStateBySlot(ctx context.Context, slot types.Slot) (iface.Bea
 	if slot == 0 {
 		return s.beaconDB.GenesisState(ctx)
 	}
+	if s.isBeforeOrigin(slot) {
+		return nil, errors.Wrapf(errSlotBeforeOrigin, "slot %d, origin slot %d", slot, s.origin.slot)
+	}
 
 	// Gather the last saved block root and the slot number.
 	lastValidRoot, lastValidSlot, err := s.lastSavedBlock(ctx, slot)
@@ -214,6 +227,13 @@ func (s *State) loadStateBySlot(ctx context.Context, slot types.Slot) (iface.Bea
 		return nil, errors.Wrap(err, "could not get last valid block for hot state using slot")
 	}
 
+	if featureconfig.Get().EnableHierarchicalStateDiffs && s.isColdSlot(slot) {
+		st, err := s.loadColdStateFromDiffs(ctx, slot, lastValidRoot)
+		if !errors.Is(err, errUnknownHierarchicalState) {
+			return st, err
+		}
+	}
+
 	replayStartState, err := s.loadStateByRoot(ctx, lastValidRoot)
 	if err != nil {
 		return nil, err
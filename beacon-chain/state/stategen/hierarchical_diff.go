@@ -0,0 +1,235 @@
+package stategen
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var errMismatchedStateTypes = errors.New("cannot diff states of different types")
+
+// diffStates returns a compact binary diff which turns the base state into the target state.
+// The diff is generic over the fields of the state protobuf, so it handles every state version:
+// each changed field is written as its field number followed by its encoded change.
+//
+// Singular fields are written in full. Repeated uint64 fields, such as balances, are written as
+// the new length followed by the zigzag encoded delta of every element, which is mostly zeros
+// and small numbers that compress well. Other repeated fields, such as the validator registry
+// or the block roots, are written as the new length followed by the changed elements only.
+func diffStates(base, target proto.Message) ([]byte, error) {
+	bm, tm := base.ProtoReflect(), target.ProtoReflect()
+	if bm.Descriptor().FullName() != tm.Descriptor().FullName() {
+		return nil, errMismatchedStateTypes
+	}
+	var diff []byte
+	fields := tm.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		var enc []byte
+		var changed bool
+		var err error
+		if fd.IsList() {
+			enc, changed, err = diffList(fd, bm.Get(fd).List(), tm.Get(fd).List())
+		} else {
+			enc, changed, err = diffSingular(fd, bm.Get(fd), tm.Get(fd))
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not diff field %s", fd.Name())
+		}
+		if !changed {
+			continue
+		}
+		diff = protowire.AppendVarint(diff, uint64(fd.Number()))
+		diff = protowire.AppendBytes(diff, enc)
+	}
+	return diff, nil
+}
+
+// applyStateDiff applies a diff created by diffStates to the base state, which is modified in place.
+func applyStateDiff(base proto.Message, diff []byte) error {
+	m := base.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	for len(diff) > 0 {
+		num, n := protowire.ConsumeVarint(diff)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		diff = diff[n:]
+		enc, n := protowire.ConsumeBytes(diff)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		diff = diff[n:]
+
+		fd := fields.ByNumber(protowire.Number(num))
+		if fd == nil {
+			return errors.Errorf("unknown field number %d in state diff", num)
+		}
+		var err error
+		if fd.IsList() {
+			err = applyListDiff(fd, m.Mutable(fd).List(), enc)
+		} else {
+			var v protoreflect.Value
+			v, err = decodeValue(fd, enc, func() protoreflect.Value { return m.NewField(fd) })
+			if err == nil {
+				m.Set(fd, v)
+			}
+		}
+		if err != nil {
+			return errors.Wrapf(err, "could not apply diff of field %s", fd.Name())
+		}
+	}
+	return nil
+}
+
+func diffSingular(fd protoreflect.FieldDescriptor, base, target protoreflect.Value) ([]byte, bool, error) {
+	equal, err := equalValues(fd, base, target)
+	if err != nil || equal {
+		return nil, false, err
+	}
+	enc, err := encodeValue(fd, target)
+	return enc, true, err
+}
+
+func diffList(fd protoreflect.FieldDescriptor, base, target protoreflect.List) ([]byte, bool, error) {
+	changed := base.Len() != target.Len()
+	enc := protowire.AppendVarint(nil, uint64(target.Len()))
+	if fd.Kind() == protoreflect.Uint64Kind {
+		for i := 0; i < target.Len(); i++ {
+			var old uint64
+			if i < base.Len() {
+				old = base.Get(i).Uint()
+			}
+			delta := target.Get(i).Uint() - old
+			changed = changed || delta != 0
+			enc = protowire.AppendVarint(enc, protowire.EncodeZigZag(int64(delta)))
+		}
+		return enc, changed, nil
+	}
+	for i := 0; i < target.Len(); i++ {
+		if i < base.Len() {
+			equal, err := equalValues(fd, base.Get(i), target.Get(i))
+			if err != nil {
+				return nil, false, err
+			}
+			if equal {
+				continue
+			}
+		}
+		v, err := encodeValue(fd, target.Get(i))
+		if err != nil {
+			return nil, false, err
+		}
+		changed = true
+		enc = protowire.AppendVarint(enc, uint64(i))
+		enc = protowire.AppendBytes(enc, v)
+	}
+	return enc, changed, nil
+}
+
+func applyListDiff(fd protoreflect.FieldDescriptor, l protoreflect.List, enc []byte) error {
+	length, n := protowire.ConsumeVarint(enc)
+	if n < 0 {
+		return protowire.ParseError(n)
+	}
+	enc = enc[n:]
+	newLen := int(length)
+	if newLen < l.Len() {
+		l.Truncate(newLen)
+	}
+	for l.Len() < newLen {
+		switch fd.Kind() {
+		case protoreflect.Uint64Kind:
+			l.Append(protoreflect.ValueOfUint64(0))
+		case protoreflect.BytesKind:
+			l.Append(protoreflect.ValueOfBytes(nil))
+		default:
+			l.Append(l.NewElement())
+		}
+	}
+
+	if fd.Kind() == protoreflect.Uint64Kind {
+		for i := 0; i < newLen; i++ {
+			delta, n := protowire.ConsumeVarint(enc)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			enc = enc[n:]
+			l.Set(i, protoreflect.ValueOfUint64(l.Get(i).Uint()+uint64(protowire.DecodeZigZag(delta))))
+		}
+		return nil
+	}
+	for len(enc) > 0 {
+		idx, n := protowire.ConsumeVarint(enc)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		enc = enc[n:]
+		elem, n := protowire.ConsumeBytes(enc)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		enc = enc[n:]
+		if idx >= uint64(newLen) {
+			return errors.Errorf("element index %d out of range of list of length %d", idx, newLen)
+		}
+		v, err := decodeValue(fd, elem, l.NewElement)
+		if err != nil {
+			return err
+		}
+		l.Set(int(idx), v)
+	}
+	return nil
+}
+
+func equalValues(fd protoreflect.FieldDescriptor, a, b protoreflect.Value) (bool, error) {
+	switch fd.Kind() {
+	case protoreflect.Uint64Kind:
+		return a.Uint() == b.Uint(), nil
+	case protoreflect.BytesKind:
+		return bytes.Equal(a.Bytes(), b.Bytes()), nil
+	case protoreflect.MessageKind:
+		return proto.Equal(a.Message().Interface(), b.Message().Interface()), nil
+	default:
+		return false, errors.Errorf("unsupported field kind %s", fd.Kind())
+	}
+}
+
+func encodeValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) ([]byte, error) {
+	switch fd.Kind() {
+	case protoreflect.Uint64Kind:
+		return protowire.AppendVarint(nil, v.Uint()), nil
+	case protoreflect.BytesKind:
+		return v.Bytes(), nil
+	case protoreflect.MessageKind:
+		return proto.Marshal(v.Message().Interface())
+	default:
+		return nil, errors.Errorf("unsupported field kind %s", fd.Kind())
+	}
+}
+
+// decodeValue decodes a value encoded by encodeValue. Messages are unmarshaled into the
+// empty message returned by newMessage.
+func decodeValue(fd protoreflect.FieldDescriptor, enc []byte, newMessage func() protoreflect.Value) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.Uint64Kind:
+		v, n := protowire.ConsumeVarint(enc)
+		if n < 0 {
+			return protoreflect.Value{}, protowire.ParseError(n)
+		}
+		return protoreflect.ValueOfUint64(v), nil
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes(append([]byte{}, enc...)), nil
+	case protoreflect.MessageKind:
+		v := newMessage()
+		if err := proto.Unmarshal(enc, v.Message().Interface()); err != nil {
+			return protoreflect.Value{}, err
+		}
+		return v, nil
+	default:
+		return protoreflect.Value{}, errors.Errorf("unsupported field kind %s", fd.Kind())
+	}
+}
@@ -0,0 +1,74 @@
+package stategen
+
+import (
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func diffTestState() *pb.BeaconState {
+	st := &pb.BeaconState{
+		Slot:       64,
+		Fork:       &pb.Fork{PreviousVersion: []byte{0, 0, 0, 0}, CurrentVersion: []byte{0, 0, 0, 0}},
+		BlockRoots: [][]byte{bytesutil.PadTo([]byte{'a'}, 32), bytesutil.PadTo([]byte{'b'}, 32)},
+		Eth1DataVotes: []*ethpb.Eth1Data{
+			{DepositCount: 1, BlockHash: bytesutil.PadTo([]byte{'c'}, 32)},
+			{DepositCount: 2, BlockHash: bytesutil.PadTo([]byte{'d'}, 32)},
+		},
+		FinalizedCheckpoint: &ethpb.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte{'e'}, 32)},
+	}
+	for i := 0; i < 4; i++ {
+		st.Validators = append(st.Validators, &ethpb.Validator{
+			PublicKey:        bytesutil.PadTo([]byte{byte(i)}, 48),
+			EffectiveBalance: 32000000000,
+			ExitEpoch:        1 << 63,
+		})
+		st.Balances = append(st.Balances, 32000000000)
+	}
+	return st
+}
+
+func TestDiffStates_RoundTrip(t *testing.T) {
+	base := diffTestState()
+	target := proto.Clone(base).(*pb.BeaconState)
+	target.Slot = 96
+	target.BlockRoots[1] = bytesutil.PadTo([]byte{'f'}, 32)
+	target.Eth1DataVotes = target.Eth1DataVotes[:1]
+	target.FinalizedCheckpoint = &ethpb.Checkpoint{Epoch: 2, Root: bytesutil.PadTo([]byte{'g'}, 32)}
+	target.Validators[2].Slashed = true
+	target.Validators = append(target.Validators, &ethpb.Validator{
+		PublicKey:        bytesutil.PadTo([]byte{'h'}, 48),
+		EffectiveBalance: 32000000000,
+	})
+	target.Balances[0] += 1000
+	target.Balances[1] -= 2000
+	target.Balances = append(target.Balances, 32000000000)
+
+	diff, err := diffStates(base, target)
+	require.NoError(t, err)
+	got := proto.Clone(base).(*pb.BeaconState)
+	require.NoError(t, applyStateDiff(got, diff))
+	assert.Equal(t, true, proto.Equal(target, got), "Applied diff does not match target state")
+
+	// The unchanged fields are not part of the diff.
+	snapshot, err := proto.Marshal(target)
+	require.NoError(t, err)
+	assert.Equal(t, true, len(diff) < len(snapshot), "Diff is not smaller than the full state")
+}
+
+func TestDiffStates_NoChanges(t *testing.T) {
+	base := diffTestState()
+	diff, err := diffStates(base, proto.Clone(base))
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(diff))
+}
+
+func TestDiffStates_MismatchedTypes(t *testing.T) {
+	_, err := diffStates(diffTestState(), &pb.BeaconStateAltair{})
+	assert.ErrorContains(t, errMismatchedStateTypes.Error(), err)
+}
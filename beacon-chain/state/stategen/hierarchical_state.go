@@ -0,0 +1,279 @@
+package stategen
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	v2 "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// hierarchicalStateLevels are the intervals in epochs of the levels of the hierarchical state diffs.
+// States of the first level are saved as full snapshots (every ~291 days), states of the following
+// levels (every ~36 days, ~27 hours and every epoch) are saved as diffs against the closest state of
+// a coarser level. Rebuilding an epoch boundary state applies at most one diff per level.
+var hierarchicalStateLevels = []types.Epoch{1 << 16, 1 << 13, 1 << 8, 1}
+
+const (
+	hierarchicalSnapshot byte = iota
+	hierarchicalDiff
+)
+
+var errUnknownHierarchicalState = errors.New("unknown hierarchical state")
+
+// hierarchicalStateLevel returns the level of the hierarchical state of the given epoch.
+func hierarchicalStateLevel(epoch types.Epoch) int {
+	for i, interval := range hierarchicalStateLevels {
+		if epoch%interval == 0 {
+			return i
+		}
+	}
+	return len(hierarchicalStateLevels) - 1
+}
+
+// saveHierarchicalState saves an epoch boundary state as a hierarchical state. The state is
+// saved as a diff against the closest saved state of a coarser level, falling back to the
+// lowest saved state when the coarser states are missing (e.g. after checkpoint sync). It is
+// saved as a full snapshot when it belongs to the first level or no base state exists.
+func (s *State) saveHierarchicalState(ctx context.Context, st iface.BeaconState) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.saveHierarchicalState")
+	defer span.End()
+
+	slot := st.Slot()
+	if !helpers.IsEpochStart(slot) {
+		return errors.Errorf("state at slot %d is not an epoch boundary state", slot)
+	}
+	epoch := helpers.SlotToEpoch(slot)
+	target, ok := st.CloneInnerState().(proto.Message)
+	if !ok {
+		return errors.New("state is not a protobuf message")
+	}
+
+	baseSlot, hasBase, err := s.hierarchicalBaseSlot(ctx, epoch)
+	if err != nil {
+		return err
+	}
+	if hasBase {
+		base, baseVersion, err := s.loadHierarchicalStateProto(ctx, baseSlot)
+		if err != nil {
+			return errors.Wrapf(err, "could not load base state at slot %d", baseSlot)
+		}
+		// States of different forks cannot be diffed, the first state of a fork is saved in full.
+		if baseVersion == st.Version() {
+			diff, err := diffStates(base, target)
+			if err != nil {
+				return err
+			}
+			enc := []byte{hierarchicalDiff, byte(st.Version())}
+			enc = protowire.AppendVarint(enc, uint64(baseSlot))
+			enc = append(enc, snappy.Encode(nil, diff)...)
+			return s.beaconDB.SaveHierarchicalState(ctx, slot, enc)
+		}
+	}
+
+	snapshot, err := proto.Marshal(target)
+	if err != nil {
+		return err
+	}
+	enc := append([]byte{hierarchicalSnapshot, byte(st.Version())}, snappy.Encode(nil, snapshot)...)
+	return s.beaconDB.SaveHierarchicalState(ctx, slot, enc)
+}
+
+// hierarchicalBaseSlot returns the slot of the state the hierarchical state of the given epoch is
+// diffed against. It returns false if the state should be saved as a full snapshot.
+func (s *State) hierarchicalBaseSlot(ctx context.Context, epoch types.Epoch) (types.Slot, bool, error) {
+	level := hierarchicalStateLevel(epoch)
+	if level == 0 {
+		return 0, false, nil
+	}
+	for i := level - 1; i >= 0; i-- {
+		baseSlot, err := helpers.StartSlot(epoch - epoch%hierarchicalStateLevels[i])
+		if err != nil {
+			return 0, false, err
+		}
+		if s.beaconDB.HasHierarchicalState(ctx, baseSlot) {
+			return baseSlot, true, nil
+		}
+	}
+	lowest, exists, err := s.beaconDB.LowestHierarchicalStateSlot(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+	slot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return 0, false, err
+	}
+	if !exists || lowest >= slot {
+		return 0, false, nil
+	}
+	return lowest, true, nil
+}
+
+// hierarchicalState rebuilds the epoch boundary state saved at the given slot from its snapshot
+// and diffs. It returns errUnknownHierarchicalState if no state was saved at the slot.
+func (s *State) hierarchicalState(ctx context.Context, slot types.Slot) (iface.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.hierarchicalState")
+	defer span.End()
+
+	st, v, err := s.loadHierarchicalStateProto(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	switch v {
+	case version.Phase0:
+		return v1.InitializeFromProtoUnsafe(st.(*pb.BeaconState))
+	case version.Altair:
+		return v2.InitializeFromProtoUnsafe(st.(*pb.BeaconStateAltair))
+	default:
+		return nil, errors.Errorf("unknown state version %d", v)
+	}
+}
+
+// loadHierarchicalStateProto returns the state protobuf and the state version of the hierarchical
+// state at the given slot, recursively applying its diff on top of its base state.
+func (s *State) loadHierarchicalStateProto(ctx context.Context, slot types.Slot) (proto.Message, int, error) {
+	if ctx.Err() != nil {
+		return nil, 0, ctx.Err()
+	}
+	enc, err := s.beaconDB.HierarchicalState(ctx, slot)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(enc) < 2 {
+		return nil, 0, errors.Wrapf(errUnknownHierarchicalState, "slot %d", slot)
+	}
+	kind, v, enc := enc[0], int(enc[1]), enc[2:]
+
+	switch kind {
+	case hierarchicalSnapshot:
+		snapshot, err := snappy.Decode(nil, enc)
+		if err != nil {
+			return nil, 0, err
+		}
+		var st proto.Message
+		switch v {
+		case version.Phase0:
+			st = &pb.BeaconState{}
+		case version.Altair:
+			st = &pb.BeaconStateAltair{}
+		default:
+			return nil, 0, errors.Errorf("unknown state version %d", v)
+		}
+		if err := proto.Unmarshal(snapshot, st); err != nil {
+			return nil, 0, err
+		}
+		return st, v, nil
+	case hierarchicalDiff:
+		baseSlot, n := protowire.ConsumeVarint(enc)
+		if n < 0 {
+			return nil, 0, protowire.ParseError(n)
+		}
+		if types.Slot(baseSlot) >= slot {
+			return nil, 0, errors.Errorf("base slot %d of state diff is not below slot %d", baseSlot, slot)
+		}
+		diff, err := snappy.Decode(nil, enc[n:])
+		if err != nil {
+			return nil, 0, err
+		}
+		base, baseVersion, err := s.loadHierarchicalStateProto(ctx, types.Slot(baseSlot))
+		if err != nil {
+			return nil, 0, err
+		}
+		if baseVersion != v {
+			return nil, 0, errMismatchedStateTypes
+		}
+		if err := applyStateDiff(base, diff); err != nil {
+			return nil, 0, errors.Wrapf(err, "could not apply state diff at slot %d", slot)
+		}
+		return base, v, nil
+	default:
+		return nil, 0, errors.Errorf("unknown hierarchical state kind %d", kind)
+	}
+}
+
+// loadColdStateFromDiffs rebuilds a finalized state by loading the hierarchical state of its epoch
+// boundary and replaying the blocks of at most one epoch on top of it. The block root is the root
+// of the last block at or below the target slot.
+func (s *State) loadColdStateFromDiffs(ctx context.Context, targetSlot types.Slot, blockRoot [32]byte) (iface.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.loadColdStateFromDiffs")
+	defer span.End()
+
+	boundarySlot, err := helpers.StartSlot(helpers.SlotToEpoch(targetSlot))
+	if err != nil {
+		return nil, err
+	}
+	st, err := s.hierarchicalState(ctx, boundarySlot)
+	if err != nil {
+		return nil, err
+	}
+	if targetSlot == boundarySlot {
+		return st, nil
+	}
+	blks, err := s.LoadBlocks(ctx, boundarySlot+1, targetSlot, blockRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load blocks for cold state using hierarchical state")
+	}
+	replayBlockCount.Observe(float64(len(blks)))
+	return s.ReplayBlocks(ctx, st, blks, targetSlot)
+}
+
+// migrateHierarchicalState saves the epoch boundary state of the given slot as a hierarchical
+// state. In the skip slot scenario, the state of the highest block below the slot is advanced
+// to the epoch boundary.
+func (s *State) migrateHierarchicalState(ctx context.Context, slot types.Slot) error {
+	if s.beaconDB.HasHierarchicalState(ctx, slot) {
+		return nil
+	}
+	cached, exists, err := s.epochBoundaryStateCache.getBySlot(slot)
+	if err != nil {
+		return fmt.Errorf("could not get epoch boundary state for slot %d", slot)
+	}
+	var st iface.BeaconState
+	if exists {
+		st = cached.state
+	} else {
+		blks, err := s.beaconDB.HighestSlotBlocksBelow(ctx, slot+1)
+		if err != nil {
+			return err
+		}
+		// Given the block has been finalized, the db should not have more than one block in a given slot.
+		if len(blks) != 1 {
+			return errUnknownBlock
+		}
+		root, err := blks[0].Block().HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		st, err = s.StateByRoot(ctx, root)
+		if err != nil {
+			return err
+		}
+		// The state may be shared with the epoch boundary state cache, copy it before advancing it.
+		st, err = processSlotsStateGen(ctx, st.Copy(), slot)
+		if err != nil {
+			return err
+		}
+	}
+	if err := s.saveHierarchicalState(ctx, st); err != nil {
+		return errors.Wrapf(err, "could not save hierarchical state at slot %d", slot)
+	}
+	log.WithField("slot", slot).Debug("Saved hierarchical state in DB")
+	return nil
+}
+
+// isColdSlot returns true if the given slot is below the finalized slot.
+func (s *State) isColdSlot(slot types.Slot) bool {
+	s.finalizedInfo.lock.RLock()
+	defer s.finalizedInfo.lock.RUnlock()
+	return slot < s.finalizedInfo.slot
+}
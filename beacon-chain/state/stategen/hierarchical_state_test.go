@@ -0,0 +1,171 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func hierarchicalTestState(t *testing.T, epoch types.Epoch) iface.BeaconState {
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	slot, err := helpers.StartSlot(epoch)
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(slot))
+	balances := make([]uint64, 8)
+	for i := range balances {
+		balances[i] = 32000000000 + uint64(epoch)*uint64(i)
+	}
+	require.NoError(t, st.SetBalances(balances))
+	return st
+}
+
+// hierarchicalEntry returns the kind and the base slot of the hierarchical state saved at the epoch.
+func hierarchicalEntry(t *testing.T, s *State, epoch types.Epoch) (byte, types.Slot) {
+	slot, err := helpers.StartSlot(epoch)
+	require.NoError(t, err)
+	enc, err := s.beaconDB.HierarchicalState(context.Background(), slot)
+	require.NoError(t, err)
+	require.Equal(t, true, len(enc) > 2, "No hierarchical state saved")
+	if enc[0] == hierarchicalSnapshot {
+		return enc[0], 0
+	}
+	baseSlot, n := protowire.ConsumeVarint(enc[2:])
+	require.Equal(t, true, n > 0)
+	return enc[0], types.Slot(baseSlot)
+}
+
+func TestHierarchicalStateLevel(t *testing.T) {
+	assert.Equal(t, 0, hierarchicalStateLevel(0))
+	assert.Equal(t, 0, hierarchicalStateLevel(1<<16))
+	assert.Equal(t, 1, hierarchicalStateLevel(1<<13))
+	assert.Equal(t, 2, hierarchicalStateLevel(1<<8))
+	assert.Equal(t, 3, hierarchicalStateLevel(1))
+	assert.Equal(t, 3, hierarchicalStateLevel(1<<8+1))
+}
+
+func TestSaveHierarchicalState_SnapshotAndDiffs(t *testing.T) {
+	ctx := context.Background()
+	service := New(testDB.SetupDB(t))
+
+	epochs := []types.Epoch{0, 1, 2, 256, 257}
+	states := make(map[types.Epoch]iface.BeaconState)
+	for _, e := range epochs {
+		states[e] = hierarchicalTestState(t, e)
+		require.NoError(t, service.saveHierarchicalState(ctx, states[e]))
+	}
+
+	kind, _ := hierarchicalEntry(t, service, 0)
+	assert.Equal(t, hierarchicalSnapshot, kind)
+	kind, baseSlot := hierarchicalEntry(t, service, 2)
+	assert.Equal(t, hierarchicalDiff, kind)
+	assert.Equal(t, types.Slot(0), baseSlot)
+	kind, baseSlot = hierarchicalEntry(t, service, 257)
+	assert.Equal(t, hierarchicalDiff, kind)
+	assert.Equal(t, types.Slot(256)*params.BeaconConfig().SlotsPerEpoch, baseSlot)
+
+	for _, e := range epochs {
+		slot, err := helpers.StartSlot(e)
+		require.NoError(t, err)
+		st, err := service.hierarchicalState(ctx, slot)
+		require.NoError(t, err)
+		assert.DeepSSZEqual(t, states[e].InnerStateUnsafe(), st.InnerStateUnsafe())
+	}
+
+	_, err := service.hierarchicalState(ctx, 3*params.BeaconConfig().SlotsPerEpoch)
+	assert.ErrorContains(t, errUnknownHierarchicalState.Error(), err)
+	st := states[0].Copy()
+	require.NoError(t, st.SetSlot(1))
+	require.ErrorContains(t, "not an epoch boundary state", service.saveHierarchicalState(ctx, st))
+}
+
+func TestSaveHierarchicalState_NoCoarserState(t *testing.T) {
+	ctx := context.Background()
+	service := New(testDB.SetupDB(t))
+
+	// The first state saved after checkpoint sync has no coarser state to be diffed against.
+	require.NoError(t, service.saveHierarchicalState(ctx, hierarchicalTestState(t, 5)))
+	kind, _ := hierarchicalEntry(t, service, 5)
+	assert.Equal(t, hierarchicalSnapshot, kind)
+
+	// The following states are diffed against the lowest saved state.
+	st := hierarchicalTestState(t, 6)
+	require.NoError(t, service.saveHierarchicalState(ctx, st))
+	kind, baseSlot := hierarchicalEntry(t, service, 6)
+	assert.Equal(t, hierarchicalDiff, kind)
+	assert.Equal(t, 5*params.BeaconConfig().SlotsPerEpoch, baseSlot)
+
+	got, err := service.hierarchicalState(ctx, st.Slot())
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, st.InnerStateUnsafe(), got.InnerStateUnsafe())
+}
+
+func TestMigrateToCold_HierarchicalStateDiffs(t *testing.T) {
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{EnableHierarchicalStateDiffs: true})
+	defer resetCfg()
+
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB)
+	service.slotsPerArchivedPoint = params.BeaconConfig().SlotsPerEpoch * 2
+
+	var lastRoot [32]byte
+	states := make([]iface.BeaconState, 3)
+	for e := types.Epoch(0); e < 3; e++ {
+		states[e] = hierarchicalTestState(t, e)
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = states[e].Slot()
+		b.Block.ParentRoot = bytesutil.SafeCopyBytes(lastRoot[:])
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b)))
+		if e == 0 {
+			require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, root))
+		}
+		require.NoError(t, service.epochBoundaryStateCache.put(root, states[e]))
+		lastRoot = root
+	}
+	fBlock := testutil.NewBeaconBlock()
+	fBlock.Block.Slot = states[2].Slot() + 1
+	fBlock.Block.ParentRoot = bytesutil.SafeCopyBytes(lastRoot[:])
+	fRoot, err := fBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(fBlock)))
+	require.NoError(t, beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: fBlock.Block.Slot, Root: fRoot[:]}))
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: fRoot[:]}))
+	require.NoError(t, service.MigrateToCold(ctx, fRoot))
+
+	for e := types.Epoch(0); e < 3; e++ {
+		assert.Equal(t, true, beaconDB.HasHierarchicalState(ctx, states[e].Slot()), "Did not save hierarchical state")
+	}
+	// Archived points are still saved along with the diffs.
+	assert.Equal(t, true, beaconDB.HasState(ctx, lastRoot), "Did not save archived state")
+	highest, err := beaconDB.HighestSlotStatesBelow(ctx, fBlock.Block.Slot)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(highest))
+	assert.Equal(t, states[2].Slot(), highest[0].Slot())
+
+	// The epoch boundary state is rebuilt from the diffs without any cache.
+	service.SaveFinalizedState(fBlock.Block.Slot, fRoot, states[2])
+	service.epochBoundaryStateCache = newBoundaryStateCache()
+	st, err := service.StateBySlot(ctx, states[2].Slot())
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, states[2].InnerStateUnsafe(), st.InnerStateUnsafe())
+	st, err = service.StateByRoot(ctx, lastRoot)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, states[2].InnerStateUnsafe(), st.InnerStateUnsafe())
+}
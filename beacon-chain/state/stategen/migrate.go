@@ -5,8 +5,10 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -33,11 +35,20 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 
 	// Start at previous finalized slot, stop at current finalized slot.
 	// If the slot is on archived point, save the state of that slot to the DB.
+	// With hierarchical state diffs, the state of every epoch boundary is saved as well.
+	// Archived points are still saved so that the database can be used with the
+	// feature turned off, and by the era export and pruning which rely on them.
 	for slot := oldFSlot; slot < fSlot; slot++ {
 		if ctx.Err() != nil {
 			return ctx.Err()
 		}
 
+		if featureconfig.Get().EnableHierarchicalStateDiffs && helpers.IsEpochStart(slot) {
+			if err := s.migrateHierarchicalState(ctx, slot); err != nil {
+				return err
+			}
+		}
+
 		if slot%s.slotsPerArchivedPoint == 0 && slot != 0 {
 			cached, exists, err := s.epochBoundaryStateCache.getBySlot(slot)
 			if err != nil {
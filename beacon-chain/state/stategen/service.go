@@ -48,6 +48,7 @@ type State struct {
 	finalizedInfo           *finalizedInfo
 	epochBoundaryStateCache *epochBoundaryState
 	saveHotStateDB          *saveHotStateDbConfig
+	origin                  *originInfo
 }
 
 // This tracks the config in the event of long non-finality,
@@ -69,6 +70,13 @@ type finalizedInfo struct {
 	lock  sync.RWMutex
 }
 
+// This tracks the checkpoint the DB was initialized from when the node was started with checkpoint
+// sync. Blocks before the origin are not in the DB, so their states cannot be regenerated.
+type originInfo struct {
+	slot types.Slot
+	root [32]byte
+}
+
 // New returns a new state management object.
 func New(beaconDB db.NoHeadAccessDatabase) *State {
 	return &State{
@@ -113,9 +121,37 @@ func (s *State) Resume(ctx context.Context) (iface.BeaconState, error) {
 
 	s.finalizedInfo = &finalizedInfo{slot: fState.Slot(), root: fRoot, state: fState.Copy()}
 
+	if err := s.resumeOrigin(ctx); err != nil {
+		return nil, err
+	}
+
 	return fState, nil
 }
 
+// Loads the checkpoint sync origin from DB, if the DB was initialized from one.
+func (s *State) resumeOrigin(ctx context.Context) error {
+	oRoot, err := s.beaconDB.OriginBlockRoot(ctx)
+	if errors.Is(err, db.ErrNotFoundOriginBlockRoot) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	summary, err := s.stateSummary(ctx, oRoot)
+	if err != nil {
+		return err
+	}
+	s.origin = &originInfo{slot: summary.Slot, root: oRoot}
+	log.WithField("slot", summary.Slot).Info("Resuming from checkpoint sync origin")
+	return nil
+}
+
+// Returns true if the input slot is before the checkpoint sync origin, so no state can be
+// regenerated for it. The genesis state is always available.
+func (s *State) isBeforeOrigin(slot types.Slot) bool {
+	return s.origin != nil && slot != 0 && slot < s.origin.slot
+}
+
 // SaveFinalizedState saves the finalized slot, root and state into memory to be used by state gen service.
 // This used for migration at the correct start slot and used for hot state play back to ensure
 // lower bound to start is always at the last finalized state.
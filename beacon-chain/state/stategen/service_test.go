@@ -35,3 +35,32 @@ func TestResume(t *testing.T) {
 	assert.Equal(t, service.finalizedInfo.root, root, "Did not get wanted root")
 	assert.NotNil(t, service.finalizedState(), "Wanted a non nil finalized state")
 }
+
+func TestResume_Origin(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+
+	service := New(beaconDB)
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = params.BeaconConfig().SlotsPerEpoch * 4
+	require.NoError(t, service.beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b)))
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	require.NoError(t, beaconState.SetSlot(b.Block.Slot))
+	require.NoError(t, service.beaconDB.SaveState(ctx, beaconState, root))
+	require.NoError(t, service.beaconDB.SaveOriginBlockRoot(ctx, root))
+	require.NoError(t, service.beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 4, Root: root[:]}))
+
+	_, err = service.Resume(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, service.origin)
+	assert.Equal(t, b.Block.Slot, service.origin.slot)
+	assert.Equal(t, root, service.origin.root)
+
+	_, err = service.StateBySlot(ctx, b.Block.Slot-1)
+	assert.ErrorContains(t, errSlotBeforeOrigin.Error(), err)
+	st, err := service.StateBySlot(ctx, b.Block.Slot+1)
+	require.NoError(t, err)
+	assert.Equal(t, b.Block.Slot+1, st.Slot())
+}
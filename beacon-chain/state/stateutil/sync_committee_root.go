@@ -0,0 +1,52 @@
+package stateutil
+
+import (
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/htrutils"
+)
+
+// SyncCommitteeRoot computes the HashTreeRoot Merkleization of
+// a SyncCommittee struct according to the Ethereum
+// Simple Serialize specification.
+func SyncCommitteeRoot(committee *pb.SyncCommittee) ([32]byte, error) {
+	if committee == nil {
+		return [32]byte{}, errors.New("nil sync committee")
+	}
+	hasher := hashutil.CustomSHA256Hasher()
+
+	// Pubkeys vector root.
+	pubKeyRoots := make([][32]byte, len(committee.Pubkeys))
+	for i, key := range committee.Pubkeys {
+		r, err := pubKeyRoot(hasher, key)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		pubKeyRoots[i] = r
+	}
+	pubkeyVectorRoot, err := htrutils.BitwiseMerkleizeArrays(hasher, pubKeyRoots, uint64(len(pubKeyRoots)), uint64(len(pubKeyRoots)))
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not compute sync committee pubkeys merkleization")
+	}
+
+	// Aggregate pubkey root.
+	aggregateKeyRoot, err := pubKeyRoot(hasher, committee.AggregatePubkey)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	fieldRoots := [][32]byte{pubkeyVectorRoot, aggregateKeyRoot}
+	return htrutils.BitwiseMerkleizeArrays(hasher, fieldRoots, uint64(len(fieldRoots)), uint64(len(fieldRoots)))
+}
+
+// pubKeyRoot computes the HashTreeRoot Merkleization of a 48 byte BLS public key.
+func pubKeyRoot(hasher htrutils.HashFn, pubKey []byte) ([32]byte, error) {
+	key := bytesutil.ToBytes48(pubKey)
+	chunks, err := htrutils.Pack([][]byte{key[:]})
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not pack public key into chunks")
+	}
+	return htrutils.BitwiseMerkleize(hasher, chunks, uint64(len(chunks)), uint64(len(chunks)))
+}
@@ -11,6 +11,12 @@ type ReadOnlyValidator struct {
 	validator *ethpb.Validator
 }
 
+// NewValidator wraps the provided validator in a read only wrapper. The
+// validator is not copied, so callers must not mutate it afterwards.
+func NewValidator(v *ethpb.Validator) ReadOnlyValidator {
+	return ReadOnlyValidator{validator: v}
+}
+
 // EffectiveBalance returns the effective balance of the
 // read only validator.
 func (v ReadOnlyValidator) EffectiveBalance() uint64 {
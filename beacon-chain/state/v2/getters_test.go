@@ -0,0 +1,110 @@
+package v2
+
+import (
+	"testing"
+
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/version"
+)
+
+func TestBeaconState_NilInnerState(t *testing.T) {
+	b := &BeaconState{}
+
+	bits, err := b.CurrentEpochParticipation()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte(nil), bits)
+	scores, err := b.InactivityScores()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64(nil), scores)
+	committee, err := b.CurrentSyncCommittee()
+	require.NoError(t, err)
+	assert.Equal(t, true, committee == nil)
+
+	assert.ErrorContains(t, ErrNilInnerState.Error(), b.AppendCurrentParticipationBits(1))
+	assert.ErrorContains(t, ErrNilInnerState.Error(), b.SetInactivityScores(nil))
+	assert.ErrorContains(t, ErrNilInnerState.Error(), b.SetNextSyncCommittee(nil))
+}
+
+func TestBeaconState_UnsupportedPhase0Methods(t *testing.T) {
+	b := &BeaconState{}
+	assert.Equal(t, version.Altair, b.Version())
+
+	_, err := b.PreviousEpochAttestations()
+	assert.ErrorContains(t, "not supported for Altair beacon state", err)
+	_, err = b.CurrentEpochAttestations()
+	assert.ErrorContains(t, "not supported for Altair beacon state", err)
+	assert.ErrorContains(t, "not supported for Altair beacon state", b.RotateAttestations())
+}
+
+func TestBeaconState_ParticipationBits(t *testing.T) {
+	b, err := InitializeFromProto(&pbp2p.BeaconStateAltair{})
+	require.NoError(t, err)
+	require.NoError(t, b.SetPreviousParticipationBits([]byte{1, 2}))
+	require.NoError(t, b.SetCurrentParticipationBits([]byte{3}))
+	require.NoError(t, b.AppendPreviousParticipationBits(4))
+	require.NoError(t, b.AppendCurrentParticipationBits(5))
+
+	prev, err := b.PreviousEpochParticipation()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{1, 2, 4}, prev)
+	curr, err := b.CurrentEpochParticipation()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{3, 5}, curr)
+
+	// The returned bits are copies of the state bits.
+	prev[0] = 9
+	curr[0] = 9
+	prev, err = b.PreviousEpochParticipation()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{1, 2, 4}, prev)
+	curr, err = b.CurrentEpochParticipation()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{3, 5}, curr)
+}
+
+func TestBeaconState_InactivityScores(t *testing.T) {
+	b, err := InitializeFromProto(&pbp2p.BeaconStateAltair{})
+	require.NoError(t, err)
+	require.NoError(t, b.SetInactivityScores([]uint64{1, 2}))
+	require.NoError(t, b.AppendInactivityScore(3))
+
+	scores, err := b.InactivityScores()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{1, 2, 3}, scores)
+
+	// The returned scores are a copy of the state scores.
+	scores[0] = 9
+	scores, err = b.InactivityScores()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{1, 2, 3}, scores)
+}
+
+func TestBeaconState_SyncCommittees(t *testing.T) {
+	b, err := InitializeFromProto(&pbp2p.BeaconStateAltair{})
+	require.NoError(t, err)
+	current := &pbp2p.SyncCommittee{Pubkeys: [][]byte{{1}, {2}}, AggregatePubkey: []byte{3}}
+	next := &pbp2p.SyncCommittee{Pubkeys: [][]byte{{4}, {5}}, AggregatePubkey: []byte{6}}
+	require.NoError(t, b.SetCurrentSyncCommittee(current))
+	require.NoError(t, b.SetNextSyncCommittee(next))
+
+	got, err := b.CurrentSyncCommittee()
+	require.NoError(t, err)
+	assert.DeepEqual(t, current, got)
+	// The returned committee is a copy of the state committee.
+	got.Pubkeys[0][0] = 9
+	got.AggregatePubkey[0] = 9
+	got, err = b.CurrentSyncCommittee()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{1}, got.Pubkeys[0])
+	assert.DeepEqual(t, []byte{3}, got.AggregatePubkey)
+
+	got, err = b.NextSyncCommittee()
+	require.NoError(t, err)
+	assert.DeepEqual(t, next, got)
+	got.Pubkeys[0][0] = 9
+	got, err = b.NextSyncCommittee()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{4}, got.Pubkeys[0])
+}
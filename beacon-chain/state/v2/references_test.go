@@ -0,0 +1,120 @@
+package v2
+
+import (
+	"runtime"
+	"testing"
+
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStateReferenceSharing_Finalizer(t *testing.T) {
+	// This test showcases the logic on the current epoch participation field with the GC finalizer.
+
+	a, err := InitializeFromProtoUnsafe(&pbp2p.BeaconStateAltair{CurrentEpochParticipation: []byte{1, 2}})
+	require.NoError(t, err)
+	assert.Equal(t, uint(1), a.sharedFieldReferences[currentEpochParticipationBits].Refs(), "Expected a single reference for participation bits")
+
+	func() {
+		// Create object in a different scope for GC
+		b := a.Copy()
+		assert.Equal(t, uint(2), a.sharedFieldReferences[currentEpochParticipationBits].Refs(), "Expected 2 references to participation bits")
+		_ = b
+	}()
+
+	runtime.GC() // Should run finalizer on object b
+	assert.Equal(t, uint(1), a.sharedFieldReferences[currentEpochParticipationBits].Refs(), "Expected 1 shared reference to participation bits!")
+
+	copied := a.Copy()
+	b, ok := copied.(*BeaconState)
+	require.Equal(t, true, ok)
+	assert.Equal(t, uint(2), b.sharedFieldReferences[currentEpochParticipationBits].Refs(), "Expected 2 shared references to participation bits")
+	require.NoError(t, b.AppendCurrentParticipationBits(3))
+	if b.sharedFieldReferences[currentEpochParticipationBits].Refs() != 1 || a.sharedFieldReferences[currentEpochParticipationBits].Refs() != 1 {
+		t.Error("Expected 1 shared reference to participation bits for both a and b")
+	}
+}
+
+func TestStateReferenceCopy_NoUnexpectedParticipationMutation(t *testing.T) {
+	a, err := InitializeFromProtoUnsafe(&pbp2p.BeaconStateAltair{
+		PreviousEpochParticipation: []byte{1, 2},
+		CurrentEpochParticipation:  []byte{3, 4},
+	})
+	require.NoError(t, err)
+	assertRefCount(t, a, previousEpochParticipationBits, 1)
+	assertRefCount(t, a, currentEpochParticipationBits, 1)
+
+	// Copy, increases reference count.
+	copied := a.Copy()
+	b, ok := copied.(*BeaconState)
+	require.Equal(t, true, ok)
+	assertRefCount(t, a, previousEpochParticipationBits, 2)
+	assertRefCount(t, a, currentEpochParticipationBits, 2)
+	assertRefCount(t, b, previousEpochParticipationBits, 2)
+	assertRefCount(t, b, currentEpochParticipationBits, 2)
+
+	// Mutator should only affect calling state: a.
+	require.NoError(t, a.AppendPreviousParticipationBits(5))
+	require.NoError(t, a.AppendCurrentParticipationBits(6))
+	assert.DeepEqual(t, []byte{1, 2, 5}, a.state.PreviousEpochParticipation, "Expected mutation not found")
+	assert.DeepEqual(t, []byte{3, 4, 6}, a.state.CurrentEpochParticipation, "Expected mutation not found")
+	assert.DeepEqual(t, []byte{1, 2}, b.state.PreviousEpochParticipation, "Unexpected mutation found")
+	assert.DeepEqual(t, []byte{3, 4}, b.state.CurrentEpochParticipation, "Unexpected mutation found")
+
+	// Copy on write happened, reference counters are reset.
+	assertRefCount(t, a, previousEpochParticipationBits, 1)
+	assertRefCount(t, a, currentEpochParticipationBits, 1)
+	assertRefCount(t, b, previousEpochParticipationBits, 1)
+	assertRefCount(t, b, currentEpochParticipationBits, 1)
+
+	// Setting the whole list replaces the reference of the calling state only.
+	c, ok := b.Copy().(*BeaconState)
+	require.Equal(t, true, ok)
+	require.NoError(t, c.SetPreviousParticipationBits([]byte{7}))
+	require.NoError(t, c.SetCurrentParticipationBits([]byte{8}))
+	assert.DeepEqual(t, []byte{1, 2}, b.state.PreviousEpochParticipation, "Unexpected mutation found")
+	assert.DeepEqual(t, []byte{3, 4}, b.state.CurrentEpochParticipation, "Unexpected mutation found")
+	assertRefCount(t, b, previousEpochParticipationBits, 1)
+	assertRefCount(t, b, currentEpochParticipationBits, 1)
+	assertRefCount(t, c, previousEpochParticipationBits, 1)
+	assertRefCount(t, c, currentEpochParticipationBits, 1)
+}
+
+func TestStateReferenceCopy_NoUnexpectedInactivityScoresMutation(t *testing.T) {
+	a, err := InitializeFromProtoUnsafe(&pbp2p.BeaconStateAltair{
+		InactivityScores: []uint64{1, 2},
+	})
+	require.NoError(t, err)
+	assertRefCount(t, a, inactivityScores, 1)
+
+	// Copy, increases reference count.
+	copied := a.Copy()
+	b, ok := copied.(*BeaconState)
+	require.Equal(t, true, ok)
+	assertRefCount(t, a, inactivityScores, 2)
+	assertRefCount(t, b, inactivityScores, 2)
+
+	// Mutator should only affect calling state: a.
+	require.NoError(t, a.AppendInactivityScore(3))
+	assert.DeepEqual(t, []uint64{1, 2, 3}, a.state.InactivityScores, "Expected mutation not found")
+	assert.DeepEqual(t, []uint64{1, 2}, b.state.InactivityScores, "Unexpected mutation found")
+
+	// Copy on write happened, reference counters are reset.
+	assertRefCount(t, a, inactivityScores, 1)
+	assertRefCount(t, b, inactivityScores, 1)
+
+	// Setting the whole list replaces the reference of the calling state only.
+	c, ok := b.Copy().(*BeaconState)
+	require.Equal(t, true, ok)
+	require.NoError(t, c.SetInactivityScores([]uint64{4}))
+	assert.DeepEqual(t, []uint64{1, 2}, b.state.InactivityScores, "Unexpected mutation found")
+	assertRefCount(t, b, inactivityScores, 1)
+	assertRefCount(t, c, inactivityScores, 1)
+}
+
+func assertRefCount(t *testing.T, b *BeaconState, idx fieldIndex, want uint) {
+	if cnt := b.sharedFieldReferences[idx].Refs(); cnt != want {
+		t.Errorf("Unexpected count of references for index %d, want: %v, got: %v", idx, want, cnt)
+	}
+}
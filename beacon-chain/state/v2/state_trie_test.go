@@ -0,0 +1,268 @@
+package v2_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestInitializeFromProto(t *testing.T) {
+	type test struct {
+		name  string
+		state *pbp2p.BeaconStateAltair
+		error string
+	}
+	initTests := []test{
+		{
+			name:  "nil state",
+			state: nil,
+			error: "received nil state",
+		},
+		{
+			name: "nil validators",
+			state: &pbp2p.BeaconStateAltair{
+				Slot:       4,
+				Validators: nil,
+			},
+		},
+		{
+			name:  "empty state",
+			state: &pbp2p.BeaconStateAltair{},
+		},
+		{
+			name:  "full state",
+			state: testState(),
+		},
+	}
+	for _, tt := range initTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := v2.InitializeFromProto(tt.state)
+			if tt.error != "" {
+				assert.ErrorContains(t, tt.error, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBeaconState_Copy_IsIndependent(t *testing.T) {
+	st, err := v2.InitializeFromProto(testState())
+	require.NoError(t, err)
+	cpy, ok := st.Copy().(*v2.BeaconState)
+	require.Equal(t, true, ok)
+
+	require.NoError(t, cpy.AppendCurrentParticipationBits(7))
+	require.NoError(t, cpy.AppendPreviousParticipationBits(3))
+	require.NoError(t, cpy.AppendInactivityScore(10))
+	require.NoError(t, cpy.SetNextSyncCommittee(syncCommittee(2)))
+
+	curr, err := st.CurrentEpochParticipation()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{0, 1}, curr)
+	prev, err := st.PreviousEpochParticipation()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{2, 3}, prev)
+	scores, err := st.InactivityScores()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{4, 5}, scores)
+	next, err := st.NextSyncCommittee()
+	require.NoError(t, err)
+	assert.DeepEqual(t, syncCommittee(1), next)
+
+	curr, err = cpy.CurrentEpochParticipation()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{0, 1, 7}, curr)
+	scores, err = cpy.InactivityScores()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{4, 5, 10}, scores)
+}
+
+func TestBeaconState_HashTreeRoot_MatchesAfterMutation(t *testing.T) {
+	st, err := v2.InitializeFromProto(testState())
+	require.NoError(t, err)
+	_, err = st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+
+	require.NoError(t, st.AppendCurrentParticipationBits(1))
+	require.NoError(t, st.SetInactivityScores([]uint64{9, 9}))
+	require.NoError(t, st.SetCurrentSyncCommittee(syncCommittee(3)))
+	require.NoError(t, st.UpdateBlockRootAtIndex(1, [32]byte{'a'}))
+	require.NoError(t, st.UpdateRandaoMixesAtIndex(2, bytes32('b')))
+	root, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+
+	fresh, err := v2.InitializeFromProto(st.CloneInnerState().(*pbp2p.BeaconStateAltair))
+	require.NoError(t, err)
+	want, err := fresh.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, want, root)
+}
+
+func TestBeaconState_HashTreeRoot_FieldTrie(t *testing.T) {
+	st, err := v2.InitializeFromProto(testState())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		stateModify func(*v2.BeaconState) error
+	}{
+		{
+			name: "unchanged state",
+			stateModify: func(*v2.BeaconState) error {
+				return nil
+			},
+		},
+		{
+			name: "appended previous participation bits",
+			stateModify: func(b *v2.BeaconState) error {
+				return b.AppendPreviousParticipationBits(7)
+			},
+		},
+		{
+			name: "different current participation bits",
+			stateModify: func(b *v2.BeaconState) error {
+				return b.SetCurrentParticipationBits([]byte{7, 7})
+			},
+		},
+		{
+			name: "appended inactivity score",
+			stateModify: func(b *v2.BeaconState) error {
+				return b.AppendInactivityScore(7)
+			},
+		},
+		{
+			name: "different inactivity scores",
+			stateModify: func(b *v2.BeaconState) error {
+				return b.SetInactivityScores([]uint64{7, 7, 7})
+			},
+		},
+		{
+			name: "different next sync committee",
+			stateModify: func(b *v2.BeaconState) error {
+				return b.SetNextSyncCommittee(syncCommittee(7))
+			},
+		},
+	}
+	var oldHTR [32]byte
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, tt.stateModify(st))
+			root, err := st.HashTreeRoot(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, freshRoot(t, st), root, "Expected hash tree root to match recomputed root")
+			if oldHTR != [32]byte{} && root == oldHTR {
+				t.Errorf("Expected HTR to change, received %#x == old %#x", root, oldHTR)
+			}
+			oldHTR = root
+		})
+	}
+}
+
+func TestBeaconState_HashTreeRoot_CopyIsIndependent(t *testing.T) {
+	st, err := v2.InitializeFromProto(testState())
+	require.NoError(t, err)
+	root, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	cpy, ok := st.Copy().(*v2.BeaconState)
+	require.Equal(t, true, ok)
+
+	require.NoError(t, cpy.AppendPreviousParticipationBits(7))
+	require.NoError(t, cpy.AppendCurrentParticipationBits(7))
+	require.NoError(t, cpy.AppendInactivityScore(7))
+	cpyRoot, err := cpy.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, freshRoot(t, cpy), cpyRoot)
+	assert.NotEqual(t, root, cpyRoot)
+
+	// The root of the original state is not affected by the mutations of the copy.
+	newRoot, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, root, newRoot)
+	assert.Equal(t, root, freshRoot(t, st))
+}
+
+func TestBeaconState_SSZRoundTrip(t *testing.T) {
+	st, err := v2.InitializeFromProto(testState())
+	require.NoError(t, err)
+	enc, err := st.MarshalSSZ()
+	require.NoError(t, err)
+
+	decoded := &pbp2p.BeaconStateAltair{}
+	require.NoError(t, decoded.UnmarshalSSZ(enc))
+	st2, err := v2.InitializeFromProto(decoded)
+	require.NoError(t, err)
+
+	root, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	root2, err := st2.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, root, root2)
+}
+
+// freshRoot returns the hash tree root of a new state initialized from the inner state of the given
+// state, so that all its field roots are recomputed.
+func freshRoot(t *testing.T, st *v2.BeaconState) [32]byte {
+	fresh, err := v2.InitializeFromProto(st.CloneInnerState().(*pbp2p.BeaconStateAltair))
+	require.NoError(t, err)
+	root, err := fresh.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	return root
+}
+
+func testState() *pbp2p.BeaconStateAltair {
+	cfg := params.BeaconConfig()
+	return &pbp2p.BeaconStateAltair{
+		GenesisValidatorsRoot:       make([]byte, 32),
+		Fork:                        &pbp2p.Fork{PreviousVersion: make([]byte, 4), CurrentVersion: make([]byte, 4)},
+		LatestBlockHeader:           &ethpb.BeaconBlockHeader{ParentRoot: make([]byte, 32), StateRoot: make([]byte, 32), BodyRoot: make([]byte, 32)},
+		BlockRoots:                  roots(uint64(cfg.SlotsPerHistoricalRoot)),
+		StateRoots:                  roots(uint64(cfg.SlotsPerHistoricalRoot)),
+		RandaoMixes:                 roots(uint64(cfg.EpochsPerHistoricalVector)),
+		Slashings:                   make([]uint64, cfg.EpochsPerSlashingsVector),
+		Eth1Data:                    &ethpb.Eth1Data{DepositRoot: make([]byte, 32), BlockHash: make([]byte, 32)},
+		Validators:                  []*ethpb.Validator{{PublicKey: make([]byte, 48), WithdrawalCredentials: make([]byte, 32)}},
+		Balances:                    []uint64{32},
+		PreviousEpochParticipation:  []byte{2, 3},
+		CurrentEpochParticipation:   []byte{0, 1},
+		JustificationBits:           []byte{0},
+		PreviousJustifiedCheckpoint: &ethpb.Checkpoint{Root: make([]byte, 32)},
+		CurrentJustifiedCheckpoint:  &ethpb.Checkpoint{Root: make([]byte, 32)},
+		FinalizedCheckpoint:         &ethpb.Checkpoint{Root: make([]byte, 32)},
+		InactivityScores:            []uint64{4, 5},
+		CurrentSyncCommittee:        syncCommittee(0),
+		NextSyncCommittee:           syncCommittee(1),
+	}
+}
+
+func roots(n uint64) [][]byte {
+	r := make([][]byte, n)
+	for i := range r {
+		r[i] = make([]byte, 32)
+	}
+	return r
+}
+
+func bytes32(b byte) []byte {
+	r := make([]byte, 32)
+	r[0] = b
+	return r
+}
+
+func syncCommittee(seed byte) *pbp2p.SyncCommittee {
+	keys := make([][]byte, 512)
+	for i := range keys {
+		keys[i] = make([]byte, 48)
+		keys[i][0] = seed
+	}
+	return &pbp2p.SyncCommittee{
+		Pubkeys:         keys,
+		AggregatePubkey: make([]byte, 48),
+	}
+}
@@ -0,0 +1,22 @@
+package v2
+
+import (
+	"github.com/pkg/errors"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	v1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+)
+
+// PreviousEpochAttestations is not supported for Altair beacon state.
+func (b *BeaconState) PreviousEpochAttestations() ([]*pbp2p.PendingAttestation, error) {
+	return nil, errors.New("PreviousEpochAttestations is not supported for Altair beacon state")
+}
+
+// CurrentEpochAttestations is not supported for Altair beacon state.
+func (b *BeaconState) CurrentEpochAttestations() ([]*pbp2p.PendingAttestation, error) {
+	return nil, errors.New("CurrentEpochAttestations is not supported for Altair beacon state")
+}
+
+// ToProto is not supported for Altair beacon state.
+func (b *BeaconState) ToProto() (*v1.BeaconState, error) {
+	return nil, errors.New("ToProto is not supported for Altair beacon state")
+}
@@ -0,0 +1,21 @@
+package backfill
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	lowestAvailableSlotGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "backfill_lowest_available_slot",
+		Help: "The slot of the lowest block backfilled into the database.",
+	})
+	backfilledBlocksCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "backfill_blocks_total",
+		Help: "Count of blocks backfilled into the database.",
+	})
+	invalidBatchesCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "backfill_invalid_batches_total",
+		Help: "Count of backfill batches received from peers that failed verification.",
+	})
+)
@@ -0,0 +1,294 @@
+// Package backfill fills in the blocks below the origin of a node started from a checkpoint. It
+// walks backward from the origin block, requesting batches of blocks from peers and verifying
+// them against the parent roots of the blocks above, without running state transition.
+package backfill
+
+import (
+	"context"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+var _ shared.Service = (*Service)(nil)
+
+const (
+	// syncPollInterval is how often the service checks whether initial sync has completed.
+	syncPollInterval = 6 * time.Second
+	// batchInterval is the pause between batches, so backfill does not compete with regular
+	// sync for peers and disk.
+	batchInterval = time.Second
+)
+
+// blockchainService defines the interface for interaction with block chain service.
+type blockchainService interface {
+	blockchain.BlockReceiver
+	blockchain.ChainInfoFetcher
+}
+
+// blockFetcher fetches ranges of blocks from peers.
+type blockFetcher interface {
+	FetchBlocks(ctx context.Context, start types.Slot, count uint64) ([]interfaces.SignedBeaconBlock, peer.ID, error)
+}
+
+// Config to set up the backfill service.
+type Config struct {
+	DB               db.NoHeadAccessDatabase
+	P2P              p2p.P2P
+	Chain            blockchainService
+	InitialSync      sync.Checker
+	VerifySignatures bool
+}
+
+// Service backfills the blocks below the origin checkpoint of the database.
+type Service struct {
+	cfg           *Config
+	ctx           context.Context
+	cancel        context.CancelFunc
+	rangeFetcher  *initialsync.RangeFetcher
+	fetcher       blockFetcher
+	batchSize     uint64
+	batchInterval time.Duration
+}
+
+// NewService initializes the backfill service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	rangeFetcher := initialsync.NewRangeFetcher(ctx, &initialsync.RangeFetcherConfig{
+		Chain: cfg.Chain,
+		P2P:   cfg.P2P,
+		DB:    cfg.DB,
+	})
+	return &Service{
+		cfg:           cfg,
+		ctx:           ctx,
+		cancel:        cancel,
+		rangeFetcher:  rangeFetcher,
+		fetcher:       rangeFetcher,
+		batchSize:     uint64(flags.Get().BlockBatchLimit),
+		batchInterval: batchInterval,
+	}
+}
+
+// Start the backfill service, which is a no-op for nodes synced from genesis.
+func (s *Service) Start() {
+	if _, err := s.cfg.DB.OriginBlockRoot(s.ctx); err != nil {
+		if !errors.Is(err, db.ErrNotFoundOriginBlockRoot) {
+			log.WithError(err).Error("Could not retrieve origin block root")
+		}
+		return
+	}
+	if err := s.rangeFetcher.Start(); err != nil {
+		log.WithError(err).Error("Could not start block fetcher")
+		return
+	}
+	go func() {
+		defer s.rangeFetcher.Stop()
+		if err := s.run(); err != nil && !errors.Is(err, context.Canceled) {
+			log.WithError(err).Error("Backfill stopped")
+		}
+	}()
+}
+
+// Stop the backfill service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the backfill service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// run backfills blocks once initial sync has completed, until the genesis block is reached. When
+// the genesis block is not in the database, the genesis block is backfilled as well, backfill stops
+// at the block of slot 0 or at a block with a zero parent root.
+func (s *Service) run() error {
+	if err := s.waitForInitialSync(); err != nil {
+		return err
+	}
+	genesis, err := s.cfg.DB.GenesisBlock(s.ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve genesis block")
+	}
+	var genesisRoot [32]byte
+	// The lowest slot to request, the genesis block is only requested when it is not in the database.
+	minSlot := types.Slot(1)
+	if genesis == nil || genesis.IsNil() {
+		log.Warn("No genesis block in db, backfilling down to slot 0")
+		minSlot = 0
+	} else {
+		genesisRoot, err = genesis.Block().HashTreeRoot()
+		if err != nil {
+			return err
+		}
+	}
+	lowRoot, low, err := s.lowestBlock()
+	if err != nil {
+		return err
+	}
+	cursor := low.Block().Slot()
+	log.WithField("slot", cursor).Info("Backfilling blocks below origin checkpoint")
+
+	ticker := time.NewTicker(s.batchInterval)
+	defer ticker.Stop()
+	for {
+		lowestAvailableSlotGauge.Set(float64(low.Block().Slot()))
+		if lowRoot == genesisRoot || bytesutil.ToBytes32(low.Block().ParentRoot()) == genesisRoot || low.Block().Slot() == 0 {
+			if minSlot == 0 {
+				// Without a genesis block in the database, the lowest block is the last one saved.
+				genesisRoot = lowRoot
+			}
+			if err := s.cfg.DB.SaveBackfillBlockRoot(s.ctx, genesisRoot); err != nil {
+				return errors.Wrap(err, "could not save backfill block root")
+			}
+			lowestAvailableSlotGauge.Set(0)
+			log.Info("Backfill complete, all blocks down to genesis are available")
+			return nil
+		}
+		if cursor <= minSlot {
+			// Every slot down to genesis was requested without reaching the parent of the lowest
+			// block, start over from the lowest block.
+			cursor = low.Block().Slot()
+		}
+
+		select {
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		case <-ticker.C:
+		}
+
+		start := minSlot
+		if uint64(cursor) > s.batchSize+uint64(minSlot) {
+			start = cursor.SubSlot(types.Slot(s.batchSize))
+		}
+		blocks, pid, err := s.fetcher.FetchBlocks(s.ctx, start, uint64(cursor.SubSlot(start)))
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return err
+			}
+			log.WithError(err).Debug("Could not fetch blocks")
+			continue
+		}
+		blocks = blocksBelow(blocks, cursor)
+		if len(blocks) == 0 {
+			cursor = start
+			continue
+		}
+		if err := s.processBatch(blocks, bytesutil.ToBytes32(low.Block().ParentRoot())); err != nil {
+			if !errors.Is(err, errInvalidBatch) {
+				return err
+			}
+			invalidBatchesCount.Inc()
+			s.cfg.P2P.Peers().Scorers().BadResponsesScorer().Increment(pid)
+			log.WithError(err).WithField("peer", pid).Debug("Invalid backfill batch")
+			cursor = low.Block().Slot()
+			continue
+		}
+		low = blocks[0]
+		lowRoot, err = low.Block().HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		cursor = low.Block().Slot()
+		log.WithFields(logrus.Fields{
+			"slot":   cursor,
+			"blocks": len(blocks),
+		}).Debug("Backfilled blocks")
+	}
+}
+
+// processBatch verifies a batch of blocks against the expected root of the highest block and
+// saves them, moving the backfill cursor of the database to the lowest block of the batch.
+func (s *Service) processBatch(blocks []interfaces.SignedBeaconBlock, expected [32]byte) error {
+	roots, err := verifyChain(blocks, expected)
+	if err != nil {
+		return err
+	}
+	if s.cfg.VerifySignatures {
+		originRoot, err := s.cfg.DB.OriginBlockRoot(s.ctx)
+		if err != nil {
+			return err
+		}
+		originState, err := s.cfg.DB.State(s.ctx, originRoot)
+		if err != nil {
+			return errors.Wrap(err, "could not retrieve origin state")
+		}
+		if originState == nil || originState.IsNil() {
+			return errors.New("origin state not found in db")
+		}
+		if err := verifySignatures(originState, blocks); err != nil {
+			return err
+		}
+	}
+	if err := s.cfg.DB.SaveBlocks(s.ctx, blocks); err != nil {
+		return errors.Wrap(err, "could not save blocks")
+	}
+	if err := s.cfg.DB.SaveBackfillBlockRoot(s.ctx, roots[0]); err != nil {
+		return errors.Wrap(err, "could not save backfill block root")
+	}
+	backfilledBlocksCount.Add(float64(len(blocks)))
+	return nil
+}
+
+// lowestBlock returns the lowest block backfilled so far, which is the origin block before the
+// first batch is saved.
+func (s *Service) lowestBlock() ([32]byte, interfaces.SignedBeaconBlock, error) {
+	root, err := s.cfg.DB.BackfillBlockRoot(s.ctx)
+	if errors.Is(err, db.ErrNotFoundBackfillBlockRoot) {
+		root, err = s.cfg.DB.OriginBlockRoot(s.ctx)
+	}
+	if err != nil {
+		return [32]byte{}, nil, errors.Wrap(err, "could not retrieve backfill block root")
+	}
+	blk, err := s.cfg.DB.Block(s.ctx, root)
+	if err != nil {
+		return [32]byte{}, nil, err
+	}
+	if blk == nil || blk.IsNil() {
+		return [32]byte{}, nil, errors.Errorf("backfill block %#x not found in db", root)
+	}
+	return root, blk, nil
+}
+
+// waitForInitialSync blocks until initial sync has completed, so backfill does not slow down
+// syncing to the head of the chain.
+func (s *Service) waitForInitialSync() error {
+	if s.cfg.InitialSync == nil {
+		return nil
+	}
+	ticker := time.NewTicker(syncPollInterval)
+	defer ticker.Stop()
+	for !s.cfg.InitialSync.Synced() {
+		select {
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
+// blocksBelow filters out blocks at or above the given slot, which peers should not return.
+func blocksBelow(blocks []interfaces.SignedBeaconBlock, slot types.Slot) []interfaces.SignedBeaconBlock {
+	filtered := make([]interfaces.SignedBeaconBlock, 0, len(blocks))
+	for _, blk := range blocks {
+		if blk != nil && !blk.IsNil() && blk.Block().Slot() < slot {
+			filtered = append(filtered, blk)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,171 @@
+package backfill
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type mockFetcher struct {
+	blocks []interfaces.SignedBeaconBlock
+	// corrupt is the number of requests which are answered with a broken chain.
+	corrupt  int
+	requests int
+}
+
+func (m *mockFetcher) FetchBlocks(_ context.Context, start types.Slot, count uint64) ([]interfaces.SignedBeaconBlock, peer.ID, error) {
+	m.requests++
+	var blocks []interfaces.SignedBeaconBlock
+	for _, blk := range m.blocks {
+		if blk.Block().Slot() >= start && uint64(blk.Block().Slot()) < uint64(start)+count {
+			blocks = append(blocks, blk)
+		}
+	}
+	if m.corrupt > 0 && len(blocks) > 1 {
+		m.corrupt--
+		blocks = blocks[1:]
+		blocks = append(blocks[:len(blocks)/2], blocks[len(blocks)/2+1:]...)
+	}
+	return blocks, "peer", nil
+}
+
+// setupOrigin saves a genesis state and the top block of a chain of the given length as the
+// origin of the database, returning the chain and the genesis block root.
+func setupOrigin(t *testing.T, beaconDB db.Database, length uint64) ([]interfaces.SignedBeaconBlock, [32]byte) {
+	ctx := context.Background()
+	st, keys := testutil.DeterministicGenesisState(t, 8)
+	require.NoError(t, beaconDB.SaveGenesisData(ctx, st))
+	genesis, err := beaconDB.GenesisBlock(ctx)
+	require.NoError(t, err)
+	genesisRoot, err := genesis.Block().HashTreeRoot()
+	require.NoError(t, err)
+
+	blocks, roots := makeChain(t, genesisRoot, length, keys)
+	origin := roots[len(roots)-1]
+	require.NoError(t, beaconDB.SaveBlock(ctx, blocks[len(blocks)-1]))
+	require.NoError(t, beaconDB.SaveState(ctx, st, origin))
+	require.NoError(t, beaconDB.SaveOriginBlockRoot(ctx, origin))
+	require.NoError(t, beaconDB.SaveBackfillBlockRoot(ctx, origin))
+	return blocks, genesisRoot
+}
+
+func newTestService(t *testing.T, beaconDB db.Database, fetcher blockFetcher, verify bool) *Service {
+	s := NewService(context.Background(), &Config{
+		DB:               beaconDB,
+		P2P:              p2ptest.NewTestP2P(t),
+		Chain:            &mock.ChainService{},
+		InitialSync:      &mockSync.Sync{IsSynced: true},
+		VerifySignatures: verify,
+	})
+	s.fetcher = fetcher
+	s.batchSize = 16
+	s.batchInterval = time.Millisecond
+	return s
+}
+
+func TestService_Run(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	blocks, genesisRoot := setupOrigin(t, beaconDB, 100)
+
+	s := newTestService(t, beaconDB, &mockFetcher{blocks: blocks}, true)
+	require.NoError(t, s.run())
+
+	for _, blk := range blocks {
+		root, err := blk.Block().HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, beaconDB.HasBlock(ctx, root))
+	}
+	backfill, err := beaconDB.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, genesisRoot, backfill)
+}
+
+func TestService_Run_Resumes(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	blocks, _ := setupOrigin(t, beaconDB, 100)
+
+	// Blocks down to slot 60 were backfilled before a restart.
+	require.NoError(t, beaconDB.SaveBlocks(ctx, blocks[59:]))
+	root, err := blocks[59].Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBackfillBlockRoot(ctx, root))
+
+	fetcher := &mockFetcher{blocks: blocks}
+	s := newTestService(t, beaconDB, fetcher, false)
+	require.NoError(t, s.run())
+	// Slots [1, 59] in batches of 16.
+	assert.Equal(t, 4, fetcher.requests)
+}
+
+func TestService_Run_InvalidBatch(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	blocks, genesisRoot := setupOrigin(t, beaconDB, 40)
+
+	fetcher := &mockFetcher{blocks: blocks, corrupt: 2}
+	s := newTestService(t, beaconDB, fetcher, false)
+	require.NoError(t, s.run())
+
+	for _, blk := range blocks {
+		root, err := blk.Block().HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, beaconDB.HasBlock(ctx, root))
+	}
+	backfill, err := beaconDB.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, genesisRoot, backfill)
+	assert.Equal(t, 0, fetcher.corrupt)
+}
+
+func TestService_Run_NoGenesisBlock(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	st, keys := testutil.DeterministicGenesisState(t, 8)
+	genesis := wrapper.WrappedPhase0SignedBeaconBlock(testutil.NewBeaconBlock())
+	genesisRoot, err := genesis.Block().HashTreeRoot()
+	require.NoError(t, err)
+	chain, roots := makeChain(t, genesisRoot, 40, keys)
+	origin := roots[len(roots)-1]
+	require.NoError(t, beaconDB.SaveBlock(ctx, chain[len(chain)-1]))
+	require.NoError(t, beaconDB.SaveState(ctx, st, origin))
+	require.NoError(t, beaconDB.SaveOriginBlockRoot(ctx, origin))
+
+	// The genesis block is not in the database, it is backfilled down to slot 0.
+	blocks := append([]interfaces.SignedBeaconBlock{genesis}, chain...)
+	s := newTestService(t, beaconDB, &mockFetcher{blocks: blocks}, true)
+	require.NoError(t, s.run())
+
+	for _, blk := range blocks {
+		root, err := blk.Block().HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, beaconDB.HasBlock(ctx, root))
+	}
+	backfill, err := beaconDB.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, genesisRoot, backfill)
+}
+
+func TestService_Run_Canceled(t *testing.T) {
+	beaconDB := dbtest.SetupDB(t)
+	setupOrigin(t, beaconDB, 40)
+
+	s := newTestService(t, beaconDB, &mockFetcher{}, false)
+	require.NoError(t, s.Stop())
+	assert.Equal(t, true, errors.Is(s.run(), context.Canceled))
+}
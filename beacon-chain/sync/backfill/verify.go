@@ -0,0 +1,108 @@
+package backfill
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+var errInvalidBatch = errors.New("invalid backfill batch")
+
+// verifyChain checks that the given blocks, sorted by ascending slot, form a chain ending in the
+// block with the expected root, by walking the parent roots down from the highest block. The
+// roots of the blocks are returned in the same order as the blocks.
+func verifyChain(blocks []interfaces.SignedBeaconBlock, expected [32]byte) ([][32]byte, error) {
+	roots := make([][32]byte, len(blocks))
+	for i := len(blocks) - 1; i >= 0; i-- {
+		blk := blocks[i]
+		if blk == nil || blk.IsNil() {
+			return nil, errors.Wrap(errInvalidBatch, "nil block")
+		}
+		root, err := blk.Block().HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute block root")
+		}
+		if root != expected {
+			return nil, errors.Wrapf(errInvalidBatch, "block at slot %d has root %#x, expected parent root %#x",
+				blk.Block().Slot(), root, expected)
+		}
+		roots[i] = root
+		copy(expected[:], blk.Block().ParentRoot())
+	}
+	return roots, nil
+}
+
+// verifySignatures verifies the proposer signatures of the given blocks as a single batch. Blocks
+// below the origin are proposed by validators already in the registry of the origin state, so the
+// origin state is used to look up proposer keys. The blocks may predate the fork of the origin
+// state, so the signing domain uses the fork of each block epoch in the fork schedule.
+func verifySignatures(originState iface.ReadOnlyBeaconState, blocks []interfaces.SignedBeaconBlock) error {
+	set := bls.NewSet()
+	for _, blk := range blocks {
+		// The genesis block is not signed, it is only verified by its root.
+		if blk.Block().Slot() == 0 {
+			continue
+		}
+		idx := blk.Block().ProposerIndex()
+		if uint64(idx) >= uint64(originState.NumValidators()) {
+			return errors.Wrapf(errInvalidBatch, "proposer index %d of block at slot %d is out of range",
+				idx, blk.Block().Slot())
+		}
+		pubkey := originState.PubkeyAtIndex(idx)
+		epoch := helpers.SlotToEpoch(blk.Block().Slot())
+		domain, err := helpers.Domain(forkAtEpoch(epoch), epoch,
+			params.BeaconConfig().DomainBeaconProposer, originState.GenesisValidatorRoot())
+		if err != nil {
+			return err
+		}
+		sigSet, err := helpers.BlockSignatureSet(pubkey[:], blk.Signature(), domain, blk.Block().HashTreeRoot)
+		if err != nil {
+			return errors.Wrapf(errInvalidBatch, "could not build signature set of block at slot %d: %v",
+				blk.Block().Slot(), err)
+		}
+		set.Join(sigSet)
+	}
+	if len(set.Signatures) == 0 {
+		return nil
+	}
+	verified, err := set.Verify()
+	if err != nil {
+		return errors.Wrap(err, "could not verify block signatures")
+	}
+	if !verified {
+		return errors.Wrapf(errInvalidBatch, "signatures of %d blocks did not verify", len(blocks))
+	}
+	return nil
+}
+
+// forkAtEpoch returns the fork active at the given epoch according to the fork schedule of the
+// config, which starts with the genesis fork version and includes the Altair fork.
+func forkAtEpoch(epoch types.Epoch) *pb.Fork {
+	cfg := params.BeaconConfig()
+	schedule := map[types.Epoch][]byte{0: cfg.GenesisForkVersion}
+	for e, v := range cfg.ForkVersionSchedule {
+		schedule[e] = v
+	}
+	schedule[cfg.AltairForkEpoch] = cfg.AltairForkVersion
+	epochs := make([]types.Epoch, 0, len(schedule))
+	for e := range schedule {
+		epochs = append(epochs, e)
+	}
+	sort.Slice(epochs, func(i, j int) bool { return epochs[i] < epochs[j] })
+
+	fork := &pb.Fork{PreviousVersion: cfg.GenesisForkVersion, CurrentVersion: cfg.GenesisForkVersion}
+	for _, e := range epochs {
+		if e > epoch {
+			break
+		}
+		fork = &pb.Fork{PreviousVersion: fork.CurrentVersion, CurrentVersion: schedule[e], Epoch: e}
+	}
+	return fork
+}
@@ -0,0 +1,112 @@
+package backfill
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// makeChain returns signed blocks for the slots [1, count] chained on top of the given parent
+// root, along with their roots.
+func makeChain(t *testing.T, parent [32]byte, count uint64, keys []bls.SecretKey) ([]interfaces.SignedBeaconBlock, [][32]byte) {
+	st, _ := testutil.DeterministicGenesisState(t, uint64(len(keys)))
+	blocks := make([]interfaces.SignedBeaconBlock, 0, count)
+	roots := make([][32]byte, 0, count)
+	for i := uint64(1); i <= count; i++ {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = types.Slot(i)
+		blk.Block.ProposerIndex = types.ValidatorIndex(i % uint64(len(keys)))
+		blk.Block.ParentRoot = bytesutil.SafeCopyBytes(parent[:])
+		sig, err := helpers.ComputeDomainAndSign(st, helpers.SlotToEpoch(blk.Block.Slot), blk.Block,
+			params.BeaconConfig().DomainBeaconProposer, keys[blk.Block.ProposerIndex])
+		require.NoError(t, err)
+		blk.Signature = sig
+		parent, err = blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		blocks = append(blocks, wrapper.WrappedPhase0SignedBeaconBlock(blk))
+		roots = append(roots, parent)
+	}
+	return blocks, roots
+}
+
+func TestVerifyChain(t *testing.T) {
+	_, keys := testutil.DeterministicGenesisState(t, 8)
+	blocks, roots := makeChain(t, [32]byte{'g'}, 10, keys)
+
+	got, err := verifyChain(blocks[2:6], roots[5])
+	require.NoError(t, err)
+	assert.DeepEqual(t, roots[2:6], got)
+
+	_, err = verifyChain(blocks[2:6], roots[6])
+	assert.Equal(t, true, errors.Is(err, errInvalidBatch))
+
+	// A gap in the middle of the batch breaks the chain.
+	gapped := append(append([]interfaces.SignedBeaconBlock{}, blocks[2:4]...), blocks[5:7]...)
+	_, err = verifyChain(gapped, roots[6])
+	assert.Equal(t, true, errors.Is(err, errInvalidBatch))
+}
+
+func TestVerifySignatures(t *testing.T) {
+	st, keys := testutil.DeterministicGenesisState(t, 8)
+	blocks, _ := makeChain(t, [32]byte{'g'}, 10, keys)
+	require.NoError(t, verifySignatures(st, blocks))
+
+	_, otherKeys := testutil.DeterministicGenesisState(t, 16)
+	forged, _ := makeChain(t, [32]byte{'g'}, 10, otherKeys[8:])
+	err := verifySignatures(st, forged)
+	assert.Equal(t, true, errors.Is(err, errInvalidBatch))
+}
+
+func TestVerifySignatures_OriginStateOfLaterFork(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 2
+	cfg.ForkVersionSchedule = map[types.Epoch][]byte{5: {2, 0, 0, 0}}
+	params.OverrideBeaconConfig(cfg)
+
+	st, keys := testutil.DeterministicGenesisState(t, 8)
+	blocks, _ := makeChain(t, [32]byte{'g'}, 10, keys)
+	// The blocks of the genesis fork are verified with the genesis fork version, which the fork
+	// of the origin state no longer refers to.
+	originState := st.Copy()
+	require.NoError(t, originState.SetFork(&pb.Fork{
+		PreviousVersion: cfg.AltairForkVersion,
+		CurrentVersion:  []byte{2, 0, 0, 0},
+		Epoch:           5,
+	}))
+	require.NoError(t, verifySignatures(originState, blocks))
+}
+
+func TestForkAtEpoch(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 2
+	cfg.ForkVersionSchedule = map[types.Epoch][]byte{5: {2, 0, 0, 0}}
+	params.OverrideBeaconConfig(cfg)
+
+	assert.DeepEqual(t, &pb.Fork{
+		PreviousVersion: cfg.GenesisForkVersion,
+		CurrentVersion:  cfg.GenesisForkVersion,
+	}, forkAtEpoch(1))
+	assert.DeepEqual(t, &pb.Fork{
+		PreviousVersion: cfg.GenesisForkVersion,
+		CurrentVersion:  cfg.AltairForkVersion,
+		Epoch:           2,
+	}, forkAtEpoch(4))
+	assert.DeepEqual(t, &pb.Fork{
+		PreviousVersion: cfg.AltairForkVersion,
+		CurrentVersion:  []byte{2, 0, 0, 0},
+		Epoch:           5,
+	}, forkAtEpoch(5))
+}
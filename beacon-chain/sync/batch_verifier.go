@@ -0,0 +1,135 @@
+package sync
+
+import (
+	"context"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"go.opencensus.io/trace"
+)
+
+const (
+	// signatureVerificationInterval is the longest a signature set waits before its batch is verified.
+	signatureVerificationInterval = 50 * time.Millisecond
+	// verifierLimit is the number of signature sets which are verified together at most.
+	verifierLimit = 50
+)
+
+var errBatchVerificationFailed = errors.New("batch signature verification failed")
+
+// signatureVerifier holds a signature set pending verification and the
+// channel on which the result of its batch verification is sent.
+type signatureVerifier struct {
+	set     *bls.SignatureSet
+	resChan chan error
+}
+
+// A routine that runs in the background to perform batch verifications
+// of the signature sets of incoming gossip messages.
+func (s *Service) verifierRoutine() {
+	verifierBatch := make([]*signatureVerifier, 0, verifierLimit)
+	ticker := time.NewTicker(signatureVerificationInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			// Release the validators waiting on the pending batch.
+			for _, v := range verifierBatch {
+				v.resChan <- s.ctx.Err()
+			}
+			return
+		case v := <-s.signatureChan:
+			verifierBatch = append(verifierBatch, v)
+			if len(verifierBatch) >= verifierLimit {
+				verifyBatch(verifierBatch)
+				verifierBatch = make([]*signatureVerifier, 0, verifierLimit)
+			}
+		case <-ticker.C:
+			if len(verifierBatch) > 0 {
+				verifyBatch(verifierBatch)
+				verifierBatch = make([]*signatureVerifier, 0, verifierLimit)
+			}
+		}
+	}
+}
+
+// validateWithBatchVerifier sends the signature set to the verifier routine and waits for the
+// result of its batch. If the batch fails, the set is verified on its own, so an invalid message
+// does not cause valid messages of the same batch to be rejected. Without a verifier routine, the
+// set is always verified on its own.
+func (s *Service) validateWithBatchVerifier(ctx context.Context, message string, set *bls.SignatureSet) pubsub.ValidationResult {
+	ctx, span := trace.StartSpan(ctx, "sync.validateWithBatchVerifier")
+	defer span.End()
+
+	if s.signatureChan == nil {
+		return verifySignatureSet(span, message, set)
+	}
+
+	// The result channel is buffered so that the verifier routine never
+	// blocks on a validator which is no longer waiting.
+	resChan := make(chan error, 1)
+	select {
+	case s.signatureChan <- &signatureVerifier{set: set, resChan: resChan}:
+	case <-ctx.Done():
+		return pubsub.ValidationIgnore
+	}
+
+	var resErr error
+	select {
+	case resErr = <-resChan:
+	case <-ctx.Done():
+		return pubsub.ValidationIgnore
+	}
+	if resErr == nil {
+		return pubsub.ValidationAccept
+	}
+	if !errors.Is(resErr, errBatchVerificationFailed) {
+		traceutil.AnnotateError(span, resErr)
+		return pubsub.ValidationIgnore
+	}
+
+	log.WithError(resErr).Tracef("Could not perform batch verification of %s", message)
+	return verifySignatureSet(span, message, set)
+}
+
+// verifySignatureSet verifies the signature set of the message on its own. The message is only
+// rejected when its signature is invalid, not when the set could not be verified.
+func verifySignatureSet(span *trace.Span, message string, set *bls.SignatureSet) pubsub.ValidationResult {
+	verified, err := set.Verify()
+	if err != nil {
+		verErr := errors.Wrapf(err, "Could not verify %s", message)
+		log.WithError(verErr).Debug("Could not verify signature set")
+		traceutil.AnnotateError(span, verErr)
+		return pubsub.ValidationIgnore
+	}
+	if !verified {
+		log.Debugf("Verification of %s failed", message)
+		traceutil.AnnotateError(span, errors.Errorf("Could not verify signature of %s", message))
+		return pubsub.ValidationReject
+	}
+	return pubsub.ValidationAccept
+}
+
+// verifyBatch verifies the signature sets of the batch together and sends the
+// result to each of the waiting validators.
+func verifyBatch(verifierBatch []*signatureVerifier) {
+	start := time.Now()
+	aggSet := bls.NewSet()
+	for _, v := range verifierBatch {
+		aggSet.Join(v.set)
+	}
+	var verificationErr error
+	verified, err := aggSet.Verify()
+	if err != nil || !verified {
+		verificationErr = errBatchVerificationFailed
+		batchVerificationFailures.Inc()
+	}
+	batchVerificationSize.Observe(float64(len(verifierBatch)))
+	batchVerificationLatency.Observe(float64(time.Since(start).Milliseconds()))
+	for _, v := range verifierBatch {
+		v.resChan <- verificationErr
+	}
+}
@@ -0,0 +1,111 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func signatureSet(t *testing.T, msg [32]byte, signedMsg [32]byte) *bls.SignatureSet {
+	priv, err := bls.RandKey()
+	require.NoError(t, err)
+	return &bls.SignatureSet{
+		Signatures: [][]byte{priv.Sign(signedMsg[:]).Marshal()},
+		PublicKeys: []bls.PublicKey{priv.PublicKey()},
+		Messages:   [][32]byte{msg},
+	}
+}
+
+func TestValidateWithBatchVerifier(t *testing.T) {
+	tests := []struct {
+		name    string
+		sets    []*bls.SignatureSet
+		results []pubsub.ValidationResult
+	}{
+		{
+			name:    "valid set",
+			sets:    []*bls.SignatureSet{signatureSet(t, [32]byte{'a'}, [32]byte{'a'})},
+			results: []pubsub.ValidationResult{pubsub.ValidationAccept},
+		},
+		{
+			name:    "invalid set",
+			sets:    []*bls.SignatureSet{signatureSet(t, [32]byte{'a'}, [32]byte{'b'})},
+			results: []pubsub.ValidationResult{pubsub.ValidationReject},
+		},
+		{
+			name: "invalid set in batch with valid sets",
+			sets: []*bls.SignatureSet{
+				signatureSet(t, [32]byte{'a'}, [32]byte{'a'}),
+				signatureSet(t, [32]byte{'a'}, [32]byte{'b'}),
+				signatureSet(t, [32]byte{'c'}, [32]byte{'c'}),
+			},
+			results: []pubsub.ValidationResult{pubsub.ValidationAccept, pubsub.ValidationReject, pubsub.ValidationAccept},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			s := &Service{
+				ctx:           ctx,
+				signatureChan: make(chan *signatureVerifier, verifierLimit),
+			}
+			go s.verifierRoutine()
+
+			results := make([]pubsub.ValidationResult, len(tt.sets))
+			var wg sync.WaitGroup
+			for i, set := range tt.sets {
+				wg.Add(1)
+				go func(i int, set *bls.SignatureSet) {
+					defer wg.Done()
+					results[i] = s.validateWithBatchVerifier(ctx, "test", set)
+				}(i, set)
+			}
+			wg.Wait()
+			assert.DeepEqual(t, tt.results, results)
+		})
+	}
+}
+
+func TestValidateWithBatchVerifier_ContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Service{
+		ctx:           ctx,
+		signatureChan: make(chan *signatureVerifier, verifierLimit),
+	}
+	cancel()
+	// The verifier routine is not running, so the result can only come from the cancelled context.
+	res := s.validateWithBatchVerifier(ctx, "test", bls.NewSet())
+	assert.Equal(t, pubsub.ValidationIgnore, res)
+}
+
+func TestValidateWithBatchVerifier_NoVerifierRoutine(t *testing.T) {
+	s := &Service{}
+	res := s.validateWithBatchVerifier(context.Background(), "test", signatureSet(t, [32]byte{'a'}, [32]byte{'a'}))
+	assert.Equal(t, pubsub.ValidationAccept, res)
+	res = s.validateWithBatchVerifier(context.Background(), "test", signatureSet(t, [32]byte{'a'}, [32]byte{'b'}))
+	assert.Equal(t, pubsub.ValidationReject, res)
+}
+
+func TestValidateWithBatchVerifier_VerificationError(t *testing.T) {
+	set := signatureSet(t, [32]byte{'a'}, [32]byte{'a'})
+	set.Messages = append(set.Messages, [32]byte{'b'})
+
+	// A set which can't be verified is ignored rather than rejected, with or without batching.
+	s := &Service{}
+	assert.Equal(t, pubsub.ValidationIgnore, s.validateWithBatchVerifier(context.Background(), "test", set))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s = &Service{
+		ctx:           ctx,
+		signatureChan: make(chan *signatureVerifier, verifierLimit),
+	}
+	go s.verifierRoutine()
+	assert.Equal(t, pubsub.ValidationIgnore, s.validateWithBatchVerifier(ctx, "test", set))
+}
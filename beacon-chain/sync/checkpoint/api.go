@@ -0,0 +1,142 @@
+package checkpoint
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	state "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+const (
+	getFinalizedStatePath  = "/eth/v1/debug/beacon/states/finalized"
+	getBlockPathFmt        = "/eth/v1/beacon/blocks/%#x"
+	getDepositSnapshotPath = "/eth/v1/beacon/deposit_snapshot"
+	sszContentType         = "application/octet-stream"
+	jsonContentType        = "application/json"
+	// States are several hundred megabytes on large networks, so the timeout is generous.
+	requestTimeout = 10 * time.Minute
+)
+
+// APIInitializer initializes the database from the finalized state and block of a trusted beacon
+// node, downloaded in ssz through the node's REST API.
+type APIInitializer struct {
+	baseURL *url.URL
+	client  *http.Client
+}
+
+// NewAPIInitializer returns an Initializer downloading the finalized state and block from the
+// beacon node REST API at the given URL.
+func NewAPIInitializer(beaconNodeURL string) (*APIInitializer, error) {
+	u, err := url.ParseRequestURI(beaconNodeURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid checkpoint sync url %s", beaconNodeURL)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("checkpoint sync url %s must use http or https", beaconNodeURL)
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	return &APIInitializer{
+		baseURL: u,
+		client:  &http.Client{Timeout: requestTimeout},
+	}, nil
+}
+
+// Initialize downloads the finalized state of the remote beacon node and the block it was built
+// from, and saves them as the origin of the database, unless the database has been initialized
+// already.
+func (ai *APIInitializer) Initialize(ctx context.Context, d db.HeadAccessDatabase) error {
+	initialized, err := isInitialized(ctx, d)
+	if err != nil || initialized {
+		return err
+	}
+	log.WithField("url", ai.baseURL.String()).Info("Downloading finalized checkpoint state")
+	serState, err := ai.getSSZ(ctx, getFinalizedStatePath)
+	if err != nil {
+		return errors.Wrap(err, "could not download finalized state")
+	}
+	blockRoot, err := latestBlockRoot(ctx, serState)
+	if err != nil {
+		return err
+	}
+	serBlock, err := ai.getSSZ(ctx, fmt.Sprintf(getBlockPathFmt, blockRoot))
+	if err != nil {
+		return errors.Wrapf(err, "could not download finalized block %#x", blockRoot)
+	}
+	return saveOrigin(ctx, d, serState, serBlock)
+}
+
+func (ai *APIInitializer) getSSZ(ctx context.Context, path string) ([]byte, error) {
+	body, ct, err := ai.get(ctx, path, sszContentType)
+	if err != nil {
+		return nil, err
+	}
+	if ct != sszContentType {
+		return nil, fmt.Errorf("request to %s%s returned content type %q instead of ssz", ai.baseURL, path, ct)
+	}
+	return body, nil
+}
+
+// get requests the given path of the beacon node API, accepting the given content type, and
+// returns the response body and its content type.
+func (ai *APIInitializer) get(ctx context.Context, path, accept string) ([]byte, string, error) {
+	u := *ai.baseURL
+	u.Path += path
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, "", err
+	}
+	req.Header.Set("Accept", accept)
+	resp, err := ai.client.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close response body")
+		}
+	}()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("request to %s failed with status %d: %s", u.String(), resp.StatusCode, body)
+	}
+	return body, resp.Header.Get("Content-Type"), nil
+}
+
+// latestBlockRoot returns the root of the latest block applied to the ssz encoded state. The state
+// root of the latest block header is only filled in when the next slot is processed, so it is
+// filled in here when missing.
+func latestBlockRoot(ctx context.Context, serState []byte) ([32]byte, error) {
+	st := &pbp2p.BeaconState{}
+	if err := st.UnmarshalSSZ(serState); err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not unmarshal finalized state")
+	}
+	s, err := state.InitializeFromProtoUnsafe(st)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	header := s.LatestBlockHeader()
+	if header == nil {
+		return [32]byte{}, errors.New("finalized state has no latest block header")
+	}
+	if bytes.Equal(header.StateRoot, params.BeaconConfig().ZeroHash[:]) {
+		stateRoot, err := s.HashTreeRoot(ctx)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		header.StateRoot = stateRoot[:]
+	}
+	return header.HashTreeRoot()
+}
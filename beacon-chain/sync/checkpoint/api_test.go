@@ -0,0 +1,72 @@
+package checkpoint
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestNewAPIInitializer(t *testing.T) {
+	_, err := NewAPIInitializer("localhost:3500")
+	assert.ErrorContains(t, "must use http or https", err)
+	_, err = NewAPIInitializer("not a url")
+	assert.ErrorContains(t, "invalid checkpoint sync url", err)
+	ai, err := NewAPIInitializer("http://localhost:3500/")
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:3500", ai.baseURL.String())
+}
+
+func TestAPIInitializer_Initialize(t *testing.T) {
+	ctx := context.Background()
+	serState, serBlock, root := testOrigin(t)
+	mux := http.NewServeMux()
+	mux.HandleFunc(getFinalizedStatePath, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, sszContentType, r.Header.Get("Accept"))
+		w.Header().Set("Content-Type", sszContentType)
+		_, err := w.Write(serState)
+		require.NoError(t, err)
+	})
+	mux.HandleFunc(fmt.Sprintf(getBlockPathFmt, root), func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", sszContentType)
+		_, err := w.Write(serBlock)
+		require.NoError(t, err)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	ai, err := NewAPIInitializer(srv.URL)
+	require.NoError(t, err)
+	d := setupGenesisDB(t)
+	require.NoError(t, ai.Initialize(ctx, d))
+
+	origin, err := d.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, root, origin)
+	head, err := d.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch*3, head.Block().Slot())
+}
+
+func TestAPIInitializer_Initialize_Errors(t *testing.T) {
+	ctx := context.Background()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == getFinalizedStatePath {
+			w.Header().Set("Content-Type", "application/json")
+			_, err := w.Write([]byte("{}"))
+			require.NoError(t, err)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	ai, err := NewAPIInitializer(srv.URL)
+	require.NoError(t, err)
+	assert.ErrorContains(t, "instead of ssz", ai.Initialize(ctx, setupGenesisDB(t)))
+}
@@ -0,0 +1,100 @@
+// Package checkpoint initializes the beacon node database from a finalized state and block, read
+// from ssz files or downloaded from a trusted beacon node, so the node can sync forward from that
+// point instead of syncing from genesis.
+package checkpoint
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// Initializer seeds an empty database with a finalized state and block to sync from.
+type Initializer interface {
+	Initialize(ctx context.Context, d db.HeadAccessDatabase) error
+}
+
+// FileInitializer initializes the database from a state and block stored in ssz files.
+type FileInitializer struct {
+	statePath string
+	blockPath string
+}
+
+// NewFileInitializer returns an Initializer reading the state and block from the given ssz files.
+func NewFileInitializer(statePath, blockPath string) (*FileInitializer, error) {
+	if statePath == "" || blockPath == "" {
+		return nil, errors.New("both a checkpoint state and a checkpoint block file are required")
+	}
+	for _, p := range []string{statePath, blockPath} {
+		if _, err := os.Stat(p); err != nil {
+			return nil, errors.Wrapf(err, "could not open checkpoint file %s", p)
+		}
+	}
+	return &FileInitializer{statePath: statePath, blockPath: blockPath}, nil
+}
+
+// Initialize saves the state and block files as the origin of the database, unless the database
+// has been initialized already.
+func (fi *FileInitializer) Initialize(ctx context.Context, d db.HeadAccessDatabase) error {
+	initialized, err := isInitialized(ctx, d)
+	if err != nil || initialized {
+		return err
+	}
+	serState, err := ioutil.ReadFile(fi.statePath)
+	if err != nil {
+		return errors.Wrapf(err, "could not read checkpoint state file %s", fi.statePath)
+	}
+	serBlock, err := ioutil.ReadFile(fi.blockPath)
+	if err != nil {
+		return errors.Wrapf(err, "could not read checkpoint block file %s", fi.blockPath)
+	}
+	log.WithField("state", fi.statePath).WithField("block", fi.blockPath).Info("Initializing database from checkpoint files")
+	return saveOrigin(ctx, d, serState, serBlock)
+}
+
+// isInitialized returns true if the database was already initialized from a checkpoint or holds a
+// chain synced past genesis, in which case the checkpoint is ignored.
+func isInitialized(ctx context.Context, d db.HeadAccessDatabase) (bool, error) {
+	origin, err := d.OriginBlockRoot(ctx)
+	if err == nil {
+		log.WithField("root", fmt.Sprintf("%#x", bytesutil.Trunc(origin[:]))).Info(
+			"Database already initialized from a checkpoint, ignoring checkpoint sync flags")
+		return true, nil
+	}
+	if !errors.Is(err, db.ErrNotFoundOriginBlockRoot) {
+		return false, err
+	}
+	finalized, err := d.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return false, err
+	}
+	if bytesutil.ToBytes32(finalized.Root) != params.BeaconConfig().ZeroHash {
+		log.WithField("epoch", finalized.Epoch).Warn(
+			"Database already has a finalized checkpoint, ignoring checkpoint sync flags")
+		return true, nil
+	}
+	return false, nil
+}
+
+func saveOrigin(ctx context.Context, d db.HeadAccessDatabase, serState, serBlock []byte) error {
+	if err := d.SaveOrigin(ctx, serState, serBlock); err != nil {
+		return errors.Wrap(err, "could not save checkpoint sync origin")
+	}
+	root, err := d.OriginBlockRoot(ctx)
+	if err != nil {
+		return err
+	}
+	finalized, err := d.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return err
+	}
+	log.WithField("root", fmt.Sprintf("%#x", bytesutil.Trunc(root[:]))).WithField("epoch", finalized.Epoch).Info(
+		"Initialized database from checkpoint")
+	return nil
+}
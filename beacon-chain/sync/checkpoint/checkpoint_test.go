@@ -0,0 +1,115 @@
+package checkpoint
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// testOrigin returns an ssz encoded genesis-derived state at the start of epoch 3, its latest
+// block and the block root.
+func testOrigin(t *testing.T) ([]byte, []byte, [32]byte) {
+	ctx := context.Background()
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch*3))
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = st.Slot()
+	blk.Block.ParentRoot = bytesutil.PadTo([]byte{'p'}, 32)
+	bodyRoot, err := blk.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{
+		Slot:       blk.Block.Slot,
+		ParentRoot: blk.Block.ParentRoot,
+		StateRoot:  params.BeaconConfig().ZeroHash[:],
+		BodyRoot:   bodyRoot[:],
+	}))
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	blk.Block.StateRoot = stateRoot[:]
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	serState, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	serBlock, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	return serState, serBlock, root
+}
+
+func setupGenesisDB(t *testing.T) db.Database {
+	d := testDB.SetupDB(t)
+	gs, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, d.SaveGenesisData(context.Background(), gs))
+	return d
+}
+
+func TestNewFileInitializer(t *testing.T) {
+	dir := t.TempDir()
+	statePath := filepath.Join(dir, "state.ssz")
+	require.NoError(t, ioutil.WriteFile(statePath, []byte{1}, 0600))
+
+	_, err := NewFileInitializer(statePath, "")
+	assert.ErrorContains(t, "both a checkpoint state and a checkpoint block file are required", err)
+	_, err = NewFileInitializer(statePath, filepath.Join(dir, "missing.ssz"))
+	assert.ErrorContains(t, "could not open checkpoint file", err)
+}
+
+func TestFileInitializer_Initialize(t *testing.T) {
+	ctx := context.Background()
+	serState, serBlock, root := testOrigin(t)
+	dir := t.TempDir()
+	statePath := filepath.Join(dir, "state.ssz")
+	blockPath := filepath.Join(dir, "block.ssz")
+	require.NoError(t, ioutil.WriteFile(statePath, serState, 0600))
+	require.NoError(t, ioutil.WriteFile(blockPath, serBlock, 0600))
+
+	fi, err := NewFileInitializer(statePath, blockPath)
+	require.NoError(t, err)
+	d := setupGenesisDB(t)
+	require.NoError(t, fi.Initialize(ctx, d))
+
+	origin, err := d.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, root, origin)
+	finalized, err := d.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, root[:], finalized.Root)
+
+	// Restarting with the same flags is a no-op.
+	require.NoError(t, fi.Initialize(ctx, d))
+}
+
+func TestFileInitializer_Initialize_SyncedDB(t *testing.T) {
+	ctx := context.Background()
+	serState, serBlock, _ := testOrigin(t)
+	dir := t.TempDir()
+	statePath := filepath.Join(dir, "state.ssz")
+	blockPath := filepath.Join(dir, "block.ssz")
+	require.NoError(t, ioutil.WriteFile(statePath, serState, 0600))
+	require.NoError(t, ioutil.WriteFile(blockPath, serBlock, 0600))
+
+	d := setupGenesisDB(t)
+	gb, err := d.GenesisBlock(ctx)
+	require.NoError(t, err)
+	gRoot, err := gb.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, d.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: gRoot[:]}))
+
+	fi, err := NewFileInitializer(statePath, blockPath)
+	require.NoError(t, err)
+	require.NoError(t, fi.Initialize(ctx, d))
+	_, err = d.OriginBlockRoot(ctx)
+	assert.ErrorContains(t, "origin checkpoint block root not found", err)
+}
@@ -0,0 +1,118 @@
+package checkpoint
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"github.com/sirupsen/logrus"
+)
+
+// depositSnapshotResponse is the JSON response of the deposit snapshot API endpoint, which is
+// also the format of deposit snapshot files.
+type depositSnapshotResponse struct {
+	Data *struct {
+		Finalized            []string `json:"finalized"`
+		DepositRoot          string   `json:"deposit_root"`
+		DepositCount         string   `json:"deposit_count"`
+		ExecutionBlockHash   string   `json:"execution_block_hash"`
+		ExecutionBlockHeight string   `json:"execution_block_height"`
+	} `json:"data"`
+}
+
+// ImportDepositSnapshotFile saves the deposit snapshot of the given JSON file, so that the deposit
+// logs are followed from the snapshot instead of from the deployment of the deposit contract.
+func ImportDepositSnapshotFile(ctx context.Context, d db.HeadAccessDatabase, path string) error {
+	enc, err := ioutil.ReadFile(path)
+	if err != nil {
+		return errors.Wrapf(err, "could not read deposit snapshot file %s", path)
+	}
+	log.WithField("file", path).Info("Importing deposit snapshot")
+	return importDepositSnapshot(ctx, d, enc)
+}
+
+// ImportDepositSnapshot downloads the deposit snapshot of the remote beacon node and saves it, so
+// that the deposit logs are followed from the snapshot instead of from the deployment of the
+// deposit contract.
+func (ai *APIInitializer) ImportDepositSnapshot(ctx context.Context, d db.HeadAccessDatabase) error {
+	log.WithField("url", ai.baseURL.String()).Info("Downloading deposit snapshot")
+	enc, ct, err := ai.get(ctx, getDepositSnapshotPath, jsonContentType)
+	if err != nil {
+		return errors.Wrap(err, "could not download deposit snapshot")
+	}
+	if !strings.HasPrefix(ct, jsonContentType) {
+		return errors.Errorf("request to %s%s returned content type %q instead of json", ai.baseURL, getDepositSnapshotPath, ct)
+	}
+	return importDepositSnapshot(ctx, d, enc)
+}
+
+// importDepositSnapshot saves the JSON encoded deposit snapshot, unless the database already has
+// deposit data, which the snapshot would conflict with.
+func importDepositSnapshot(ctx context.Context, d db.HeadAccessDatabase, enc []byte) error {
+	snapshot, err := decodeDepositSnapshot(enc)
+	if err != nil {
+		return err
+	}
+	// The snapshot is only accepted if its finalized nodes add up to its deposit root.
+	if _, err := trieutil.TrieFromSnapshot(snapshot, params.BeaconConfig().DepositContractTreeDepth); err != nil {
+		return errors.Wrap(err, "invalid deposit snapshot")
+	}
+	eth1Data, err := d.PowchainData(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve eth1 data")
+	}
+	current, err := d.DepositSnapshot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve deposit snapshot")
+	}
+	if eth1Data != nil || current != nil {
+		log.Info("Database already has deposit data, ignoring deposit snapshot")
+		return nil
+	}
+	if err := d.SaveDepositSnapshot(ctx, snapshot); err != nil {
+		return errors.Wrap(err, "could not save deposit snapshot")
+	}
+	log.WithFields(logrus.Fields{
+		"depositCount": snapshot.DepositCount,
+		"eth1Block":    snapshot.ExecutionBlockHeight,
+	}).Info("Imported deposit snapshot")
+	return nil
+}
+
+func decodeDepositSnapshot(enc []byte) (*protodb.DepositSnapshot, error) {
+	resp := &depositSnapshotResponse{}
+	if err := json.Unmarshal(enc, resp); err != nil {
+		return nil, errors.Wrap(err, "could not decode deposit snapshot")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("deposit snapshot has no data")
+	}
+	snapshot := &protodb.DepositSnapshot{Finalized: make([][]byte, len(resp.Data.Finalized))}
+	var err error
+	for i, node := range resp.Data.Finalized {
+		if snapshot.Finalized[i], err = hexutil.Decode(node); err != nil {
+			return nil, errors.Wrapf(err, "could not decode finalized node %d", i)
+		}
+	}
+	if snapshot.DepositRoot, err = hexutil.Decode(resp.Data.DepositRoot); err != nil {
+		return nil, errors.Wrap(err, "could not decode deposit root")
+	}
+	if snapshot.DepositCount, err = strconv.ParseUint(resp.Data.DepositCount, 10, 64); err != nil {
+		return nil, errors.Wrap(err, "could not decode deposit count")
+	}
+	if snapshot.ExecutionBlockHash, err = hexutil.Decode(resp.Data.ExecutionBlockHash); err != nil {
+		return nil, errors.Wrap(err, "could not decode execution block hash")
+	}
+	if snapshot.ExecutionBlockHeight, err = strconv.ParseUint(resp.Data.ExecutionBlockHeight, 10, 64); err != nil {
+		return nil, errors.Wrap(err, "could not decode execution block height")
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,104 @@
+package checkpoint
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+// testDepositSnapshot returns a deposit snapshot of 5 deposits and its JSON encoding.
+func testDepositSnapshot(t *testing.T) (*protodb.DepositSnapshot, []byte) {
+	items := make([][]byte, 5)
+	for i := range items {
+		items[i] = []byte(fmt.Sprintf("deposit %d", i))
+	}
+	trie, err := trieutil.GenerateTrieFromItems(items, params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err)
+	snapshot, err := trie.Snapshot(uint64(len(items)))
+	require.NoError(t, err)
+	snapshot.ExecutionBlockHash = bytesutil.PadTo([]byte("hash"), 32)
+	snapshot.ExecutionBlockHeight = 100
+
+	finalized := make([]string, len(snapshot.Finalized))
+	for i, node := range snapshot.Finalized {
+		finalized[i] = fmt.Sprintf("%q", hexutil.Encode(node))
+	}
+	enc := fmt.Sprintf(`{"data":{"finalized":[%s],"deposit_root":"%s","deposit_count":"%d","execution_block_hash":"%s","execution_block_height":"%d"}}`,
+		strings.Join(finalized, ","), hexutil.Encode(snapshot.DepositRoot), snapshot.DepositCount,
+		hexutil.Encode(snapshot.ExecutionBlockHash), snapshot.ExecutionBlockHeight)
+	return snapshot, []byte(enc)
+}
+
+func TestImportDepositSnapshotFile(t *testing.T) {
+	ctx := context.Background()
+	snapshot, enc := testDepositSnapshot(t)
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	require.NoError(t, ioutil.WriteFile(path, enc, 0600))
+
+	d := testDB.SetupDB(t)
+	require.NoError(t, ImportDepositSnapshotFile(ctx, d, path))
+	saved, err := d.DepositSnapshot(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, snapshot, saved)
+
+	// A snapshot is never imported over existing deposit data.
+	other := strings.Replace(string(enc), `"execution_block_height":"100"`, `"execution_block_height":"200"`, 1)
+	require.NoError(t, ioutil.WriteFile(path, []byte(other), 0600))
+	require.NoError(t, ImportDepositSnapshotFile(ctx, d, path))
+	saved, err = d.DepositSnapshot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), saved.ExecutionBlockHeight)
+}
+
+func TestImportDepositSnapshotFile_Invalid(t *testing.T) {
+	ctx := context.Background()
+	_, enc := testDepositSnapshot(t)
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	d := testDB.SetupDB(t)
+
+	wrongCount := strings.Replace(string(enc), `"deposit_count":"5"`, `"deposit_count":"6"`, 1)
+	require.NoError(t, ioutil.WriteFile(path, []byte(wrongCount), 0600))
+	assert.ErrorContains(t, "invalid deposit snapshot", ImportDepositSnapshotFile(ctx, d, path))
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{}`), 0600))
+	assert.ErrorContains(t, "deposit snapshot has no data", ImportDepositSnapshotFile(ctx, d, path))
+
+	saved, err := d.DepositSnapshot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, saved == nil)
+}
+
+func TestAPIInitializer_ImportDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	snapshot, enc := testDepositSnapshot(t)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, getDepositSnapshotPath, r.URL.Path)
+		assert.Equal(t, jsonContentType, r.Header.Get("Accept"))
+		w.Header().Set("Content-Type", jsonContentType)
+		_, err := w.Write(enc)
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	ai, err := NewAPIInitializer(srv.URL)
+	require.NoError(t, err)
+	d := testDB.SetupDB(t)
+	require.NoError(t, ai.ImportDepositSnapshot(ctx, d))
+	saved, err := d.DepositSnapshot(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, snapshot, saved)
+}
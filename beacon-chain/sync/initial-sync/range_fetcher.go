@@ -0,0 +1,66 @@
+package initialsync
+
+import (
+	"context"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+)
+
+// RangeFetcherConfig is a config to setup the range fetcher.
+type RangeFetcherConfig struct {
+	Chain blockchainService
+	P2P   p2p.P2P
+	DB    db.ReadOnlyDatabase
+}
+
+// RangeFetcher fetches ranges of finalized blocks from peers for services other than initial
+// sync, such as backfill, sharing the peer selection and rate limiting of the blocks fetcher.
+// Requests are served one at a time.
+type RangeFetcher struct {
+	fetcher *blocksFetcher
+}
+
+// NewRangeFetcher creates a range fetcher, which must be started before use.
+func NewRangeFetcher(ctx context.Context, cfg *RangeFetcherConfig) *RangeFetcher {
+	return &RangeFetcher{
+		fetcher: newBlocksFetcher(ctx, &blocksFetcherConfig{
+			chain: cfg.Chain,
+			p2p:   cfg.P2P,
+			db:    cfg.DB,
+			mode:  modeStopOnFinalizedEpoch,
+		}),
+	}
+}
+
+// Start boots up the underlying blocks fetcher.
+func (r *RangeFetcher) Start() error {
+	return r.fetcher.start()
+}
+
+// Stop terminates the underlying blocks fetcher.
+func (r *RangeFetcher) Stop() {
+	r.fetcher.stop()
+}
+
+// FetchBlocks requests the blocks in slots [start, start+count) from a single peer, returning the
+// blocks and the peer which served them.
+func (r *RangeFetcher) FetchBlocks(
+	ctx context.Context, start types.Slot, count uint64,
+) ([]interfaces.SignedBeaconBlock, peer.ID, error) {
+	if err := r.fetcher.scheduleRequest(ctx, start, count); err != nil {
+		return nil, "", err
+	}
+	select {
+	case <-ctx.Done():
+		return nil, "", ctx.Err()
+	case resp, ok := <-r.fetcher.requestResponses():
+		if !ok {
+			return nil, "", errFetcherCtxIsDone
+		}
+		return resp.blocks, resp.pid, resp.err
+	}
+}
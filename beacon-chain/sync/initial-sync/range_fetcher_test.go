@@ -0,0 +1,43 @@
+package initialsync
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestRangeFetcher_FetchBlocks(t *testing.T) {
+	mc, p2p, beaconDB := initializeTestServices(t, makeSequence(1, 128), []*peerData{
+		{
+			blocks:         makeSequence(1, 128),
+			finalizedEpoch: helpers.SlotToEpoch(128),
+			headSlot:       128,
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fetcher := NewRangeFetcher(ctx, &RangeFetcherConfig{
+		Chain: mc,
+		P2P:   p2p,
+		DB:    beaconDB,
+	})
+	require.NoError(t, fetcher.Start())
+	defer fetcher.Stop()
+
+	blocks, pid, err := fetcher.FetchBlocks(ctx, 33, 32)
+	require.NoError(t, err)
+	assert.NotEqual(t, "", pid.String())
+	require.Equal(t, 32, len(blocks))
+	for i, blk := range blocks {
+		assert.Equal(t, types.Slot(33+i), blk.Block().Slot())
+	}
+
+	cancel()
+	_, _, err = fetcher.FetchBlocks(ctx, 65, 32)
+	assert.NotNil(t, err)
+}
@@ -55,6 +55,32 @@ var (
 			Buckets: []float64{250, 500, 1000, 1500, 2000, 4000, 8000, 16000},
 		},
 	)
+	batchVerificationSize = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "gossip_batch_verification_size",
+			Help:    "The number of signature sets of gossip messages verified together in a batch.",
+			Buckets: []float64{1, 2, 4, 8, 16, 32, 50},
+		},
+	)
+	batchVerificationLatency = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "gossip_batch_verification_latency_milliseconds",
+			Help:    "Captures the time taken to verify a batch of gossip signature sets in milliseconds.",
+			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200},
+		},
+	)
+	batchVerificationFailures = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "gossip_batch_verification_failures_total",
+			Help: "Count of batches of gossip signature sets which failed verification and were verified individually.",
+		},
+	)
+	slasherAttestationsDropped = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "gossip_slasher_attestations_dropped_total",
+			Help: "Count of validated attestations which were not sent to the slasher because its queue was full.",
+		},
+	)
 )
 
 func (s *Service) updateMetrics() {
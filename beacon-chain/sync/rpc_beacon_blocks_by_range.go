@@ -142,6 +142,18 @@ func (s *Service) writeBlockRangeToStream(ctx context.Context, startSlot, endSlo
 		blks = append([]interfaces.SignedBeaconBlock{genBlock}, blks...)
 		roots = append([][32]byte{genRoot}, roots...)
 	}
+	// Fill the slots missing from the database, such as before the origin of a checkpoint synced
+	// node, with the finalized history held by the era files.
+	var eraRoots map[[32]byte]bool
+	if s.cfg.EraArchive != nil {
+		blks, roots, eraRoots, err = s.appendEraBlocks(ctx, blks, roots, startSlot, endSlot, step)
+		if err != nil {
+			log.WithError(err).Debug("Could not retrieve blocks from era files")
+			s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
+			traceutil.AnnotateError(span, err)
+			return err
+		}
+	}
 	// Filter and sort our retrieved blocks, so that
 	// we only return valid sets of blocks.
 	blks, roots, err = s.dedupBlocksAndRoots(blks, roots)
@@ -152,7 +164,7 @@ func (s *Service) writeBlockRangeToStream(ctx context.Context, startSlot, endSlo
 	}
 	blks, roots = s.sortBlocksAndRoots(blks, roots)
 
-	blks, err = s.filterBlocks(ctx, blks, roots, prevRoot, step, startSlot)
+	blks, err = s.filterBlocks(ctx, blks, roots, eraRoots, prevRoot, step, startSlot)
 	if err != nil && err != p2ptypes.ErrInvalidParent {
 		s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
 		traceutil.AnnotateError(span, err)
@@ -174,6 +186,30 @@ func (s *Service) writeBlockRangeToStream(ctx context.Context, startSlot, endSlo
 	return err
 }
 
+// appendEraBlocks appends the blocks of the range held by the era archive to the given blocks, for
+// the slots which have no block yet. The roots of the appended blocks are returned as well.
+func (s *Service) appendEraBlocks(ctx context.Context, blks []interfaces.SignedBeaconBlock, roots [][32]byte,
+	startSlot, endSlot types.Slot, step uint64) ([]interfaces.SignedBeaconBlock, [][32]byte, map[[32]byte]bool, error) {
+	eraBlks, eraRoots, err := s.cfg.EraArchive.Blocks(ctx, startSlot, endSlot, step)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	slots := make(map[types.Slot]bool, len(blks))
+	for _, b := range blks {
+		slots[b.Block().Slot()] = true
+	}
+	appended := make(map[[32]byte]bool, len(eraBlks))
+	for i, b := range eraBlks {
+		if slots[b.Block().Slot()] {
+			continue
+		}
+		blks = append(blks, b)
+		roots = append(roots, eraRoots[i])
+		appended[eraRoots[i]] = true
+	}
+	return blks, roots, appended, nil
+}
+
 func (s *Service) validateRangeRequest(r *pb.BeaconBlocksByRangeRequest) error {
 	startSlot := r.StartSlot
 	count := r.Count
@@ -206,18 +242,23 @@ func (s *Service) validateRangeRequest(r *pb.BeaconBlocksByRangeRequest) error {
 }
 
 // filters all the provided blocks to ensure they are canonical
-// and are strictly linear.
-func (s *Service) filterBlocks(ctx context.Context, blks []interfaces.SignedBeaconBlock, roots [][32]byte, prevRoot *[32]byte,
-	step uint64, startSlot types.Slot) ([]interfaces.SignedBeaconBlock, error) {
+// and are strictly linear. Blocks from the era files are finalized,
+// and so canonical.
+func (s *Service) filterBlocks(ctx context.Context, blks []interfaces.SignedBeaconBlock, roots [][32]byte, eraRoots map[[32]byte]bool,
+	prevRoot *[32]byte, step uint64, startSlot types.Slot) ([]interfaces.SignedBeaconBlock, error) {
 	if len(blks) != len(roots) {
 		return nil, errors.New("input blks and roots are diff lengths")
 	}
 
 	newBlks := make([]interfaces.SignedBeaconBlock, 0, len(blks))
 	for i, b := range blks {
-		isCanonical, err := s.cfg.Chain.IsCanonical(ctx, roots[i])
-		if err != nil {
-			return nil, err
+		isCanonical := eraRoots[roots[i]]
+		if !isCanonical {
+			var err error
+			isCanonical, err = s.cfg.Chain.IsCanonical(ctx, roots[i])
+			if err != nil {
+				return nil, err
+			}
 		}
 		parentValid := *prevRoot != [32]byte{}
 		isLinear := *prevRoot == bytesutil.ToBytes32(b.Block().ParentRoot())
@@ -3,6 +3,8 @@ package sync
 import (
 	"context"
 	"io"
+	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -14,6 +16,7 @@ import (
 	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	db2 "github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/era"
 	db "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
@@ -273,6 +276,142 @@ func TestRPCBeaconBlocksByRange_ReturnsGenesisBlock(t *testing.T) {
 	}
 }
 
+func TestRPCBeaconBlocksByRange_ServesFromEraArchive(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	assert.Equal(t, 1, len(p1.BHost.Network().Peers()), "Expected peers to be connected")
+	d := db.SetupDB(t)
+
+	req := &pb.BeaconBlocksByRangeRequest{
+		StartSlot: 1,
+		Step:      1,
+		Count:     4,
+	}
+
+	// Write the blocks to an era file instead of the database.
+	eraDir := t.TempDir()
+	f, err := os.Create(filepath.Join(eraDir, era.FileName(0)))
+	require.NoError(t, err)
+	w, err := era.NewWriter(f, 0)
+	require.NoError(t, err)
+	prevRoot := [32]byte{}
+	for i := req.StartSlot; i < req.StartSlot.Add(req.Step*req.Count); i++ {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = i
+		blk.Block.ParentRoot = bytesutil.SafeCopyBytes(prevRoot[:])
+		rt, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, w.AddBlock(wrapper.WrappedPhase0SignedBeaconBlock(blk)))
+		prevRoot = rt
+	}
+	require.NoError(t, w.Finish())
+	require.NoError(t, f.Close())
+	archive, err := era.NewArchive(eraDir)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, archive.Close())
+	}()
+
+	r := &Service{cfg: &Config{P2P: p1, DB: d, Chain: &chainMock.ChainService{}, EraArchive: archive}, rateLimiter: newRateLimiter(p1)}
+	pcl := protocol.ID(p2p.RPCBlocksByRangeTopicV1)
+	topic := string(pcl)
+	r.rateLimiter.limiterMap[topic] = leakybucket.NewCollector(10000, 10000, false)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		for i := req.StartSlot; i < req.StartSlot.Add(req.Step*req.Count); i++ {
+			expectSuccess(t, stream)
+			res := &ethpb.SignedBeaconBlock{}
+			assert.NoError(t, r.cfg.P2P.Encoding().DecodeWithMaxLength(stream, res))
+			assert.Equal(t, i, res.Block.Slot)
+		}
+	})
+
+	stream1, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.NoError(t, r.beaconBlocksByRangeRPCHandler(context.Background(), req, stream1))
+
+	if testutil.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestRPCBeaconBlocksByRange_MergesEraArchive(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	assert.Equal(t, 1, len(p1.BHost.Network().Peers()), "Expected peers to be connected")
+	d := db.SetupDB(t)
+
+	req := &pb.BeaconBlocksByRangeRequest{
+		StartSlot: 1,
+		Step:      1,
+		Count:     6,
+	}
+
+	// The era file holds the finalized history up to slot 4, while the database only holds the
+	// blocks from slot 3 onwards, as after a checkpoint sync.
+	eraDir := t.TempDir()
+	f, err := os.Create(filepath.Join(eraDir, era.FileName(0)))
+	require.NoError(t, err)
+	w, err := era.NewWriter(f, 0)
+	require.NoError(t, err)
+	canonicalRoots := make(map[[32]byte]bool)
+	prevRoot := [32]byte{}
+	for i := req.StartSlot; i < req.StartSlot.Add(req.Step*req.Count); i++ {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = i
+		blk.Block.ParentRoot = bytesutil.SafeCopyBytes(prevRoot[:])
+		rt, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		if i <= 4 {
+			require.NoError(t, w.AddBlock(wrapper.WrappedPhase0SignedBeaconBlock(blk)))
+		}
+		if i >= 3 {
+			require.NoError(t, d.SaveBlock(context.Background(), wrapper.WrappedPhase0SignedBeaconBlock(blk)))
+			canonicalRoots[rt] = true
+		}
+		prevRoot = rt
+	}
+	require.NoError(t, w.Finish())
+	require.NoError(t, f.Close())
+	archive, err := era.NewArchive(eraDir)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, archive.Close())
+	}()
+
+	// Only the blocks of the database are known to fork choice.
+	chain := &chainMock.ChainService{CanonicalRoots: canonicalRoots}
+	r := &Service{cfg: &Config{P2P: p1, DB: d, Chain: chain, EraArchive: archive}, rateLimiter: newRateLimiter(p1)}
+	pcl := protocol.ID(p2p.RPCBlocksByRangeTopicV1)
+	topic := string(pcl)
+	r.rateLimiter.limiterMap[topic] = leakybucket.NewCollector(10000, 10000, false)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		for i := req.StartSlot; i < req.StartSlot.Add(req.Step*req.Count); i++ {
+			expectSuccess(t, stream)
+			res := &ethpb.SignedBeaconBlock{}
+			assert.NoError(t, r.cfg.P2P.Encoding().DecodeWithMaxLength(stream, res))
+			assert.Equal(t, i, res.Block.Slot)
+		}
+	})
+
+	stream1, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.NoError(t, r.beaconBlocksByRangeRPCHandler(context.Background(), req, stream1))
+
+	if testutil.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
 func TestRPCBeaconBlocksByRange_RPCHandlerRateLimitOverflow(t *testing.T) {
 	d := db.SetupDB(t)
 	saveBlocks := func(req *pb.BeaconBlocksByRangeRequest) {
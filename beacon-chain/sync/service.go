@@ -21,8 +21,10 @@ import (
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/era"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
@@ -30,6 +32,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/abool"
+	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/runutil"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
@@ -43,9 +46,14 @@ const seenBlockSize = 1000
 const seenAttSize = 10000
 const seenExitSize = 100
 const seenProposerSlashingSize = 100
+const seenSyncMsgSize = 1000
+const seenSyncContributionSize = 512
 const badBlockSize = 1000
 const syncMetricsInterval = 10 * time.Second
 
+// slasherAttestationsLimit is the number of validated attestations queued for the slasher at most.
+const slasherAttestationsLimit = 1024
+
 var (
 	// Seconds in one epoch.
 	pendingBlockExpTime = time.Duration(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().SecondsPerSlot)) * time.Second
@@ -57,17 +65,20 @@ var (
 
 // Config to set up the regular sync service.
 type Config struct {
-	P2P                 p2p.P2P
-	DB                  db.NoHeadAccessDatabase
-	AttPool             attestations.Pool
-	ExitPool            voluntaryexits.PoolManager
-	SlashingPool        slashings.PoolManager
-	Chain               blockchainService
-	InitialSync         Checker
-	StateNotifier       statefeed.Notifier
-	BlockNotifier       blockfeed.Notifier
-	AttestationNotifier operation.Notifier
-	StateGen            *stategen.State
+	P2P                     p2p.P2P
+	DB                      db.NoHeadAccessDatabase
+	AttPool                 attestations.Pool
+	ExitPool                voluntaryexits.PoolManager
+	SlashingPool            slashings.PoolManager
+	SyncCommsPool           synccommittee.Pool
+	Chain                   blockchainService
+	InitialSync             Checker
+	StateNotifier           statefeed.Notifier
+	BlockNotifier           blockfeed.Notifier
+	AttestationNotifier     operation.Notifier
+	StateGen                *stategen.State
+	SlasherAttestationsFeed *event.Feed
+	EraArchive              *era.Archive
 }
 
 // This defines the interface for interacting with block chain service
@@ -106,8 +117,14 @@ type Service struct {
 	seenProposerSlashingCache *lru.Cache
 	seenAttesterSlashingLock  sync.RWMutex
 	seenAttesterSlashingCache map[uint64]bool
+	seenSyncMessageLock       sync.RWMutex
+	seenSyncMessageCache      *lru.Cache
+	seenSyncContributionLock  sync.RWMutex
+	seenSyncContributionCache *lru.Cache
 	badBlockCache             *lru.Cache
 	badBlockLock              sync.RWMutex
+	signatureChan             chan *signatureVerifier
+	slasherAttestationChan    chan *slasherAttestation
 }
 
 // NewService initializes new regular sync service.
@@ -117,17 +134,21 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 	rLimiter := newRateLimiter(cfg.P2P)
 	ctx, cancel := context.WithCancel(ctx)
 	r := &Service{
-		cfg:                  cfg,
-		ctx:                  ctx,
-		cancel:               cancel,
-		chainStarted:         abool.New(),
-		slotToPendingBlocks:  c,
-		seenPendingBlocks:    make(map[[32]byte]bool),
-		blkRootToPendingAtts: make(map[[32]byte][]*ethpb.SignedAggregateAttestationAndProof),
-		rateLimiter:          rLimiter,
+		cfg:                    cfg,
+		ctx:                    ctx,
+		cancel:                 cancel,
+		chainStarted:           abool.New(),
+		slotToPendingBlocks:    c,
+		seenPendingBlocks:      make(map[[32]byte]bool),
+		blkRootToPendingAtts:   make(map[[32]byte][]*ethpb.SignedAggregateAttestationAndProof),
+		rateLimiter:            rLimiter,
+		signatureChan:          make(chan *signatureVerifier, verifierLimit),
+		slasherAttestationChan: make(chan *slasherAttestation, slasherAttestationsLimit),
 	}
 
 	go r.registerHandlers()
+	go r.verifierRoutine()
+	go r.slasherAttestationRoutine()
 
 	return r
 }
@@ -206,6 +227,14 @@ func (s *Service) initCaches() error {
 	if err != nil {
 		return err
 	}
+	syncMsgCache, err := lru.New(seenSyncMsgSize)
+	if err != nil {
+		return err
+	}
+	syncContributionCache, err := lru.New(seenSyncContributionSize)
+	if err != nil {
+		return err
+	}
 	badBlockCache, err := lru.New(badBlockSize)
 	if err != nil {
 		return err
@@ -215,6 +244,8 @@ func (s *Service) initCaches() error {
 	s.seenExitCache = exitCache
 	s.seenAttesterSlashingCache = make(map[uint64]bool)
 	s.seenProposerSlashingCache = proposerSlashingCache
+	s.seenSyncMessageCache = syncMsgCache
+	s.seenSyncContributionCache = syncContributionCache
 	s.badBlockCache = badBlockCache
 
 	return nil
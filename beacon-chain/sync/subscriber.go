@@ -11,6 +11,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	pb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
@@ -80,6 +81,19 @@ func (s *Service) registerSubscribers() {
 			s.committeeIndexBeaconAttestationSubscriber, /* message handler */
 		)
 	}
+	// Sync committee topics are only relevant once the chain has reached the Altair fork.
+	if helpers.SlotToEpoch(s.cfg.Chain.CurrentSlot()) >= params.BeaconConfig().AltairForkEpoch {
+		s.subscribe(
+			p2p.SyncContributionAndProofSubnetTopicFormat,
+			s.validateSyncContributionAndProof,
+			s.syncContributionAndProofSubscriber,
+		)
+		s.subscribeStaticWithSyncSubnets(
+			p2p.SyncCommitteeSubnetTopicFormat,
+			s.validateSyncCommitteeMessage,   /* validator */
+			s.syncCommitteeMessageSubscriber, /* message handler */
+		)
+	}
 }
 
 // subscribe to a given topic with a given validator and subscription handler.
@@ -240,6 +254,52 @@ func (s *Service) subscribeStaticWithSubnets(topic string, validator pubsub.Vali
 	}()
 }
 
+// subscribe to a static subnet with the given topic and index for all sync committee subnets.
+// A given validator and subscription handler is used to handle messages from the subnet. The
+// base protobuf message is used to initialize new messages for decoding.
+func (s *Service) subscribeStaticWithSyncSubnets(topic string, validator pubsub.ValidatorEx, handle subHandler) {
+	base := p2p.GossipTopicMappings[topic]
+	if base == nil {
+		panic(fmt.Sprintf("%s is not mapped to any message in GossipTopicMappings", topic))
+	}
+	for i := uint64(0); i < params.BeaconConfig().SyncCommitteeSubnetCount; i++ {
+		s.subscribeWithBase(s.addDigestAndIndexToTopic(topic, i), validator, handle)
+	}
+	genesis := s.cfg.Chain.GenesisTime()
+	ticker := slotutil.NewSlotTicker(genesis, params.BeaconConfig().SecondsPerSlot)
+
+	go func() {
+		for {
+			select {
+			case <-s.ctx.Done():
+				ticker.Done()
+				return
+			case <-ticker.C():
+				if s.chainStarted.IsSet() && s.cfg.InitialSync.Syncing() {
+					continue
+				}
+				// Check every slot that there are enough peers
+				for i := uint64(0); i < params.BeaconConfig().SyncCommitteeSubnetCount; i++ {
+					if !s.validPeersExist(s.addDigestAndIndexToTopic(topic, i)) {
+						log.Debugf("No peers found subscribed to sync gossip subnet with "+
+							"committee index %d. Searching network for peers subscribed to the subnet.", i)
+						_, err := s.cfg.P2P.FindPeersWithSubnet(
+							s.ctx,
+							s.addDigestAndIndexToTopic(topic, i),
+							i,
+							params.BeaconNetworkConfig().MinimumPeersInSubnet,
+						)
+						if err != nil {
+							log.WithError(err).Debug("Could not search for peers")
+							return
+						}
+					}
+				}
+			}
+		}
+	}()
+}
+
 // subscribe to a dynamically changing list of subnets. This method expects a fmt compatible
 // string for the topic name and the list of subnets for subscribed topics that should be
 // maintained.
@@ -0,0 +1,19 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+// syncCommitteeMessageSubscriber forwards the incoming validated sync committee message to the
+// sync committee pool for aggregation.
+func (s *Service) syncCommitteeMessageSubscriber(_ context.Context, msg proto.Message) error {
+	m, ok := msg.(*prysmv2.SyncCommitteeMessage)
+	if !ok {
+		return fmt.Errorf("message was not type *prysmv2.SyncCommitteeMessage, type=%T", msg)
+	}
+	return s.cfg.SyncCommsPool.SaveSyncCommitteeMessage(m)
+}
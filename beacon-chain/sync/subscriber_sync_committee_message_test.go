@@ -0,0 +1,32 @@
+package sync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSyncCommitteeMessageSubscriber_CanSaveMessage(t *testing.T) {
+	s := &Service{
+		cfg: &Config{
+			SyncCommsPool: synccommittee.NewStore(),
+		},
+	}
+	root := bytesutil.ToBytes32([]byte("root"))
+	m := &prysmv2.SyncCommitteeMessage{
+		Slot:           1,
+		BlockRoot:      root[:],
+		ValidatorIndex: 2,
+		Signature:      make([]byte, 96),
+	}
+	require.NoError(t, s.syncCommitteeMessageSubscriber(context.Background(), m))
+	msgs, err := s.cfg.SyncCommsPool.SyncCommitteeMessages(1, root)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, []*prysmv2.SyncCommitteeMessage{m}, msgs)
+
+	require.ErrorContains(t, "message was not type", s.syncCommitteeMessageSubscriber(context.Background(), &prysmv2.SyncAggregate{}))
+}
@@ -0,0 +1,25 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+// syncContributionAndProofSubscriber forwards the incoming validated sync contribution and proof to the
+// sync committee pool for processing.
+func (s *Service) syncContributionAndProofSubscriber(_ context.Context, msg proto.Message) error {
+	sContr, ok := msg.(*prysmv2.SignedContributionAndProof)
+	if !ok {
+		return fmt.Errorf("message was not type *prysmv2.SignedContributionAndProof, type=%T", msg)
+	}
+
+	if sContr.Message == nil || sContr.Message.Contribution == nil {
+		return errors.New("nil contribution")
+	}
+
+	return s.cfg.SyncCommsPool.SaveSyncCommitteeContribution(sContr.Message.Contribution)
+}
@@ -0,0 +1,40 @@
+package sync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSyncContributionAndProofSubscriber_CanSaveContribution(t *testing.T) {
+	s := &Service{
+		cfg: &Config{
+			SyncCommsPool: synccommittee.NewStore(),
+		},
+	}
+	c := &prysmv2.SyncCommitteeContribution{
+		Slot:              1,
+		BlockRoot:         make([]byte, 32),
+		SubcommitteeIndex: 1,
+		AggregationBits:   bitfield.NewBitvector128(),
+		Signature:         make([]byte, 96),
+	}
+	m := &prysmv2.SignedContributionAndProof{
+		Message: &prysmv2.ContributionAndProof{
+			AggregatorIndex: 1,
+			Contribution:    c,
+			SelectionProof:  make([]byte, 96),
+		},
+		Signature: make([]byte, 96),
+	}
+	require.NoError(t, s.syncContributionAndProofSubscriber(context.Background(), m))
+	contributions, err := s.cfg.SyncCommsPool.SyncCommitteeContributions(1)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, []*prysmv2.SyncCommitteeContribution{c}, contributions)
+
+	require.ErrorContains(t, "nil contribution", s.syncContributionAndProofSubscriber(context.Background(), &prysmv2.SignedContributionAndProof{}))
+}
@@ -165,7 +165,7 @@ func (s *Service) validateAggregatedAtt(ctx context.Context, signed *ethpb.Signe
 	}
 
 	// Verify selection signature, aggregator signature and attestation signature are valid.
-	// We use batch verify here to save compute.
+	// We use batch verify here to save compute, together with the signatures of other gossip messages.
 	aggregatorSigSet, err := aggSigSet(bs, signed)
 	if err != nil {
 		traceutil.AnnotateError(span, errors.Wrapf(err, "Could not get aggregator sig set %d", signed.Message.AggregatorIndex))
@@ -178,17 +178,7 @@ func (s *Service) validateAggregatedAtt(ctx context.Context, signed *ethpb.Signe
 	}
 	set := bls.NewSet()
 	set.Join(selectionSigSet).Join(aggregatorSigSet).Join(attSigSet)
-	valid, err := set.Verify()
-	if err != nil {
-		traceutil.AnnotateError(span, errors.Errorf("Could not join signature set"))
-		return pubsub.ValidationIgnore
-	}
-	if !valid {
-		traceutil.AnnotateError(span, errors.Errorf("Could not verify selection or aggregator or attestation signature"))
-		return pubsub.ValidationReject
-	}
-
-	return pubsub.ValidationAccept
+	return s.validateWithBatchVerifier(ctx, "selection, aggregator and attestation signatures", set)
 }
 
 func (s *Service) validateBlockInAttestation(ctx context.Context, satt *ethpb.SignedAggregateAttestationAndProof) bool {
@@ -16,7 +16,9 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	eth "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"go.opencensus.io/trace"
 )
@@ -134,11 +136,65 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 
 	s.setSeenCommitteeIndicesSlot(att.Data.Slot, att.Data.CommitteeIndex, att.AggregationBits)
 
+	if featureconfig.Get().EnableSlasher && s.cfg.SlasherAttestationsFeed != nil {
+		// Feed the indexed attestation to the slasher in the background
+		// to avoid adding load to the attestation validation path.
+		s.queueAttestationForSlasher(att, preState)
+	}
+
 	msg.ValidatorData = att
 
 	return pubsub.ValidationAccept
 }
 
+// slasherAttestation is a validated attestation waiting to be sent to the slasher, with the state
+// to compute its committee from.
+type slasherAttestation struct {
+	att      *eth.Attestation
+	preState iface.ReadOnlyBeaconState
+}
+
+// Queues a validated attestation for the slasher routine. The attestation is dropped when the
+// queue is full, so that a slow slasher does not hold up gossip validation.
+func (s *Service) queueAttestationForSlasher(att *eth.Attestation, preState iface.ReadOnlyBeaconState) {
+	select {
+	case s.slasherAttestationChan <- &slasherAttestation{att: att, preState: preState}:
+	default:
+		slasherAttestationsDropped.Inc()
+		log.Debug("Slasher attestation queue is full, dropping attestation")
+	}
+}
+
+// A routine that runs in the background to send the queued attestations to the slasher.
+func (s *Service) slasherAttestationRoutine() {
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case a := <-s.slasherAttestationChan:
+			s.sendAttestationToSlasher(a.att, a.preState)
+		}
+	}
+}
+
+// Converts a validated attestation to an indexed attestation and sends it to the slasher.
+func (s *Service) sendAttestationToSlasher(att *eth.Attestation, preState iface.ReadOnlyBeaconState) {
+	// A new context is used as the gossip validation context may be cancelled
+	// before the conversion completes.
+	ctx := context.Background()
+	committee, err := helpers.BeaconCommitteeFromState(preState, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		log.WithError(err).Error("Could not get attestation committee")
+		return
+	}
+	indexedAtt, err := attestationutil.ConvertToIndexed(ctx, att, committee)
+	if err != nil {
+		log.WithError(err).Error("Could not convert to indexed attestation")
+		return
+	}
+	s.cfg.SlasherAttestationsFeed.Send(indexedAtt)
+}
+
 // This validates beacon unaggregated attestation has correct topic string.
 func (s *Service) validateUnaggregatedAttTopic(ctx context.Context, a *eth.Attestation, bs iface.ReadOnlyBeaconState, t string) pubsub.ValidationResult {
 	ctx, span := trace.StartSpan(ctx, "sync.validateUnaggregatedAttTopic")
@@ -170,7 +226,7 @@ func (s *Service) validateUnaggregatedAttTopic(ctx context.Context, a *eth.Attes
 }
 
 // This validates beacon unaggregated attestation using the given state, the validation consists of bitfield length and count consistency
-// and signature verification.
+// and signature verification. The signature is verified in a batch with other gossip messages.
 func (s *Service) validateUnaggregatedAttWithState(ctx context.Context, a *eth.Attestation, bs iface.ReadOnlyBeaconState) pubsub.ValidationResult {
 	ctx, span := trace.StartSpan(ctx, "sync.validateUnaggregatedAttWithState")
 	defer span.End()
@@ -193,13 +249,13 @@ func (s *Service) validateUnaggregatedAttWithState(ctx context.Context, a *eth.A
 		return pubsub.ValidationReject
 	}
 
-	if err := blocks.VerifyAttestationSignature(ctx, bs, a); err != nil {
+	set, err := blocks.AttestationSignatureSet(ctx, bs, []*eth.Attestation{a})
+	if err != nil {
 		log.WithError(err).Debug("Could not verify attestation")
 		traceutil.AnnotateError(span, err)
 		return pubsub.ValidationReject
 	}
-
-	return pubsub.ValidationAccept
+	return s.validateWithBatchVerifier(ctx, "attestation", set)
 }
 
 // Returns true if the attestation was already seen for the participating validator for the slot.
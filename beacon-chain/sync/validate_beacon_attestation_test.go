@@ -19,6 +19,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -294,3 +295,49 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 		})
 	}
 }
+
+func TestService_sendAttestationToSlasher(t *testing.T) {
+	s := &Service{cfg: &Config{SlasherAttestationsFeed: new(event.Feed)}}
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	att := testutil.HydrateAttestation(&ethpb.Attestation{
+		AggregationBits: bitfield.Bitlist{0b110},
+		Data:            &ethpb.AttestationData{Slot: 0, CommitteeIndex: 0},
+	})
+	indexedAttsChan := make(chan *ethpb.IndexedAttestation, 1)
+	sub := s.cfg.SlasherAttestationsFeed.Subscribe(indexedAttsChan)
+	defer sub.Unsubscribe()
+
+	s.sendAttestationToSlasher(att, beaconState)
+	committee, err := helpers.BeaconCommitteeFromState(beaconState, att.Data.Slot, att.Data.CommitteeIndex)
+	require.NoError(t, err)
+	indexedAtt := <-indexedAttsChan
+	require.DeepEqual(t, []uint64{uint64(committee[1])}, indexedAtt.AttestingIndices)
+	require.DeepEqual(t, att.Data, indexedAtt.Data)
+}
+
+func TestService_queueAttestationForSlasher(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := &Service{
+		ctx:                    ctx,
+		cfg:                    &Config{SlasherAttestationsFeed: new(event.Feed)},
+		slasherAttestationChan: make(chan *slasherAttestation, 1),
+	}
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	att := testutil.HydrateAttestation(&ethpb.Attestation{
+		AggregationBits: bitfield.Bitlist{0b110},
+		Data:            &ethpb.AttestationData{Slot: 0, CommitteeIndex: 0},
+	})
+
+	// Without the routine, attestations beyond the capacity of the queue are dropped.
+	s.queueAttestationForSlasher(att, beaconState)
+	s.queueAttestationForSlasher(att, beaconState)
+	require.Equal(t, 1, len(s.slasherAttestationChan))
+
+	indexedAttsChan := make(chan *ethpb.IndexedAttestation, 1)
+	sub := s.cfg.SlasherAttestationsFeed.Subscribe(indexedAttsChan)
+	defer sub.Unsubscribe()
+	go s.slasherAttestationRoutine()
+	indexedAtt := <-indexedAttsChan
+	require.DeepEqual(t, att.Data, indexedAtt.Data)
+}
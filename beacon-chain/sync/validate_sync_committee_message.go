@@ -0,0 +1,170 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"go.opencensus.io/trace"
+)
+
+// Validation
+// - The message's slot is for the current slot (with a MAXIMUM_GOSSIP_CLOCK_DISPARITY allowance).
+// - The subnet_id is valid for the given validator, i.e. subnet_id in compute_subnets_for_sync_committee(state, validator_index).
+// - This is the first valid message received for the validator index, slot and subnet.
+// - The signature is valid for the message beacon_block_root for the validator referenced by validator_index.
+func (s *Service) validateSyncCommitteeMessage(ctx context.Context, pid peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+	if pid == s.cfg.P2P.PeerID() {
+		return pubsub.ValidationAccept
+	}
+	// Sync committee messages are validated against the head state, so we'll skip
+	// validating or processing them until fully synced.
+	if s.cfg.InitialSync.Syncing() {
+		return pubsub.ValidationIgnore
+	}
+	ctx, span := trace.StartSpan(ctx, "sync.validateSyncCommitteeMessage")
+	defer span.End()
+
+	if msg.Topic == nil {
+		return pubsub.ValidationReject
+	}
+
+	// Override topic for decoding.
+	originalTopic := msg.Topic
+	format := p2p.GossipTypeMapping[reflect.TypeOf(&prysmv2.SyncCommitteeMessage{})]
+	msg.Topic = &format
+
+	raw, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		log.WithError(err).Debug("Could not decode message")
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationReject
+	}
+	// Restore topic.
+	msg.Topic = originalTopic
+
+	m, ok := raw.(*prysmv2.SyncCommitteeMessage)
+	if !ok {
+		return pubsub.ValidationReject
+	}
+	if m == nil || len(m.BlockRoot) != 32 {
+		return pubsub.ValidationReject
+	}
+
+	if err := altair.ValidateSyncMessageTime(m.Slot, s.cfg.Chain.GenesisTime(),
+		params.BeaconNetworkConfig().MaximumGossipClockDisparity); err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationIgnore
+	}
+
+	headState, err := s.cfg.Chain.HeadState(ctx)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationIgnore
+	}
+	if headState.Version() != version.Altair {
+		return pubsub.ValidationIgnore
+	}
+
+	subnets, err := altair.SubnetsForSyncCommittee(headState, m.ValidatorIndex)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationIgnore
+	}
+	subnet, ok, err := s.syncSubnetFromTopic(*originalTopic, subnets)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationIgnore
+	}
+	if !ok {
+		return pubsub.ValidationReject
+	}
+
+	if s.hasSeenSyncMessageIndexSlot(m.Slot, m.ValidatorIndex, subnet) {
+		return pubsub.ValidationIgnore
+	}
+
+	if err := verifySyncCommitteeMessageSignature(headState, m); err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationReject
+	}
+
+	s.setSeenSyncMessageIndexSlot(m.Slot, m.ValidatorIndex, subnet)
+
+	msg.ValidatorData = m
+	return pubsub.ValidationAccept
+}
+
+// syncSubnetFromTopic returns the subnet out of the given ones that the topic belongs to.
+func (s *Service) syncSubnetFromTopic(topic string, subnets []uint64) (uint64, bool, error) {
+	format := p2p.GossipTypeMapping[reflect.TypeOf(&prysmv2.SyncCommitteeMessage{})]
+	digest, err := s.forkDigest()
+	if err != nil {
+		return 0, false, err
+	}
+	for _, subnet := range subnets {
+		if topic == fmt.Sprintf(format, digest, subnet)+s.cfg.P2P.Encoding().ProtocolSuffix() {
+			return subnet, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+// verifySyncCommitteeMessageSignature verifies the signature of the validator over the message's block root.
+func verifySyncCommitteeMessageSignature(st iface.ReadOnlyBeaconState, m *prysmv2.SyncCommitteeMessage) error {
+	d, err := helpers.Domain(st.Fork(), helpers.SlotToEpoch(m.Slot), params.BeaconConfig().DomainSyncCommittee, st.GenesisValidatorRoot())
+	if err != nil {
+		return err
+	}
+	sigRoot, err := (&pb.SigningData{ObjectRoot: m.BlockRoot, Domain: d}).HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	pubkey := st.PubkeyAtIndex(m.ValidatorIndex)
+	pk, err := bls.PublicKeyFromBytes(pubkey[:])
+	if err != nil {
+		return err
+	}
+	sig, err := bls.SignatureFromBytes(m.Signature)
+	if err != nil {
+		return err
+	}
+	if !sig.Verify(pk, sigRoot[:]) {
+		return helpers.ErrSigFailedToVerify
+	}
+	return nil
+}
+
+// Returns true if the node has received a sync committee message for the validator index, slot and subnet.
+func (s *Service) hasSeenSyncMessageIndexSlot(slot types.Slot, valIndex types.ValidatorIndex, subnet uint64) bool {
+	s.seenSyncMessageLock.RLock()
+	defer s.seenSyncMessageLock.RUnlock()
+	_, seen := s.seenSyncMessageCache.Get(seenSyncKey(slot, uint64(valIndex), subnet))
+	return seen
+}
+
+// Set the sync committee message of the validator index, slot and subnet as seen.
+func (s *Service) setSeenSyncMessageIndexSlot(slot types.Slot, valIndex types.ValidatorIndex, subnet uint64) {
+	s.seenSyncMessageLock.Lock()
+	defer s.seenSyncMessageLock.Unlock()
+	s.seenSyncMessageCache.Add(seenSyncKey(slot, uint64(valIndex), subnet), true)
+}
+
+func seenSyncKey(slot types.Slot, index, subnet uint64) string {
+	b := append(bytesutil.Bytes32(uint64(slot)), bytesutil.Bytes32(index)...)
+	return string(append(b, bytesutil.Bytes32(subnet)...))
+}
@@ -0,0 +1,64 @@
+package sync
+
+import (
+	"fmt"
+	"testing"
+
+	lru "github.com/hashicorp/golang-lru"
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+func TestService_SyncSubnetFromTopic(t *testing.T) {
+	s := &Service{
+		cfg: &Config{
+			P2P:   p2ptest.NewTestP2P(t),
+			Chain: &mockChain.ChainService{Genesis: timeutils.Now(), ValidatorsRoot: [32]byte{'A'}},
+		},
+	}
+	digest, err := s.forkDigest()
+	require.NoError(t, err)
+	topic := fmt.Sprintf(p2p.SyncCommitteeSubnetTopicFormat, digest, 2) + s.cfg.P2P.Encoding().ProtocolSuffix()
+
+	subnet, ok, err := s.syncSubnetFromTopic(topic, []uint64{0, 2})
+	require.NoError(t, err)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, uint64(2), subnet)
+
+	_, ok, err = s.syncSubnetFromTopic(topic, []uint64{1, 3})
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+
+	// A subnet whose index is a prefix of the topic's index must not match.
+	topic = fmt.Sprintf(p2p.SyncCommitteeSubnetTopicFormat, digest, 12) + s.cfg.P2P.Encoding().ProtocolSuffix()
+	_, ok, err = s.syncSubnetFromTopic(topic, []uint64{1})
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+}
+
+func TestService_SeenSyncMessageIndexSlot(t *testing.T) {
+	c, err := lru.New(10)
+	require.NoError(t, err)
+	s := &Service{seenSyncMessageCache: c}
+
+	assert.Equal(t, false, s.hasSeenSyncMessageIndexSlot(1, 2, 3))
+	s.setSeenSyncMessageIndexSlot(1, 2, 3)
+	assert.Equal(t, true, s.hasSeenSyncMessageIndexSlot(1, 2, 3))
+	assert.Equal(t, false, s.hasSeenSyncMessageIndexSlot(1, 2, 0))
+	assert.Equal(t, false, s.hasSeenSyncMessageIndexSlot(2, 2, 3))
+}
+
+func TestService_SeenSyncContributionIndexSlot(t *testing.T) {
+	c, err := lru.New(10)
+	require.NoError(t, err)
+	s := &Service{seenSyncContributionCache: c}
+
+	assert.Equal(t, false, s.hasSeenSyncContributionIndexSlot(1, 2, 3))
+	s.setSyncContributionIndexSlotSeen(1, 2, 3)
+	assert.Equal(t, true, s.hasSeenSyncContributionIndexSlot(1, 2, 3))
+	assert.Equal(t, false, s.hasSeenSyncContributionIndexSlot(1, 3, 3))
+}
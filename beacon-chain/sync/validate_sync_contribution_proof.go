@@ -0,0 +1,201 @@
+package sync
+
+import (
+	"context"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"go.opencensus.io/trace"
+)
+
+// validateSyncContributionAndProof verifies the aggregated signature and the selection proof is valid before forwarding to the
+// network and downstream services.
+// Validation
+// - The contribution's slot is for the current slot (with a MAXIMUM_GOSSIP_CLOCK_DISPARITY allowance).
+// - The subcommittee index is in the allowed range, i.e. contribution.subcommittee_index < SYNC_COMMITTEE_SUBNET_COUNT.
+// - The contribution has participants.
+// - The selection proof selects the validator as an aggregator for the slot.
+// - The aggregator's validator index is in the declared subcommittee of the current sync committee.
+// - This is the first valid contribution received for the aggregator index, slot and subcommittee index.
+// - The selection proof, the aggregator signature and the aggregate signature are valid.
+func (s *Service) validateSyncContributionAndProof(ctx context.Context, pid peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+	if pid == s.cfg.P2P.PeerID() {
+		return pubsub.ValidationAccept
+	}
+	// Contributions are validated against the head state, so we'll skip
+	// validating or processing them until fully synced.
+	if s.cfg.InitialSync.Syncing() {
+		return pubsub.ValidationIgnore
+	}
+	ctx, span := trace.StartSpan(ctx, "sync.validateSyncContributionAndProof")
+	defer span.End()
+
+	raw, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		log.WithError(err).Debug("Could not decode message")
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationReject
+	}
+	m, ok := raw.(*prysmv2.SignedContributionAndProof)
+	if !ok {
+		return pubsub.ValidationReject
+	}
+	if m == nil || m.Message == nil || m.Message.Contribution == nil {
+		return pubsub.ValidationReject
+	}
+	c := m.Message.Contribution
+	if len(c.BlockRoot) != 32 {
+		return pubsub.ValidationReject
+	}
+
+	if err := altair.ValidateSyncMessageTime(c.Slot, s.cfg.Chain.GenesisTime(),
+		params.BeaconNetworkConfig().MaximumGossipClockDisparity); err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationIgnore
+	}
+	if c.SubcommitteeIndex >= params.BeaconConfig().SyncCommitteeSubnetCount {
+		return pubsub.ValidationReject
+	}
+	if c.AggregationBits.Count() == 0 {
+		return pubsub.ValidationReject
+	}
+	isAggregator, err := altair.IsSyncCommitteeAggregator(m.Message.SelectionProof)
+	if err != nil || !isAggregator {
+		return pubsub.ValidationReject
+	}
+
+	if s.hasSeenSyncContributionIndexSlot(c.Slot, m.Message.AggregatorIndex, c.SubcommitteeIndex) {
+		return pubsub.ValidationIgnore
+	}
+
+	headState, err := s.cfg.Chain.HeadState(ctx)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationIgnore
+	}
+	if headState.Version() != version.Altair {
+		return pubsub.ValidationIgnore
+	}
+	committee, err := altair.SyncCommitteeForNextSlot(headState)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationIgnore
+	}
+	subCommitteePubkeys, err := altair.SyncSubCommitteePubkeys(committee, c.SubcommitteeIndex)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationIgnore
+	}
+
+	aggregatorPubkey := headState.PubkeyAtIndex(m.Message.AggregatorIndex)
+	inSubCommittee := false
+	for _, pubkey := range subCommitteePubkeys {
+		if bytesutil.ToBytes48(pubkey) == aggregatorPubkey {
+			inSubCommittee = true
+			break
+		}
+	}
+	if !inSubCommittee {
+		return pubsub.ValidationReject
+	}
+
+	if err := verifySyncContributionAndProofSignatures(headState, m, subCommitteePubkeys); err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationReject
+	}
+
+	s.setSyncContributionIndexSlotSeen(c.Slot, m.Message.AggregatorIndex, c.SubcommitteeIndex)
+
+	msg.ValidatorData = m
+	return pubsub.ValidationAccept
+}
+
+// verifySyncContributionAndProofSignatures verifies the selection proof, the aggregator signature
+// and the aggregate signature of the participants of the contribution.
+func verifySyncContributionAndProofSignatures(
+	st iface.ReadOnlyBeaconState,
+	m *prysmv2.SignedContributionAndProof,
+	subCommitteePubkeys [][]byte,
+) error {
+	c := m.Message.Contribution
+	epoch := helpers.SlotToEpoch(c.Slot)
+	aggregatorPubkey := st.PubkeyAtIndex(m.Message.AggregatorIndex)
+
+	// Verify the selection proof.
+	d, err := helpers.Domain(st.Fork(), epoch, params.BeaconConfig().DomainSyncCommitteeSelectionProof, st.GenesisValidatorRoot())
+	if err != nil {
+		return err
+	}
+	selectionData := &pb.SyncAggregatorSelectionData{
+		Slot:              c.Slot,
+		SubcommitteeIndex: c.SubcommitteeIndex,
+	}
+	if err := helpers.VerifySigningRoot(selectionData, aggregatorPubkey[:], m.Message.SelectionProof, d); err != nil {
+		return errors.Wrap(err, "could not verify selection proof")
+	}
+
+	// Verify the aggregator signature.
+	d, err = helpers.Domain(st.Fork(), epoch, params.BeaconConfig().DomainContributionAndProof, st.GenesisValidatorRoot())
+	if err != nil {
+		return err
+	}
+	if err := helpers.VerifySigningRoot(m.Message, aggregatorPubkey[:], m.Signature, d); err != nil {
+		return errors.Wrap(err, "could not verify aggregator signature")
+	}
+
+	// Verify the aggregate signature of the participants over the block root.
+	participants := make([]bls.PublicKey, 0, len(subCommitteePubkeys))
+	for i, pubkey := range subCommitteePubkeys {
+		if !c.AggregationBits.BitAt(uint64(i)) {
+			continue
+		}
+		pk, err := bls.PublicKeyFromBytes(pubkey)
+		if err != nil {
+			return err
+		}
+		participants = append(participants, pk)
+	}
+	d, err = helpers.Domain(st.Fork(), epoch, params.BeaconConfig().DomainSyncCommittee, st.GenesisValidatorRoot())
+	if err != nil {
+		return err
+	}
+	sigRoot, err := (&pb.SigningData{ObjectRoot: c.BlockRoot, Domain: d}).HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	sig, err := bls.SignatureFromBytes(c.Signature)
+	if err != nil {
+		return err
+	}
+	if !sig.Eth2FastAggregateVerify(participants, sigRoot) {
+		return errors.New("could not verify contribution aggregate signature")
+	}
+	return nil
+}
+
+// Returns true if the node has received a contribution for the aggregator index, slot and subcommittee index.
+func (s *Service) hasSeenSyncContributionIndexSlot(slot types.Slot, aggregatorIndex types.ValidatorIndex, subComIdx uint64) bool {
+	s.seenSyncContributionLock.RLock()
+	defer s.seenSyncContributionLock.RUnlock()
+	_, seen := s.seenSyncContributionCache.Get(seenSyncKey(slot, uint64(aggregatorIndex), subComIdx))
+	return seen
+}
+
+// Set the contribution of the aggregator index, slot and subcommittee index as seen.
+func (s *Service) setSyncContributionIndexSlotSeen(slot types.Slot, aggregatorIndex types.ValidatorIndex, subComIdx uint64) {
+	s.seenSyncContributionLock.Lock()
+	defer s.seenSyncContributionLock.Unlock()
+	s.seenSyncContributionCache.Add(seenSyncKey(slot, uint64(aggregatorIndex), subComIdx), true)
+}
@@ -0,0 +1,219 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_ValidateSyncContributionAndProof(t *testing.T) {
+	ctx := context.Background()
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	genesisState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	st, err := altair.UpgradeToAltair(ctx, genesisState)
+	require.NoError(t, err)
+	require.NoError(t, st.SetGenesisTime(uint64(time.Now().Unix())))
+
+	committee, err := altair.SyncCommitteeForNextSlot(st)
+	require.NoError(t, err)
+	subComIdx := uint64(1)
+	subCommitteePubkeys, err := altair.SyncSubCommitteePubkeys(committee, subComIdx)
+	require.NoError(t, err)
+	subCommitteeIndices := make([]types.ValidatorIndex, len(subCommitteePubkeys))
+	for i, pubkey := range subCommitteePubkeys {
+		idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubkey))
+		require.Equal(t, true, ok)
+		subCommitteeIndices[i] = idx
+	}
+
+	slot := types.Slot(0)
+	selectionProof := func(idx types.ValidatorIndex) []byte {
+		sig, err := helpers.ComputeDomainAndSign(st, 0, &pb.SyncAggregatorSelectionData{
+			Slot:              slot,
+			SubcommitteeIndex: subComIdx,
+		}, params.BeaconConfig().DomainSyncCommitteeSelectionProof, privKeys[idx])
+		require.NoError(t, err)
+		return sig
+	}
+	// Pick an aggregator and a member of the subcommittee whose selection proof does not
+	// select them as an aggregator.
+	aggregator, nonAggregator := types.ValidatorIndex(0), types.ValidatorIndex(0)
+	foundAggregator, foundNonAggregator := false, false
+	for _, idx := range subCommitteeIndices {
+		isAggregator, err := altair.IsSyncCommitteeAggregator(selectionProof(idx))
+		require.NoError(t, err)
+		if isAggregator && !foundAggregator {
+			aggregator, foundAggregator = idx, true
+		} else if !isAggregator && !foundNonAggregator {
+			nonAggregator, foundNonAggregator = idx, true
+		}
+	}
+	require.Equal(t, true, foundAggregator, "No aggregator in subcommittee")
+	require.Equal(t, true, foundNonAggregator, "No non-aggregator in subcommittee")
+
+	blockRoot := bytesutil.PadTo([]byte("block root"), 32)
+	contributionSignature := func(root []byte) []byte {
+		d, err := helpers.Domain(st.Fork(), 0, params.BeaconConfig().DomainSyncCommittee, st.GenesisValidatorRoot())
+		require.NoError(t, err)
+		sigRoot, err := (&pb.SigningData{ObjectRoot: root, Domain: d}).HashTreeRoot()
+		require.NoError(t, err)
+		sigs := make([]bls.Signature, 0, 4)
+		for _, idx := range subCommitteeIndices[:4] {
+			sigs = append(sigs, privKeys[idx].Sign(sigRoot[:]))
+		}
+		return bls.AggregateSignatures(sigs).Marshal()
+	}
+	signMessage := func(m *prysmv2.SignedContributionAndProof, idx types.ValidatorIndex) {
+		sig, err := helpers.ComputeDomainAndSign(st, 0, m.Message, params.BeaconConfig().DomainContributionAndProof, privKeys[idx])
+		require.NoError(t, err)
+		m.Signature = sig
+	}
+	validMessage := func() *prysmv2.SignedContributionAndProof {
+		bits := bitfield.NewBitvector128()
+		for i := uint64(0); i < 4; i++ {
+			bits.SetBitAt(i, true)
+		}
+		m := &prysmv2.SignedContributionAndProof{
+			Message: &prysmv2.ContributionAndProof{
+				AggregatorIndex: aggregator,
+				Contribution: &prysmv2.SyncCommitteeContribution{
+					Slot:              slot,
+					BlockRoot:         blockRoot,
+					SubcommitteeIndex: subComIdx,
+					AggregationBits:   bits,
+					Signature:         contributionSignature(blockRoot),
+				},
+				SelectionProof: selectionProof(aggregator),
+			},
+		}
+		signMessage(m, aggregator)
+		return m
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(s *Service, m *prysmv2.SignedContributionAndProof)
+		want   pubsub.ValidationResult
+	}{
+		{
+			name:   "valid contribution",
+			mutate: func(*Service, *prysmv2.SignedContributionAndProof) {},
+			want:   pubsub.ValidationAccept,
+		},
+		{
+			name: "wrong subnet",
+			mutate: func(_ *Service, m *prysmv2.SignedContributionAndProof) {
+				m.Message.Contribution.SubcommitteeIndex = params.BeaconConfig().SyncCommitteeSubnetCount
+				signMessage(m, aggregator)
+			},
+			want: pubsub.ValidationReject,
+		},
+		{
+			name: "no participants",
+			mutate: func(_ *Service, m *prysmv2.SignedContributionAndProof) {
+				m.Message.Contribution.AggregationBits = bitfield.NewBitvector128()
+				signMessage(m, aggregator)
+			},
+			want: pubsub.ValidationReject,
+		},
+		{
+			name: "non-aggregator selection proof",
+			mutate: func(_ *Service, m *prysmv2.SignedContributionAndProof) {
+				m.Message.AggregatorIndex = nonAggregator
+				m.Message.SelectionProof = selectionProof(nonAggregator)
+				signMessage(m, nonAggregator)
+			},
+			want: pubsub.ValidationReject,
+		},
+		{
+			name: "bad aggregator signature",
+			mutate: func(_ *Service, m *prysmv2.SignedContributionAndProof) {
+				signMessage(m, nonAggregator)
+			},
+			want: pubsub.ValidationReject,
+		},
+		{
+			name: "bad contribution signature",
+			mutate: func(_ *Service, m *prysmv2.SignedContributionAndProof) {
+				m.Message.Contribution.Signature = contributionSignature(bytesutil.PadTo([]byte("other root"), 32))
+				signMessage(m, aggregator)
+			},
+			want: pubsub.ValidationReject,
+		},
+		{
+			name: "already seen",
+			mutate: func(s *Service, m *prysmv2.SignedContributionAndProof) {
+				c := m.Message.Contribution
+				s.setSyncContributionIndexSlotSeen(c.Slot, m.Message.AggregatorIndex, c.SubcommitteeIndex)
+			},
+			want: pubsub.ValidationIgnore,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := contributionValidationService(t, st)
+			m := validMessage()
+			tt.mutate(s, m)
+
+			buf := new(bytes.Buffer)
+			_, err := s.cfg.P2P.Encoding().EncodeGossip(buf, m)
+			require.NoError(t, err)
+			digest, err := s.forkDigest()
+			require.NoError(t, err)
+			topic := fmt.Sprintf(p2p.SyncContributionAndProofSubnetTopicFormat, digest) + s.cfg.P2P.Encoding().ProtocolSuffix()
+			msg := &pubsub.Message{
+				Message: &pubsubpb.Message{
+					Data:  buf.Bytes(),
+					Topic: &topic,
+				},
+			}
+			assert.Equal(t, tt.want, s.validateSyncContributionAndProof(ctx, "foobar", msg))
+			if tt.want == pubsub.ValidationAccept {
+				assert.NotNil(t, msg.ValidatorData, "Did not set validator data")
+				c := m.Message.Contribution
+				assert.Equal(t, true, s.hasSeenSyncContributionIndexSlot(c.Slot, m.Message.AggregatorIndex, c.SubcommitteeIndex))
+			}
+		})
+	}
+}
+
+func contributionValidationService(t *testing.T, st iface.BeaconState) *Service {
+	c, err := lru.New(10)
+	require.NoError(t, err)
+	return &Service{
+		cfg: &Config{
+			P2P:         p2ptest.NewTestP2P(t),
+			InitialSync: &mockSync.Sync{IsSyncing: false},
+			Chain: &mockChain.ChainService{
+				Genesis:        time.Unix(int64(st.GenesisTime()), 0),
+				ValidatorsRoot: bytesutil.ToBytes32(st.GenesisValidatorRoot()),
+				State:          st,
+			},
+		},
+		seenSyncContributionCache: c,
+	}
+}
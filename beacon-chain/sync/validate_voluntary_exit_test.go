@@ -11,6 +11,8 @@ import (
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
@@ -81,11 +83,17 @@ func TestValidateVoluntaryExit_ValidExit(t *testing.T) {
 			Chain: &mock.ChainService{
 				State: s,
 			},
-			InitialSync: &mockSync.Sync{IsSyncing: false},
+			InitialSync:         &mockSync.Sync{IsSyncing: false},
+			AttestationNotifier: (&mock.ChainService{}).OperationNotifier(),
 		},
 		seenExitCache: c,
 	}
 
+	// Subscribe to operation notifications.
+	opChannel := make(chan *feed.Event, 1)
+	opSub := r.cfg.AttestationNotifier.OperationFeed().Subscribe(opChannel)
+	defer opSub.Unsubscribe()
+
 	buf := new(bytes.Buffer)
 	_, err = p.Encoding().EncodeGossip(buf, exit)
 	require.NoError(t, err)
@@ -99,6 +107,17 @@ func TestValidateVoluntaryExit_ValidExit(t *testing.T) {
 	valid := r.validateVoluntaryExit(ctx, "", m) == pubsub.ValidationAccept
 	assert.Equal(t, true, valid, "Failed validation")
 	assert.NotNil(t, m.ValidatorData, "Decoded message was not set on the message validator data")
+
+	// Ensure the operation notification was broadcast.
+	select {
+	case event := <-opChannel:
+		assert.Equal(t, feed.EventType(operation.ExitReceived), event.Type)
+		data, ok := event.Data.(*operation.ExitReceivedData)
+		require.Equal(t, true, ok, "Entity is not of type *operation.ExitReceivedData")
+		assert.DeepEqual(t, exit, data.Exit)
+	default:
+		t.Error("Exit was not sent on the operation feed")
+	}
 }
 
 func TestValidateVoluntaryExit_InvalidExitSlot(t *testing.T) {
@@ -2,6 +2,7 @@ package db
 
 import (
 	beacondb "github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/tos"
 	"github.com/sirupsen/logrus"
@@ -31,5 +32,94 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name:        "inspect",
+			Description: `shows the size of every bucket, the head, checkpoints and migration status of the database of a stopped beacon node`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+			}),
+			Action: func(cliCtx *cli.Context) error {
+				if err := beacondb.Inspect(cliCtx); err != nil {
+					log.Fatalf("Could not inspect database: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "prune",
+			Description: `removes non-finalized forks and old hot states below a slot from the database of a stopped beacon node`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				cmd.PruneBeforeSlotFlag,
+				flags.SlotsPerArchivedPoint,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := beacondb.Prune(cliCtx); err != nil {
+					log.Fatalf("Could not prune database: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "compact",
+			Description: `rewrites the database of a stopped beacon node to reclaim the disk space of free pages`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := beacondb.Compact(cliCtx); err != nil {
+					log.Fatalf("Could not compact database: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "verify",
+			Description: `checks the parent linkage and state summaries of the finalized chain in the database of a stopped beacon node`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+			}),
+			Action: func(cliCtx *cli.Context) error {
+				if err := beacondb.Verify(cliCtx); err != nil {
+					log.Fatalf("Could not verify database: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "export-era",
+			Description: `writes the finalized blocks and archived point states in the database of a stopped beacon node to era files`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.EraDir,
+				cmd.EraStartFlag,
+				cmd.EraEndFlag,
+				flags.SlotsPerArchivedPoint,
+			}),
+			Action: func(cliCtx *cli.Context) error {
+				if err := beacondb.ExportEra(cliCtx); err != nil {
+					log.Fatalf("Could not export era files: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "import-era",
+			Description: `saves the blocks and states of era files up to a trusted finalized block into the database of a stopped beacon node and finalizes the latest imported state`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.EraDir,
+				flags.EraFinalizedRoot,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := beacondb.ImportEra(cliCtx); err != nil {
+					log.Fatalf("Could not import era files: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
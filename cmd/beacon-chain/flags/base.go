@@ -19,6 +19,24 @@ var (
 		Name:  "fallback-web3provider",
 		Usage: "A mainchain web3 provider string http endpoint. This is our fallback web3 provider, this flag may be used multiple times.",
 	}
+	// ExecutionEngineEndpoint provides an HTTP access endpoint to the engine API of an execution client.
+	ExecutionEngineEndpoint = &cli.StringFlag{
+		Name: "execution-endpoint",
+		Usage: "An execution client engine API http endpoint, used to drive the execution client after the Merge. " +
+			"Requests are authenticated with the secret given by --jwt-secret.",
+		Value: "",
+	}
+	// FallbackExecutionEngineEndpoint provides a fallback endpoint to the engine API of an execution client.
+	FallbackExecutionEngineEndpoint = &cli.StringSliceFlag{
+		Name:  "fallback-execution-endpoint",
+		Usage: "An execution client engine API http endpoint used when the --execution-endpoint is unavailable, this flag may be used multiple times.",
+	}
+	// ExecutionJWTSecretFlag provides a path to the secret shared with the execution client.
+	ExecutionJWTSecretFlag = &cli.StringFlag{
+		Name:  "jwt-secret",
+		Usage: "Path to a file containing a hex encoded 32 byte secret used to authenticate with the execution client engine API.",
+		Value: "",
+	}
 	// DepositContractFlag defines a flag for the deposit contract address.
 	DepositContractFlag = &cli.StringFlag{
 		Name:  "deposit-contract",
@@ -115,6 +133,16 @@ var (
 		Usage: "The slot durations of when an archived state gets saved in the DB.",
 		Value: 2048,
 	}
+	// EraDir specifies the directory of the era files of finalized history.
+	EraDir = &cli.StringFlag{
+		Name:  "era-dir",
+		Usage: "Directory of era files to export finalized history to, import it from, or serve blocks by range from when they are not in the DB.",
+	}
+	// EraFinalizedRoot specifies the trusted finalized block root up to which era files are imported.
+	EraFinalizedRoot = &cli.StringFlag{
+		Name:  "era-finalized-root",
+		Usage: "Hex encoded root of a trusted finalized block, e.g. from a block explorer or another node. Era files are only imported up to this block.",
+	}
 	// DisableDiscv5 disables running discv5.
 	DisableDiscv5 = &cli.BoolFlag{
 		Name:  "disable-discv5",
@@ -153,6 +181,12 @@ var (
 		Name:  "historical-slasher-node",
 		Usage: "Enables required flags for serving historical data to a slasher client. Results in additional storage usage",
 	}
+	// SlasherDirFlag defines a path on disk where the slasher database of the beacon node is stored.
+	SlasherDirFlag = &cli.StringFlag{
+		Name:  "slasher-datadir",
+		Usage: "Directory for the slasher database of the beacon node, defaults to the beacon node data directory",
+		Value: "",
+	}
 	// ChainID defines a flag to set the chain id. If none is set, it derives this value from NetworkConfig
 	ChainID = &cli.Uint64Flag{
 		Name:  "chain-id",
@@ -182,4 +216,45 @@ var (
 		Usage: "Load a genesis state from ssz file. Testnet genesis files can be found in the " +
 			"eth2-clients/eth2-testnets repository on github.",
 	}
+	// CheckpointState defines a flag to start the beacon chain from a finalized state file.
+	CheckpointState = &cli.StringFlag{
+		Name: "checkpoint-state",
+		Usage: "Start the beacon node from a finalized state in an ssz file instead of syncing from genesis. " +
+			"Must be used together with --checkpoint-block.",
+	}
+	// CheckpointBlock defines a flag to start the beacon chain from the block of a finalized state file.
+	CheckpointBlock = &cli.StringFlag{
+		Name:  "checkpoint-block",
+		Usage: "The latest block of the --checkpoint-state, in an ssz file.",
+	}
+	// CheckpointSyncURL defines a flag to start the beacon chain from the finalized state of another beacon node.
+	CheckpointSyncURL = &cli.StringFlag{
+		Name: "checkpoint-sync-url",
+		Usage: "URL of a trusted beacon node's REST API (e.g. http://127.0.0.1:3500) to download the finalized state " +
+			"and block from, to start the beacon node from instead of syncing from genesis.",
+	}
+	// DepositSnapshot defines a flag to start the deposit trie from a finalized deposit snapshot file.
+	DepositSnapshot = &cli.StringFlag{
+		Name: "deposit-snapshot",
+		Usage: "Finalized deposit tree snapshot, as served by /eth/v1/beacon/deposit_snapshot, to follow the deposit " +
+			"contract logs from instead of processing them all. Downloaded from --checkpoint-sync-url when not set.",
+	}
+	// BackfillVerifySignatures defines a flag to verify the proposer signatures of backfilled blocks.
+	BackfillVerifySignatures = &cli.BoolFlag{
+		Name: "backfill-verify-signatures",
+		Usage: "Verify the proposer signatures of the blocks backfilled below the checkpoint a node was started from, " +
+			"in addition to checking that they chain up to the checkpoint block.",
+	}
+	// MonitorIndices defines a flag to monitor the activity of validators by index.
+	MonitorIndices = &cli.IntSliceFlag{
+		Name: "monitor-indices",
+		Usage: "Index of a validator to report the proposals, attestations, exits, slashings and balance changes " +
+			"of in logs and metrics, this flag may be used multiple times.",
+	}
+	// MonitorPubkeysFile defines a flag to monitor the activity of validators by public key.
+	MonitorPubkeysFile = &cli.StringFlag{
+		Name: "monitor-pubkeys-file",
+		Usage: "Path to a file of hex encoded public keys, one per line, of validators to monitor in addition " +
+			"to the --monitor-indices.",
+	}
 )
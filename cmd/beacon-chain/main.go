@@ -32,6 +32,9 @@ var appFlags = []cli.Flag{
 	flags.DepositContractFlag,
 	flags.HTTPWeb3ProviderFlag,
 	flags.FallbackWeb3ProviderFlag,
+	flags.ExecutionEngineEndpoint,
+	flags.FallbackExecutionEngineEndpoint,
+	flags.ExecutionJWTSecretFlag,
 	flags.RPCHost,
 	flags.RPCPort,
 	flags.CertFlag,
@@ -54,14 +57,23 @@ var appFlags = []cli.Flag{
 	flags.InteropNumValidatorsFlag,
 	flags.InteropGenesisTimeFlag,
 	flags.SlotsPerArchivedPoint,
+	flags.EraDir,
 	flags.EnableDebugRPCEndpoints,
 	flags.SubscribeToAllSubnets,
 	flags.HistoricalSlasherNode,
+	flags.SlasherDirFlag,
 	flags.ChainID,
 	flags.NetworkID,
 	flags.WeakSubjectivityCheckpt,
 	flags.Eth1HeaderReqLimit,
 	flags.GenesisStatePath,
+	flags.CheckpointState,
+	flags.CheckpointBlock,
+	flags.CheckpointSyncURL,
+	flags.DepositSnapshot,
+	flags.BackfillVerifySignatures,
+	flags.MonitorIndices,
+	flags.MonitorPubkeysFile,
 	cmd.EnableBackupWebhookFlag,
 	cmd.BackupWebhookOutputDir,
 	cmd.MinimalConfigFlag,
@@ -81,6 +93,8 @@ var appFlags = []cli.Flag{
 	cmd.P2PMetadata,
 	cmd.P2PAllowList,
 	cmd.P2PDenyList,
+	cmd.P2PColocationLimit,
+	cmd.P2PSubnetColocationLimit,
 	cmd.DataDirFlag,
 	cmd.VerbosityFlag,
 	cmd.EnableTracingFlag,
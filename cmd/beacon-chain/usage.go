@@ -106,21 +106,33 @@ var appHelpFlagGroups = []flagGroup{
 			flags.GPRCGatewayCorsDomain,
 			flags.HTTPWeb3ProviderFlag,
 			flags.FallbackWeb3ProviderFlag,
+			flags.ExecutionEngineEndpoint,
+			flags.FallbackExecutionEngineEndpoint,
+			flags.ExecutionJWTSecretFlag,
 			flags.SetGCPercent,
 			flags.HeadSync,
 			flags.DisableSync,
 			flags.SlotsPerArchivedPoint,
+			flags.EraDir,
 			flags.DisableDiscv5,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
 			flags.EnableDebugRPCEndpoints,
 			flags.SubscribeToAllSubnets,
 			flags.HistoricalSlasherNode,
+			flags.SlasherDirFlag,
 			flags.ChainID,
 			flags.NetworkID,
 			flags.WeakSubjectivityCheckpt,
 			flags.Eth1HeaderReqLimit,
 			flags.GenesisStatePath,
+			flags.CheckpointState,
+			flags.CheckpointBlock,
+			flags.CheckpointSyncURL,
+			flags.DepositSnapshot,
+			flags.BackfillVerifySignatures,
+			flags.MonitorIndices,
+			flags.MonitorPubkeysFile,
 		},
 	},
 	{
@@ -134,6 +146,8 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.P2PMetadata,
 			cmd.P2PAllowList,
 			cmd.P2PDenyList,
+			cmd.P2PColocationLimit,
+			cmd.P2PSubnetColocationLimit,
 			cmd.StaticPeers,
 			cmd.EnableUPnPFlag,
 			flags.MinSyncPeers,
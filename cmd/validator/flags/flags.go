@@ -32,6 +32,13 @@ var (
 		Usage: "Beacon node RPC provider endpoint",
 		Value: "127.0.0.1:4000",
 	}
+	// BeaconRESTApiProviderFlag defines a beacon node REST API endpoint used instead of the gRPC one.
+	BeaconRESTApiProviderFlag = &cli.StringFlag{
+		Name: "beacon-rest-api-provider",
+		Usage: "Beacon node REST API provider endpoint, e.g. http://127.0.0.1:3500. If set, the validator " +
+			"performs its duties through the standard Ethereum beacon node API instead of the gRPC API " +
+			"of --beacon-rpc-provider, which allows using beacon nodes of other clients",
+	}
 	// BeaconRPCGatewayProviderFlag defines a beacon node JSON-RPC endpoint.
 	BeaconRPCGatewayProviderFlag = &cli.StringFlag{
 		Name:  "beacon-rpc-gateway-provider",
@@ -267,10 +274,93 @@ var (
 		Usage: "/path/to/ca.crt for establishing a secure, TLS gRPC connection to a remote signer server",
 		Value: "",
 	}
+	// Web3SignerURLFlag defines the URL of a remote signer implementing the Web3Signer HTTP API.
+	Web3SignerURLFlag = &cli.StringFlag{
+		Name:  "web3signer-url",
+		Usage: "URL of a remote signer implementing the Web3Signer HTTP API, such as http://localhost:9000",
+		Value: "",
+	}
+	// Web3SignerGenesisValidatorsRootFlag defines the genesis validators root sent to a Web3Signer
+	// remote signer along with every signing request.
+	Web3SignerGenesisValidatorsRootFlag = &cli.StringFlag{
+		Name:  "web3signer-genesis-validators-root",
+		Usage: "Hex encoded genesis validators root of the network, sent to the Web3Signer remote signer to compute signing domains",
+		Value: "",
+	}
+	// ThresholdKeymanagerConfigFlag defines the path to the options of a threshold keymanager.
+	ThresholdKeymanagerConfigFlag = &cli.StringFlag{
+		Name:  "threshold-keymanager-config",
+		Usage: "Path to a JSON file with the options of a threshold keymanager: its share index, the threshold and the co-signers",
+		Value: "",
+	}
+	// ThresholdShareKeystoreFlag defines the path to the EIP-2335 keystore of a validator key share.
+	ThresholdShareKeystoreFlag = &cli.StringFlag{
+		Name:  "threshold-share-keystore",
+		Usage: "Path to the EIP-2335 keystore of a validator key share, as written by the threshold split-key command",
+		Value: "",
+	}
+	// ThresholdSharePasswordFileFlag defines the path to the password of key share keystores.
+	ThresholdSharePasswordFileFlag = &cli.StringFlag{
+		Name:  "threshold-share-password-file",
+		Usage: "Path to a plain-text, .txt file containing the password of validator key share keystores",
+	}
+	// ThresholdValidatorKeystoreFlag defines the path to the keystore of a validator key to split.
+	ThresholdValidatorKeystoreFlag = &cli.StringFlag{
+		Name:  "threshold-validator-keystore",
+		Usage: "Path to the EIP-2335 keystore of the validator key to split into shares",
+		Value: "",
+	}
+	// ThresholdFlag defines the number of key shares required to sign.
+	ThresholdFlag = &cli.Uint64Flag{
+		Name:  "threshold",
+		Usage: "Number of key shares required to sign with a split validator key",
+		Value: 2,
+	}
+	// ThresholdSharesFlag defines the number of shares a validator key is split into.
+	ThresholdSharesFlag = &cli.Uint64Flag{
+		Name:  "threshold-shares",
+		Usage: "Number of shares to split a validator key into",
+		Value: 3,
+	}
+	// ThresholdOutputDirFlag defines the directory where key share keystores are written.
+	ThresholdOutputDirFlag = &cli.StringFlag{
+		Name:  "threshold-output-dir",
+		Usage: "Directory where the keystores and public keys of the key shares are written",
+		Value: "",
+	}
+	// ThresholdCoSignerListenAddressFlag defines the host:port a co-signer serves signing requests on.
+	ThresholdCoSignerListenAddressFlag = &cli.StringFlag{
+		Name:  "threshold-cosigner-listen-address",
+		Usage: "Host:port on which a co-signer serves the signing requests of threshold keymanagers",
+		Value: "127.0.0.1:7600",
+	}
+	// ThresholdCoSignerAuthTokenFileFlag defines the path to the bearer token required by a co-signer.
+	ThresholdCoSignerAuthTokenFileFlag = &cli.StringFlag{
+		Name:  "threshold-cosigner-auth-token-file",
+		Usage: "Path to a file containing the bearer token threshold keymanagers must present to a co-signer",
+		Value: "",
+	}
+	// ThresholdCoSignerTLSCertFlag defines the path to the TLS certificate of a co-signer.
+	ThresholdCoSignerTLSCertFlag = &cli.StringFlag{
+		Name:  "threshold-cosigner-tls-cert",
+		Usage: "Path to the TLS certificate a co-signer serves signing requests with, requires --threshold-cosigner-tls-key",
+		Value: "",
+	}
+	// ThresholdCoSignerTLSKeyFlag defines the path to the TLS key of a co-signer.
+	ThresholdCoSignerTLSKeyFlag = &cli.StringFlag{
+		Name:  "threshold-cosigner-tls-key",
+		Usage: "Path to the TLS key a co-signer serves signing requests with, requires --threshold-cosigner-tls-cert",
+		Value: "",
+	}
+	// ThresholdCoSignerAllowExitsFlag allows a co-signer to sign voluntary exits.
+	ThresholdCoSignerAllowExitsFlag = &cli.BoolFlag{
+		Name:  "threshold-cosigner-allow-exits",
+		Usage: "Allows a co-signer to sign voluntary exits, which are refused by default as they cannot be undone",
+	}
 	// KeymanagerKindFlag defines the kind of keymanager desired by a user during wallet creation.
 	KeymanagerKindFlag = &cli.StringFlag{
 		Name:  "keymanager-kind",
-		Usage: "Kind of keymanager, either imported, derived, or remote, specified during wallet creation",
+		Usage: "Kind of keymanager, either imported, derived, remote, web3signer, or threshold, specified during wallet creation",
 		Value: "",
 	}
 	// SkipDepositConfirmationFlag skips the y/n confirmation prompt for sending a deposit to the deposit contract.
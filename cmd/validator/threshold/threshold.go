@@ -0,0 +1,81 @@
+package threshold
+
+import (
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/tos"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/threshold"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var log = logrus.WithField("prefix", "threshold")
+
+// Commands for threshold signing with a validator key split across several hosts.
+var Commands = &cli.Command{
+	Name:     "threshold",
+	Category: "threshold",
+	Usage:    "defines commands for splitting a validator key into shares and running co-signers for threshold signing",
+	Subcommands: []*cli.Command{
+		{
+			Name: "split-key",
+			Description: `splits the key of an EIP-2335 keystore into shares, any threshold of which can sign,
+and writes a keystore for every share along with the public keys of the shares`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.ThresholdValidatorKeystoreFlag,
+				flags.AccountPasswordFileFlag,
+				flags.ThresholdFlag,
+				flags.ThresholdSharesFlag,
+				flags.ThresholdOutputDirFlag,
+				flags.ThresholdSharePasswordFileFlag,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := threshold.SplitKeyCli(cliCtx); err != nil {
+					log.Fatalf("Could not split validator key: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "cosigner",
+			Description: `runs a co-signer holding a validator key share, which serves the signing requests of
+threshold keymanagers with its own slashing protection database`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.ThresholdCoSignerListenAddressFlag,
+				flags.ThresholdCoSignerAuthTokenFileFlag,
+				flags.ThresholdCoSignerTLSCertFlag,
+				flags.ThresholdCoSignerTLSKeyFlag,
+				flags.ThresholdCoSignerAllowExitsFlag,
+				flags.ThresholdShareKeystoreFlag,
+				flags.ThresholdSharePasswordFileFlag,
+				cmd.DataDirFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				featureconfig.PraterTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := threshold.RunCoSignerCli(cliCtx); err != nil {
+					log.Fatalf("Could not run co-signer: %v", err)
+				}
+				return nil
+			},
+		},
+	},
+}
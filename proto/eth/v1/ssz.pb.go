@@ -0,0 +1,344 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.15.8
+// source: proto/eth/v1/ssz.proto
+
+package v1
+
+import (
+	context "context"
+	reflect "reflect"
+	sync "sync"
+
+	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type SSZContainer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *SSZContainer) Reset() {
+	*x = SSZContainer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_ssz_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSZContainer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSZContainer) ProtoMessage() {}
+
+func (x *SSZContainer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_ssz_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSZContainer.ProtoReflect.Descriptor instead.
+func (*SSZContainer) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_ssz_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SSZContainer) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_proto_eth_v1_ssz_proto protoreflect.FileDescriptor
+
+var file_proto_eth_v1_ssz_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x73, 0x7a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
+	0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x74, 0x68, 0x2f,
+	0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x22, 0x0a, 0x0c, 0x53, 0x53,
+	0x5a, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x7f,
+	0x0a, 0x0e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x53, 0x5a,
+	0x12, 0x6d, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
+	0x53, 0x5a, 0x12, 0x1d, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x53, 0x5a, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2f, 0x73, 0x73, 0x7a, 0x32,
+	0x9a, 0x01, 0x0a, 0x12, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x53, 0x53, 0x5a, 0x12, 0x83, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x53, 0x5a, 0x12, 0x24, 0x2e, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x53, 0x5a, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x22,
+	0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31,
+	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x2f, 0x7b, 0x73, 0x6c, 0x6f, 0x74, 0x7d, 0x2f, 0x73, 0x73, 0x7a, 0x42, 0x72, 0x0a, 0x13,
+	0x6f, 0x72, 0x67, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68,
+	0x2e, 0x76, 0x31, 0x42, 0x08, 0x53, 0x73, 0x7a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73,
+	0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0xaa, 0x02, 0x0f, 0x45,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x45, 0x74, 0x68, 0x2e, 0x76, 0x31, 0xca, 0x02,
+	0x0f, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x5c, 0x45, 0x74, 0x68, 0x5c, 0x76, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_eth_v1_ssz_proto_rawDescOnce sync.Once
+	file_proto_eth_v1_ssz_proto_rawDescData = file_proto_eth_v1_ssz_proto_rawDesc
+)
+
+func file_proto_eth_v1_ssz_proto_rawDescGZIP() []byte {
+	file_proto_eth_v1_ssz_proto_rawDescOnce.Do(func() {
+		file_proto_eth_v1_ssz_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_eth_v1_ssz_proto_rawDescData)
+	})
+	return file_proto_eth_v1_ssz_proto_rawDescData
+}
+
+var file_proto_eth_v1_ssz_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_proto_eth_v1_ssz_proto_goTypes = []interface{}{
+	(*SSZContainer)(nil),        // 0: ethereum.eth.v1.SSZContainer
+	(*ProduceBlockRequest)(nil), // 1: ethereum.eth.v1.ProduceBlockRequest
+	(*empty.Empty)(nil),         // 2: google.protobuf.Empty
+}
+var file_proto_eth_v1_ssz_proto_depIdxs = []int32{
+	0, // 0: ethereum.eth.v1.BeaconChainSSZ.SubmitBlockSSZ:input_type -> ethereum.eth.v1.SSZContainer
+	1, // 1: ethereum.eth.v1.BeaconValidatorSSZ.ProduceBlockSSZ:input_type -> ethereum.eth.v1.ProduceBlockRequest
+	2, // 2: ethereum.eth.v1.BeaconChainSSZ.SubmitBlockSSZ:output_type -> google.protobuf.Empty
+	0, // 3: ethereum.eth.v1.BeaconValidatorSSZ.ProduceBlockSSZ:output_type -> ethereum.eth.v1.SSZContainer
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_proto_eth_v1_ssz_proto_init() }
+func file_proto_eth_v1_ssz_proto_init() {
+	if File_proto_eth_v1_ssz_proto != nil {
+		return
+	}
+	file_proto_eth_v1_validator_service_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_proto_eth_v1_ssz_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SSZContainer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_eth_v1_ssz_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_proto_eth_v1_ssz_proto_goTypes,
+		DependencyIndexes: file_proto_eth_v1_ssz_proto_depIdxs,
+		MessageInfos:      file_proto_eth_v1_ssz_proto_msgTypes,
+	}.Build()
+	File_proto_eth_v1_ssz_proto = out.File
+	file_proto_eth_v1_ssz_proto_rawDesc = nil
+	file_proto_eth_v1_ssz_proto_goTypes = nil
+	file_proto_eth_v1_ssz_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// BeaconChainSSZClient is the client API for BeaconChainSSZ service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BeaconChainSSZClient interface {
+	SubmitBlockSSZ(ctx context.Context, in *SSZContainer, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type beaconChainSSZClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBeaconChainSSZClient(cc grpc.ClientConnInterface) BeaconChainSSZClient {
+	return &beaconChainSSZClient{cc}
+}
+
+func (c *beaconChainSSZClient) SubmitBlockSSZ(ctx context.Context, in *SSZContainer, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1.BeaconChainSSZ/SubmitBlockSSZ", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BeaconChainSSZServer is the server API for BeaconChainSSZ service.
+type BeaconChainSSZServer interface {
+	SubmitBlockSSZ(context.Context, *SSZContainer) (*empty.Empty, error)
+}
+
+// UnimplementedBeaconChainSSZServer can be embedded to have forward compatible implementations.
+type UnimplementedBeaconChainSSZServer struct {
+}
+
+func (*UnimplementedBeaconChainSSZServer) SubmitBlockSSZ(context.Context, *SSZContainer) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBlockSSZ not implemented")
+}
+
+func RegisterBeaconChainSSZServer(s *grpc.Server, srv BeaconChainSSZServer) {
+	s.RegisterService(&_BeaconChainSSZ_serviceDesc, srv)
+}
+
+func _BeaconChainSSZ_SubmitBlockSSZ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSZContainer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainSSZServer).SubmitBlockSSZ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1.BeaconChainSSZ/SubmitBlockSSZ",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainSSZServer).SubmitBlockSSZ(ctx, req.(*SSZContainer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BeaconChainSSZ_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.eth.v1.BeaconChainSSZ",
+	HandlerType: (*BeaconChainSSZServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitBlockSSZ",
+			Handler:    _BeaconChainSSZ_SubmitBlockSSZ_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/eth/v1/ssz.proto",
+}
+
+// BeaconValidatorSSZClient is the client API for BeaconValidatorSSZ service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BeaconValidatorSSZClient interface {
+	ProduceBlockSSZ(ctx context.Context, in *ProduceBlockRequest, opts ...grpc.CallOption) (*SSZContainer, error)
+}
+
+type beaconValidatorSSZClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBeaconValidatorSSZClient(cc grpc.ClientConnInterface) BeaconValidatorSSZClient {
+	return &beaconValidatorSSZClient{cc}
+}
+
+func (c *beaconValidatorSSZClient) ProduceBlockSSZ(ctx context.Context, in *ProduceBlockRequest, opts ...grpc.CallOption) (*SSZContainer, error) {
+	out := new(SSZContainer)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1.BeaconValidatorSSZ/ProduceBlockSSZ", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BeaconValidatorSSZServer is the server API for BeaconValidatorSSZ service.
+type BeaconValidatorSSZServer interface {
+	ProduceBlockSSZ(context.Context, *ProduceBlockRequest) (*SSZContainer, error)
+}
+
+// UnimplementedBeaconValidatorSSZServer can be embedded to have forward compatible implementations.
+type UnimplementedBeaconValidatorSSZServer struct {
+}
+
+func (*UnimplementedBeaconValidatorSSZServer) ProduceBlockSSZ(context.Context, *ProduceBlockRequest) (*SSZContainer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProduceBlockSSZ not implemented")
+}
+
+func RegisterBeaconValidatorSSZServer(s *grpc.Server, srv BeaconValidatorSSZServer) {
+	s.RegisterService(&_BeaconValidatorSSZ_serviceDesc, srv)
+}
+
+func _BeaconValidatorSSZ_ProduceBlockSSZ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProduceBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconValidatorSSZServer).ProduceBlockSSZ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1.BeaconValidatorSSZ/ProduceBlockSSZ",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconValidatorSSZServer).ProduceBlockSSZ(ctx, req.(*ProduceBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BeaconValidatorSSZ_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.eth.v1.BeaconValidatorSSZ",
+	HandlerType: (*BeaconValidatorSSZServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ProduceBlockSSZ",
+			Handler:    _BeaconValidatorSSZ_ProduceBlockSSZ_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/eth/v1/ssz.proto",
+}
@@ -1,13 +1,20 @@
 package wrapper
 
 import (
+	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	"github.com/prysmaticlabs/prysm/shared/copyutil"
+	"github.com/prysmaticlabs/prysm/shared/version"
 	"google.golang.org/protobuf/proto"
 )
 
+// ErrUnsupportedField is returned when a getter is called on a block
+// wrapper whose fork does not have the requested field.
+var ErrUnsupportedField = errors.New("unsupported field for block type")
+
 // Phase0SignedBeaconBlock is a convenience wrapper around a phase 0 beacon block
 // object. This wrapper allows us to conform to a common interface so that beacon
 // blocks for future forks can also be applied across prysm without issues.
@@ -60,6 +67,16 @@ func (w Phase0SignedBeaconBlock) PbPhase0Block() (*eth.SignedBeaconBlock, error)
 	return w.b, nil
 }
 
+// PbAltairBlock is a stub.
+func (w Phase0SignedBeaconBlock) PbAltairBlock() (*prysmv2.SignedBeaconBlockAltair, error) {
+	return nil, errors.New("unsupported altair block")
+}
+
+// Version of the underlying protobuf object.
+func (w Phase0SignedBeaconBlock) Version() int {
+	return version.Phase0
+}
+
 // Phase0BeaconBlock is the wrapper for the actual block.
 type Phase0BeaconBlock struct {
 	b *eth.BeaconBlock
@@ -169,6 +186,12 @@ func (w Phase0BeaconBlockBody) VoluntaryExits() []*eth.SignedVoluntaryExit {
 	return w.b.VoluntaryExits
 }
 
+// SyncAggregate returns an error since phase 0 blocks
+// do not carry a sync aggregate.
+func (w Phase0BeaconBlockBody) SyncAggregate() (*prysmv2.SyncAggregate, error) {
+	return nil, ErrUnsupportedField
+}
+
 // IsNil checks if the block body is nil.
 func (w Phase0BeaconBlockBody) IsNil() bool {
 	return w.b == nil
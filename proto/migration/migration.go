@@ -222,6 +222,21 @@ func V1AttToV1Alpha1(v1Att *ethpb.Attestation) *ethpb_alpha.Attestation {
 	}
 }
 
+// V1SignedAggregateAttAndProofToV1Alpha1 converts a v1 signed aggregate attestation and proof to v1alpha1.
+func V1SignedAggregateAttAndProofToV1Alpha1(v1Att *ethpb.SignedAggregateAttestationAndProof) *ethpb_alpha.SignedAggregateAttestationAndProof {
+	if v1Att == nil || v1Att.Message == nil {
+		return &ethpb_alpha.SignedAggregateAttestationAndProof{}
+	}
+	return &ethpb_alpha.SignedAggregateAttestationAndProof{
+		Message: &ethpb_alpha.AggregateAttestationAndProof{
+			AggregatorIndex: v1Att.Message.AggregatorIndex,
+			Aggregate:       V1AttToV1Alpha1(v1Att.Message.Aggregate),
+			SelectionProof:  v1Att.Message.SelectionProof,
+		},
+		Signature: v1Att.Signature,
+	}
+}
+
 // V1IndexedAttToV1Alpha1 converts a v1 indexed attestation to v1alpha1.
 func V1IndexedAttToV1Alpha1(v1Att *ethpb.IndexedAttestation) *ethpb_alpha.IndexedAttestation {
 	if v1Att == nil {
@@ -3,6 +3,8 @@
 package blst
 
 import (
+	"math/big"
+
 	"github.com/prysmaticlabs/prysm/shared/bls/common"
 )
 
@@ -132,3 +134,8 @@ func RandKey() (common.SecretKey, error) {
 func VerifyCompressed(_, _, _ []byte) bool {
 	panic(err)
 }
+
+// LinearCombination -- stub
+func LinearCombination(_ []common.Signature, _ []*big.Int) (common.Signature, error) {
+	panic(err)
+}
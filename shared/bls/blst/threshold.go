@@ -0,0 +1,44 @@
+// +build linux,amd64 linux,arm64 darwin,amd64 windows,amd64
+// +build !blst_disabled
+
+package blst
+
+import (
+	"math/big"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bls/common"
+)
+
+// LinearCombination returns the sum of the signatures multiplied by the given non-negative
+// scalars, which is used to interpolate threshold signatures from signature shares.
+func LinearCombination(sigs []common.Signature, scalars []*big.Int) (common.Signature, error) {
+	if len(sigs) != len(scalars) {
+		return nil, errors.Errorf("got %d signatures and %d scalars", len(sigs), len(scalars))
+	}
+	sum := new(blstAggregateSignature)
+	for i, sig := range sigs {
+		s, ok := sig.(*Signature)
+		if !ok || s.s == nil {
+			return nil, errors.Errorf("invalid signature at position %d", i)
+		}
+		if scalars[i] == nil || scalars[i].Sign() < 0 {
+			return nil, errors.Errorf("invalid scalar at position %d", i)
+		}
+		sum.AddAggregate(multiplySignature(s.s, scalars[i]))
+	}
+	return &Signature{s: sum.ToAffine()}, nil
+}
+
+// multiplySignature multiplies a signature by a scalar with the double and add method, as the
+// bindings do not expose scalar multiplication in G2.
+func multiplySignature(sig *blstSignature, scalar *big.Int) *blstAggregateSignature {
+	product := new(blstAggregateSignature)
+	for i := scalar.BitLen() - 1; i >= 0; i-- {
+		product.AddAggregate(product)
+		if scalar.Bit(i) == 1 {
+			product.Add(sig, false)
+		}
+	}
+	return product
+}
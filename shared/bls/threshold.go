@@ -0,0 +1,149 @@
+package bls
+
+import (
+	"math/big"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bls/blst"
+	"github.com/prysmaticlabs/prysm/shared/rand"
+)
+
+// curveOrder is the order r of the BLS12-381 groups, secret keys are elements of the field of integers modulo r.
+var curveOrder, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+
+// SecretKeyShare is a Shamir share of a secret key, the evaluation at Index of the polynomial
+// whose constant term is the secret key.
+type SecretKeyShare struct {
+	Index uint64
+	Key   SecretKey
+}
+
+// SignatureShare is a partial signature created with the secret key share of the given index.
+type SignatureShare struct {
+	Index     uint64
+	Signature Signature
+}
+
+// SplitSecretKey splits a secret key into n shares with indices 1 to n, any threshold of which
+// can recover signatures of the secret key while fewer shares reveal nothing about it.
+func SplitSecretKey(secretKey SecretKey, threshold, n uint64) ([]*SecretKeyShare, error) {
+	if threshold == 0 || threshold > n {
+		return nil, errors.Errorf("invalid threshold %d for %d shares", threshold, n)
+	}
+	secret := new(big.Int).SetBytes(secretKey.Marshal())
+	shares, err := splitScalar(secret, threshold, n)
+	if err != nil {
+		return nil, err
+	}
+	keyShares := make([]*SecretKeyShare, n)
+	for i, share := range shares {
+		key, err := SecretKeyFromBytes(scalarBytes(share))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not create secret key share %d", i+1)
+		}
+		keyShares[i] = &SecretKeyShare{Index: uint64(i + 1), Key: key}
+	}
+	return keyShares, nil
+}
+
+// RecoverSignature combines the partial signatures of at least threshold distinct shares into
+// the signature of the split secret key. The result is only valid if the shares are valid and
+// there are at least as many as the threshold, which should be checked by verifying it.
+func RecoverSignature(shares []*SignatureShare) (Signature, error) {
+	if len(shares) == 0 {
+		return nil, errors.New("no signature shares")
+	}
+	indices := make([]uint64, len(shares))
+	sigs := make([]Signature, len(shares))
+	for i, share := range shares {
+		if share == nil || share.Signature == nil {
+			return nil, errors.Errorf("nil signature share at position %d", i)
+		}
+		indices[i] = share.Index
+		sigs[i] = share.Signature
+	}
+	coefficients, err := lagrangeCoefficients(indices)
+	if err != nil {
+		return nil, err
+	}
+	return blst.LinearCombination(sigs, coefficients)
+}
+
+// splitScalar returns the evaluations at 1 to n of a random polynomial of degree threshold-1
+// with the given constant term.
+func splitScalar(secret *big.Int, threshold, n uint64) ([]*big.Int, error) {
+	if secret.Sign() <= 0 || secret.Cmp(curveOrder) >= 0 {
+		return nil, errors.New("secret is not a non-zero field element")
+	}
+	randGen := rand.NewGenerator()
+	coefficients := make([]*big.Int, threshold)
+	coefficients[0] = secret
+	for i := uint64(1); i < threshold; i++ {
+		coefficients[i] = new(big.Int).Rand(randGen, curveOrder)
+	}
+	shares := make([]*big.Int, 0, n)
+	for x := uint64(1); x <= n; x++ {
+		share := evaluatePolynomial(coefficients, new(big.Int).SetUint64(x))
+		// A zero share is not a valid secret key, this happens with negligible probability.
+		if share.Sign() == 0 {
+			return nil, errors.New("zero secret key share")
+		}
+		shares = append(shares, share)
+	}
+	return shares, nil
+}
+
+// evaluatePolynomial evaluates the polynomial with the given coefficients, lowest degree first,
+// at x modulo the curve order.
+func evaluatePolynomial(coefficients []*big.Int, x *big.Int) *big.Int {
+	result := new(big.Int)
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		result.Mul(result, x)
+		result.Add(result, coefficients[i])
+		result.Mod(result, curveOrder)
+	}
+	return result
+}
+
+// lagrangeCoefficients returns the Lagrange basis polynomials of the given indices evaluated at
+// zero, the weights of the shares when interpolating the constant term of the polynomial.
+//
+//  lambda_i = prod_{j != i} x_j / (x_j - x_i) mod r
+func lagrangeCoefficients(indices []uint64) ([]*big.Int, error) {
+	seen := make(map[uint64]bool, len(indices))
+	for _, idx := range indices {
+		if idx == 0 {
+			return nil, errors.New("share index must not be zero")
+		}
+		if seen[idx] {
+			return nil, errors.Errorf("duplicate share index %d", idx)
+		}
+		seen[idx] = true
+	}
+	coefficients := make([]*big.Int, len(indices))
+	for i, xi := range indices {
+		num, den := big.NewInt(1), big.NewInt(1)
+		for j, xj := range indices {
+			if i == j {
+				continue
+			}
+			num.Mul(num, new(big.Int).SetUint64(xj))
+			num.Mod(num, curveOrder)
+			diff := new(big.Int).Sub(new(big.Int).SetUint64(xj), new(big.Int).SetUint64(xi))
+			den.Mul(den, diff)
+			den.Mod(den, curveOrder)
+		}
+		inv := new(big.Int).ModInverse(den, curveOrder)
+		if inv == nil {
+			return nil, errors.Errorf("could not invert denominator of share index %d", xi)
+		}
+		coefficients[i] = num.Mul(num, inv).Mod(num, curveOrder)
+	}
+	return coefficients, nil
+}
+
+// scalarBytes returns the 32 byte big endian encoding of a field element.
+func scalarBytes(s *big.Int) []byte {
+	b := make([]byte, 32)
+	return s.FillBytes(b)
+}
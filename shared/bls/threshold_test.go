@@ -0,0 +1,92 @@
+package bls
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestLagrangeCoefficients(t *testing.T) {
+	// For the indices 1 and 2 the coefficients are 2 and -1.
+	coefficients, err := lagrangeCoefficients([]uint64{1, 2})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(coefficients))
+	assert.Equal(t, 0, coefficients[0].Cmp(big.NewInt(2)))
+	assert.Equal(t, 0, coefficients[1].Cmp(new(big.Int).Sub(curveOrder, big.NewInt(1))))
+
+	_, err = lagrangeCoefficients([]uint64{1, 0})
+	assert.ErrorContains(t, "must not be zero", err)
+	_, err = lagrangeCoefficients([]uint64{3, 1, 3})
+	assert.ErrorContains(t, "duplicate share index 3", err)
+}
+
+func TestSplitScalar_Interpolation(t *testing.T) {
+	secret := new(big.Int).Sub(curveOrder, big.NewInt(12345))
+	shares, err := splitScalar(secret, 3, 5)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(shares))
+
+	subsets := [][]uint64{{1, 2, 3}, {5, 2, 4}, {1, 3, 4, 5}}
+	for _, indices := range subsets {
+		coefficients, err := lagrangeCoefficients(indices)
+		require.NoError(t, err)
+		recovered := new(big.Int)
+		for i, idx := range indices {
+			recovered.Add(recovered, new(big.Int).Mul(coefficients[i], shares[idx-1]))
+		}
+		recovered.Mod(recovered, curveOrder)
+		assert.Equal(t, 0, recovered.Cmp(secret), "indices %v", indices)
+	}
+
+	// Fewer shares than the threshold interpolate a different polynomial.
+	coefficients, err := lagrangeCoefficients([]uint64{1, 2})
+	require.NoError(t, err)
+	recovered := new(big.Int).Mul(coefficients[0], shares[0])
+	recovered.Add(recovered, new(big.Int).Mul(coefficients[1], shares[1]))
+	recovered.Mod(recovered, curveOrder)
+	assert.NotEqual(t, 0, recovered.Cmp(secret))
+}
+
+func TestSplitScalar_InvalidSecret(t *testing.T) {
+	_, err := splitScalar(big.NewInt(0), 2, 3)
+	assert.ErrorContains(t, "not a non-zero field element", err)
+	_, err = splitScalar(curveOrder, 2, 3)
+	assert.ErrorContains(t, "not a non-zero field element", err)
+}
+
+func TestRecoverSignature(t *testing.T) {
+	secretKey, err := RandKey()
+	require.NoError(t, err)
+	shares, err := SplitSecretKey(secretKey, 3, 5)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(shares))
+
+	msg := []byte("threshold signature")
+	sigShares := make([]*SignatureShare, len(shares))
+	for i, share := range shares {
+		sigShares[i] = &SignatureShare{Index: share.Index, Signature: share.Key.Sign(msg)}
+	}
+
+	sig, err := RecoverSignature([]*SignatureShare{sigShares[4], sigShares[0], sigShares[2]})
+	require.NoError(t, err)
+	assert.DeepEqual(t, secretKey.Sign(msg).Marshal(), sig.Marshal())
+	assert.Equal(t, true, sig.Verify(secretKey.PublicKey(), msg))
+
+	sig, err = RecoverSignature(sigShares[:2])
+	require.NoError(t, err)
+	assert.Equal(t, false, sig.Verify(secretKey.PublicKey(), msg))
+
+	_, err = RecoverSignature([]*SignatureShare{sigShares[1], sigShares[1]})
+	assert.ErrorContains(t, "duplicate share index", err)
+}
+
+func TestSplitSecretKey_InvalidThreshold(t *testing.T) {
+	secretKey, err := RandKey()
+	require.NoError(t, err)
+	_, err = SplitSecretKey(secretKey, 0, 3)
+	assert.ErrorContains(t, "invalid threshold", err)
+	_, err = SplitSecretKey(secretKey, 4, 3)
+	assert.ErrorContains(t, "invalid threshold", err)
+}
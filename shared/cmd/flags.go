@@ -3,6 +3,7 @@ package cmd
 
 import (
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -171,6 +172,18 @@ var (
 			"192.168.0.0/16 would deny connections from peers on your local network only. The " +
 			"default is to accept all connections.",
 	}
+	// P2PColocationLimit defines the max number of peers allowed from a single ip address.
+	P2PColocationLimit = &cli.UintFlag{
+		Name:  "p2p-colocation-limit",
+		Usage: "The max number of p2p peers allowed to connect from a single ip address.",
+		Value: 5,
+	}
+	// P2PSubnetColocationLimit defines the max number of peers allowed from a single ip subnet.
+	P2PSubnetColocationLimit = &cli.UintFlag{
+		Name:  "p2p-subnet-colocation-limit",
+		Usage: "The max number of p2p peers allowed to connect from a single /24 (ipv4) or /64 (ipv6) subnet.",
+		Value: 10,
+	}
 	// ForceClearDB removes any previously stored data at the data directory.
 	ForceClearDB = &cli.BoolFlag{
 		Name:  "force-clear-db",
@@ -236,6 +249,22 @@ var (
 		Usage: "Target directory of the restored database",
 		Value: DefaultDataDir(),
 	}
+	// PruneBeforeSlotFlag specifies the slot below which the db prune command removes data.
+	PruneBeforeSlotFlag = &cli.Uint64Flag{
+		Name:  "before-slot",
+		Usage: "Remove non-finalized blocks and states which are not archived points below this slot",
+	}
+	// EraStartFlag specifies the first era the db export-era command writes.
+	EraStartFlag = &cli.Uint64Flag{
+		Name:  "start-era",
+		Usage: "First era to export, an era being SLOTS_PER_HISTORICAL_ROOT slots",
+	}
+	// EraEndFlag specifies the last era the db export-era command writes.
+	EraEndFlag = &cli.Uint64Flag{
+		Name:  "end-era",
+		Usage: "Last era to export, defaults to the last finalized era",
+		Value: math.MaxUint64,
+	}
 	// BoltMMapInitialSizeFlag specifies the initial size in bytes of boltdb's mmap syscall.
 	BoltMMapInitialSizeFlag = &cli.IntFlag{
 		Name:  "bolt-mmap-initial-size",
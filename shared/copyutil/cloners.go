@@ -299,3 +299,81 @@ func CopySyncCommitteeContribution(c *prysmv2.SyncCommitteeContribution) *prysmv
 		Signature:         bytesutil.SafeCopyBytes(c.Signature),
 	}
 }
+
+// CopySyncCommitteeMessage copies the provided sync committee message object.
+func CopySyncCommitteeMessage(s *prysmv2.SyncCommitteeMessage) *prysmv2.SyncCommitteeMessage {
+	if s == nil {
+		return nil
+	}
+	return &prysmv2.SyncCommitteeMessage{
+		Slot:           s.Slot,
+		BlockRoot:      bytesutil.SafeCopyBytes(s.BlockRoot),
+		ValidatorIndex: s.ValidatorIndex,
+		Signature:      bytesutil.SafeCopyBytes(s.Signature),
+	}
+}
+
+// CopySyncCommittee copies the provided sync committee object.
+func CopySyncCommittee(c *pbp2p.SyncCommittee) *pbp2p.SyncCommittee {
+	if c == nil {
+		return nil
+	}
+	return &pbp2p.SyncCommittee{
+		Pubkeys:         bytesutil.Copy2dBytes(c.Pubkeys),
+		AggregatePubkey: bytesutil.SafeCopyBytes(c.AggregatePubkey),
+	}
+}
+
+// CopySignedBeaconBlockAltair copies the provided SignedBeaconBlockAltair.
+func CopySignedBeaconBlockAltair(sigBlock *prysmv2.SignedBeaconBlockAltair) *prysmv2.SignedBeaconBlockAltair {
+	if sigBlock == nil {
+		return nil
+	}
+	return &prysmv2.SignedBeaconBlockAltair{
+		Block:     CopyBeaconBlockAltair(sigBlock.Block),
+		Signature: bytesutil.SafeCopyBytes(sigBlock.Signature),
+	}
+}
+
+// CopyBeaconBlockAltair copies the provided BeaconBlockAltair.
+func CopyBeaconBlockAltair(block *prysmv2.BeaconBlockAltair) *prysmv2.BeaconBlockAltair {
+	if block == nil {
+		return nil
+	}
+	return &prysmv2.BeaconBlockAltair{
+		Slot:          block.Slot,
+		ProposerIndex: block.ProposerIndex,
+		ParentRoot:    bytesutil.SafeCopyBytes(block.ParentRoot),
+		StateRoot:     bytesutil.SafeCopyBytes(block.StateRoot),
+		Body:          CopyBeaconBlockBodyAltair(block.Body),
+	}
+}
+
+// CopyBeaconBlockBodyAltair copies the provided BeaconBlockBodyAltair.
+func CopyBeaconBlockBodyAltair(body *prysmv2.BeaconBlockBodyAltair) *prysmv2.BeaconBlockBodyAltair {
+	if body == nil {
+		return nil
+	}
+	return &prysmv2.BeaconBlockBodyAltair{
+		RandaoReveal:      bytesutil.SafeCopyBytes(body.RandaoReveal),
+		Eth1Data:          CopyETH1Data(body.Eth1Data),
+		Graffiti:          bytesutil.SafeCopyBytes(body.Graffiti),
+		ProposerSlashings: CopyProposerSlashings(body.ProposerSlashings),
+		AttesterSlashings: CopyAttesterSlashings(body.AttesterSlashings),
+		Attestations:      CopyAttestations(body.Attestations),
+		Deposits:          CopyDeposits(body.Deposits),
+		VoluntaryExits:    CopySignedVoluntaryExits(body.VoluntaryExits),
+		SyncAggregate:     CopySyncAggregate(body.SyncAggregate),
+	}
+}
+
+// CopySyncAggregate copies the provided sync aggregate object.
+func CopySyncAggregate(a *prysmv2.SyncAggregate) *prysmv2.SyncAggregate {
+	if a == nil {
+		return nil
+	}
+	return &prysmv2.SyncAggregate{
+		SyncCommitteeBits:      bytesutil.SafeCopyBytes(a.SyncCommitteeBits),
+		SyncCommitteeSignature: bytesutil.SafeCopyBytes(a.SyncCommitteeSignature),
+	}
+}
@@ -50,6 +50,8 @@ type Flags struct {
 	ProposerAttsSelectionUsingMaxCover bool // ProposerAttsSelectionUsingMaxCover enables max-cover algorithm when selecting attestations for proposing.
 	EnableOptimizedBalanceUpdate       bool // EnableOptimizedBalanceUpdate uses an updated method of performing balance updates.
 	EnableDoppelGanger                 bool // EnableDoppelGanger enables doppelganger protection on startup for the validator.
+	EnableSlasher                      bool // EnableSlasher enables a slasher in the beacon node.
+	EnableHierarchicalStateDiffs       bool // EnableHierarchicalStateDiffs archives finalized states as hierarchical state diffs.
 	// Logging related toggles.
 	DisableGRPCConnectionLogs bool // Disables logging when a new grpc client has connected.
 
@@ -196,6 +198,14 @@ func ConfigureBeaconChain(ctx *cli.Context) {
 		log.WithField(enableOptimizedBalanceUpdate.Name, enableOptimizedBalanceUpdate.Usage).Warn(enabledFeatureFlag)
 		cfg.EnableOptimizedBalanceUpdate = true
 	}
+	if ctx.Bool(enableSlasherFlag.Name) {
+		log.WithField(enableSlasherFlag.Name, enableSlasherFlag.Usage).Warn(enabledFeatureFlag)
+		cfg.EnableSlasher = true
+	}
+	if ctx.Bool(enableHierarchicalStateDiffs.Name) {
+		log.WithField(enableHierarchicalStateDiffs.Name, enableHierarchicalStateDiffs.Usage).Warn(enabledFeatureFlag)
+		cfg.EnableHierarchicalStateDiffs = true
+	}
 	Init(cfg)
 }
 
@@ -122,6 +122,15 @@ var (
 		Name:  "enable-optimized-balance-update",
 		Usage: "Enables the optimized method of updating validator balances.",
 	}
+	enableSlasherFlag = &cli.BoolFlag{
+		Name:  "slasher",
+		Usage: "Enables a slasher in the beacon node for detecting slashable offenses",
+	}
+	enableHierarchicalStateDiffs = &cli.BoolFlag{
+		Name: "enable-hierarchical-state-diffs",
+		Usage: "Enables archiving finalized states as hierarchical state diffs in addition to archived points, " +
+			"which allows historical epoch boundary states to be rebuilt without replaying blocks",
+	}
 	enableDoppelGangerProtection = &cli.BoolFlag{
 		Name: "enable-doppelganger",
 		Usage: "Enables the validator to perform a doppelganger check. (Warning): This is not " +
@@ -189,6 +198,8 @@ var BeaconChainFlags = append(deprecatedFlags, []cli.Flag{
 	updateHeadTimely,
 	disableProposerAttsSelectionUsingMaxCover,
 	enableOptimizedBalanceUpdate,
+	enableSlasherFlag,
+	enableHierarchicalStateDiffs,
 }...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
@@ -58,6 +58,10 @@ type BeaconChainConfig struct {
 	Eth1FollowDistance               uint64      `yaml:"ETH1_FOLLOW_DISTANCE" spec:"true"`                // Eth1FollowDistance is the number of eth1.0 blocks to wait before considering a new deposit for voting. This only applies after the chain as been started.
 	SafeSlotsToUpdateJustified       types.Slot  `yaml:"SAFE_SLOTS_TO_UPDATE_JUSTIFIED" spec:"true"`      // SafeSlotsToUpdateJustified is the minimal slots needed to update justified check point.
 	SecondsPerETH1Block              uint64      `yaml:"SECONDS_PER_ETH1_BLOCK" spec:"true"`              // SecondsPerETH1Block is the approximate time for a single eth1 block to be produced.
+	IntervalsPerSlot                 uint64      `yaml:"INTERVALS_PER_SLOT" spec:"true"`                  // IntervalsPerSlot is the number of intervals a slot is divided into by the fork choice, a block is timely if it arrives within the first interval.
+
+	// Fork choice parameters.
+	ProposerScoreBoost uint64 `yaml:"PROPOSER_SCORE_BOOST" spec:"true"` // ProposerScoreBoost is the percentage of the committee weight given to a timely block of the current slot.
 
 	// Ethereum PoW parameters.
 	DepositChainID         uint64 `yaml:"DEPOSIT_CHAIN_ID" spec:"true"`         // DepositChainID of the eth1 network. This used for replay protection.
@@ -98,21 +102,54 @@ type BeaconChainConfig struct {
 	DomainSelectionProof    [4]byte `yaml:"DOMAIN_SELECTION_PROOF" spec:"true"`     // DomainSelectionProof defines the BLS signature domain for selection proof.
 	DomainAggregateAndProof [4]byte `yaml:"DOMAIN_AGGREGATE_AND_PROOF" spec:"true"` // DomainAggregateAndProof defines the BLS signature domain for aggregate and proof.
 
+	// Altair BLS domain values.
+	DomainSyncCommittee               [4]byte `yaml:"DOMAIN_SYNC_COMMITTEE"`                 // DomainSyncCommittee defines the BLS signature domain for sync committee messages.
+	DomainSyncCommitteeSelectionProof [4]byte `yaml:"DOMAIN_SYNC_COMMITTEE_SELECTION_PROOF"` // DomainSyncCommitteeSelectionProof defines the BLS signature domain for sync committee selection proofs.
+	DomainContributionAndProof        [4]byte `yaml:"DOMAIN_CONTRIBUTION_AND_PROOF"`         // DomainContributionAndProof defines the BLS signature domain for contribution and proof.
+
+	// Altair participation flag indices and incentivization weights.
+	TimelySourceFlagIndex uint8  `yaml:"TIMELY_SOURCE_FLAG_INDEX"` // TimelySourceFlagIndex is the source flag position of the participation bits.
+	TimelyTargetFlagIndex uint8  `yaml:"TIMELY_TARGET_FLAG_INDEX"` // TimelyTargetFlagIndex is the target flag position of the participation bits.
+	TimelyHeadFlagIndex   uint8  `yaml:"TIMELY_HEAD_FLAG_INDEX"`   // TimelyHeadFlagIndex is the head flag position of the participation bits.
+	TimelySourceWeight    uint64 `yaml:"TIMELY_SOURCE_WEIGHT"`     // TimelySourceWeight is the factor of how much source rewards receives.
+	TimelyTargetWeight    uint64 `yaml:"TIMELY_TARGET_WEIGHT"`     // TimelyTargetWeight is the factor of how much target rewards receives.
+	TimelyHeadWeight      uint64 `yaml:"TIMELY_HEAD_WEIGHT"`       // TimelyHeadWeight is the factor of how much head rewards receives.
+	SyncRewardWeight      uint64 `yaml:"SYNC_REWARD_WEIGHT"`       // SyncRewardWeight is the factor of how much sync committee rewards receives.
+	ProposerWeight        uint64 `yaml:"PROPOSER_WEIGHT"`          // ProposerWeight is the factor of how much proposer rewards receives.
+	WeightDenominator     uint64 `yaml:"WEIGHT_DENOMINATOR"`       // WeightDenominator accounts for total rewards denomination.
+
+	// Altair misc and state list length constants.
+	SyncCommitteeSize            uint64      `yaml:"SYNC_COMMITTEE_SIZE"`              // SyncCommitteeSize for light client sync committee size.
+	EpochsPerSyncCommitteePeriod types.Epoch `yaml:"EPOCHS_PER_SYNC_COMMITTEE_PERIOD"` // EpochsPerSyncCommitteePeriod defines how many epochs per sync committee period.
+	InactivityScoreBias          uint64      `yaml:"INACTIVITY_SCORE_BIAS"`            // InactivityScoreBias for calculating score bias penalties during inactivity.
+	InactivityScoreRecoveryRate  uint64      `yaml:"INACTIVITY_SCORE_RECOVERY_RATE"`   // InactivityScoreRecoveryRate for recovering score bias penalties during inactivity.
+	MinSyncCommitteeParticipants uint64      `yaml:"MIN_SYNC_COMMITTEE_PARTICIPANTS"`  // MinSyncCommitteeParticipants defines the minimum amount of sync committee participants for which the light client acknowledges the signature.
+
+	// Altair validator constants.
+	TargetAggregatorsPerSyncSubcommittee uint64 `yaml:"TARGET_AGGREGATORS_PER_SYNC_SUBCOMMITTEE"` // TargetAggregatorsPerSyncSubcommittee for aggregating in sync committee.
+	SyncCommitteeSubnetCount             uint64 `yaml:"SYNC_COMMITTEE_SUBNET_COUNT"`              // SyncCommitteeSubnetCount for sync committee subnet count.
+
+	// Altair reward and penalty quotients constants.
+	InactivityPenaltyQuotientAltair      uint64 `yaml:"INACTIVITY_PENALTY_QUOTIENT_ALTAIR"`      // InactivityPenaltyQuotientAltair for penalties during inactivity post Altair hard fork.
+	MinSlashingPenaltyQuotientAltair     uint64 `yaml:"MIN_SLASHING_PENALTY_QUOTIENT_ALTAIR"`    // MinSlashingPenaltyQuotientAltair for slashing penalties post Altair hard fork.
+	ProportionalSlashingMultiplierAltair uint64 `yaml:"PROPORTIONAL_SLASHING_MULTIPLIER_ALTAIR"` // ProportionalSlashingMultiplierAltair for slashing penalties multiplier post Altair hard fork.
+
 	// Prysm constants.
-	GweiPerEth                uint64        // GweiPerEth is the amount of gwei corresponding to 1 eth.
-	BLSSecretKeyLength        int           // BLSSecretKeyLength defines the expected length of BLS secret keys in bytes.
-	BLSPubkeyLength           int           // BLSPubkeyLength defines the expected length of BLS public keys in bytes.
-	BLSSignatureLength        int           // BLSSignatureLength defines the expected length of BLS signatures in bytes.
-	DefaultBufferSize         int           // DefaultBufferSize for channels across the Prysm repository.
-	ValidatorPrivkeyFileName  string        // ValidatorPrivKeyFileName specifies the string name of a validator private key file.
-	WithdrawalPrivkeyFileName string        // WithdrawalPrivKeyFileName specifies the string name of a withdrawal private key file.
-	RPCSyncCheck              time.Duration // Number of seconds to query the sync service, to find out if the node is synced or not.
-	EmptySignature            [96]byte      // EmptySignature is used to represent a zeroed out BLS Signature.
-	DefaultPageSize           int           // DefaultPageSize defines the default page size for RPC server request.
-	MaxPeersToSync            int           // MaxPeersToSync describes the limit for number of peers in round robin sync.
-	SlotsPerArchivedPoint     types.Slot    // SlotsPerArchivedPoint defines the number of slots per one archived point.
-	GenesisCountdownInterval  time.Duration // How often to log the countdown until the genesis time is reached.
-	BeaconStateFieldCount     int           // BeaconStateFieldCount defines how many fields are in beacon state.
+	GweiPerEth                  uint64        // GweiPerEth is the amount of gwei corresponding to 1 eth.
+	BLSSecretKeyLength          int           // BLSSecretKeyLength defines the expected length of BLS secret keys in bytes.
+	BLSPubkeyLength             int           // BLSPubkeyLength defines the expected length of BLS public keys in bytes.
+	BLSSignatureLength          int           // BLSSignatureLength defines the expected length of BLS signatures in bytes.
+	DefaultBufferSize           int           // DefaultBufferSize for channels across the Prysm repository.
+	ValidatorPrivkeyFileName    string        // ValidatorPrivKeyFileName specifies the string name of a validator private key file.
+	WithdrawalPrivkeyFileName   string        // WithdrawalPrivKeyFileName specifies the string name of a withdrawal private key file.
+	RPCSyncCheck                time.Duration // Number of seconds to query the sync service, to find out if the node is synced or not.
+	EmptySignature              [96]byte      // EmptySignature is used to represent a zeroed out BLS Signature.
+	DefaultPageSize             int           // DefaultPageSize defines the default page size for RPC server request.
+	MaxPeersToSync              int           // MaxPeersToSync describes the limit for number of peers in round robin sync.
+	SlotsPerArchivedPoint       types.Slot    // SlotsPerArchivedPoint defines the number of slots per one archived point.
+	GenesisCountdownInterval    time.Duration // How often to log the countdown until the genesis time is reached.
+	BeaconStateFieldCount       int           // BeaconStateFieldCount defines how many fields are in beacon state.
+	BeaconStateAltairFieldCount int           // BeaconStateAltairFieldCount defines how many fields are in the Altair beacon state.
 
 	// Slasher constants.
 	WeakSubjectivityPeriod    types.Epoch // WeakSubjectivityPeriod defines the time period expressed in number of epochs were proof of stake network should validate block headers and attestations for slashable events.
@@ -126,6 +163,8 @@ type BeaconChainConfig struct {
 	NextForkVersion     []byte                 `yaml:"NEXT_FORK_VERSION"`                // NextForkVersion is used to track the upcoming fork version, if any.
 	NextForkEpoch       types.Epoch            `yaml:"NEXT_FORK_EPOCH"`                  // NextForkEpoch is used to track the epoch of the next fork, if any.
 	ForkVersionSchedule map[types.Epoch][]byte // Schedule of fork versions by epoch number.
+	AltairForkVersion   []byte                 `yaml:"ALTAIR_FORK_VERSION"` // AltairForkVersion is used to represent the fork version for Altair.
+	AltairForkEpoch     types.Epoch            `yaml:"ALTAIR_FORK_EPOCH"`   // AltairForkEpoch is used to represent the assigned fork epoch for Altair.
 
 	// Weak subjectivity values.
 	SafetyDecay uint64 // SafetyDecay is defined as the loss in the 1/3 consensus safety margin of the casper FFG mechanism.
@@ -30,6 +30,7 @@ var mainnetNetworkConfig = &NetworkConfig{
 	MessageDomainValidSnappy:        [4]byte{01, 00, 00, 00},
 	ETH2Key:                         "eth2",
 	AttSubnetKey:                    "attnets",
+	SyncCommsSubnetKey:              "syncnets",
 	MinimumPeersInSubnet:            4,
 	MinimumPeersInSubnetSearch:      20,
 	ContractDeploymentBlock:         11184524, // Note: contract was deployed in block 11052984 but no transactions were sent until 11184524.
@@ -114,6 +115,10 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	// Bug prompting this change: https://github.com/prysmaticlabs/prysm/issues/7856
 	// Future optimization: https://github.com/prysmaticlabs/prysm/issues/7739
 	SecondsPerETH1Block: 14,
+	IntervalsPerSlot:    3,
+
+	// Fork choice parameters.
+	ProposerScoreBoost: 70,
 
 	// State list length constants.
 	EpochsPerHistoricalVector: 65536,
@@ -145,22 +150,55 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	DomainSelectionProof:    bytesutil.ToBytes4(bytesutil.Bytes4(5)),
 	DomainAggregateAndProof: bytesutil.ToBytes4(bytesutil.Bytes4(6)),
 
+	// Altair BLS domain values.
+	DomainSyncCommittee:               bytesutil.ToBytes4(bytesutil.Bytes4(7)),
+	DomainSyncCommitteeSelectionProof: bytesutil.ToBytes4(bytesutil.Bytes4(8)),
+	DomainContributionAndProof:        bytesutil.ToBytes4(bytesutil.Bytes4(9)),
+
+	// Altair participation flag indices and incentivization weights.
+	TimelySourceFlagIndex: 0,
+	TimelyTargetFlagIndex: 1,
+	TimelyHeadFlagIndex:   2,
+	TimelySourceWeight:    14,
+	TimelyTargetWeight:    26,
+	TimelyHeadWeight:      14,
+	SyncRewardWeight:      2,
+	ProposerWeight:        8,
+	WeightDenominator:     64,
+
+	// Altair misc and state list length constants.
+	SyncCommitteeSize:            512,
+	EpochsPerSyncCommitteePeriod: 256,
+	InactivityScoreBias:          4,
+	InactivityScoreRecoveryRate:  16,
+	MinSyncCommitteeParticipants: 1,
+
+	// Altair validator constants.
+	TargetAggregatorsPerSyncSubcommittee: 16,
+	SyncCommitteeSubnetCount:             4,
+
+	// Altair reward and penalty quotients constants.
+	InactivityPenaltyQuotientAltair:      3 * 1 << 24, // 50331648
+	MinSlashingPenaltyQuotientAltair:     64,
+	ProportionalSlashingMultiplierAltair: 2,
+
 	// Prysm constants.
-	GweiPerEth:                1000000000,
-	BLSSecretKeyLength:        32,
-	BLSPubkeyLength:           48,
-	BLSSignatureLength:        96,
-	DefaultBufferSize:         10000,
-	WithdrawalPrivkeyFileName: "/shardwithdrawalkey",
-	ValidatorPrivkeyFileName:  "/validatorprivatekey",
-	RPCSyncCheck:              1,
-	EmptySignature:            [96]byte{},
-	DefaultPageSize:           250,
-	MaxPeersToSync:            15,
-	SlotsPerArchivedPoint:     2048,
-	GenesisCountdownInterval:  time.Minute,
-	ConfigName:                ConfigNames[Mainnet],
-	BeaconStateFieldCount:     21,
+	GweiPerEth:                  1000000000,
+	BLSSecretKeyLength:          32,
+	BLSPubkeyLength:             48,
+	BLSSignatureLength:          96,
+	DefaultBufferSize:           10000,
+	WithdrawalPrivkeyFileName:   "/shardwithdrawalkey",
+	ValidatorPrivkeyFileName:    "/validatorprivatekey",
+	RPCSyncCheck:                1,
+	EmptySignature:              [96]byte{},
+	DefaultPageSize:             250,
+	MaxPeersToSync:              15,
+	SlotsPerArchivedPoint:       2048,
+	GenesisCountdownInterval:    time.Minute,
+	ConfigName:                  ConfigNames[Mainnet],
+	BeaconStateFieldCount:       21,
+	BeaconStateAltairFieldCount: 24,
 
 	// Slasher related values.
 	WeakSubjectivityPeriod:          54000,
@@ -177,4 +215,6 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	ForkVersionSchedule: map[types.Epoch][]byte{
 		// Any further forks must be specified here by their epoch number.
 	},
+	AltairForkVersion: []byte{1, 0, 0, 0},
+	AltairForkEpoch:   1<<64 - 1, // Set to FarFutureEpoch until the Altair fork is scheduled.
 }
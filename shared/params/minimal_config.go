@@ -75,6 +75,15 @@ func MinimalSpecConfig() *BeaconChainConfig {
 	minimalConfig.DomainVoluntaryExit = bytesutil.ToBytes4(bytesutil.Bytes4(4))
 	minimalConfig.GenesisForkVersion = []byte{0, 0, 0, 1}
 
+	// Altair
+	minimalConfig.SyncCommitteeSize = 32
+	minimalConfig.EpochsPerSyncCommitteePeriod = 8
+	minimalConfig.InactivityPenaltyQuotientAltair = 3 * 1 << 24
+	minimalConfig.MinSlashingPenaltyQuotientAltair = 64
+	minimalConfig.ProportionalSlashingMultiplierAltair = 2
+	minimalConfig.AltairForkVersion = []byte{1, 0, 0, 1}
+	minimalConfig.AltairForkEpoch = 1<<64 - 1
+
 	minimalConfig.DepositContractTreeDepth = 32
 	minimalConfig.FarFutureEpoch = 1<<64 - 1
 	minimalConfig.FarFutureSlot = 1<<64 - 1
@@ -0,0 +1,135 @@
+package trieutil
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+// Snapshot returns the finalized part of the trie for its first count items. The snapshot
+// only holds the roots of the largest complete subtrees covering those items, which is all
+// that is needed to keep inserting items and computing the root of the trie. The eth1 block
+// of the snapshot is left for the caller to fill in.
+func (m *SparseMerkleTrie) Snapshot(count uint64) (*protodb.DepositSnapshot, error) {
+	if count > uint64(m.NumOfItems()) {
+		return nil, fmt.Errorf("cannot snapshot %d items of a trie with %d items", count, m.NumOfItems())
+	}
+	finalized := make([][]byte, 0)
+	for i := int(m.depth); i >= 0; i-- {
+		if (count>>uint(i))&1 == 1 {
+			node := bytesutil.ToBytes32(m.branches[i][(count>>uint(i))-1])
+			finalized = append(finalized, node[:])
+		}
+	}
+	root, err := snapshotRoot(finalized, count, uint64(m.depth), nil)
+	if err != nil {
+		return nil, err
+	}
+	return &protodb.DepositSnapshot{
+		Finalized:    finalized,
+		DepositRoot:  root[:],
+		DepositCount: count,
+	}, nil
+}
+
+// TrieFromSnapshot creates a trie from a deposit snapshot, which new items can be inserted
+// into. Nodes below the finalized subtrees are unknown, so Merkle proofs can only be computed
+// for the items inserted after the snapshot.
+func TrieFromSnapshot(snapshot *protodb.DepositSnapshot, depth uint64) (*SparseMerkleTrie, error) {
+	if snapshot == nil {
+		return nil, errors.New("nil deposit snapshot")
+	}
+	count := snapshot.DepositCount
+	if count == 0 {
+		return NewTrie(depth)
+	}
+	if depth >= 64 || count > 1<<depth {
+		return nil, fmt.Errorf("deposit count %d does not fit in a trie of depth %d", count, depth)
+	}
+	layers := make([][][]byte, depth+1)
+	for i := range layers {
+		size := ((count - 1) >> uint(i)) + 1
+		layers[i] = make([][]byte, size)
+		for j := range layers[i] {
+			layers[i][j] = ZeroHashes[i][:]
+		}
+	}
+	root, err := snapshotRoot(snapshot.Finalized, count, depth, layers)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(root[:], snapshot.DepositRoot) {
+		return nil, fmt.Errorf("deposit snapshot root %#x does not match the finalized nodes root %#x", snapshot.DepositRoot, root)
+	}
+	items := make([][]byte, count)
+	for i := range items {
+		items[i] = ZeroHashes[0][:]
+	}
+	// The last item is only known if it is a finalized node on its own. This also keeps a
+	// snapshot of a single item from being taken for an empty trie.
+	if count&1 == 1 {
+		items[count-1] = layers[0][count-1]
+	}
+	return &SparseMerkleTrie{
+		depth:         uint(depth),
+		branches:      layers,
+		originalItems: items,
+	}, nil
+}
+
+// snapshotRoot computes the deposit root of finalized nodes, ordered from the largest subtree
+// to the smallest, with every item after the first count ones being empty. When layers is not
+// nil, the finalized nodes and the nodes of the branch of the first empty item are set in it.
+func snapshotRoot(finalized [][]byte, count, depth uint64, layers [][][]byte) ([32]byte, error) {
+	nodes := make(map[uint64][]byte, len(finalized))
+	next := 0
+	for i := int(depth); i >= 0; i-- {
+		if (count>>uint(i))&1 == 0 {
+			continue
+		}
+		if next >= len(finalized) {
+			return [32]byte{}, fmt.Errorf("deposit snapshot has %d finalized nodes, fewer than needed for %d deposits", len(finalized), count)
+		}
+		if len(finalized[next]) != 32 {
+			return [32]byte{}, fmt.Errorf("finalized node %d has length %d, expected 32", next, len(finalized[next]))
+		}
+		nodes[uint64(i)] = finalized[next]
+		next++
+	}
+	if next != len(finalized) {
+		return [32]byte{}, fmt.Errorf("deposit snapshot has %d finalized nodes, more than needed for %d deposits", len(finalized), count)
+	}
+
+	var node [32]byte
+	if top, ok := nodes[depth]; ok {
+		// The trie is full.
+		node = bytesutil.ToBytes32(top)
+	} else {
+		node = ZeroHashes[0]
+		for i := uint64(0); i < depth; i++ {
+			idx := count >> i
+			if layers != nil && idx < uint64(len(layers[i])) {
+				layers[i][idx] = bytesutil.SafeCopyBytes(node[:])
+			}
+			if left, ok := nodes[i]; ok {
+				if layers != nil {
+					layers[i][idx-1] = bytesutil.SafeCopyBytes(left)
+				}
+				node = hashutil.Hash(append(bytesutil.SafeCopyBytes(left), node[:]...))
+			} else {
+				node = hashutil.Hash(append(node[:], ZeroHashes[i][:]...))
+			}
+		}
+	}
+	if layers != nil {
+		layers[depth][0] = bytesutil.SafeCopyBytes(node[:])
+	}
+	enc := [32]byte{}
+	binary.LittleEndian.PutUint64(enc[:], count)
+	return hashutil.Hash(append(node[:], enc[:]...)), nil
+}
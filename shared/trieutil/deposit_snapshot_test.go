@@ -0,0 +1,99 @@
+package trieutil
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func snapshotTestItems(n int) [][]byte {
+	items := make([][]byte, n)
+	for i := range items {
+		items[i] = []byte(fmt.Sprintf("item %d", i))
+	}
+	return items
+}
+
+func TestSparseMerkleTrie_SnapshotRoundTrip(t *testing.T) {
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	items := snapshotTestItems(13)
+	full, err := GenerateTrieFromItems(items, depth)
+	require.NoError(t, err)
+
+	for count := 1; count <= len(items); count++ {
+		partial, err := GenerateTrieFromItems(items[:count], depth)
+		require.NoError(t, err)
+		snapshot, err := full.Snapshot(uint64(count))
+		require.NoError(t, err)
+		want := partial.HashTreeRoot()
+		assert.DeepEqual(t, want[:], snapshot.DepositRoot, "wrong root for %d items", count)
+
+		trie, err := TrieFromSnapshot(snapshot, depth)
+		require.NoError(t, err)
+		assert.Equal(t, count, trie.NumOfItems())
+		assert.Equal(t, want, trie.HashTreeRoot())
+		for i := count; i < len(items); i++ {
+			trie.Insert(items[i], i)
+		}
+		assert.Equal(t, full.HashTreeRoot(), trie.HashTreeRoot(), "wrong root after inserting from %d items", count)
+		root := trie.HashTreeRoot()
+		for i := count; i < len(items); i++ {
+			proof, err := trie.MerkleProof(i)
+			require.NoError(t, err)
+			assert.Equal(t, true, VerifyMerkleBranch(root[:], items[i], i, proof, depth), "invalid proof of item %d from %d items", i, count)
+		}
+
+		// Snapshots of a trie created from a snapshot match the ones of the full trie.
+		again, err := trie.Snapshot(uint64(len(items)))
+		require.NoError(t, err)
+		expected, err := full.Snapshot(uint64(len(items)))
+		require.NoError(t, err)
+		assert.DeepEqual(t, expected.Finalized, again.Finalized)
+	}
+}
+
+func TestSparseMerkleTrie_SnapshotEmpty(t *testing.T) {
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	empty, err := NewTrie(depth)
+	require.NoError(t, err)
+	snapshot, err := empty.Snapshot(0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(snapshot.Finalized))
+	want := empty.HashTreeRoot()
+	assert.DeepEqual(t, want[:], snapshot.DepositRoot)
+
+	trie, err := TrieFromSnapshot(snapshot, depth)
+	require.NoError(t, err)
+	assert.Equal(t, 0, trie.NumOfItems())
+
+	_, err = empty.Snapshot(1)
+	assert.ErrorContains(t, "cannot snapshot 1 items", err)
+}
+
+func TestTrieFromSnapshot_Invalid(t *testing.T) {
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	full, err := GenerateTrieFromItems(snapshotTestItems(6), depth)
+	require.NoError(t, err)
+
+	snapshot, err := full.Snapshot(6)
+	require.NoError(t, err)
+	snapshot.DepositRoot = make([]byte, 32)
+	_, err = TrieFromSnapshot(snapshot, depth)
+	assert.ErrorContains(t, "does not match the finalized nodes root", err)
+
+	snapshot, err = full.Snapshot(6)
+	require.NoError(t, err)
+	snapshot.DepositCount = 7
+	_, err = TrieFromSnapshot(snapshot, depth)
+	assert.ErrorContains(t, "fewer than needed for 7 deposits", err)
+
+	snapshot.DepositCount = 4
+	_, err = TrieFromSnapshot(snapshot, depth)
+	assert.ErrorContains(t, "more than needed for 4 deposits", err)
+
+	_, err = TrieFromSnapshot(nil, depth)
+	assert.ErrorContains(t, "nil deposit snapshot", err)
+}
@@ -0,0 +1,11 @@
+package rewards
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/spectest/shared/altair/rewards"
+)
+
+func TestMainnet_Altair_Rewards(t *testing.T) {
+	rewards.RunPrecomputeRewardsAndPenaltiesTests(t, "mainnet")
+}
@@ -0,0 +1,11 @@
+package operations
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/spectest/shared/altair/operations"
+)
+
+func TestMinimal_Altair_Operations_SyncCommittee(t *testing.T) {
+	operations.RunSyncCommitteeTest(t, "minimal")
+}
@@ -0,0 +1,42 @@
+package epoch_processing
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/spectest/utils"
+)
+
+// RunJustificationAndFinalizationTests executes "epoch_processing/justification_and_finalization" tests.
+func RunJustificationAndFinalizationTests(t *testing.T, config string) {
+	require.NoError(t, utils.SetConfig(t, config))
+
+	testPath := "epoch_processing/justification_and_finalization/pyspec_tests"
+	testFolders, testsFolderPath := utils.TestFolders(t, config, "altair", testPath)
+	for _, folder := range testFolders {
+		helpers.ClearCache()
+		t.Run(folder.Name(), func(t *testing.T) {
+			folderPath := path.Join(testsFolderPath, folder.Name())
+			RunEpochOperationTest(t, folderPath, processJustificationAndFinalizationPrecomputeWrapper)
+		})
+	}
+}
+
+func processJustificationAndFinalizationPrecomputeWrapper(t *testing.T, st iface.BeaconState) (iface.BeaconState, error) {
+	ctx := context.Background()
+	vp, bp, err := altair.InitializeEpochValidators(ctx, st)
+	require.NoError(t, err)
+	_, bp, err = altair.ProcessEpochParticipation(ctx, st, bp, vp)
+	require.NoError(t, err)
+
+	st, err = precompute.ProcessJustificationAndFinalizationPreCompute(st, bp)
+	require.NoError(t, err, "Could not process justification")
+
+	return st, nil
+}
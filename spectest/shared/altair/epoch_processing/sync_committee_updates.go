@@ -0,0 +1,30 @@
+package epoch_processing
+
+import (
+	"path"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/spectest/utils"
+)
+
+// RunSyncCommitteeUpdatesTests executes "epoch_processing/sync_committee_updates" tests.
+func RunSyncCommitteeUpdatesTests(t *testing.T, config string) {
+	require.NoError(t, utils.SetConfig(t, config))
+
+	testFolders, testsFolderPath := utils.TestFolders(t, config, "altair", "epoch_processing/sync_committee_updates/pyspec_tests")
+	for _, folder := range testFolders {
+		t.Run(folder.Name(), func(t *testing.T) {
+			folderPath := path.Join(testsFolderPath, folder.Name())
+			RunEpochOperationTest(t, folderPath, processSyncCommitteeUpdatesWrapper)
+		})
+	}
+}
+
+func processSyncCommitteeUpdatesWrapper(t *testing.T, state iface.BeaconState) (iface.BeaconState, error) {
+	state, err := altair.ProcessSyncCommitteeUpdates(state)
+	require.NoError(t, err, "Could not process sync committee updates")
+	return state, nil
+}
@@ -0,0 +1,6 @@
+package sanity
+
+// SanityConfig --
+type SanityConfig struct {
+	BlocksCount int `json:"blocks_count"`
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const forkChoicePath = "/eth/v1/debug/fork_choice"
+
+// forkChoiceResponse is the JSON response of the fork choice debug endpoint of the beacon node API.
+type forkChoiceResponse struct {
+	Data *forkChoice `json:"data"`
+}
+
+type forkChoice struct {
+	JustifiedEpoch string            `json:"justified_epoch"`
+	FinalizedEpoch string            `json:"finalized_epoch"`
+	Nodes          []*forkChoiceNode `json:"nodes"`
+	Votes          []*forkChoiceVote `json:"votes"`
+}
+
+type forkChoiceNode struct {
+	Slot           string `json:"slot"`
+	Root           string `json:"root"`
+	ParentRoot     string `json:"parent_root"`
+	JustifiedEpoch string `json:"justified_epoch"`
+	FinalizedEpoch string `json:"finalized_epoch"`
+	Weight         string `json:"weight"`
+	BestChild      string `json:"best_child"`
+	Canonical      bool   `json:"canonical"`
+}
+
+type forkChoiceVote struct {
+	NextRoot string `json:"next_root"`
+}
+
+// fetchForkChoice reads the fork choice dump either from a file or from the API of a beacon node.
+func fetchForkChoice(inputPath, endpoint string) (*forkChoice, error) {
+	var raw []byte
+	var err error
+	if inputPath != "" {
+		raw, err = ioutil.ReadFile(inputPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read fork choice file")
+		}
+	} else {
+		resp, err := http.Get(strings.TrimSuffix(endpoint, "/") + forkChoicePath)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not request fork choice")
+		}
+		defer func() {
+			if err := resp.Body.Close(); err != nil {
+				log.WithError(err).Error("Could not close response body")
+			}
+		}()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("unexpected status code %d, make sure the beacon node runs with debug endpoints enabled", resp.StatusCode)
+		}
+		raw, err = ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read response body")
+		}
+	}
+	fc := &forkChoiceResponse{}
+	if err := json.Unmarshal(raw, fc); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal fork choice")
+	}
+	if fc.Data == nil {
+		return nil, errors.New("no fork choice data")
+	}
+	return fc.Data, nil
+}
+
+// writeForkChoiceDOT writes the fork choice tree in the Graphviz DOT format. Every node points to its
+// parent, canonical nodes are filled and the edges from best children are drawn in bold.
+func writeForkChoiceDOT(w io.Writer, fc *forkChoice) error {
+	votes := make(map[string]int)
+	for _, v := range fc.Votes {
+		votes[v.NextRoot]++
+	}
+
+	var b strings.Builder
+	b.WriteString("digraph forkchoice {\n")
+	b.WriteString("\trankdir=RL;\n")
+	b.WriteString("\tnode [shape=box, style=rounded];\n")
+	fmt.Fprintf(&b, "\tlabel=\"justified epoch %s, finalized epoch %s\";\n", fc.JustifiedEpoch, fc.FinalizedEpoch)
+	bestChildren := make(map[string]bool, len(fc.Nodes))
+	for _, n := range fc.Nodes {
+		bestChildren[n.BestChild] = true
+	}
+	for _, n := range fc.Nodes {
+		style := ""
+		if n.Canonical {
+			style = ", style=\"rounded,filled\", fillcolor=lightblue"
+		}
+		fmt.Fprintf(
+			&b,
+			"\t\"%s\" [label=\"slot %s\\n%s\\nweight %s\\nvotes %d\\nJ %s / F %s\"%s];\n",
+			n.Root, n.Slot, shortRoot(n.Root), n.Weight, votes[n.Root], n.JustifiedEpoch, n.FinalizedEpoch, style,
+		)
+		if n.ParentRoot == "" || n.ParentRoot == "0x" {
+			continue
+		}
+		edgeStyle := ""
+		if bestChildren[n.Root] {
+			edgeStyle = " [style=bold]"
+		}
+		fmt.Fprintf(&b, "\t\"%s\" -> \"%s\"%s;\n", n.Root, n.ParentRoot, edgeStyle)
+	}
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func shortRoot(root string) string {
+	if len(root) > 10 {
+		return root[:10]
+	}
+	return root
+}
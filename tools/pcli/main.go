@@ -30,6 +30,9 @@ func main() {
 	var expectedPostStatePath string
 	var sszPath string
 	var sszType string
+	var forkChoiceEndpoint string
+	var forkChoiceInputPath string
+	var forkChoiceOutputPath string
 
 	customFormatter := new(prefixed.TextFormatter)
 	customFormatter.TimestampFormat = "2006-01-02 15:04:05"
@@ -200,6 +203,47 @@ func main() {
 				return nil
 			},
 		},
+		{
+			Name:  "forkchoice",
+			Usage: "Export the fork choice store of a beacon node as a Graphviz DOT graph",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:        "beacon-node-endpoint",
+					Usage:       "HTTP API endpoint of a beacon node running with debug endpoints enabled",
+					Value:       "http://localhost:3500",
+					Destination: &forkChoiceEndpoint,
+				},
+				&cli.StringFlag{
+					Name:        "input-path",
+					Usage:       "Path to a fork choice dump(json) to use instead of requesting the beacon node",
+					Destination: &forkChoiceInputPath,
+				},
+				&cli.StringFlag{
+					Name:        "output-path",
+					Usage:       "Path to the output file(dot), the graph is printed if not provided",
+					Destination: &forkChoiceOutputPath,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				fc, err := fetchForkChoice(forkChoiceInputPath, forkChoiceEndpoint)
+				if err != nil {
+					log.Fatal(err)
+				}
+				out := os.Stdout
+				if forkChoiceOutputPath != "" {
+					out, err = os.Create(forkChoiceOutputPath)
+					if err != nil {
+						log.Fatal(err)
+					}
+					defer func() {
+						if err := out.Close(); err != nil {
+							log.WithError(err).Error("Could not close output file")
+						}
+					}()
+				}
+				return writeForkChoiceDOT(out, fc)
+			},
+		},
 	}
 	if err := app.Run(os.Args); err != nil {
 		log.Error(err.Error())
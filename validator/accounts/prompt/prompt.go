@@ -5,6 +5,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/logrusorgru/aurora"
 	"github.com/manifoldco/promptui"
 	"github.com/pkg/errors"
@@ -12,6 +13,8 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
 	"github.com/prysmaticlabs/prysm/shared/promptutil"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/threshold"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
 	"github.com/urfave/cli/v2"
 )
 
@@ -141,6 +144,113 @@ func InputRemoteKeymanagerConfig(cliCtx *cli.Context) (*remote.KeymanagerOpts, e
 	return newCfg, nil
 }
 
+// InputWeb3SignerKeymanagerConfig via the cli.
+func InputWeb3SignerKeymanagerConfig(cliCtx *cli.Context) (*web3signer.KeymanagerOpts, error) {
+	url := cliCtx.String(flags.Web3SignerURLFlag.Name)
+	gvr := cliCtx.String(flags.Web3SignerGenesisValidatorsRootFlag.Name)
+	log.Info("Input desired configuration")
+	var err error
+	if url == "" {
+		url, err = promptutil.ValidatePrompt(
+			os.Stdin,
+			"Remote signer URL (such as http://localhost:9000)",
+			promptutil.NotEmpty)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if gvr == "" {
+		gvr, err = promptutil.ValidatePrompt(
+			os.Stdin,
+			"Genesis validators root of the network (such as 0x4b36...)",
+			validateGenesisValidatorsRoot)
+		if err != nil {
+			return nil, err
+		}
+	} else if err := validateGenesisValidatorsRoot(gvr); err != nil {
+		return nil, err
+	}
+	newCfg := &web3signer.KeymanagerOpts{
+		BaseEndpoint:          strings.TrimRight(url, "\r\n"),
+		GenesisValidatorsRoot: strings.TrimRight(gvr, "\r\n"),
+	}
+	fmt.Printf("%s\n", newCfg)
+	return newCfg, nil
+}
+
+// InputThresholdKeymanagerConfig via the cli, read from a keymanager options file.
+func InputThresholdKeymanagerConfig(cliCtx *cli.Context) (*threshold.KeymanagerOpts, error) {
+	configPath := cliCtx.String(flags.ThresholdKeymanagerConfigFlag.Name)
+	log.Info("Input desired configuration")
+	var err error
+	if configPath == "" {
+		configPath, err = promptutil.ValidatePrompt(
+			os.Stdin,
+			"Path to the threshold keymanager options file (such as /path/to/keymanageropts.json)",
+			promptutil.NotEmpty)
+		if err != nil {
+			return nil, err
+		}
+	}
+	fullPath, err := fileutil.ExpandPath(strings.TrimRight(configPath, "\r\n"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not determine absolute path for %s", configPath)
+	}
+	f, err := os.Open(fullPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open threshold keymanager options file")
+	}
+	newCfg, err := threshold.UnmarshalOptionsFile(f)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Printf("%s\n", newCfg)
+	return newCfg, nil
+}
+
+// InputThresholdShareKeystore via the cli, the keystore of the key share held by a threshold
+// keymanager along with its password.
+func InputThresholdShareKeystore(cliCtx *cli.Context) (*threshold.ShareKeystore, string, error) {
+	keystorePath := cliCtx.String(flags.ThresholdShareKeystoreFlag.Name)
+	var err error
+	if keystorePath == "" {
+		keystorePath, err = promptutil.ValidatePrompt(
+			os.Stdin,
+			"Path to the keystore of the key share (such as /path/to/share-keystore-1.json)",
+			promptutil.NotEmpty)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	keystore, err := threshold.ReadShareKeystoreFile(strings.TrimRight(keystorePath, "\r\n"))
+	if err != nil {
+		return nil, "", err
+	}
+	password, err := promptutil.InputPassword(
+		cliCtx,
+		flags.ThresholdSharePasswordFileFlag,
+		"Password of the key share keystore",
+		"Confirm password",
+		false, /* Do not confirm password */
+		promptutil.NotEmpty,
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	return keystore, password, nil
+}
+
+func validateGenesisValidatorsRoot(input string) error {
+	root, err := hexutil.Decode(strings.TrimRight(input, "\r\n"))
+	if err != nil {
+		return errors.Wrap(err, "genesis validators root is not valid hex")
+	}
+	if len(root) != 32 {
+		return fmt.Errorf("genesis validators root has length %d, expected 32", len(root))
+	}
+	return nil
+}
+
 func validateCertPath(input string) error {
 	if input == "" {
 		return errors.New("crt path cannot be empty")
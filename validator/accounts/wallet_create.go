@@ -18,16 +18,22 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/threshold"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
 	"github.com/urfave/cli/v2"
 )
 
 // CreateWalletConfig defines the parameters needed to call the create wallet functions.
 type CreateWalletConfig struct {
-	SkipMnemonicConfirm  bool
-	NumAccounts          int
-	RemoteKeymanagerOpts *remote.KeymanagerOpts
-	WalletCfg            *wallet.Config
-	Mnemonic25thWord     string
+	SkipMnemonicConfirm      bool
+	NumAccounts              int
+	RemoteKeymanagerOpts     *remote.KeymanagerOpts
+	Web3SignerKeymanagerOpts *web3signer.KeymanagerOpts
+	ThresholdKeymanagerOpts  *threshold.KeymanagerOpts
+	ThresholdShareKeystore   *threshold.ShareKeystore
+	ThresholdSharePassword   string
+	WalletCfg                *wallet.Config
+	Mnemonic25thWord         string
 }
 
 // CreateAndSaveWalletCli from user input with a desired keymanager. If a
@@ -116,6 +122,26 @@ func CreateWalletWithKeymanager(ctx context.Context, cfg *CreateWalletConfig) (*
 		log.WithField("--wallet-dir", cfg.WalletCfg.WalletDir).Info(
 			"Successfully created wallet with remote keymanager configuration",
 		)
+	case keymanager.Web3Signer:
+		if err = createWeb3SignerKeymanagerWallet(ctx, w, cfg.Web3SignerKeymanagerOpts); err != nil {
+			return nil, errors.Wrap(err, "could not initialize wallet")
+		}
+		log.WithField("--wallet-dir", cfg.WalletCfg.WalletDir).Info(
+			"Successfully created wallet with web3signer keymanager configuration",
+		)
+	case keymanager.Threshold:
+		if err = createThresholdKeymanagerWallet(
+			ctx,
+			w,
+			cfg.ThresholdKeymanagerOpts,
+			cfg.ThresholdShareKeystore,
+			cfg.ThresholdSharePassword,
+		); err != nil {
+			return nil, errors.Wrap(err, "could not initialize wallet")
+		}
+		log.WithField("--wallet-dir", cfg.WalletCfg.WalletDir).Info(
+			"Successfully created wallet with threshold keymanager configuration",
+		)
 	default:
 		return nil, errors.Wrapf(err, errKeymanagerNotSupported, w.KeymanagerKind())
 	}
@@ -193,6 +219,26 @@ func extractWalletCreationConfigFromCli(cliCtx *cli.Context, keymanagerKind keym
 		}
 		createWalletConfig.RemoteKeymanagerOpts = opts
 	}
+	if keymanagerKind == keymanager.Web3Signer {
+		opts, err := prompt.InputWeb3SignerKeymanagerConfig(cliCtx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not input web3signer keymanager config")
+		}
+		createWalletConfig.Web3SignerKeymanagerOpts = opts
+	}
+	if keymanagerKind == keymanager.Threshold {
+		opts, err := prompt.InputThresholdKeymanagerConfig(cliCtx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not input threshold keymanager config")
+		}
+		createWalletConfig.ThresholdKeymanagerOpts = opts
+		keystore, password, err := prompt.InputThresholdShareKeystore(cliCtx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not input threshold key share keystore")
+		}
+		createWalletConfig.ThresholdShareKeystore = keystore
+		createWalletConfig.ThresholdSharePassword = password
+	}
 	return createWalletConfig, nil
 }
 
@@ -236,6 +282,46 @@ func createDerivedKeymanagerWallet(
 	return nil
 }
 
+func createWeb3SignerKeymanagerWallet(ctx context.Context, wallet *wallet.Wallet, opts *web3signer.KeymanagerOpts) error {
+	keymanagerConfig, err := web3signer.MarshalOptionsFile(ctx, opts)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal config file")
+	}
+	if err := wallet.SaveWallet(); err != nil {
+		return errors.Wrap(err, "could not save wallet to disk")
+	}
+	if err := wallet.WriteKeymanagerConfigToDisk(ctx, keymanagerConfig); err != nil {
+		return errors.Wrap(err, "could not write keymanager config to disk")
+	}
+	return nil
+}
+
+func createThresholdKeymanagerWallet(
+	ctx context.Context,
+	wallet *wallet.Wallet,
+	opts *threshold.KeymanagerOpts,
+	shareKeystore *threshold.ShareKeystore,
+	sharePassword string,
+) error {
+	if shareKeystore == nil {
+		return errors.New("key share keystore is required")
+	}
+	keymanagerConfig, err := threshold.MarshalOptionsFile(ctx, opts)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal config file")
+	}
+	if err := wallet.SaveWallet(); err != nil {
+		return errors.Wrap(err, "could not save wallet to disk")
+	}
+	if err := wallet.WriteKeymanagerConfigToDisk(ctx, keymanagerConfig); err != nil {
+		return errors.Wrap(err, "could not write keymanager config to disk")
+	}
+	if err := threshold.ImportShareKeystore(ctx, wallet, opts, shareKeystore, sharePassword); err != nil {
+		return errors.Wrap(err, "could not import key share keystore")
+	}
+	return nil
+}
+
 func createRemoteKeymanagerWallet(ctx context.Context, wallet *wallet.Wallet, opts *remote.KeymanagerOpts) error {
 	keymanagerConfig, err := remote.MarshalOptionsFile(ctx, opts)
 	if err != nil {
@@ -260,6 +346,8 @@ func inputKeymanagerKind(cliCtx *cli.Context) (keymanager.Kind, error) {
 			wallet.KeymanagerKindSelections[keymanager.Imported],
 			wallet.KeymanagerKindSelections[keymanager.Derived],
 			wallet.KeymanagerKindSelections[keymanager.Remote],
+			wallet.KeymanagerKindSelections[keymanager.Web3Signer],
+			wallet.KeymanagerKindSelections[keymanager.Threshold],
 		},
 	}
 	selection, _, err := promptSelect.Run()
@@ -2,6 +2,7 @@ package accounts
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"io/ioutil"
 	"os"
@@ -9,15 +10,21 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/accounts/iface"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/threshold"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
 	"github.com/sirupsen/logrus"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/urfave/cli/v2"
@@ -270,3 +277,130 @@ func TestCreateWallet_Remote(t *testing.T) {
 	// We assert the created configuration was as desired.
 	assert.DeepEqual(t, wantCfg, cfg)
 }
+
+func TestCreateWallet_Web3Signer(t *testing.T) {
+	walletDir, _, walletPasswordFile := setupWalletAndPasswordsDir(t)
+	wantCfg := &web3signer.KeymanagerOpts{
+		BaseEndpoint:          "http://signer.example.com:9000",
+		GenesisValidatorsRoot: "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95",
+	}
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	keymanagerKind := "web3signer"
+	set.String(flags.WalletDirFlag.Name, walletDir, "")
+	set.String(flags.WalletPasswordFileFlag.Name, walletDir, "")
+	set.String(flags.KeymanagerKindFlag.Name, keymanagerKind, "")
+	set.String(flags.Web3SignerURLFlag.Name, wantCfg.BaseEndpoint, "")
+	set.String(flags.Web3SignerGenesisValidatorsRootFlag.Name, wantCfg.GenesisValidatorsRoot, "")
+	assert.NoError(t, set.Set(flags.WalletDirFlag.Name, walletDir))
+	assert.NoError(t, set.Set(flags.WalletPasswordFileFlag.Name, walletPasswordFile))
+	assert.NoError(t, set.Set(flags.KeymanagerKindFlag.Name, keymanagerKind))
+	assert.NoError(t, set.Set(flags.Web3SignerURLFlag.Name, wantCfg.BaseEndpoint))
+	assert.NoError(t, set.Set(flags.Web3SignerGenesisValidatorsRootFlag.Name, wantCfg.GenesisValidatorsRoot))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	// We attempt to create the wallet.
+	_, err := CreateAndSaveWalletCli(cliCtx)
+	require.NoError(t, err)
+
+	// We attempt to open the newly created wallet.
+	ctx := context.Background()
+	w, err := wallet.OpenWallet(cliCtx.Context, &wallet.Config{
+		WalletDir: walletDir,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, keymanager.Web3Signer, w.KeymanagerKind())
+
+	// We read the keymanager config for the newly created wallet.
+	encoded, err := w.ReadKeymanagerConfigFromDisk(ctx)
+	assert.NoError(t, err)
+	cfg, err := web3signer.UnmarshalOptionsFile(encoded)
+	assert.NoError(t, err)
+
+	// We assert the created configuration was as desired.
+	assert.DeepEqual(t, wantCfg, cfg)
+}
+
+func TestCreateWallet_Threshold(t *testing.T) {
+	walletDir, _, walletPasswordFile := setupWalletAndPasswordsDir(t)
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	shares, err := bls.SplitSecretKey(secretKey, 2, 2)
+	require.NoError(t, err)
+	wantCfg := &threshold.KeymanagerOpts{
+		PublicKey:  hexutil.Encode(secretKey.PublicKey().Marshal()),
+		Threshold:  2,
+		ShareIndex: 1,
+		CoSigners: []*threshold.CoSignerOpts{{
+			Index:     2,
+			URL:       "http://cosigner.example.com:7600",
+			PublicKey: hexutil.Encode(shares[1].Key.PublicKey().Marshal()),
+		}},
+	}
+	enc, err := threshold.MarshalOptionsFile(context.Background(), wantCfg)
+	require.NoError(t, err)
+	configFile := filepath.Join(t.TempDir(), "keymanageropts.json")
+	require.NoError(t, ioutil.WriteFile(configFile, enc, params.BeaconIoConfig().ReadWritePermissions))
+	// The share is imported from a keystore encrypted with another password than the wallet.
+	keystore, err := threshold.EncryptShare(shares[0], secretKey.PublicKey(), "Pa$sW0rD0__Fo0xPr")
+	require.NoError(t, err)
+	enc, err = json.Marshal(keystore)
+	require.NoError(t, err)
+	keystoreFile := filepath.Join(t.TempDir(), "share-keystore-1.json")
+	require.NoError(t, ioutil.WriteFile(keystoreFile, enc, params.BeaconIoConfig().ReadWritePermissions))
+	sharePasswordFile := filepath.Join(t.TempDir(), "share-password.txt")
+	require.NoError(t, ioutil.WriteFile(sharePasswordFile, []byte("Pa$sW0rD0__Fo0xPr"), params.BeaconIoConfig().ReadWritePermissions))
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	keymanagerKind := "threshold"
+	set.String(flags.WalletDirFlag.Name, walletDir, "")
+	set.String(flags.WalletPasswordFileFlag.Name, walletDir, "")
+	set.String(flags.KeymanagerKindFlag.Name, keymanagerKind, "")
+	set.String(flags.ThresholdKeymanagerConfigFlag.Name, configFile, "")
+	set.String(flags.ThresholdShareKeystoreFlag.Name, keystoreFile, "")
+	set.String(flags.ThresholdSharePasswordFileFlag.Name, sharePasswordFile, "")
+	assert.NoError(t, set.Set(flags.WalletDirFlag.Name, walletDir))
+	assert.NoError(t, set.Set(flags.WalletPasswordFileFlag.Name, walletPasswordFile))
+	assert.NoError(t, set.Set(flags.KeymanagerKindFlag.Name, keymanagerKind))
+	assert.NoError(t, set.Set(flags.ThresholdKeymanagerConfigFlag.Name, configFile))
+	assert.NoError(t, set.Set(flags.ThresholdShareKeystoreFlag.Name, keystoreFile))
+	assert.NoError(t, set.Set(flags.ThresholdSharePasswordFileFlag.Name, sharePasswordFile))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	// We attempt to create the wallet.
+	_, err = CreateAndSaveWalletCli(cliCtx)
+	require.NoError(t, err)
+
+	// We attempt to open the newly created wallet.
+	ctx := context.Background()
+	w, err := wallet.OpenWallet(cliCtx.Context, &wallet.Config{
+		WalletDir:      walletDir,
+		WalletPassword: password,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, keymanager.Threshold, w.KeymanagerKind())
+
+	// We read the keymanager config for the newly created wallet.
+	encoded, err := w.ReadKeymanagerConfigFromDisk(ctx)
+	assert.NoError(t, err)
+	cfg, err := threshold.UnmarshalOptionsFile(encoded)
+	assert.NoError(t, err)
+
+	// We assert the created configuration was as desired.
+	assert.DeepEqual(t, wantCfg, cfg)
+
+	// The share is stored in the wallet encrypted with the wallet password.
+	enc, err = w.ReadFileAtPath(ctx, threshold.AccountsPath, threshold.ShareKeystoreFileName)
+	require.NoError(t, err)
+	walletKeystore := &threshold.ShareKeystore{}
+	require.NoError(t, json.Unmarshal(enc, walletKeystore))
+	share, err := threshold.DecryptShare(walletKeystore, password)
+	require.NoError(t, err)
+	assert.DeepEqual(t, shares[0].Key.Marshal(), share.Key.Marshal())
+	km, err := w.InitializeKeymanager(ctx, iface.InitKeymanagerConfig{})
+	require.NoError(t, err)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][48]byte{bytesutil.ToBytes48(secretKey.PublicKey().Marshal())}, keys)
+}
@@ -0,0 +1,153 @@
+package beaconapi
+
+import (
+	"bufio"
+	"context"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// GetChainHead retrieves the head block and the finality checkpoints of the beacon node.
+func (c *Client) GetChainHead(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.ChainHead, error) {
+	headerResp := &ethpbv1.BlockHeaderResponse{}
+	if err := c.get(ctx, "/eth/v1/beacon/headers/head", headerResp); err != nil {
+		return nil, err
+	}
+	if headerResp.Data == nil || headerResp.Data.Header == nil || headerResp.Data.Header.Message == nil {
+		return nil, status.Error(codes.Internal, "Empty head block header response")
+	}
+	checkpointsResp := &ethpbv1.StateFinalityCheckpointResponse{}
+	if err := c.get(ctx, "/eth/v1/beacon/states/head/finality_checkpoints", checkpointsResp); err != nil {
+		return nil, err
+	}
+	checkpoints := checkpointsResp.Data
+	if checkpoints == nil || checkpoints.Finalized == nil || checkpoints.CurrentJustified == nil || checkpoints.PreviousJustified == nil {
+		return nil, status.Error(codes.Internal, "Empty finality checkpoints response")
+	}
+
+	finalizedSlot, err := helpers.StartSlot(checkpoints.Finalized.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute finalized slot: %v", err)
+	}
+	justifiedSlot, err := helpers.StartSlot(checkpoints.CurrentJustified.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute justified slot: %v", err)
+	}
+	prevJustifiedSlot, err := helpers.StartSlot(checkpoints.PreviousJustified.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute previous justified slot: %v", err)
+	}
+	headSlot := headerResp.Data.Header.Message.Slot
+	return &ethpb.ChainHead{
+		HeadSlot:                   headSlot,
+		HeadEpoch:                  helpers.SlotToEpoch(headSlot),
+		HeadBlockRoot:              headerResp.Data.Root,
+		FinalizedSlot:              finalizedSlot,
+		FinalizedEpoch:             checkpoints.Finalized.Epoch,
+		FinalizedBlockRoot:         checkpoints.Finalized.Root,
+		JustifiedSlot:              justifiedSlot,
+		JustifiedEpoch:             checkpoints.CurrentJustified.Epoch,
+		JustifiedBlockRoot:         checkpoints.CurrentJustified.Root,
+		PreviousJustifiedSlot:      prevJustifiedSlot,
+		PreviousJustifiedEpoch:     checkpoints.PreviousJustified.Epoch,
+		PreviousJustifiedBlockRoot: checkpoints.PreviousJustified.Root,
+	}, nil
+}
+
+// StreamBlocks subscribes to the block events of the beacon node and returns a stream of the
+// imported blocks. Blocks are only announced once imported, so every block is verified.
+func (c *Client) StreamBlocks(ctx context.Context, _ *ethpb.StreamBlocksRequest, _ ...grpc.CallOption) (ethpb.BeaconChain_StreamBlocksClient, error) {
+	const path = "/eth/v1/events?topics=block"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.host+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	resp, err := c.streamClient.Do(req)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "could not subscribe to events: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, err := ioutil.ReadAll(resp.Body)
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			log.WithError(closeErr).Debug("Could not close response body")
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "could not read response of %s: %v", path, err)
+		}
+		return nil, responseError(path, resp.StatusCode, body)
+	}
+	return &blockStream{
+		baseStream: baseStream{ctx: ctx},
+		client:     c,
+		body:       resp.Body,
+		reader:     bufio.NewReader(resp.Body),
+	}, nil
+}
+
+// GetValidatorPerformance is not supported by the standard API.
+func (c *Client) GetValidatorPerformance(_ context.Context, _ *ethpb.ValidatorPerformanceRequest, _ ...grpc.CallOption) (*ethpb.ValidatorPerformanceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "Validator performance is not supported by the beacon node API")
+}
+
+// GetSyncStatus reports whether the beacon node is currently syncing.
+func (c *Client) GetSyncStatus(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.SyncStatus, error) {
+	resp := &ethpbv1.SyncingResponse{}
+	if err := c.get(ctx, "/eth/v1/node/syncing", resp); err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, status.Error(codes.Internal, "Empty syncing response")
+	}
+	return &ethpb.SyncStatus{Syncing: resp.Data.IsSyncing}, nil
+}
+
+// GetGenesis retrieves the genesis time and validators root of the chain along with the address
+// of the deposit contract.
+func (c *Client) GetGenesis(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.Genesis, error) {
+	genesis, err := c.genesisInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &ethpbv1.DepositContractResponse{}
+	if err := c.get(ctx, "/eth/v1/config/deposit_contract", resp); err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, status.Error(codes.Internal, "Empty deposit contract response")
+	}
+	address, err := decodeHex(resp.Data.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Invalid deposit contract address: %v", err)
+	}
+	return &ethpb.Genesis{
+		GenesisTime:            genesis.GenesisTime,
+		DepositContractAddress: address,
+		GenesisValidatorsRoot:  genesis.GenesisValidatorsRoot,
+	}, nil
+}
+
+// block retrieves the block with the given root.
+func (c *Client) block(ctx context.Context, root []byte) (*ethpb.SignedBeaconBlock, error) {
+	resp := &ethpbv1.BlockResponse{}
+	if err := c.get(ctx, "/eth/v1/beacon/blocks/"+hexString(root), resp); err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, status.Error(codes.Internal, "Empty block response")
+	}
+	blk, err := migration.V1ToV1Alpha1Block(&ethpbv1.SignedBeaconBlock{Block: resp.Data.Message, Signature: resp.Data.Signature})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not convert block: %v", err)
+	}
+	return blk, nil
+}
@@ -0,0 +1,134 @@
+package beaconapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestGetSyncStatus(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/node/syncing", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, `{"data":{"head_slot":"100","sync_distance":"20","is_syncing":true}}`)
+	})
+	c := setupClient(t, mux)
+
+	resp, err := c.GetSyncStatus(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, true, resp.Syncing)
+}
+
+func TestGetGenesis(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/beacon/genesis", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, `{"data":{"genesis_time":"1606824023","genesis_validators_root":"0x01","genesis_fork_version":"0x00000000"}}`)
+	})
+	mux.HandleFunc("/eth/v1/config/deposit_contract", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, `{"data":{"chain_id":"1","address":"0x00000000219ab540356cbb839cbe05303d7705fa"}}`)
+	})
+	c := setupClient(t, mux)
+
+	genesis, err := c.GetGenesis(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1606824023), genesis.GenesisTime.Seconds)
+	assert.DeepEqual(t, []byte{0x01}, genesis.GenesisValidatorsRoot)
+	assert.Equal(t, "0x00000000219ab540356cbb839cbe05303d7705fa", fmt.Sprintf("%#x", genesis.DepositContractAddress))
+}
+
+func TestGetChainHead(t *testing.T) {
+	headRoot := bytesutil.PadTo([]byte{0x01}, 32)
+	finalizedRoot := bytesutil.PadTo([]byte{0x02}, 32)
+	justifiedRoot := bytesutil.PadTo([]byte{0x03}, 32)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/beacon/headers/head", func(w http.ResponseWriter, _ *http.Request) {
+		writeMessage(t, w, &ethpbv1.BlockHeaderResponse{Data: &ethpbv1.BlockHeaderContainer{
+			Root:      headRoot,
+			Canonical: true,
+			Header: &ethpbv1.BeaconBlockHeaderContainer{
+				Message: &ethpbv1.BeaconBlockHeader{Slot: 100},
+			},
+		}})
+	})
+	mux.HandleFunc("/eth/v1/beacon/states/head/finality_checkpoints", func(w http.ResponseWriter, _ *http.Request) {
+		writeMessage(t, w, &ethpbv1.StateFinalityCheckpointResponse{Data: &ethpbv1.StateFinalityCheckpointResponse_StateFinalityCheckpoint{
+			PreviousJustified: &ethpbv1.Checkpoint{Epoch: 1, Root: finalizedRoot},
+			CurrentJustified:  &ethpbv1.Checkpoint{Epoch: 2, Root: justifiedRoot},
+			Finalized:         &ethpbv1.Checkpoint{Epoch: 1, Root: finalizedRoot},
+		}})
+	})
+	c := setupClient(t, mux)
+
+	head, err := c.GetChainHead(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(100), head.HeadSlot)
+	assert.Equal(t, types.Epoch(3), head.HeadEpoch)
+	assert.DeepEqual(t, headRoot, head.HeadBlockRoot)
+	assert.Equal(t, types.Epoch(1), head.FinalizedEpoch)
+	assert.Equal(t, types.Slot(32), head.FinalizedSlot)
+	assert.DeepEqual(t, finalizedRoot, head.FinalizedBlockRoot)
+	assert.Equal(t, types.Epoch(2), head.JustifiedEpoch)
+	assert.Equal(t, types.Slot(64), head.JustifiedSlot)
+	assert.DeepEqual(t, justifiedRoot, head.JustifiedBlockRoot)
+	assert.Equal(t, types.Epoch(1), head.PreviousJustifiedEpoch)
+}
+
+func TestStreamBlocks(t *testing.T) {
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 12
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	v1Blk, err := migration.V1Alpha1ToV1Block(blk)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/events", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "block", r.URL.Query().Get("topics"))
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, err := fmt.Fprintf(w, "event: block\ndata: {\"slot\":\"12\",\"block\":\"%s\"}\n\n", hexString(root[:]))
+		require.NoError(t, err)
+	})
+	mux.HandleFunc("/eth/v1/beacon/blocks/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/eth/v1/beacon/blocks/"+hexString(root[:]), r.URL.Path)
+		writeMessage(t, w, &ethpbv1.BlockResponse{Data: &ethpbv1.BeaconBlockContainer{
+			Message:   v1Blk.Block,
+			Signature: v1Blk.Signature,
+		}})
+	})
+	c := setupClient(t, mux)
+
+	stream, err := c.StreamBlocks(context.Background(), &ethpb.StreamBlocksRequest{VerifiedOnly: true})
+	require.NoError(t, err)
+	received, err := stream.Recv()
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, blk, received)
+
+	// The stub closes the stream after the first event.
+	_, err = stream.Recv()
+	assert.ErrorContains(t, "could not read event stream", err)
+	require.NoError(t, stream.CloseSend())
+}
+
+func TestStreamBlocks_ErrorResponse(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/events", func(w http.ResponseWriter, _ *http.Request) {
+		writeError(t, w, http.StatusBadRequest, "Invalid topic")
+	})
+	c := setupClient(t, mux)
+
+	_, err := c.StreamBlocks(context.Background(), &ethpb.StreamBlocksRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.ErrorContains(t, "Invalid topic", err)
+}
@@ -0,0 +1,168 @@
+// Package beaconapi implements the beacon node clients required by the validator client on top
+// of the standard Ethereum beacon node REST API (/eth/v1/...), allowing Prysm validators
+// to perform their duties against any beacon node implementation.
+package beaconapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/validator/client/iface"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ iface.BeaconNodeClient = (*Client)(nil)
+
+// Client talks to a beacon node over the standard REST API, translating between the API's
+// JSON representation and the v1alpha1 types used throughout the validator client.
+type Client struct {
+	host       string
+	httpClient *http.Client
+	// streamClient has no timeout, it is used for long lived event stream connections.
+	streamClient *http.Client
+
+	genesisLock sync.RWMutex
+	genesis     *ethpbv1.GenesisResponse_Genesis
+
+	// The standard API requires the validator index and the number of committees in the slot
+	// to subscribe to a committee subnet, the v1alpha1 request does not carry them. They are
+	// remembered from the attester duties instead.
+	attesterDutiesLock sync.RWMutex
+	attesterDuties     map[subnetKey]*ethpbv1.AttesterDuty
+}
+
+type subnetKey struct {
+	slot           types.Slot
+	committeeIndex types.CommitteeIndex
+}
+
+// errorJson is the error body returned by beacon nodes, as defined in the API specification.
+type errorJson struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// NewClient creates a beacon node REST API client for the given endpoint, for example
+// http://127.0.0.1:3500. Requests that do not complete within the timeout are aborted.
+func NewClient(host string, timeout time.Duration) *Client {
+	if !strings.HasPrefix(host, "http://") && !strings.HasPrefix(host, "https://") {
+		host = "http://" + host
+	}
+	return &Client{
+		host:           strings.TrimSuffix(host, "/"),
+		httpClient:     &http.Client{Timeout: timeout},
+		streamClient:   &http.Client{},
+		attesterDuties: make(map[subnetKey]*ethpbv1.AttesterDuty),
+	}
+}
+
+// get performs a GET request and decodes the response into the given message.
+func (c *Client) get(ctx context.Context, path string, resp proto.Message) error {
+	return c.do(ctx, http.MethodGet, path, nil, resp)
+}
+
+// post performs a POST request with the given JSON body. The response is decoded into
+// resp unless it is nil.
+func (c *Client) post(ctx context.Context, path string, body []byte, resp proto.Message) error {
+	return c.do(ctx, http.MethodPost, path, body, resp)
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body []byte, resp proto.Message) error {
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.host+path, reqBody)
+	if err != nil {
+		return errors.Wrap(err, "could not create request")
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	httpResp, err := c.httpClient.Do(req)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "could not send request to %s: %v", path, err)
+	}
+	defer func() {
+		if err := httpResp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	respBody, err := ioutil.ReadAll(httpResp.Body)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "could not read response of %s: %v", path, err)
+	}
+	if httpResp.StatusCode < 200 || httpResp.StatusCode >= 300 {
+		return responseError(path, httpResp.StatusCode, respBody)
+	}
+	if resp == nil {
+		return nil
+	}
+	if err := unmarshalSpecJSON(respBody, resp); err != nil {
+		return status.Errorf(codes.Internal, "could not decode response of %s: %v", path, err)
+	}
+	return nil
+}
+
+// responseError converts an error response of the beacon node into a gRPC status error,
+// so that callers can handle both backends the same way.
+func responseError(path string, statusCode int, body []byte) error {
+	msg := http.StatusText(statusCode)
+	errJson := &errorJson{}
+	if err := json.Unmarshal(body, errJson); err == nil && errJson.Message != "" {
+		msg = errJson.Message
+	}
+	var code codes.Code
+	switch statusCode {
+	case http.StatusBadRequest:
+		code = codes.InvalidArgument
+	case http.StatusNotFound:
+		code = codes.NotFound
+	case http.StatusServiceUnavailable:
+		code = codes.Unavailable
+	case http.StatusInternalServerError:
+		code = codes.Internal
+	default:
+		code = codes.Unknown
+	}
+	return status.Errorf(code, "%s returned %d: %s", path, statusCode, msg)
+}
+
+// genesisInfo returns the chain's genesis information, which never changes once known.
+func (c *Client) genesisInfo(ctx context.Context) (*ethpbv1.GenesisResponse_Genesis, error) {
+	c.genesisLock.RLock()
+	genesis := c.genesis
+	c.genesisLock.RUnlock()
+	if genesis != nil {
+		return genesis, nil
+	}
+	resp := &ethpbv1.GenesisResponse{}
+	if err := c.get(ctx, "/eth/v1/beacon/genesis", resp); err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, status.Error(codes.Internal, "empty genesis response")
+	}
+	c.genesisLock.Lock()
+	c.genesis = resp.Data
+	c.genesisLock.Unlock()
+	return resp.Data, nil
+}
+
+func hexString(b []byte) string {
+	return "0x" + hex.EncodeToString(b)
+}
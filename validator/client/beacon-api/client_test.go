@@ -0,0 +1,91 @@
+package beaconapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// setupClient starts a local HTTP stub of a beacon node serving the given handlers.
+func setupClient(t *testing.T, mux *http.ServeMux) *Client {
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return NewClient(srv.URL, time.Second)
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	_, err := w.Write([]byte(body))
+	require.NoError(t, err)
+}
+
+func writeMessage(t *testing.T, w http.ResponseWriter, m proto.Message) {
+	enc, err := marshalSpecJSON(m)
+	require.NoError(t, err)
+	writeJSON(t, w, string(enc))
+}
+
+func writeError(t *testing.T, w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, err := fmt.Fprintf(w, `{"code":%d,"message":"%s"}`, code, message)
+	require.NoError(t, err)
+}
+
+func TestNewClient_Host(t *testing.T) {
+	assert.Equal(t, "http://127.0.0.1:3500", NewClient("127.0.0.1:3500", time.Second).host)
+	assert.Equal(t, "https://node.example.com", NewClient("https://node.example.com/", time.Second).host)
+}
+
+func TestClient_ErrorResponse(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/not_found", func(w http.ResponseWriter, _ *http.Request) {
+		writeError(t, w, http.StatusNotFound, "State not found")
+	})
+	mux.HandleFunc("/unavailable", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	mux.HandleFunc("/bad_request", func(w http.ResponseWriter, _ *http.Request) {
+		writeError(t, w, http.StatusBadRequest, "Invalid epoch")
+	})
+	c := setupClient(t, mux)
+
+	err := c.get(context.Background(), "/not_found", &ethpbv1.GenesisResponse{})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.ErrorContains(t, "State not found", err)
+
+	err = c.get(context.Background(), "/unavailable", &ethpbv1.GenesisResponse{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.ErrorContains(t, "Service Unavailable", err)
+
+	err = c.post(context.Background(), "/bad_request", []byte("[]"), nil)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.ErrorContains(t, "Invalid epoch", err)
+}
+
+func TestClient_GenesisInfoCached(t *testing.T) {
+	calls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/beacon/genesis", func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		writeJSON(t, w, `{"data":{"genesis_time":"1606824023","genesis_validators_root":"0x01","genesis_fork_version":"0x00000000"}}`)
+	})
+	c := setupClient(t, mux)
+
+	for i := 0; i < 3; i++ {
+		genesis, err := c.genesisInfo(context.Background())
+		require.NoError(t, err)
+		assert.DeepEqual(t, []byte{0x01}, genesis.GenesisValidatorsRoot)
+	}
+	assert.Equal(t, 1, calls)
+}
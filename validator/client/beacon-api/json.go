@@ -0,0 +1,179 @@
+package beaconapi
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const timestampFullName = "google.protobuf.Timestamp"
+
+var (
+	protoMarshaler   = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+	protoUnmarshaler = protojson.UnmarshalOptions{DiscardUnknown: true}
+)
+
+// marshalSpecJSON encodes an eth/v1 message into the JSON representation mandated by the
+// beacon node API specification. protojson already takes care of field names and of
+// quoting 64-bit integers, what is left is converting bytes to hex, enums to lowercase
+// and timestamps to unix seconds.
+func marshalSpecJSON(m proto.Message) ([]byte, error) {
+	v, err := specValue(m)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+// marshalSpecJSONList encodes a list of eth/v1 messages as a JSON array.
+func marshalSpecJSONList(msgs []proto.Message) ([]byte, error) {
+	values := make([]interface{}, len(msgs))
+	for i, m := range msgs {
+		v, err := specValue(m)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return json.Marshal(values)
+}
+
+// unmarshalSpecJSON decodes JSON following the beacon node API specification into an eth/v1 message.
+func unmarshalSpecJSON(data []byte, m proto.Message) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return errors.Wrap(err, "could not decode JSON")
+	}
+	if err := convertMessage(m.ProtoReflect().Descriptor(), v, false /* toSpec */); err != nil {
+		return err
+	}
+	protoJSON, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return protoUnmarshaler.Unmarshal(protoJSON, m)
+}
+
+func specValue(m proto.Message) (interface{}, error) {
+	protoJSON, err := protoMarshaler.Marshal(m)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal message")
+	}
+	var v interface{}
+	if err := json.Unmarshal(protoJSON, &v); err != nil {
+		return nil, err
+	}
+	if err := convertMessage(m.ProtoReflect().Descriptor(), v, true /* toSpec */); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// convertMessage walks a decoded JSON object alongside the message descriptor and converts
+// all values whose representation differs between protojson and the API specification.
+func convertMessage(md protoreflect.MessageDescriptor, v interface{}, toSpec bool) error {
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		name := string(fd.Name())
+		fieldValue, ok := obj[name]
+		if !ok || fieldValue == nil || fd.IsMap() {
+			continue
+		}
+		if fd.IsList() {
+			list, ok := fieldValue.([]interface{})
+			if !ok {
+				return fmt.Errorf("field %s is not a list", name)
+			}
+			for j := range list {
+				converted, err := convertField(fd, list[j], toSpec)
+				if err != nil {
+					return errors.Wrapf(err, "could not convert field %s", name)
+				}
+				list[j] = converted
+			}
+			continue
+		}
+		converted, err := convertField(fd, fieldValue, toSpec)
+		if err != nil {
+			return errors.Wrapf(err, "could not convert field %s", name)
+		}
+		obj[name] = converted
+	}
+	return nil
+}
+
+func convertField(fd protoreflect.FieldDescriptor, v interface{}, toSpec bool) (interface{}, error) {
+	switch fd.Kind() {
+	case protoreflect.BytesKind:
+		s, ok := v.(string)
+		if !ok {
+			return nil, errors.New("bytes value is not a string")
+		}
+		if toSpec {
+			b, err := base64.StdEncoding.DecodeString(s)
+			if err != nil {
+				return nil, err
+			}
+			return "0x" + hex.EncodeToString(b), nil
+		}
+		b, err := decodeHex(s)
+		if err != nil {
+			return nil, err
+		}
+		return base64.StdEncoding.EncodeToString(b), nil
+	case protoreflect.EnumKind:
+		s, ok := v.(string)
+		if !ok {
+			return v, nil
+		}
+		if toSpec {
+			return strings.ToLower(s), nil
+		}
+		return strings.ToUpper(s), nil
+	case protoreflect.MessageKind:
+		if fd.Message().FullName() == timestampFullName {
+			return convertTimestamp(v, toSpec)
+		}
+		return v, convertMessage(fd.Message(), v, toSpec)
+	default:
+		return v, nil
+	}
+}
+
+// The API specification represents timestamps as unix seconds, protojson as RFC 3339 strings.
+func convertTimestamp(v interface{}, toSpec bool) (interface{}, error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, errors.New("timestamp is not a string")
+	}
+	if toSpec {
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return nil, err
+		}
+		return strconv.FormatInt(t.Unix(), 10), nil
+	}
+	secs, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return time.Unix(secs, 0).UTC().Format(time.RFC3339Nano), nil
+}
+
+func decodeHex(s string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(s, "0x"))
+}
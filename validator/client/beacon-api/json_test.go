@@ -0,0 +1,94 @@
+package beaconapi
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMarshalSpecJSON_Attestation(t *testing.T) {
+	att := &ethpbv1.Attestation{
+		AggregationBits: bitfield.Bitlist{0b1101},
+		Data: &ethpbv1.AttestationData{
+			Slot:            5,
+			Index:           2,
+			BeaconBlockRoot: bytesutil.PadTo([]byte{0xaa}, 32),
+			Source:          &ethpbv1.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte{0xbb}, 32)},
+			Target:          &ethpbv1.Checkpoint{Epoch: 2, Root: bytesutil.PadTo([]byte{0xcc}, 32)},
+		},
+		Signature: bytesutil.PadTo([]byte{0xdd}, 96),
+	}
+	enc, err := marshalSpecJSON(att)
+	require.NoError(t, err)
+
+	raw := make(map[string]interface{})
+	require.NoError(t, json.Unmarshal(enc, &raw))
+	assert.Equal(t, "0x0d", raw["aggregation_bits"])
+	data, ok := raw["data"].(map[string]interface{})
+	require.Equal(t, true, ok)
+	assert.Equal(t, "5", data["slot"])
+	assert.Equal(t, "2", data["index"])
+	assert.Equal(t, hexString(att.Data.BeaconBlockRoot), data["beacon_block_root"])
+	source, ok := data["source"].(map[string]interface{})
+	require.Equal(t, true, ok)
+	assert.Equal(t, hexString(att.Data.Source.Root), source["root"])
+
+	decoded := &ethpbv1.Attestation{}
+	require.NoError(t, unmarshalSpecJSON(enc, decoded))
+	assert.Equal(t, true, proto.Equal(att, decoded))
+}
+
+func TestMarshalSpecJSONList(t *testing.T) {
+	enc, err := marshalSpecJSONList([]proto.Message{
+		&ethpbv1.Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+		&ethpbv1.Checkpoint{Epoch: 2, Root: make([]byte, 32)},
+	})
+	require.NoError(t, err)
+	var raw []map[string]interface{}
+	require.NoError(t, json.Unmarshal(enc, &raw))
+	require.Equal(t, 2, len(raw))
+	assert.Equal(t, "1", raw[0]["epoch"])
+	assert.Equal(t, "2", raw[1]["epoch"])
+}
+
+func TestUnmarshalSpecJSON_Enum(t *testing.T) {
+	enc := []byte(`{"data":[{"index":"3","balance":"32000000000","status":"active_exiting","validator":{"pubkey":"0x01","slashed":false,"unknown_field":"1"}}]}`)
+	resp := &ethpbv1.StateValidatorsResponse{}
+	require.NoError(t, unmarshalSpecJSON(enc, resp))
+	require.Equal(t, 1, len(resp.Data))
+	assert.Equal(t, ethpbv1.ValidatorStatus_ACTIVE_EXITING, resp.Data[0].Status)
+	assert.DeepEqual(t, []byte{0x01}, resp.Data[0].Validator.Pubkey)
+
+	reenc, err := marshalSpecJSON(resp.Data[0])
+	require.NoError(t, err)
+	raw := make(map[string]interface{})
+	require.NoError(t, json.Unmarshal(reenc, &raw))
+	assert.Equal(t, "active_exiting", raw["status"])
+}
+
+func TestUnmarshalSpecJSON_Timestamp(t *testing.T) {
+	enc := []byte(`{"data":{"genesis_time":"1606824023","genesis_validators_root":"0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95","genesis_fork_version":"0x00000000"}}`)
+	resp := &ethpbv1.GenesisResponse{}
+	require.NoError(t, unmarshalSpecJSON(enc, resp))
+	assert.Equal(t, int64(1606824023), resp.Data.GenesisTime.AsTime().Unix())
+	assert.Equal(t, "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95", hexString(resp.Data.GenesisValidatorsRoot))
+
+	reenc, err := marshalSpecJSON(&ethpbv1.GenesisResponse_Genesis{GenesisTime: timestamppb.New(time.Unix(1606824023, 0))})
+	require.NoError(t, err)
+	raw := make(map[string]interface{})
+	require.NoError(t, json.Unmarshal(reenc, &raw))
+	assert.Equal(t, "1606824023", raw["genesis_time"])
+}
+
+func TestUnmarshalSpecJSON_InvalidHex(t *testing.T) {
+	err := unmarshalSpecJSON([]byte(`{"epoch":"1","root":"0xzz"}`), &ethpbv1.Checkpoint{})
+	assert.ErrorContains(t, "could not convert field root", err)
+}
@@ -0,0 +1,168 @@
+package beaconapi
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// chainStartPollInterval is how often the beacon node is asked whether the chain has started.
+var chainStartPollInterval = 10 * time.Second
+
+// baseStream implements grpc.ClientStream for the gRPC streams emulated on top of the REST API.
+type baseStream struct {
+	ctx context.Context
+}
+
+// Header is not supported by emulated streams.
+func (s *baseStream) Header() (metadata.MD, error) {
+	return nil, nil
+}
+
+// Trailer is not supported by emulated streams.
+func (s *baseStream) Trailer() metadata.MD {
+	return nil
+}
+
+// CloseSend is a no-op for emulated streams.
+func (s *baseStream) CloseSend() error {
+	return nil
+}
+
+// Context returns the context of the stream.
+func (s *baseStream) Context() context.Context {
+	return s.ctx
+}
+
+// SendMsg is not supported by emulated streams.
+func (s *baseStream) SendMsg(_ interface{}) error {
+	return status.Error(codes.Unimplemented, "SendMsg is not supported by the beacon node API client")
+}
+
+// RecvMsg is not supported by emulated streams, Recv must be used instead.
+func (s *baseStream) RecvMsg(_ interface{}) error {
+	return status.Error(codes.Unimplemented, "RecvMsg is not supported by the beacon node API client")
+}
+
+// chainStartStream polls the genesis endpoint until the beacon node knows about the chain start.
+type chainStartStream struct {
+	baseStream
+	client *Client
+	done   bool
+}
+
+// Recv blocks until the chain has started.
+func (s *chainStartStream) Recv() (*ethpb.ChainStartResponse, error) {
+	if s.done {
+		return nil, io.EOF
+	}
+	for {
+		genesis, err := s.client.genesisInfo(s.ctx)
+		if err == nil {
+			s.done = true
+			resp := &ethpb.ChainStartResponse{
+				Started:               true,
+				GenesisValidatorsRoot: genesis.GenesisValidatorsRoot,
+			}
+			if genesis.GenesisTime != nil {
+				resp.GenesisTime = uint64(genesis.GenesisTime.AsTime().Unix())
+			}
+			return resp, nil
+		}
+		// The genesis endpoint returns 404 until the chain has started.
+		if status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+		select {
+		case <-time.After(chainStartPollInterval):
+		case <-s.ctx.Done():
+			return nil, s.ctx.Err()
+		}
+	}
+}
+
+// activationStream reports the statuses of a set of validators once per slot.
+type activationStream struct {
+	baseStream
+	client   *Client
+	pubKeys  [][]byte
+	received bool
+}
+
+// Recv returns the current statuses of the validators, waiting for one slot between calls.
+func (s *activationStream) Recv() (*ethpb.ValidatorActivationResponse, error) {
+	if s.received {
+		select {
+		case <-time.After(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second):
+		case <-s.ctx.Done():
+			return nil, s.ctx.Err()
+		}
+	}
+	s.received = true
+
+	vals, err := s.client.validators(s.ctx, s.pubKeys)
+	if err != nil {
+		return nil, err
+	}
+	resp := &ethpb.ValidatorActivationResponse{
+		Statuses: make([]*ethpb.ValidatorActivationResponse_Status, len(s.pubKeys)),
+	}
+	for i, pubKey := range s.pubKeys {
+		v := vals[bytesutil.ToBytes48(pubKey)]
+		index := nonExistentIndex
+		if v != nil {
+			index = v.Index
+		}
+		resp.Statuses[i] = &ethpb.ValidatorActivationResponse_Status{
+			PublicKey: pubKey,
+			Status:    validatorStatus(v),
+			Index:     index,
+		}
+	}
+	return resp, nil
+}
+
+// blockStream reads block events from the beacon node's event stream and fetches the
+// corresponding blocks.
+type blockStream struct {
+	baseStream
+	client *Client
+	body   io.ReadCloser
+	reader *bufio.Reader
+}
+
+// Recv blocks until the beacon node has imported a new block and returns it.
+func (s *blockStream) Recv() (*ethpb.SignedBeaconBlock, error) {
+	for {
+		line, err := s.reader.ReadString('\n')
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read event stream")
+		}
+		// Only block events are subscribed to, so every data line holds a block event.
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "data:") {
+			continue
+		}
+		event := &ethpbv1.EventBlock{}
+		if err := unmarshalSpecJSON([]byte(strings.TrimSpace(strings.TrimPrefix(line, "data:"))), event); err != nil {
+			return nil, errors.Wrap(err, "could not decode block event")
+		}
+		return s.client.block(s.ctx, event.Block)
+	}
+}
+
+// CloseSend closes the underlying event stream connection.
+func (s *blockStream) CloseSend() error {
+	return s.body.Close()
+}
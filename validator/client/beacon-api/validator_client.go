@@ -0,0 +1,498 @@
+package beaconapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// maxIdsPerRequest limits the number of validator ids sent in a single query string.
+const maxIdsPerRequest = 64
+
+// nonExistentIndex is reported for validators that are not known to the beacon node.
+const nonExistentIndex = types.ValidatorIndex(^uint64(0))
+
+// GetDuties retrieves the attester and proposer duties of the given validators for the
+// requested epoch and for the next one.
+func (c *Client) GetDuties(ctx context.Context, in *ethpb.DutiesRequest, _ ...grpc.CallOption) (*ethpb.DutiesResponse, error) {
+	vals, err := c.validators(ctx, in.PublicKeys)
+	if err != nil {
+		return nil, err
+	}
+	subnetDuties := make(map[subnetKey]*ethpbv1.AttesterDuty)
+	currentEpochDuties, err := c.dutiesForEpoch(ctx, in.Epoch, in.PublicKeys, vals, subnetDuties)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get duties for current epoch")
+	}
+	nextEpochDuties, err := c.dutiesForEpoch(ctx, in.Epoch+1, in.PublicKeys, vals, subnetDuties)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get duties for next epoch")
+	}
+
+	c.attesterDutiesLock.Lock()
+	c.attesterDuties = subnetDuties
+	c.attesterDutiesLock.Unlock()
+
+	return &ethpb.DutiesResponse{
+		CurrentEpochDuties: currentEpochDuties,
+		NextEpochDuties:    nextEpochDuties,
+	}, nil
+}
+
+func (c *Client) dutiesForEpoch(
+	ctx context.Context,
+	epoch types.Epoch,
+	pubKeys [][]byte,
+	vals map[[48]byte]*ethpbv1.ValidatorContainer,
+	subnetDuties map[subnetKey]*ethpbv1.AttesterDuty,
+) ([]*ethpb.DutiesResponse_Duty, error) {
+	indices := make([]string, 0, len(vals))
+	for _, v := range vals {
+		indices = append(indices, strconv.FormatUint(uint64(v.Index), 10))
+	}
+
+	attesterDuties := make(map[types.ValidatorIndex]*ethpbv1.AttesterDuty)
+	committees := make(map[subnetKey][]types.ValidatorIndex)
+	proposerSlots := make(map[types.ValidatorIndex][]types.Slot)
+	if len(indices) > 0 {
+		body, err := json.Marshal(indices)
+		if err != nil {
+			return nil, err
+		}
+		attesterResp := &ethpbv1.AttesterDutiesResponse{}
+		if err := c.post(ctx, fmt.Sprintf("/eth/v1/validator/duties/attester/%d", epoch), body, attesterResp); err != nil {
+			return nil, err
+		}
+		for _, d := range attesterResp.Data {
+			attesterDuties[d.ValidatorIndex] = d
+			subnetDuties[subnetKey{slot: d.Slot, committeeIndex: d.CommitteeIndex}] = d
+		}
+
+		committeesResp := &ethpbv1.StateCommitteesResponse{}
+		if err := c.get(ctx, fmt.Sprintf("/eth/v1/beacon/states/head/committees?epoch=%d", epoch), committeesResp); err != nil {
+			return nil, err
+		}
+		for _, committee := range committeesResp.Data {
+			committees[subnetKey{slot: committee.Slot, committeeIndex: committee.Index}] = committee.Validators
+		}
+
+		// Proposer duties can only be computed for the current epoch.
+		proposerResp := &ethpbv1.ProposerDutiesResponse{}
+		err = c.get(ctx, fmt.Sprintf("/eth/v1/validator/duties/proposer/%d", epoch), proposerResp)
+		if err != nil && status.Code(err) != codes.InvalidArgument {
+			return nil, err
+		}
+		for _, d := range proposerResp.Data {
+			proposerSlots[d.ValidatorIndex] = append(proposerSlots[d.ValidatorIndex], d.Slot)
+		}
+	}
+
+	duties := make([]*ethpb.DutiesResponse_Duty, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		v, ok := vals[bytesutil.ToBytes48(pubKey)]
+		if !ok {
+			duties = append(duties, &ethpb.DutiesResponse_Duty{
+				PublicKey: pubKey,
+				Status:    ethpb.ValidatorStatus_UNKNOWN_STATUS,
+			})
+			continue
+		}
+		duty := &ethpb.DutiesResponse_Duty{
+			PublicKey:      pubKey,
+			Status:         validatorStatus(v).Status,
+			ValidatorIndex: v.Index,
+			ProposerSlots:  proposerSlots[v.Index],
+		}
+		if d, ok := attesterDuties[v.Index]; ok {
+			duty.AttesterSlot = d.Slot
+			duty.CommitteeIndex = d.CommitteeIndex
+			duty.Committee = committees[subnetKey{slot: d.Slot, committeeIndex: d.CommitteeIndex}]
+		}
+		duties = append(duties, duty)
+	}
+	return duties, nil
+}
+
+// StreamDuties is not supported by the standard API, GetDuties is used instead.
+func (c *Client) StreamDuties(_ context.Context, _ *ethpb.DutiesRequest, _ ...grpc.CallOption) (ethpb.BeaconNodeValidator_StreamDutiesClient, error) {
+	return nil, status.Error(codes.Unimplemented, "Streaming duties is not supported by the beacon node API")
+}
+
+// DomainData computes the signature domain for the given epoch and domain type, based on
+// the fork of the head state and the genesis validators root.
+func (c *Client) DomainData(ctx context.Context, in *ethpb.DomainRequest, _ ...grpc.CallOption) (*ethpb.DomainResponse, error) {
+	genesis, err := c.genesisInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	forkResp := &ethpbv1.StateForkResponse{}
+	if err := c.get(ctx, "/eth/v1/beacon/states/head/fork", forkResp); err != nil {
+		return nil, err
+	}
+	fork := forkResp.Data
+	if fork == nil {
+		return nil, status.Error(codes.Internal, "Empty fork response")
+	}
+	forkVersion := fork.CurrentVersion
+	if in.Epoch < fork.Epoch {
+		forkVersion = fork.PreviousVersion
+	}
+	domain, err := helpers.ComputeDomain(bytesutil.ToBytes4(in.Domain), forkVersion, genesis.GenesisValidatorsRoot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute domain: %v", err)
+	}
+	return &ethpb.DomainResponse{SignatureDomain: domain}, nil
+}
+
+// WaitForChainStart returns a stream which yields once the beacon node knows the genesis of the chain.
+func (c *Client) WaitForChainStart(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (ethpb.BeaconNodeValidator_WaitForChainStartClient, error) {
+	return &chainStartStream{baseStream: baseStream{ctx: ctx}, client: c}, nil
+}
+
+// WaitForActivation returns a stream which yields the status of the given validators once
+// per slot.
+func (c *Client) WaitForActivation(ctx context.Context, in *ethpb.ValidatorActivationRequest, _ ...grpc.CallOption) (ethpb.BeaconNodeValidator_WaitForActivationClient, error) {
+	return &activationStream{baseStream: baseStream{ctx: ctx}, client: c, pubKeys: in.PublicKeys}, nil
+}
+
+// ValidatorIndex retrieves the index of the validator with the given public key.
+func (c *Client) ValidatorIndex(ctx context.Context, in *ethpb.ValidatorIndexRequest, _ ...grpc.CallOption) (*ethpb.ValidatorIndexResponse, error) {
+	resp := &ethpbv1.StateValidatorResponse{}
+	if err := c.get(ctx, "/eth/v1/beacon/states/head/validators/"+hexString(in.PublicKey), resp); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "Could not find validator index for public key %#x", in.PublicKey)
+		}
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, status.Errorf(codes.NotFound, "Could not find validator index for public key %#x", in.PublicKey)
+	}
+	return &ethpb.ValidatorIndexResponse{Index: resp.Data.Index}, nil
+}
+
+// ValidatorStatus retrieves the status of the validator with the given public key.
+func (c *Client) ValidatorStatus(ctx context.Context, in *ethpb.ValidatorStatusRequest, _ ...grpc.CallOption) (*ethpb.ValidatorStatusResponse, error) {
+	vals, err := c.validators(ctx, [][]byte{in.PublicKey})
+	if err != nil {
+		return nil, err
+	}
+	return validatorStatus(vals[bytesutil.ToBytes48(in.PublicKey)]), nil
+}
+
+// MultipleValidatorStatus retrieves the statuses of the validators with the given public keys or indices.
+func (c *Client) MultipleValidatorStatus(ctx context.Context, in *ethpb.MultipleValidatorStatusRequest, _ ...grpc.CallOption) (*ethpb.MultipleValidatorStatusResponse, error) {
+	vals, err := c.validators(ctx, in.PublicKeys)
+	if err != nil {
+		return nil, err
+	}
+	resp := &ethpb.MultipleValidatorStatusResponse{
+		PublicKeys: make([][]byte, 0, len(in.PublicKeys)+len(in.Indices)),
+		Statuses:   make([]*ethpb.ValidatorStatusResponse, 0, len(in.PublicKeys)+len(in.Indices)),
+		Indices:    make([]types.ValidatorIndex, 0, len(in.PublicKeys)+len(in.Indices)),
+	}
+	for _, pubKey := range in.PublicKeys {
+		v := vals[bytesutil.ToBytes48(pubKey)]
+		index := nonExistentIndex
+		if v != nil {
+			index = v.Index
+		}
+		resp.PublicKeys = append(resp.PublicKeys, pubKey)
+		resp.Statuses = append(resp.Statuses, validatorStatus(v))
+		resp.Indices = append(resp.Indices, index)
+	}
+	for _, index := range in.Indices {
+		v := &ethpbv1.StateValidatorResponse{}
+		if err := c.get(ctx, fmt.Sprintf("/eth/v1/beacon/states/head/validators/%d", index), v); err != nil {
+			if status.Code(err) == codes.NotFound {
+				continue
+			}
+			return nil, err
+		}
+		if v.Data == nil || v.Data.Validator == nil {
+			continue
+		}
+		resp.PublicKeys = append(resp.PublicKeys, v.Data.Validator.Pubkey)
+		resp.Statuses = append(resp.Statuses, validatorStatus(v.Data))
+		resp.Indices = append(resp.Indices, v.Data.Index)
+	}
+	return resp, nil
+}
+
+// GetBlock asks the beacon node to produce an unsigned block for the given slot.
+func (c *Client) GetBlock(ctx context.Context, in *ethpb.BlockRequest, _ ...grpc.CallOption) (*ethpb.BeaconBlock, error) {
+	query := url.Values{}
+	query.Set("randao_reveal", hexString(in.RandaoReveal))
+	if len(in.Graffiti) > 0 {
+		query.Set("graffiti", hexString(in.Graffiti))
+	}
+	resp := &ethpbv1.ProduceBlockResponse{}
+	if err := c.get(ctx, fmt.Sprintf("/eth/v1/validator/blocks/%d?%s", in.Slot, query.Encode()), resp); err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, status.Error(codes.Internal, "Empty block response")
+	}
+	blk, err := migration.V1ToV1Alpha1Block(&ethpbv1.SignedBeaconBlock{Block: resp.Data})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not convert block: %v", err)
+	}
+	return blk.Block, nil
+}
+
+// ProposeBlock submits a signed block to the beacon node for broadcast.
+func (c *Client) ProposeBlock(ctx context.Context, in *ethpb.SignedBeaconBlock, _ ...grpc.CallOption) (*ethpb.ProposeResponse, error) {
+	if in == nil || in.Block == nil {
+		return nil, status.Error(codes.InvalidArgument, "Empty block")
+	}
+	v1Blk, err := migration.V1Alpha1ToV1Block(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not convert block: %v", err)
+	}
+	body, err := marshalSpecJSON(&ethpbv1.BeaconBlockContainer{Message: v1Blk.Block, Signature: v1Blk.Signature})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.post(ctx, "/eth/v1/beacon/blocks", body, nil); err != nil {
+		return nil, err
+	}
+	root, err := in.Block.HashTreeRoot()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not tree hash block: %v", err)
+	}
+	return &ethpb.ProposeResponse{BlockRoot: root[:]}, nil
+}
+
+// GetAttestationData asks the beacon node to produce attestation data for the given slot and committee.
+func (c *Client) GetAttestationData(ctx context.Context, in *ethpb.AttestationDataRequest, _ ...grpc.CallOption) (*ethpb.AttestationData, error) {
+	data, err := c.attestationData(ctx, in.Slot, in.CommitteeIndex)
+	if err != nil {
+		return nil, err
+	}
+	return migration.V1AttDataToV1Alpha1(data), nil
+}
+
+func (c *Client) attestationData(ctx context.Context, slot types.Slot, committeeIndex types.CommitteeIndex) (*ethpbv1.AttestationData, error) {
+	resp := &ethpbv1.ProduceAttestationDataResponse{}
+	path := fmt.Sprintf("/eth/v1/validator/attestation_data?slot=%d&committee_index=%d", slot, committeeIndex)
+	if err := c.get(ctx, path, resp); err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, status.Error(codes.Internal, "Empty attestation data response")
+	}
+	return resp.Data, nil
+}
+
+// ProposeAttestation submits a signed attestation to the beacon node's pool.
+func (c *Client) ProposeAttestation(ctx context.Context, in *ethpb.Attestation, _ ...grpc.CallOption) (*ethpb.AttestResponse, error) {
+	if in == nil || in.Data == nil {
+		return nil, status.Error(codes.InvalidArgument, "Empty attestation")
+	}
+	body, err := marshalSpecJSONList([]proto.Message{migration.V1Alpha1AttestationToV1(in)})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.post(ctx, "/eth/v1/beacon/pool/attestations", body, nil); err != nil {
+		return nil, err
+	}
+	root, err := in.Data.HashTreeRoot()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not tree hash attestation data: %v", err)
+	}
+	return &ethpb.AttestResponse{AttestationDataRoot: root[:]}, nil
+}
+
+// SubmitAggregateSelectionProof retrieves the best aggregate known to the beacon node for
+// the given slot and committee, and wraps it with the aggregator's selection proof.
+func (c *Client) SubmitAggregateSelectionProof(ctx context.Context, in *ethpb.AggregateSelectionRequest, _ ...grpc.CallOption) (*ethpb.AggregateSelectionResponse, error) {
+	idx, err := c.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: in.PublicKey})
+	if err != nil {
+		return nil, err
+	}
+	data, err := c.attestationData(ctx, in.Slot, in.CommitteeIndex)
+	if err != nil {
+		return nil, err
+	}
+	dataRoot, err := data.HashTreeRoot()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not tree hash attestation data: %v", err)
+	}
+	resp := &ethpbv1.AggregateAttestationResponse{}
+	path := fmt.Sprintf("/eth/v1/validator/aggregate_attestation?attestation_data_root=%s&slot=%d", hexString(dataRoot[:]), in.Slot)
+	if err := c.get(ctx, path, resp); err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, status.Error(codes.Internal, "Empty aggregate attestation response")
+	}
+	return &ethpb.AggregateSelectionResponse{
+		AggregateAndProof: &ethpb.AggregateAttestationAndProof{
+			AggregatorIndex: idx.Index,
+			Aggregate:       migration.V1AttToV1Alpha1(resp.Data),
+			SelectionProof:  in.SlotSignature,
+		},
+	}, nil
+}
+
+// SubmitSignedAggregateSelectionProof submits a signed aggregate and proof to the beacon node for broadcast.
+func (c *Client) SubmitSignedAggregateSelectionProof(ctx context.Context, in *ethpb.SignedAggregateSubmitRequest, _ ...grpc.CallOption) (*ethpb.SignedAggregateSubmitResponse, error) {
+	signed := in.SignedAggregateAndProof
+	if signed == nil || signed.Message == nil || signed.Message.Aggregate == nil || signed.Message.Aggregate.Data == nil {
+		return nil, status.Error(codes.InvalidArgument, "Signed aggregate request can't be nil")
+	}
+	body, err := marshalSpecJSONList([]proto.Message{&ethpbv1.SignedAggregateAttestationAndProof{
+		Message:   migration.V1Alpha1AggregateAttAndProofToV1(signed.Message),
+		Signature: signed.Signature,
+	}})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.post(ctx, "/eth/v1/validator/aggregate_and_proofs", body, nil); err != nil {
+		return nil, err
+	}
+	root, err := signed.Message.Aggregate.Data.HashTreeRoot()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not tree hash attestation data: %v", err)
+	}
+	return &ethpb.SignedAggregateSubmitResponse{AttestationDataRoot: root[:]}, nil
+}
+
+// ProposeExit submits a signed voluntary exit to the beacon node's pool.
+func (c *Client) ProposeExit(ctx context.Context, in *ethpb.SignedVoluntaryExit, _ ...grpc.CallOption) (*ethpb.ProposeExitResponse, error) {
+	if in == nil || in.Exit == nil {
+		return nil, status.Error(codes.InvalidArgument, "Empty voluntary exit")
+	}
+	body, err := marshalSpecJSON(migration.V1Alpha1ExitToV1(in))
+	if err != nil {
+		return nil, err
+	}
+	if err := c.post(ctx, "/eth/v1/beacon/pool/voluntary_exits", body, nil); err != nil {
+		return nil, err
+	}
+	root, err := in.Exit.HashTreeRoot()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not tree hash exit: %v", err)
+	}
+	return &ethpb.ProposeExitResponse{ExitRoot: root[:]}, nil
+}
+
+// SubscribeCommitteeSubnets asks the beacon node to subscribe to the subnets of the given committees.
+// Only committees for which attester duties were previously retrieved can be subscribed to.
+func (c *Client) SubscribeCommitteeSubnets(ctx context.Context, in *ethpb.CommitteeSubnetsSubscribeRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	if len(in.Slots) != len(in.CommitteeIds) || len(in.CommitteeIds) != len(in.IsAggregator) {
+		return nil, status.Error(codes.InvalidArgument, "Request fields are not the same length")
+	}
+	subscriptions := make([]proto.Message, 0, len(in.Slots))
+	c.attesterDutiesLock.RLock()
+	for i := range in.Slots {
+		duty, ok := c.attesterDuties[subnetKey{slot: in.Slots[i], committeeIndex: in.CommitteeIds[i]}]
+		if !ok {
+			c.attesterDutiesLock.RUnlock()
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"No attester duty known for slot %d and committee %d",
+				in.Slots[i],
+				in.CommitteeIds[i],
+			)
+		}
+		subscriptions = append(subscriptions, &ethpbv1.BeaconCommitteeSubscribe{
+			ValidatorIndex:   duty.ValidatorIndex,
+			CommitteeIndex:   in.CommitteeIds[i],
+			CommitteesAtSlot: duty.CommitteesAtSlot,
+			Slot:             in.Slots[i],
+			IsAggregator:     in.IsAggregator[i],
+		})
+	}
+	c.attesterDutiesLock.RUnlock()
+	if len(subscriptions) == 0 {
+		return &emptypb.Empty{}, nil
+	}
+
+	body, err := marshalSpecJSONList(subscriptions)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.post(ctx, "/eth/v1/validator/beacon_committee_subscriptions", body, nil); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// CheckDoppelGanger is not supported by the standard API.
+func (c *Client) CheckDoppelGanger(_ context.Context, _ *ethpb.DoppelGangerRequest, _ ...grpc.CallOption) (*ethpb.DoppelGangerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "Doppelganger detection is not supported by the beacon node API")
+}
+
+// validators retrieves the validators of the head state with the given public keys. Validators
+// unknown to the beacon node are absent from the returned map.
+func (c *Client) validators(ctx context.Context, pubKeys [][]byte) (map[[48]byte]*ethpbv1.ValidatorContainer, error) {
+	vals := make(map[[48]byte]*ethpbv1.ValidatorContainer, len(pubKeys))
+	for start := 0; start < len(pubKeys); start += maxIdsPerRequest {
+		end := start + maxIdsPerRequest
+		if end > len(pubKeys) {
+			end = len(pubKeys)
+		}
+		query := url.Values{}
+		for _, pubKey := range pubKeys[start:end] {
+			query.Add("id", hexString(pubKey))
+		}
+		resp := &ethpbv1.StateValidatorsResponse{}
+		if err := c.get(ctx, "/eth/v1/beacon/states/head/validators?"+query.Encode(), resp); err != nil {
+			return nil, err
+		}
+		for _, v := range resp.Data {
+			if v.Validator == nil {
+				continue
+			}
+			vals[bytesutil.ToBytes48(v.Validator.Pubkey)] = v
+		}
+	}
+	return vals, nil
+}
+
+// validatorStatus converts the status of a validator as reported by the standard API
+// into its v1alpha1 equivalent. A nil validator has an unknown status.
+func validatorStatus(v *ethpbv1.ValidatorContainer) *ethpb.ValidatorStatusResponse {
+	resp := &ethpb.ValidatorStatusResponse{
+		Status:          ethpb.ValidatorStatus_UNKNOWN_STATUS,
+		ActivationEpoch: params.BeaconConfig().FarFutureEpoch,
+	}
+	if v == nil {
+		return resp
+	}
+	if v.Validator != nil {
+		resp.ActivationEpoch = v.Validator.ActivationEpoch
+	}
+	switch v.Status {
+	case ethpbv1.ValidatorStatus_PENDING_INITIALIZED:
+		resp.Status = ethpb.ValidatorStatus_DEPOSITED
+	case ethpbv1.ValidatorStatus_PENDING_QUEUED, ethpbv1.ValidatorStatus_PENDING:
+		resp.Status = ethpb.ValidatorStatus_PENDING
+	case ethpbv1.ValidatorStatus_ACTIVE_ONGOING, ethpbv1.ValidatorStatus_ACTIVE:
+		resp.Status = ethpb.ValidatorStatus_ACTIVE
+	case ethpbv1.ValidatorStatus_ACTIVE_EXITING:
+		resp.Status = ethpb.ValidatorStatus_EXITING
+	case ethpbv1.ValidatorStatus_ACTIVE_SLASHED:
+		resp.Status = ethpb.ValidatorStatus_SLASHING
+	case ethpbv1.ValidatorStatus_EXITED_UNSLASHED, ethpbv1.ValidatorStatus_EXITED_SLASHED, ethpbv1.ValidatorStatus_EXITED,
+		ethpbv1.ValidatorStatus_WITHDRAWAL_POSSIBLE, ethpbv1.ValidatorStatus_WITHDRAWAL_DONE, ethpbv1.ValidatorStatus_WITHDRAWAL:
+		resp.Status = ethpb.ValidatorStatus_EXITED
+	}
+	return resp
+}
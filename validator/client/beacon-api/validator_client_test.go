@@ -0,0 +1,418 @@
+package beaconapi
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	pubKey1 = bytesutil.PadTo([]byte{0x01}, 48)
+	pubKey2 = bytesutil.PadTo([]byte{0x02}, 48)
+)
+
+func handleValidators(t *testing.T, mux *http.ServeMux) {
+	mux.HandleFunc("/eth/v1/beacon/states/head/validators", func(w http.ResponseWriter, r *http.Request) {
+		resp := &ethpbv1.StateValidatorsResponse{}
+		for _, id := range r.URL.Query()["id"] {
+			if id == hexString(pubKey1) {
+				resp.Data = append(resp.Data, &ethpbv1.ValidatorContainer{
+					Index:  5,
+					Status: ethpbv1.ValidatorStatus_ACTIVE_ONGOING,
+					Validator: &ethpbv1.Validator{
+						Pubkey:          pubKey1,
+						ActivationEpoch: 3,
+					},
+				})
+			}
+		}
+		writeMessage(t, w, resp)
+	})
+}
+
+func TestGetDuties(t *testing.T) {
+	mux := http.NewServeMux()
+	handleValidators(t, mux)
+	mux.HandleFunc("/eth/v1/validator/duties/attester/", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		var indices []string
+		require.NoError(t, json.Unmarshal(body, &indices))
+		assert.DeepEqual(t, []string{"5"}, indices)
+
+		duty := &ethpbv1.AttesterDuty{Pubkey: pubKey1, ValidatorIndex: 5, CommitteeIndex: 1, CommitteesAtSlot: 4, Slot: 33}
+		if r.URL.Path == "/eth/v1/validator/duties/attester/2" {
+			duty.CommitteeIndex = 0
+			duty.Slot = 70
+		}
+		writeMessage(t, w, &ethpbv1.AttesterDutiesResponse{Data: []*ethpbv1.AttesterDuty{duty}})
+	})
+	mux.HandleFunc("/eth/v1/beacon/states/head/committees", func(w http.ResponseWriter, r *http.Request) {
+		resp := &ethpbv1.StateCommitteesResponse{}
+		if r.URL.Query().Get("epoch") == "1" {
+			resp.Data = []*ethpbv1.Committee{
+				{Index: 0, Slot: 33, Validators: []types.ValidatorIndex{1, 2}},
+				{Index: 1, Slot: 33, Validators: []types.ValidatorIndex{3, 5}},
+			}
+		} else {
+			resp.Data = []*ethpbv1.Committee{{Index: 0, Slot: 70, Validators: []types.ValidatorIndex{5, 8}}}
+		}
+		writeMessage(t, w, resp)
+	})
+	mux.HandleFunc("/eth/v1/validator/duties/proposer/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/eth/v1/validator/duties/proposer/1" {
+			writeError(t, w, http.StatusBadRequest, "Request epoch can not be greater than current epoch")
+			return
+		}
+		writeMessage(t, w, &ethpbv1.ProposerDutiesResponse{Data: []*ethpbv1.ProposerDuty{
+			{Pubkey: pubKey1, ValidatorIndex: 5, Slot: 40},
+			{Pubkey: pubKey2, ValidatorIndex: 6, Slot: 41},
+		}})
+	})
+	var subscriptions []map[string]interface{}
+	mux.HandleFunc("/eth/v1/validator/beacon_committee_subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(body, &subscriptions))
+	})
+	c := setupClient(t, mux)
+
+	resp, err := c.GetDuties(context.Background(), &ethpb.DutiesRequest{Epoch: 1, PublicKeys: [][]byte{pubKey1, pubKey2}})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.CurrentEpochDuties))
+	current := resp.CurrentEpochDuties[0]
+	assert.Equal(t, types.ValidatorIndex(5), current.ValidatorIndex)
+	assert.Equal(t, ethpb.ValidatorStatus_ACTIVE, current.Status)
+	assert.Equal(t, types.Slot(33), current.AttesterSlot)
+	assert.Equal(t, types.CommitteeIndex(1), current.CommitteeIndex)
+	assert.DeepEqual(t, []types.ValidatorIndex{3, 5}, current.Committee)
+	assert.DeepEqual(t, []types.Slot{40}, current.ProposerSlots)
+	assert.Equal(t, ethpb.ValidatorStatus_UNKNOWN_STATUS, resp.CurrentEpochDuties[1].Status)
+	assert.DeepEqual(t, pubKey2, resp.CurrentEpochDuties[1].PublicKey)
+
+	require.Equal(t, 2, len(resp.NextEpochDuties))
+	next := resp.NextEpochDuties[0]
+	assert.Equal(t, types.Slot(70), next.AttesterSlot)
+	assert.DeepEqual(t, []types.ValidatorIndex{5, 8}, next.Committee)
+	assert.Equal(t, 0, len(next.ProposerSlots))
+
+	// Subnet subscriptions are completed with the information of the attester duties.
+	_, err = c.SubscribeCommitteeSubnets(context.Background(), &ethpb.CommitteeSubnetsSubscribeRequest{
+		Slots:        []types.Slot{33},
+		CommitteeIds: []types.CommitteeIndex{1},
+		IsAggregator: []bool{true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(subscriptions))
+	assert.Equal(t, "5", subscriptions[0]["validator_index"])
+	assert.Equal(t, "4", subscriptions[0]["committees_at_slot"])
+	assert.Equal(t, "33", subscriptions[0]["slot"])
+	assert.Equal(t, true, subscriptions[0]["is_aggregator"])
+
+	_, err = c.SubscribeCommitteeSubnets(context.Background(), &ethpb.CommitteeSubnetsSubscribeRequest{
+		Slots:        []types.Slot{34},
+		CommitteeIds: []types.CommitteeIndex{1},
+		IsAggregator: []bool{false},
+	})
+	assert.ErrorContains(t, "No attester duty known for slot 34 and committee 1", err)
+}
+
+func TestDomainData(t *testing.T) {
+	gvr := bytesutil.PadTo([]byte{0x0a}, 32)
+	fork := &ethpbv1.Fork{
+		PreviousVersion: []byte{0, 0, 0, 0},
+		CurrentVersion:  []byte{1, 0, 0, 0},
+		Epoch:           10,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/beacon/genesis", func(w http.ResponseWriter, _ *http.Request) {
+		writeMessage(t, w, &ethpbv1.GenesisResponse{Data: &ethpbv1.GenesisResponse_Genesis{GenesisValidatorsRoot: gvr}})
+	})
+	mux.HandleFunc("/eth/v1/beacon/states/head/fork", func(w http.ResponseWriter, _ *http.Request) {
+		writeMessage(t, w, &ethpbv1.StateForkResponse{Data: fork})
+	})
+	c := setupClient(t, mux)
+	domainType := params.BeaconConfig().DomainBeaconAttester
+
+	resp, err := c.DomainData(context.Background(), &ethpb.DomainRequest{Epoch: 9, Domain: domainType[:]})
+	require.NoError(t, err)
+	want, err := helpers.ComputeDomain(domainType, fork.PreviousVersion, gvr)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, resp.SignatureDomain)
+
+	resp, err = c.DomainData(context.Background(), &ethpb.DomainRequest{Epoch: 10, Domain: domainType[:]})
+	require.NoError(t, err)
+	want, err = helpers.ComputeDomain(domainType, fork.CurrentVersion, gvr)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, resp.SignatureDomain)
+}
+
+func TestWaitForChainStart(t *testing.T) {
+	defaultInterval := chainStartPollInterval
+	chainStartPollInterval = 10 * time.Millisecond
+	defer func() {
+		chainStartPollInterval = defaultInterval
+	}()
+
+	calls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/beacon/genesis", func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		if calls < 3 {
+			writeError(t, w, http.StatusNotFound, "Chain genesis info is not yet known")
+			return
+		}
+		writeJSON(t, w, `{"data":{"genesis_time":"1606824023","genesis_validators_root":"0x0a","genesis_fork_version":"0x00000000"}}`)
+	})
+	c := setupClient(t, mux)
+
+	stream, err := c.WaitForChainStart(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, true, resp.Started)
+	assert.Equal(t, uint64(1606824023), resp.GenesisTime)
+	assert.DeepEqual(t, []byte{0x0a}, resp.GenesisValidatorsRoot)
+}
+
+func TestWaitForActivation(t *testing.T) {
+	mux := http.NewServeMux()
+	handleValidators(t, mux)
+	c := setupClient(t, mux)
+
+	stream, err := c.WaitForActivation(context.Background(), &ethpb.ValidatorActivationRequest{PublicKeys: [][]byte{pubKey1, pubKey2}})
+	require.NoError(t, err)
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Statuses))
+	assert.Equal(t, types.ValidatorIndex(5), resp.Statuses[0].Index)
+	assert.Equal(t, ethpb.ValidatorStatus_ACTIVE, resp.Statuses[0].Status.Status)
+	assert.Equal(t, types.Epoch(3), resp.Statuses[0].Status.ActivationEpoch)
+	assert.Equal(t, nonExistentIndex, resp.Statuses[1].Index)
+	assert.Equal(t, ethpb.ValidatorStatus_UNKNOWN_STATUS, resp.Statuses[1].Status.Status)
+
+	// Following responses are only sent once per slot.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	stream, err = c.WaitForActivation(ctx, &ethpb.ValidatorActivationRequest{PublicKeys: [][]byte{pubKey1}})
+	require.NoError(t, err)
+	stream.(*activationStream).received = true
+	_, err = stream.Recv()
+	assert.ErrorContains(t, "context canceled", err)
+}
+
+func TestValidatorIndex(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/beacon/states/head/validators/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/eth/v1/beacon/states/head/validators/"+hexString(pubKey1) {
+			writeError(t, w, http.StatusNotFound, "Could not find validator")
+			return
+		}
+		writeMessage(t, w, &ethpbv1.StateValidatorResponse{Data: &ethpbv1.ValidatorContainer{
+			Index:     5,
+			Validator: &ethpbv1.Validator{Pubkey: pubKey1},
+		}})
+	})
+	c := setupClient(t, mux)
+
+	resp, err := c.ValidatorIndex(context.Background(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey1})
+	require.NoError(t, err)
+	assert.Equal(t, types.ValidatorIndex(5), resp.Index)
+
+	_, err = c.ValidatorIndex(context.Background(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey2})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestMultipleValidatorStatus(t *testing.T) {
+	mux := http.NewServeMux()
+	handleValidators(t, mux)
+	c := setupClient(t, mux)
+
+	resp, err := c.MultipleValidatorStatus(context.Background(), &ethpb.MultipleValidatorStatusRequest{PublicKeys: [][]byte{pubKey1, pubKey2}})
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][]byte{pubKey1, pubKey2}, resp.PublicKeys)
+	assert.DeepEqual(t, []types.ValidatorIndex{5, nonExistentIndex}, resp.Indices)
+	require.Equal(t, 2, len(resp.Statuses))
+	assert.Equal(t, ethpb.ValidatorStatus_ACTIVE, resp.Statuses[0].Status)
+	assert.Equal(t, ethpb.ValidatorStatus_UNKNOWN_STATUS, resp.Statuses[1].Status)
+}
+
+func TestValidatorStatusConversion(t *testing.T) {
+	tests := []struct {
+		status ethpbv1.ValidatorStatus
+		want   ethpb.ValidatorStatus
+	}{
+		{status: ethpbv1.ValidatorStatus_PENDING_INITIALIZED, want: ethpb.ValidatorStatus_DEPOSITED},
+		{status: ethpbv1.ValidatorStatus_PENDING_QUEUED, want: ethpb.ValidatorStatus_PENDING},
+		{status: ethpbv1.ValidatorStatus_ACTIVE_ONGOING, want: ethpb.ValidatorStatus_ACTIVE},
+		{status: ethpbv1.ValidatorStatus_ACTIVE_EXITING, want: ethpb.ValidatorStatus_EXITING},
+		{status: ethpbv1.ValidatorStatus_ACTIVE_SLASHED, want: ethpb.ValidatorStatus_SLASHING},
+		{status: ethpbv1.ValidatorStatus_EXITED_UNSLASHED, want: ethpb.ValidatorStatus_EXITED},
+		{status: ethpbv1.ValidatorStatus_EXITED_SLASHED, want: ethpb.ValidatorStatus_EXITED},
+		{status: ethpbv1.ValidatorStatus_WITHDRAWAL_POSSIBLE, want: ethpb.ValidatorStatus_EXITED},
+		{status: ethpbv1.ValidatorStatus_WITHDRAWAL_DONE, want: ethpb.ValidatorStatus_EXITED},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status.String(), func(t *testing.T) {
+			assert.Equal(t, tt.want, validatorStatus(&ethpbv1.ValidatorContainer{Status: tt.status}).Status)
+		})
+	}
+	assert.Equal(t, ethpb.ValidatorStatus_UNKNOWN_STATUS, validatorStatus(nil).Status)
+}
+
+func TestGetBlock(t *testing.T) {
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 8
+	blk.Block.ProposerIndex = 3
+	v1Blk, err := migration.V1Alpha1ToV1Block(blk)
+	require.NoError(t, err)
+	randao := bytesutil.PadTo([]byte{0x0b}, 96)
+	graffiti := bytesutil.PadTo([]byte("prysm"), 32)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/validator/blocks/8", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, hexString(randao), r.URL.Query().Get("randao_reveal"))
+		assert.Equal(t, hexString(graffiti), r.URL.Query().Get("graffiti"))
+		writeMessage(t, w, &ethpbv1.ProduceBlockResponse{Data: v1Blk.Block})
+	})
+	c := setupClient(t, mux)
+
+	resp, err := c.GetBlock(context.Background(), &ethpb.BlockRequest{Slot: 8, RandaoReveal: randao, Graffiti: graffiti})
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, blk.Block, resp)
+}
+
+func TestProposeBlock(t *testing.T) {
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 8
+	blk.Signature = bytesutil.PadTo([]byte{0x0c}, 96)
+
+	received := &ethpbv1.BeaconBlockContainer{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/beacon/blocks", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, unmarshalSpecJSON(body, received))
+	})
+	c := setupClient(t, mux)
+
+	resp, err := c.ProposeBlock(context.Background(), blk)
+	require.NoError(t, err)
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, root[:], resp.BlockRoot)
+	assert.Equal(t, types.Slot(8), received.Message.Slot)
+	assert.DeepEqual(t, blk.Signature, received.Signature)
+}
+
+func TestProposeAttestation(t *testing.T) {
+	att := testutil.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b101}})
+	att.Data.Slot = 4
+
+	var received []*ethpbv1.Attestation
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/beacon/pool/attestations", func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		var raw []json.RawMessage
+		require.NoError(t, json.Unmarshal(body, &raw))
+		for _, a := range raw {
+			v1Att := &ethpbv1.Attestation{}
+			require.NoError(t, unmarshalSpecJSON(a, v1Att))
+			received = append(received, v1Att)
+		}
+	})
+	c := setupClient(t, mux)
+
+	resp, err := c.ProposeAttestation(context.Background(), att)
+	require.NoError(t, err)
+	root, err := att.Data.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, root[:], resp.AttestationDataRoot)
+	require.Equal(t, 1, len(received))
+	assert.DeepSSZEqual(t, migration.V1Alpha1AttestationToV1(att), received[0])
+}
+
+func TestSubmitAggregateSelectionProof(t *testing.T) {
+	data := testutil.HydrateAttestationData(&ethpb.AttestationData{Slot: 4, CommitteeIndex: 2})
+	dataRoot, err := data.HashTreeRoot()
+	require.NoError(t, err)
+	aggregate := testutil.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b111}, Data: data})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/beacon/states/head/validators/", func(w http.ResponseWriter, _ *http.Request) {
+		writeMessage(t, w, &ethpbv1.StateValidatorResponse{Data: &ethpbv1.ValidatorContainer{Index: 5}})
+	})
+	mux.HandleFunc("/eth/v1/validator/attestation_data", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "4", r.URL.Query().Get("slot"))
+		assert.Equal(t, "2", r.URL.Query().Get("committee_index"))
+		writeMessage(t, w, &ethpbv1.ProduceAttestationDataResponse{Data: migration.V1Alpha1AttDataToV1(data)})
+	})
+	mux.HandleFunc("/eth/v1/validator/aggregate_attestation", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, hexString(dataRoot[:]), r.URL.Query().Get("attestation_data_root"))
+		writeMessage(t, w, &ethpbv1.AggregateAttestationResponse{Data: migration.V1Alpha1AttestationToV1(aggregate)})
+	})
+	c := setupClient(t, mux)
+
+	proof := bytesutil.PadTo([]byte{0x0d}, 96)
+	resp, err := c.SubmitAggregateSelectionProof(context.Background(), &ethpb.AggregateSelectionRequest{
+		Slot:           4,
+		CommitteeIndex: 2,
+		PublicKey:      pubKey1,
+		SlotSignature:  proof,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, types.ValidatorIndex(5), resp.AggregateAndProof.AggregatorIndex)
+	assert.DeepEqual(t, proof, resp.AggregateAndProof.SelectionProof)
+	assert.DeepSSZEqual(t, aggregate, resp.AggregateAndProof.Aggregate)
+}
+
+func TestProposeExit(t *testing.T) {
+	exit := &ethpb.SignedVoluntaryExit{
+		Exit:      &ethpb.VoluntaryExit{Epoch: 10, ValidatorIndex: 5},
+		Signature: bytesutil.PadTo([]byte{0x0e}, 96),
+	}
+	received := &ethpbv1.SignedVoluntaryExit{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/beacon/pool/voluntary_exits", func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, unmarshalSpecJSON(body, received))
+	})
+	c := setupClient(t, mux)
+
+	resp, err := c.ProposeExit(context.Background(), exit)
+	require.NoError(t, err)
+	root, err := exit.Exit.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, root[:], resp.ExitRoot)
+	assert.Equal(t, types.ValidatorIndex(5), received.Message.ValidatorIndex)
+	assert.Equal(t, types.Epoch(10), received.Message.Epoch)
+}
+
+func TestCheckDoppelGanger_Unimplemented(t *testing.T) {
+	c := NewClient("127.0.0.1:3500", time.Second)
+	_, err := c.CheckDoppelGanger(context.Background(), &ethpb.DoppelGangerRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
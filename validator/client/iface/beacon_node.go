@@ -0,0 +1,31 @@
+package iface
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// BeaconChainClient defines the subset of the beacon chain API used by the validator client.
+// It is satisfied by Prysm's gRPC client as well as by alternative beacon node adapters.
+type BeaconChainClient interface {
+	GetChainHead(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ethpb.ChainHead, error)
+	StreamBlocks(ctx context.Context, in *ethpb.StreamBlocksRequest, opts ...grpc.CallOption) (ethpb.BeaconChain_StreamBlocksClient, error)
+	GetValidatorPerformance(ctx context.Context, in *ethpb.ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ethpb.ValidatorPerformanceResponse, error)
+}
+
+// NodeClient defines the subset of the node API used by the validator client.
+type NodeClient interface {
+	GetSyncStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ethpb.SyncStatus, error)
+	GetGenesis(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ethpb.Genesis, error)
+}
+
+// BeaconNodeClient groups all the beacon node APIs a validator client needs to perform its duties.
+// Prysm's gRPC API is the default implementation, the standard REST beacon API is another.
+type BeaconNodeClient interface {
+	ethpb.BeaconNodeValidatorClient
+	BeaconChainClient
+	NodeClient
+}
@@ -17,10 +17,12 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	accountsiface "github.com/prysmaticlabs/prysm/validator/accounts/iface"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	beaconapi "github.com/prysmaticlabs/prysm/validator/client/beacon-api"
 	"github.com/prysmaticlabs/prysm/validator/client/iface"
 	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/graffiti"
@@ -33,6 +35,9 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+// beaconApiTimeout is the maximum duration of a request to the beacon node REST API.
+var beaconApiTimeout = 10 * time.Second
+
 // SyncChecker is able to determine if a beacon node is currently
 // going through chain synchronization.
 type SyncChecker interface {
@@ -53,6 +58,7 @@ type ValidatorService struct {
 	logValidatorBalances  bool
 	logDutyCountDown      bool
 	conn                  *grpc.ClientConn
+	beaconApiEndpoint     string
 	grpcRetryDelay        time.Duration
 	grpcRetries           uint
 	maxCallRecvMsgSize    int
@@ -63,6 +69,7 @@ type ValidatorService struct {
 	withCert              string
 	endpoint              string
 	validator             iface.Validator
+	nodeClient            iface.NodeClient
 	protector             slashingiface.Protector
 	ctx                   context.Context
 	keyManager            keymanager.IKeymanager
@@ -83,6 +90,7 @@ type Config struct {
 	GrpcMaxCallRecvMsgSizeFlag int
 	Protector                  slashingiface.Protector
 	Endpoint                   string
+	BeaconApiEndpoint          string
 	Validator                  iface.Validator
 	ValDB                      db.Database
 	KeyManager                 keymanager.IKeymanager
@@ -96,11 +104,17 @@ type Config struct {
 // NewValidatorService creates a new validator service for the service
 // registry.
 func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, error) {
+	// The standard beacon node API has no doppelganger check, which would otherwise fail at startup.
+	if cfg.BeaconApiEndpoint != "" && featureconfig.Get().EnableDoppelGanger {
+		return nil, errors.New("doppelganger protection is not supported by the beacon node REST API, " +
+			"remove --enable-doppelganger or use --beacon-rpc-provider")
+	}
 	ctx, cancel := context.WithCancel(ctx)
 	return &ValidatorService{
 		ctx:                   ctx,
 		cancel:                cancel,
 		endpoint:              cfg.Endpoint,
+		beaconApiEndpoint:     cfg.BeaconApiEndpoint,
 		withCert:              cfg.CertFlag,
 		dataDir:               cfg.DataDir,
 		graffiti:              []byte(cfg.GraffitiFlag),
@@ -124,28 +138,41 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 // Start the validator service. Launches the main go routine for the validator
 // client.
 func (v *ValidatorService) Start() {
-	dialOpts := ConstructDialOptions(
-		v.maxCallRecvMsgSize,
-		v.withCert,
-		v.grpcRetries,
-		v.grpcRetryDelay,
-	)
-	if dialOpts == nil {
-		return
-	}
+	var validatorClient ethpb.BeaconNodeValidatorClient
+	var beaconClient iface.BeaconChainClient
+	var nodeClient iface.NodeClient
+	if v.beaconApiEndpoint != "" {
+		apiClient := beaconapi.NewClient(v.beaconApiEndpoint, beaconApiTimeout)
+		validatorClient, beaconClient, nodeClient = apiClient, apiClient, apiClient
+		log.WithField("endpoint", v.beaconApiEndpoint).Info("Using the standard beacon node API")
+	} else {
+		dialOpts := ConstructDialOptions(
+			v.maxCallRecvMsgSize,
+			v.withCert,
+			v.grpcRetries,
+			v.grpcRetryDelay,
+		)
+		if dialOpts == nil {
+			return
+		}
 
-	v.ctx = grpcutils.AppendHeaders(v.ctx, v.grpcHeaders)
+		v.ctx = grpcutils.AppendHeaders(v.ctx, v.grpcHeaders)
 
-	conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpts...)
-	if err != nil {
-		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
-		return
-	}
-	if v.withCert != "" {
-		log.Info("Established secure gRPC connection")
-	}
+		conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpts...)
+		if err != nil {
+			log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
+			return
+		}
+		if v.withCert != "" {
+			log.Info("Established secure gRPC connection")
+		}
 
-	v.conn = conn
+		v.conn = conn
+		validatorClient = ethpb.NewBeaconNodeValidatorClient(v.conn)
+		beaconClient = ethpb.NewBeaconChainClient(v.conn)
+		nodeClient = ethpb.NewNodeClient(v.conn)
+	}
+	v.nodeClient = nodeClient
 	cache, err := ristretto.NewCache(&ristretto.Config{
 		NumCounters: 1920, // number of keys to track.
 		MaxCost:     192,  // maximum cost of cache, 1 item = 1 cost.
@@ -179,9 +206,9 @@ func (v *ValidatorService) Start() {
 
 	v.validator = &validator{
 		db:                             v.db,
-		validatorClient:                ethpb.NewBeaconNodeValidatorClient(v.conn),
-		beaconClient:                   ethpb.NewBeaconChainClient(v.conn),
-		node:                           ethpb.NewNodeClient(v.conn),
+		validatorClient:                validatorClient,
+		beaconClient:                   beaconClient,
+		node:                           nodeClient,
 		keyManager:                     v.keyManager,
 		graffiti:                       v.graffiti,
 		logValidatorBalances:           v.logValidatorBalances,
@@ -217,7 +244,7 @@ func (v *ValidatorService) Stop() error {
 
 // Status of the validator service.
 func (v *ValidatorService) Status() error {
-	if v.conn == nil {
+	if v.conn == nil && v.beaconApiEndpoint == "" {
 		return errors.New("no connection to beacon RPC")
 	}
 	return nil
@@ -311,8 +338,10 @@ func ConstructDialOptions(
 
 // Syncing returns whether or not the beacon node is currently synchronizing the chain.
 func (v *ValidatorService) Syncing(ctx context.Context) (bool, error) {
-	nc := ethpb.NewNodeClient(v.conn)
-	resp, err := nc.GetSyncStatus(ctx, &emptypb.Empty{})
+	if v.nodeClient == nil {
+		return false, errors.New("not connected to a beacon node")
+	}
+	resp, err := v.nodeClient.GetSyncStatus(ctx, &emptypb.Empty{})
 	if err != nil {
 		return false, err
 	}
@@ -322,8 +351,10 @@ func (v *ValidatorService) Syncing(ctx context.Context) (bool, error) {
 // GenesisInfo queries the beacon node for the chain genesis info containing
 // the genesis time along with the validator deposit contract address.
 func (v *ValidatorService) GenesisInfo(ctx context.Context) (*ethpb.Genesis, error) {
-	nc := ethpb.NewNodeClient(v.conn)
-	return nc.GetGenesis(ctx, &emptypb.Empty{})
+	if v.nodeClient == nil {
+		return nil, errors.New("not connected to a beacon node")
+	}
+	return v.nodeClient.GetGenesis(ctx, &emptypb.Empty{})
 }
 
 // to accounts changes in the keymanager, then updates those keys'
@@ -2,13 +2,17 @@ package client
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	beaconapi "github.com/prysmaticlabs/prysm/validator/client/beacon-api"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"google.golang.org/grpc/metadata"
 )
@@ -70,6 +74,51 @@ func TestStatus_NoConnectionError(t *testing.T) {
 	assert.ErrorContains(t, "no connection", validatorService.Status())
 }
 
+func TestStatus_BeaconApiEndpoint(t *testing.T) {
+	validatorService := &ValidatorService{beaconApiEndpoint: "http://127.0.0.1:3500"}
+	assert.NoError(t, validatorService.Status())
+}
+
+func TestSyncingAndGenesisInfo_BeaconApiEndpoint(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/node/syncing", func(w http.ResponseWriter, _ *http.Request) {
+		_, err := w.Write([]byte(`{"data":{"head_slot":"100","sync_distance":"0","is_syncing":true}}`))
+		require.NoError(t, err)
+	})
+	mux.HandleFunc("/eth/v1/beacon/genesis", func(w http.ResponseWriter, _ *http.Request) {
+		_, err := w.Write([]byte(`{"data":{"genesis_time":"1606824023","genesis_validators_root":"0x01","genesis_fork_version":"0x00000000"}}`))
+		require.NoError(t, err)
+	})
+	mux.HandleFunc("/eth/v1/config/deposit_contract", func(w http.ResponseWriter, _ *http.Request) {
+		_, err := w.Write([]byte(`{"data":{"chain_id":"1","address":"0x00000000219ab540356cbb839cbe05303d7705fa"}}`))
+		require.NoError(t, err)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	validatorService := &ValidatorService{}
+	_, err := validatorService.Syncing(context.Background())
+	assert.ErrorContains(t, "not connected to a beacon node", err)
+	_, err = validatorService.GenesisInfo(context.Background())
+	assert.ErrorContains(t, "not connected to a beacon node", err)
+
+	// Without a gRPC connection, the beacon node REST API is queried.
+	validatorService.nodeClient = beaconapi.NewClient(srv.URL, time.Second)
+	syncing, err := validatorService.Syncing(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, true, syncing)
+	genesis, err := validatorService.GenesisInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1606824023), genesis.GenesisTime.Seconds)
+}
+
+func TestNewValidatorService_DoppelGangerWithBeaconApiEndpoint(t *testing.T) {
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{EnableDoppelGanger: true})
+	defer resetCfg()
+	_, err := NewValidatorService(context.Background(), &Config{BeaconApiEndpoint: "http://127.0.0.1:3500"})
+	assert.ErrorContains(t, "doppelganger protection is not supported", err)
+}
+
 func TestStart_GrpcHeaders(t *testing.T) {
 	hook := logTest.NewGlobal()
 	// Use canceled context so that the run function exits immediately.
@@ -74,9 +74,9 @@ type validator struct {
 	duties                             *ethpb.DutiesResponse
 	startBalances                      map[[48]byte]uint64
 	attLogs                            map[[32]byte]*attSubmitted
-	node                               ethpb.NodeClient
+	node                               iface.NodeClient
 	keyManager                         keymanager.IKeymanager
-	beaconClient                       ethpb.BeaconChainClient
+	beaconClient                       iface.BeaconChainClient
 	validatorClient                    ethpb.BeaconNodeValidatorClient
 	protector                          slashingiface.Protector
 	db                                 vdb.Database
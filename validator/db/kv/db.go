@@ -65,11 +65,14 @@ type Store struct {
 	batchedAttestationsChan            chan *AttestationRecord
 	batchAttestationsFlushedFeed       *event.Feed
 	batchedAttestationsFlushInProgress abool.AtomicBool
+	// collector is the bolt metrics collector registered by the store, nil if another
+	// store of the process already registered one.
+	collector prometheus.Collector
 }
 
 // Close closes the underlying boltdb database.
 func (s *Store) Close() error {
-	prometheus.Unregister(createBoltCollector(s.db))
+	s.unregisterCollector()
 	return s.db.Close()
 }
 
@@ -85,7 +88,7 @@ func (s *Store) ClearDB() error {
 	if _, err := os.Stat(s.databasePath); os.IsNotExist(err) {
 		return nil
 	}
-	prometheus.Unregister(createBoltCollector(s.db))
+	s.unregisterCollector()
 	return os.Remove(filepath.Join(s.databasePath, ProtectionDbFileName))
 }
 
@@ -173,7 +176,18 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 	// intervals to our database.
 	go kv.batchAttestationWrites(ctx)
 
-	return kv, prometheus.Register(createBoltCollector(kv.db))
+	// Several stores can be open in the same process, such as the slashing protection of
+	// in-process co-signers, in which case only the first one reports bolt metrics.
+	collector := createBoltCollector(kv.db)
+	if err := prometheus.Register(collector); err != nil {
+		var alreadyRegistered prometheus.AlreadyRegisteredError
+		if !errors.As(err, &alreadyRegistered) {
+			return nil, err
+		}
+		return kv, nil
+	}
+	kv.collector = collector
+	return kv, nil
 }
 
 // UpdatePublicKeysBuckets for a specified list of keys.
@@ -199,6 +213,13 @@ func (s *Store) Size() (int64, error) {
 	return size, err
 }
 
+func (s *Store) unregisterCollector() {
+	if s.collector != nil {
+		prometheus.Unregister(s.collector)
+		s.collector = nil
+	}
+}
+
 // createBoltCollector returns a prometheus collector specifically configured for boltdb.
 func createBoltCollector(db *bolt.DB) prometheus.Collector {
 	return prombolt.New("boltDB", db, blockedBuckets...)
@@ -0,0 +1,164 @@
+package threshold
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/promptutil"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// shutdownTimeout bounds the time given to in-flight signing requests when a co-signer stops.
+const shutdownTimeout = 5 * time.Second
+
+// RunCoSignerCli runs a co-signer for a key share via a CLI entrypoint, serving the signing
+// requests of threshold keymanagers until interrupted.
+//
+// Steps:
+// 1. Read and decrypt the EIP-2335 keystore of the key share.
+// 2. Read the authentication token keymanagers must present.
+// 3. Open the slashing protection database of the co-signer in the data directory.
+// 4. Serve signing requests on the listen address, over TLS if a certificate is given.
+func RunCoSignerCli(cliCtx *cli.Context) error {
+	c, store, err := coSignerFromCli(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := store.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator DB")
+		}
+	}()
+
+	certPath := cliCtx.String(flags.ThresholdCoSignerTLSCertFlag.Name)
+	keyPath := cliCtx.String(flags.ThresholdCoSignerTLSKeyFlag.Name)
+	if (certPath == "") != (keyPath == "") {
+		return errors.New("both a TLS certificate and a TLS key are required to serve over TLS")
+	}
+	useTLS := certPath != ""
+	if !useTLS {
+		log.Warn("Serving signing requests without TLS, the authentication token is sent in clear text")
+	}
+	if c.allowExits {
+		log.Warn("Signing of voluntary exits is allowed")
+	}
+
+	srv := &http.Server{
+		Addr:    cliCtx.String(flags.ThresholdCoSignerListenAddressFlag.Name),
+		Handler: c,
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		if useTLS {
+			errCh <- srv.ListenAndServeTLS(certPath, keyPath)
+			return
+		}
+		errCh <- srv.ListenAndServe()
+	}()
+	log.WithFields(logrus.Fields{
+		"address":    srv.Addr,
+		"tls":        useTLS,
+		"publicKey":  bytesutil.Trunc(c.pubKey[:]),
+		"shareIndex": c.share.Index,
+	}).Info("Serving threshold signing requests")
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigc)
+	select {
+	case err := <-errCh:
+		return errors.Wrap(err, "co-signer server failed")
+	case <-sigc:
+	case <-cliCtx.Context.Done():
+	}
+	log.Info("Shutting down co-signer")
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return srv.Shutdown(ctx)
+}
+
+// coSignerFromCli instantiates a co-signer from the key share keystore, the authentication
+// token and the data directory given on the command line.
+func coSignerFromCli(cliCtx *cli.Context) (*CoSigner, *kv.Store, error) {
+	authToken, err := readAuthToken(cliCtx.String(flags.ThresholdCoSignerAuthTokenFileFlag.Name))
+	if err != nil {
+		return nil, nil, err
+	}
+	keystorePath, err := inputFilePath(cliCtx, flags.ThresholdShareKeystoreFlag, "Path to the keystore of the key share")
+	if err != nil {
+		return nil, nil, err
+	}
+	keystore, err := ReadShareKeystoreFile(keystorePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	password, err := promptutil.InputPassword(
+		cliCtx,
+		flags.ThresholdSharePasswordFileFlag,
+		"Password of the key share keystore",
+		"Confirm password",
+		false, /* Do not confirm password */
+		promptutil.NotEmpty,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	share, err := DecryptShare(keystore, password)
+	if err != nil {
+		return nil, nil, err
+	}
+	pubKey, err := decodePublicKey(keystore.ValidatorPublicKey)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not decode validator public key of key share keystore")
+	}
+
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	store, err := kv.NewKVStore(cliCtx.Context, dataDir, &kv.Config{
+		PubKeys: [][48]byte{bytesutil.ToBytes48(pubKey.Marshal())},
+	})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "could not access validator database at path %s", dataDir)
+	}
+	c, err := NewCoSigner(&CoSignerConfig{
+		PublicKey:  pubKey,
+		Share:      share,
+		DB:         store,
+		AuthToken:  authToken,
+		AllowExits: cliCtx.Bool(flags.ThresholdCoSignerAllowExitsFlag.Name),
+	})
+	if err != nil {
+		if closeErr := store.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close validator DB")
+		}
+		return nil, nil, err
+	}
+	return c, store, nil
+}
+
+// readAuthToken reads the authentication token of a co-signer from a file.
+func readAuthToken(path string) (string, error) {
+	if path == "" {
+		return "", errors.Errorf("--%s is required", flags.ThresholdCoSignerAuthTokenFileFlag.Name)
+	}
+	enc, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", errors.Wrap(err, "could not read authentication token file")
+	}
+	token := strings.TrimSpace(string(enc))
+	if token == "" {
+		return "", errors.New("authentication token file is empty")
+	}
+	return token, nil
+}
@@ -0,0 +1,163 @@
+package threshold
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/promptutil"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/urfave/cli/v2"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+// SharePublicKeysFileName is the name of the file listing the public keys of the key shares
+// written by the split-key command.
+const SharePublicKeysFileName = "share-public-keys.json"
+
+// SharePublicKeys lists the public keys of the shares a validator key was split into, which
+// are used to fill in the co-signers of the keymanager options.
+type SharePublicKeys struct {
+	PublicKey string            `json:"public_key"`
+	Threshold uint64            `json:"threshold"`
+	Shares    []*SharePublicKey `json:"shares"`
+}
+
+// SharePublicKey is the public key of the key share with the given index.
+type SharePublicKey struct {
+	Index     uint64 `json:"index"`
+	PublicKey string `json:"public_key"`
+}
+
+// SplitKeyCli splits a validator key into shares for threshold signing via a CLI entrypoint.
+//
+// Steps:
+// 1. Read and decrypt the EIP-2335 keystore of the validator key.
+// 2. Split the validator key into n shares, any threshold of which can sign.
+// 3. Write every share to an EIP-2335 keystore in the output directory, along with a file
+// listing the public keys of the shares.
+func SplitKeyCli(cliCtx *cli.Context) error {
+	keystorePath, err := inputFilePath(cliCtx, flags.ThresholdValidatorKeystoreFlag, "Path to the keystore of the validator key to split")
+	if err != nil {
+		return err
+	}
+	secretKey, err := readValidatorKeystore(cliCtx, keystorePath)
+	if err != nil {
+		return err
+	}
+	threshold := cliCtx.Uint64(flags.ThresholdFlag.Name)
+	shares, err := bls.SplitSecretKey(secretKey, threshold, cliCtx.Uint64(flags.ThresholdSharesFlag.Name))
+	if err != nil {
+		return errors.Wrap(err, "could not split validator key")
+	}
+	outputDir, err := inputFilePath(cliCtx, flags.ThresholdOutputDirFlag, "Directory to write the key shares to")
+	if err != nil {
+		return err
+	}
+	if err := fileutil.MkdirAll(outputDir); err != nil {
+		return errors.Wrapf(err, "could not create output directory %s", outputDir)
+	}
+	password, err := promptutil.InputPassword(
+		cliCtx,
+		flags.ThresholdSharePasswordFileFlag,
+		"New password for the key share keystores",
+		"Confirm password",
+		true, /* Should confirm password */
+		promptutil.ValidatePasswordInput,
+	)
+	if err != nil {
+		return err
+	}
+
+	pubKey := secretKey.PublicKey()
+	publicKeys := &SharePublicKeys{
+		PublicKey: fmt.Sprintf("%#x", pubKey.Marshal()),
+		Threshold: threshold,
+		Shares:    make([]*SharePublicKey, len(shares)),
+	}
+	for i, share := range shares {
+		keystore, err := EncryptShare(share, pubKey, password)
+		if err != nil {
+			return err
+		}
+		enc, err := json.MarshalIndent(keystore, "", "\t")
+		if err != nil {
+			return err
+		}
+		fileName := fmt.Sprintf("share-keystore-%d.json", share.Index)
+		if err := fileutil.WriteFile(filepath.Join(outputDir, fileName), enc); err != nil {
+			return errors.Wrapf(err, "could not write keystore of share %d", share.Index)
+		}
+		publicKeys.Shares[i] = &SharePublicKey{
+			Index:     share.Index,
+			PublicKey: fmt.Sprintf("%#x", share.Key.PublicKey().Marshal()),
+		}
+	}
+	enc, err := json.MarshalIndent(publicKeys, "", "\t")
+	if err != nil {
+		return err
+	}
+	if err := fileutil.WriteFile(filepath.Join(outputDir, SharePublicKeysFileName), enc); err != nil {
+		return errors.Wrap(err, "could not write share public keys")
+	}
+	log.WithField("outputDir", outputDir).Infof(
+		"Split validator key %#x into %d shares with a threshold of %d", pubKey.Marshal(), len(shares), threshold,
+	)
+	return nil
+}
+
+// readValidatorKeystore reads and decrypts the EIP-2335 keystore of a validator key.
+func readValidatorKeystore(cliCtx *cli.Context, path string) (bls.SecretKey, error) {
+	enc, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read validator keystore")
+	}
+	keystore := &keymanager.Keystore{}
+	if err := json.Unmarshal(enc, keystore); err != nil {
+		return nil, errors.Wrap(err, "could not decode validator keystore")
+	}
+	password, err := promptutil.InputPassword(
+		cliCtx,
+		flags.AccountPasswordFileFlag,
+		"Password of the validator keystore",
+		"Confirm password",
+		false, /* Do not confirm password */
+		promptutil.NotEmpty,
+	)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := keystorev4.New().Decrypt(keystore.Crypto, password)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decrypt validator keystore")
+	}
+	secretKey, err := bls.SecretKeyFromBytes(secret)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create validator secret key")
+	}
+	return secretKey, nil
+}
+
+// inputFilePath reads a path from a flag, or prompts for it if the flag is not set.
+func inputFilePath(cliCtx *cli.Context, flag *cli.StringFlag, promptText string) (string, error) {
+	path := cliCtx.String(flag.Name)
+	if path == "" {
+		var err error
+		path, err = promptutil.ValidatePrompt(os.Stdin, promptText, promptutil.NotEmpty)
+		if err != nil {
+			return "", err
+		}
+	}
+	fullPath, err := fileutil.ExpandPath(strings.TrimRight(path, "\r\n"))
+	if err != nil {
+		return "", errors.Wrapf(err, "could not determine absolute path for %s", path)
+	}
+	return fullPath, nil
+}
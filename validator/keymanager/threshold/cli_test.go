@@ -0,0 +1,111 @@
+package threshold
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/urfave/cli/v2"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+func writeFile(t *testing.T, dir, name string, data []byte) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, ioutil.WriteFile(path, data, params.BeaconIoConfig().ReadWritePermissions))
+	return path
+}
+
+func setupCliCtx(t *testing.T, values map[string]string) *cli.Context {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.Uint64(flags.ThresholdFlag.Name, flags.ThresholdFlag.Value, "")
+	set.Uint64(flags.ThresholdSharesFlag.Name, flags.ThresholdSharesFlag.Value, "")
+	for name, value := range values {
+		if name != flags.ThresholdFlag.Name && name != flags.ThresholdSharesFlag.Name {
+			set.String(name, value, "")
+		}
+		require.NoError(t, set.Set(name, value))
+	}
+	return cli.NewContext(&app, set, nil)
+}
+
+func TestSplitKeyCli_CoSignerFromCli(t *testing.T) {
+	dir := t.TempDir()
+	outputDir := filepath.Join(dir, "shares")
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	cryptoFields, err := keystorev4.New().Encrypt(secretKey.Marshal(), "validatorpassword")
+	require.NoError(t, err)
+	enc, err := json.Marshal(&keymanager.Keystore{
+		Crypto:  cryptoFields,
+		Pubkey:  fmt.Sprintf("%x", secretKey.PublicKey().Marshal()),
+		Version: 4,
+	})
+	require.NoError(t, err)
+	validatorKeystore := writeFile(t, dir, "keystore.json", enc)
+	validatorPasswordFile := writeFile(t, dir, "validator-password.txt", []byte("validatorpassword"))
+	sharePasswordFile := writeFile(t, dir, "share-password.txt", []byte(walletPassword))
+
+	cliCtx := setupCliCtx(t, map[string]string{
+		flags.ThresholdValidatorKeystoreFlag.Name: validatorKeystore,
+		flags.AccountPasswordFileFlag.Name:        validatorPasswordFile,
+		flags.ThresholdFlag.Name:                  "2",
+		flags.ThresholdSharesFlag.Name:            "3",
+		flags.ThresholdOutputDirFlag.Name:         outputDir,
+		flags.ThresholdSharePasswordFileFlag.Name: sharePasswordFile,
+	})
+	require.NoError(t, SplitKeyCli(cliCtx))
+
+	enc, err = ioutil.ReadFile(filepath.Join(outputDir, SharePublicKeysFileName))
+	require.NoError(t, err)
+	publicKeys := &SharePublicKeys{}
+	require.NoError(t, json.Unmarshal(enc, publicKeys))
+	assert.Equal(t, fmt.Sprintf("%#x", secretKey.PublicKey().Marshal()), publicKeys.PublicKey)
+	assert.Equal(t, uint64(2), publicKeys.Threshold)
+	require.Equal(t, 3, len(publicKeys.Shares))
+
+	// Any two of the written shares sign for the validator key.
+	msg := []byte("message")
+	sigs := make([]*bls.SignatureShare, 0, 2)
+	for _, i := range []uint64{1, 3} {
+		keystore, err := ReadShareKeystoreFile(filepath.Join(outputDir, fmt.Sprintf("share-keystore-%d.json", i)))
+		require.NoError(t, err)
+		share, err := DecryptShare(keystore, walletPassword)
+		require.NoError(t, err)
+		assert.Equal(t, publicKeys.Shares[i-1].PublicKey, fmt.Sprintf("%#x", share.Key.PublicKey().Marshal()))
+		sigs = append(sigs, &bls.SignatureShare{Index: share.Index, Signature: share.Key.Sign(msg)})
+	}
+	sig, err := bls.RecoverSignature(sigs)
+	require.NoError(t, err)
+	assert.DeepEqual(t, secretKey.Sign(msg).Marshal(), sig.Marshal())
+
+	cosignerValues := map[string]string{
+		flags.ThresholdShareKeystoreFlag.Name:     filepath.Join(outputDir, "share-keystore-2.json"),
+		flags.ThresholdSharePasswordFileFlag.Name: sharePasswordFile,
+		cmd.DataDirFlag.Name:                      filepath.Join(dir, "cosigner"),
+	}
+	_, _, err = coSignerFromCli(setupCliCtx(t, cosignerValues))
+	assert.ErrorContains(t, "--threshold-cosigner-auth-token-file is required", err)
+
+	cosignerValues[flags.ThresholdCoSignerAuthTokenFileFlag.Name] = writeFile(t, dir, "token.txt", []byte(testAuthToken+"\n"))
+	c, store, err := coSignerFromCli(setupCliCtx(t, cosignerValues))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, store.Close())
+	}()
+	assert.Equal(t, uint64(2), c.share.Index)
+	assert.Equal(t, bytesutil.ToBytes48(secretKey.PublicKey().Marshal()), c.pubKey)
+	assert.Equal(t, testAuthToken, c.authToken)
+	assert.Equal(t, false, c.allowExits)
+}
@@ -0,0 +1,84 @@
+package threshold
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// SignPath is the path of the signing endpoint of a co-signer.
+const SignPath = "/api/v1/threshold/sign"
+
+// bearerPrefix prefixes the authentication token in the Authorization header of signing requests.
+const bearerPrefix = "Bearer "
+
+// SignResponse is the answer of a co-signer to a signing request, the partial signature
+// created with the share of the given index.
+type SignResponse struct {
+	Index     uint64 `json:"index"`
+	Signature string `json:"signature"`
+}
+
+// client performs requests against the HTTP API of a co-signer.
+type client struct {
+	baseURL    string
+	authToken  string
+	httpClient *http.Client
+}
+
+// sign requests a partial signature of the given request, which is sent as protobuf JSON so
+// that the co-signer can verify the signing root and apply slashing protection.
+func (c *client) sign(ctx context.Context, signReq *validatorpb.SignRequest) (uint64, []byte, error) {
+	enc, err := protojson.Marshal(signReq)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "could not encode sign request")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+SignPath, bytes.NewReader(enc))
+	if err != nil {
+		return 0, nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", bearerPrefix+c.authToken)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "could not reach co-signer")
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "could not read response of co-signer")
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return 0, nil, ErrPublicKeyNotFound
+	case http.StatusUnauthorized:
+		return 0, nil, errors.Wrap(ErrSigningFailed, "co-signer refused the authentication token")
+	case http.StatusPreconditionFailed:
+		return 0, nil, errors.Wrap(ErrSigningDenied, strings.TrimSpace(string(body)))
+	default:
+		return 0, nil, errors.Wrap(ErrSigningFailed, fmt.Sprintf("%s returned %d: %s", req.URL.Path, resp.StatusCode, strings.TrimSpace(string(body))))
+	}
+	signResp := &SignResponse{}
+	if err := json.Unmarshal(body, signResp); err != nil {
+		return 0, nil, errors.Wrap(err, "could not decode sign response")
+	}
+	sig, err := hexutil.Decode(signResp.Signature)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "could not decode signature")
+	}
+	return signResp.Index, sig, nil
+}
@@ -0,0 +1,163 @@
+package threshold
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/db/iface"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// maxRequestSize bounds the size of a signing request accepted by a co-signer.
+const maxRequestSize = 1 << 20
+
+// errInvalidRequest defines a signing request whose object does not match its signing root.
+var errInvalidRequest = errors.New("invalid sign request")
+
+// CoSignerConfig includes configuration values for initializing a co-signer.
+type CoSignerConfig struct {
+	PublicKey bls.PublicKey
+	Share     *bls.SecretKeyShare
+	DB        iface.ValidatorDB
+	// AuthToken is the bearer token the keymanagers must present in their signing requests.
+	AuthToken string
+	// AllowExits allows signing voluntary exits. They are not covered by slashing protection
+	// and cannot be undone, so they are refused unless explicitly allowed.
+	AllowExits bool
+}
+
+// CoSigner holds a share of a validator key and creates partial signatures for a threshold
+// keymanager. It serves the signing endpoint over HTTP and keeps its own slashing protection
+// history, independently of the keymanager and of the other co-signers.
+type CoSigner struct {
+	pubKey     [48]byte
+	share      *bls.SecretKeyShare
+	db         iface.ValidatorDB
+	authToken  string
+	allowExits bool
+	// lock serializes the slashing protection checks and updates of signing requests.
+	lock sync.Mutex
+}
+
+// NewCoSigner instantiates a co-signer for the share of the validator key with the given
+// public key.
+func NewCoSigner(cfg *CoSignerConfig) (*CoSigner, error) {
+	if cfg.PublicKey == nil {
+		return nil, errors.New("validator public key is required")
+	}
+	if cfg.Share == nil || cfg.Share.Key == nil || cfg.Share.Index == 0 {
+		return nil, errors.New("secret key share is required")
+	}
+	if cfg.DB == nil {
+		return nil, errors.New("slashing protection database is required")
+	}
+	if cfg.AuthToken == "" {
+		return nil, errors.New("authentication token is required")
+	}
+	return &CoSigner{
+		pubKey:     bytesutil.ToBytes48(cfg.PublicKey.Marshal()),
+		share:      cfg.Share,
+		db:         cfg.DB,
+		authToken:  cfg.AuthToken,
+		allowExits: cfg.AllowExits,
+	}, nil
+}
+
+// Sign creates a partial signature for the request after checking that its signing root
+// matches the signed object and that signing it is not slashable. Voluntary exits are only
+// signed if the co-signer allows them.
+func (c *CoSigner) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	if req == nil {
+		return nil, errors.Wrap(errInvalidRequest, "nil sign request")
+	}
+	if bytesutil.ToBytes48(req.PublicKey) != c.pubKey {
+		return nil, ErrPublicKeyNotFound
+	}
+	if _, ok := req.Object.(*validatorpb.SignRequest_Exit); ok && !c.allowExits {
+		return nil, errors.Wrap(ErrSigningDenied, "signing voluntary exits is not allowed")
+	}
+	signingRoot, err := signingRootFromRequest(req)
+	if err != nil {
+		return nil, errors.Wrap(errInvalidRequest, err.Error())
+	}
+	if signingRoot != bytesutil.ToBytes32(req.SigningRoot) {
+		return nil, errors.Wrap(errInvalidRequest, "signing root does not match the signed object")
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err := c.slashingProtection(ctx, req, signingRoot); err != nil {
+		return nil, err
+	}
+	return c.share.Key.Sign(signingRoot[:]), nil
+}
+
+// ServeHTTP handles signing requests of a threshold keymanager on SignPath. Requests must be
+// authenticated with the bearer token of the co-signer.
+func (c *CoSigner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != SignPath {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !c.authorized(r) {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestSize))
+	if err != nil {
+		http.Error(w, "could not read request", http.StatusBadRequest)
+		return
+	}
+	req := &validatorpb.SignRequest{}
+	if err := protojson.Unmarshal(body, req); err != nil {
+		http.Error(w, "could not decode sign request", http.StatusBadRequest)
+		return
+	}
+	sig, err := c.Sign(r.Context(), req)
+	if err != nil {
+		fields := logrus.Fields{"publicKey": fmt.Sprintf("%#x", req.PublicKey)}
+		switch {
+		case errors.Is(err, ErrPublicKeyNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case errors.Is(err, ErrSigningDenied):
+			log.WithFields(fields).WithError(err).Warn("Refused to sign request")
+			http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		case errors.Is(err, errInvalidRequest):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			log.WithFields(fields).WithError(err).Error("Could not sign request")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+	enc, err := json.Marshal(&SignResponse{Index: c.share.Index, Signature: hexutil.Encode(sig.Marshal())})
+	if err != nil {
+		http.Error(w, "could not encode sign response", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(enc); err != nil {
+		log.WithError(err).Debug("Could not write sign response")
+	}
+}
+
+// authorized returns true if the request carries the bearer token of the co-signer.
+func (c *CoSigner) authorized(r *http.Request) bool {
+	want := []byte(bearerPrefix + c.authToken)
+	return subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), want) == 1
+}
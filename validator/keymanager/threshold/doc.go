@@ -0,0 +1,44 @@
+/*
+Package threshold defines a keymanager implementation which spreads a single validator key
+across several hosts, so that no host holds the full secret key.
+
+The secret key is split into n Shamir shares with bls.SplitSecretKey, any threshold t of
+which can produce signatures of the validator key. The keymanager holds one share and the
+public key of the validator. To sign, it creates a partial signature with its own share and
+requests partial signatures from the co-signers holding the other shares via
+POST /api/v1/threshold/sign, verifying each against the public key of the share. As soon as
+t valid partial signatures are collected they are combined with bls.RecoverSignature.
+
+Co-signers are served by CoSigner, which recomputes the signing root of every request from
+the signed object and runs local slashing protection for blocks and attestations against
+its own validator database before signing, so a compromised keymanager cannot obtain
+slashable signatures from honest co-signers. Requests must carry the bearer token of the
+co-signer in their Authorization header, and co-signers serve them over TLS when given a
+certificate. Voluntary exits are not slashable but cannot be undone, so co-signers refuse to
+sign them unless the operator allows it with --threshold-cosigner-allow-exits.
+
+The validator key is split with the `validator threshold split-key` command, which writes
+one EIP-2335 keystore per share along with the public keys of the shares. Each co-signer is
+run with the `validator threshold cosigner` command from the keystore of its share. The
+share of the keymanager is imported at wallet creation and stored in the wallet in an
+EIP-2335 keystore encrypted with the wallet password.
+
+The keymanager can be customized via a keymanageropts.json file, which holds the
+authentication tokens of the co-signers and requires the following schema:
+
+	{
+	  "public_key": "0xa99a...", // Public key of the validator.
+	  "threshold": 2, // Number of partial signatures required to sign.
+	  "share_index": 1, // Index of the share held by the keymanager.
+	  "cosigners": [
+	    {
+	      "index": 2, // Index of the share held by the co-signer.
+	      "url": "https://cosigner-2.example.com:7600", // Co-signer URL.
+	      "public_key": "0x8f1c...", // Public key of the share held by the co-signer.
+	      "auth_token": "...", // Bearer token of the co-signer.
+	      "ca_cert_path": "/path/to/ca.crt" // Optional CA certificate of the co-signer.
+	    }
+	  ]
+	}
+*/
+package threshold
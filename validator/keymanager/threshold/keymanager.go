@@ -0,0 +1,334 @@
+package threshold
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/logrusorgru/aurora"
+	"github.com/pkg/errors"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/validator/accounts/iface"
+)
+
+var (
+	// ErrSigningDenied defines a signing request refused by the slashing
+	// protection of a co-signer.
+	ErrSigningDenied = errors.New("signing request was denied by co-signer")
+	// ErrSigningFailed defines a failure from a co-signer when performing a signing operation.
+	ErrSigningFailed = errors.New("signing failed in co-signer")
+	// ErrPublicKeyNotFound defines a signing request for a key unknown to a co-signer.
+	ErrPublicKeyNotFound = errors.New("public key not found in co-signer")
+	// ErrNotEnoughShares defines a signing request for which fewer valid partial
+	// signatures than the threshold could be collected.
+	ErrNotEnoughShares = errors.New("not enough partial signatures to reach the threshold")
+)
+
+// requestTimeout bounds every request made to a co-signer.
+var requestTimeout = 10 * time.Second
+
+// CoSignerOpts defines the connection to a co-signer holding a share of the validator key.
+type CoSignerOpts struct {
+	Index      uint64 `json:"index"`
+	URL        string `json:"url"`
+	PublicKey  string `json:"public_key"`
+	AuthToken  string `json:"auth_token"`
+	CACertPath string `json:"ca_cert_path,omitempty"`
+}
+
+// KeymanagerOpts for a threshold keymanager.
+type KeymanagerOpts struct {
+	PublicKey  string          `json:"public_key"`
+	Threshold  uint64          `json:"threshold"`
+	ShareIndex uint64          `json:"share_index"`
+	CoSigners  []*CoSignerOpts `json:"cosigners"`
+}
+
+// SetupConfig includes configuration values for initializing a threshold keymanager.
+// The key share is read from the keystore of the wallet, encrypted with the wallet password.
+type SetupConfig struct {
+	Wallet iface.Wallet
+	Opts   *KeymanagerOpts
+}
+
+// Keymanager implementation holding a share of a validator key, which combines its own
+// partial signatures with those of remote co-signers into signatures of the validator key.
+type Keymanager struct {
+	opts                *KeymanagerOpts
+	pubKey              [48]byte
+	publicKey           bls.PublicKey
+	threshold           uint64
+	share               *bls.SecretKeyShare
+	cosigners           []*cosigner
+	accountsChangedFeed *event.Feed
+}
+
+// cosigner is a co-signer along with the public key of its share, which is used to
+// verify its partial signatures.
+type cosigner struct {
+	index     uint64
+	publicKey bls.PublicKey
+	client    *client
+}
+
+// NewKeymanager instantiates a new threshold keymanager from configuration options.
+func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	opts := cfg.Opts
+	if opts == nil {
+		return nil, errors.New("keymanager options are required")
+	}
+	if cfg.Wallet == nil {
+		return nil, errors.New("wallet is required")
+	}
+	keystore, share, err := readShareKeystore(ctx, cfg.Wallet)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := checkShare(opts, keystore, share)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Threshold == 0 || opts.Threshold > uint64(len(opts.CoSigners))+1 {
+		return nil, fmt.Errorf("threshold %d is not reachable with %d co-signers", opts.Threshold, len(opts.CoSigners))
+	}
+	indices := map[uint64]bool{opts.ShareIndex: true}
+	cosigners := make([]*cosigner, len(opts.CoSigners))
+	for i, c := range opts.CoSigners {
+		if c == nil || c.URL == "" {
+			return nil, fmt.Errorf("co-signer %d has no URL", i)
+		}
+		if c.Index == 0 || indices[c.Index] {
+			return nil, fmt.Errorf("co-signer %s has invalid or duplicate share index %d", c.URL, c.Index)
+		}
+		indices[c.Index] = true
+		if c.AuthToken == "" {
+			return nil, fmt.Errorf("co-signer %s has no auth token", c.URL)
+		}
+		sharePubKey, err := decodePublicKey(c.PublicKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode share public key of co-signer %s", c.URL)
+		}
+		httpClient, err := cosignerHTTPClient(c)
+		if err != nil {
+			return nil, err
+		}
+		cosigners[i] = &cosigner{
+			index:     c.Index,
+			publicKey: sharePubKey,
+			client: &client{
+				baseURL:    normalizeURL(c.URL),
+				authToken:  c.AuthToken,
+				httpClient: httpClient,
+			},
+		}
+	}
+	return &Keymanager{
+		opts:                opts,
+		pubKey:              bytesutil.ToBytes48(pubKey.Marshal()),
+		publicKey:           pubKey,
+		threshold:           opts.Threshold,
+		share:               share,
+		cosigners:           cosigners,
+		accountsChangedFeed: new(event.Feed),
+	}, nil
+}
+
+// cosignerHTTPClient returns the HTTP client for requests to a co-signer, which trusts the
+// CA certificate of the co-signer if one is configured.
+func cosignerHTTPClient(c *CoSignerOpts) (*http.Client, error) {
+	httpClient := &http.Client{Timeout: requestTimeout}
+	if c.CACertPath == "" {
+		return httpClient, nil
+	}
+	caCert, err := ioutil.ReadFile(c.CACertPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read CA certificate of co-signer %s", c.URL)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("could not add CA certificate of co-signer %s to pool", c.URL)
+	}
+	httpClient.Transport = &http.Transport{
+		TLSClientConfig: &tls.Config{
+			RootCAs:    pool,
+			MinVersion: tls.VersionTLS13,
+		},
+	}
+	return httpClient, nil
+}
+
+// UnmarshalOptionsFile attempts to JSON unmarshal a keymanager
+// options file into a struct.
+func UnmarshalOptionsFile(r io.ReadCloser) (*KeymanagerOpts, error) {
+	enc, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read config")
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.Errorf("Could not close keymanager config file: %v", err)
+		}
+	}()
+	opts := &KeymanagerOpts{}
+	if err := json.Unmarshal(enc, opts); err != nil {
+		return nil, errors.Wrap(err, "could not JSON unmarshal")
+	}
+	return opts, nil
+}
+
+// MarshalOptionsFile for the keymanager.
+func MarshalOptionsFile(_ context.Context, cfg *KeymanagerOpts) ([]byte, error) {
+	return json.MarshalIndent(cfg, "", "\t")
+}
+
+// String pretty-print of threshold keymanager options.
+func (opts *KeymanagerOpts) String() string {
+	au := aurora.NewAurora(true)
+	var b strings.Builder
+	lines := []string{
+		fmt.Sprintf("%s: %s\n", au.BrightMagenta("Validator public key"), opts.PublicKey),
+		fmt.Sprintf("%s: %d of %d\n", au.BrightMagenta("Threshold"), opts.Threshold, len(opts.CoSigners)+1),
+		fmt.Sprintf("%s: %d\n", au.BrightMagenta("Share index"), opts.ShareIndex),
+	}
+	for _, c := range opts.CoSigners {
+		lines = append(lines, fmt.Sprintf("%s %d: %s\n", au.BrightMagenta("Co-signer"), c.Index, c.URL))
+	}
+	for _, line := range lines {
+		if _, err := b.WriteString(line); err != nil {
+			log.Error(err)
+			return ""
+		}
+	}
+	return b.String()
+}
+
+// KeymanagerOpts for the threshold keymanager.
+func (km *Keymanager) KeymanagerOpts() *KeymanagerOpts {
+	return km.opts
+}
+
+// FetchValidatingPublicKeys returns the public key of the validator whose key is split.
+func (km *Keymanager) FetchValidatingPublicKeys(_ context.Context) ([][48]byte, error) {
+	return [][48]byte{km.pubKey}, nil
+}
+
+// Sign signs a message for the validator key by combining the partial signature of the
+// local share with partial signatures requested from the co-signers.
+func (km *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	if req == nil {
+		return nil, errors.New("nil sign request")
+	}
+	if bytesutil.ToBytes48(req.PublicKey) != km.pubKey {
+		return nil, fmt.Errorf("no key share for public key %#x", req.PublicKey)
+	}
+	shares := []*bls.SignatureShare{{
+		Index:     km.share.Index,
+		Signature: km.share.Key.Sign(req.SigningRoot),
+	}}
+	if uint64(len(shares)) < km.threshold {
+		remote, err := km.collectShares(ctx, req, km.threshold-uint64(len(shares)))
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, remote...)
+	}
+	sig, err := bls.RecoverSignature(shares)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not combine partial signatures")
+	}
+	if !sig.Verify(km.publicKey, req.SigningRoot) {
+		return nil, errors.New("combined signature is invalid")
+	}
+	return sig, nil
+}
+
+// collectShares requests partial signatures from all co-signers concurrently and returns as
+// soon as the wanted number of valid partial signatures was received.
+func (km *Keymanager) collectShares(ctx context.Context, req *validatorpb.SignRequest, wanted uint64) ([]*bls.SignatureShare, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		share *bls.SignatureShare
+		err   error
+	}
+	results := make(chan result, len(km.cosigners))
+	for _, c := range km.cosigners {
+		go func(c *cosigner) {
+			share, err := c.partialSignature(ctx, req)
+			results <- result{share: share, err: err}
+		}(c)
+	}
+
+	shares := make([]*bls.SignatureShare, 0, wanted)
+	var errs []string
+	for range km.cosigners {
+		res := <-results
+		if res.err != nil {
+			errs = append(errs, res.err.Error())
+			continue
+		}
+		shares = append(shares, res.share)
+		if uint64(len(shares)) == wanted {
+			return shares, nil
+		}
+	}
+	return nil, errors.Wrapf(
+		ErrNotEnoughShares,
+		"got %d of %d partial signatures from co-signers: %s", len(shares), wanted, strings.Join(errs, "; "),
+	)
+}
+
+// partialSignature requests a partial signature from the co-signer and verifies it against
+// the public key of its share.
+func (c *cosigner) partialSignature(ctx context.Context, req *validatorpb.SignRequest) (*bls.SignatureShare, error) {
+	index, enc, err := c.client.sign(ctx, req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "co-signer %d", c.index)
+	}
+	if index != c.index {
+		return nil, fmt.Errorf("co-signer %d answered with share index %d", c.index, index)
+	}
+	sig, err := bls.SignatureFromBytes(enc)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decode partial signature of co-signer %d", c.index)
+	}
+	if !sig.Verify(c.publicKey, req.SigningRoot) {
+		return nil, fmt.Errorf("invalid partial signature from co-signer %d", c.index)
+	}
+	return &bls.SignatureShare{Index: c.index, Signature: sig}, nil
+}
+
+// SubscribeAccountChanges creates an event subscription for a channel
+// to listen for public key changes at runtime. The public key of a threshold
+// keymanager does not change, so no event is sent.
+func (km *Keymanager) SubscribeAccountChanges(pubKeysChan chan [][48]byte) event.Subscription {
+	return km.accountsChangedFeed.Subscribe(pubKeysChan)
+}
+
+func decodePublicKey(s string) (bls.PublicKey, error) {
+	enc, err := hexutil.Decode(s)
+	if err != nil {
+		return nil, err
+	}
+	return bls.PublicKeyFromBytes(enc)
+}
+
+func normalizeURL(url string) string {
+	url = strings.TrimSuffix(url, "/")
+	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+		url = "http://" + url
+	}
+	return url
+}
@@ -0,0 +1,219 @@
+package threshold
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	mock "github.com/prysmaticlabs/prysm/validator/accounts/testing"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+)
+
+const walletPassword = "Pa$sW0rD0__Fo0xPr"
+
+const testAuthToken = "cosigner-auth-token"
+
+// setupThreshold splits a new validator key into n shares, keeps the first one for the
+// keymanager in the keystore of a wallet and serves the others from in-process co-signers.
+func setupThreshold(t *testing.T, threshold, n uint64) (bls.SecretKey, *SetupConfig, []*httptest.Server) {
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	shares, err := bls.SplitSecretKey(secretKey, threshold, n)
+	require.NoError(t, err)
+	pubKey := bytesutil.ToBytes48(secretKey.PublicKey().Marshal())
+
+	opts := &KeymanagerOpts{
+		PublicKey:  hexutil.Encode(pubKey[:]),
+		Threshold:  threshold,
+		ShareIndex: shares[0].Index,
+	}
+	wallet := &mock.Wallet{
+		Files:          make(map[string]map[string][]byte),
+		WalletPassword: walletPassword,
+	}
+	keystore, err := EncryptShare(shares[0], secretKey.PublicKey(), walletPassword)
+	require.NoError(t, err)
+	require.NoError(t, ImportShareKeystore(context.Background(), wallet, opts, keystore, walletPassword))
+
+	servers := make([]*httptest.Server, 0, n-1)
+	for _, share := range shares[1:] {
+		c, err := NewCoSigner(&CoSignerConfig{
+			PublicKey: secretKey.PublicKey(),
+			Share:     share,
+			DB:        dbtest.SetupDB(t, [][48]byte{pubKey}),
+			AuthToken: testAuthToken,
+		})
+		require.NoError(t, err)
+		srv := httptest.NewServer(c)
+		t.Cleanup(srv.Close)
+		servers = append(servers, srv)
+		opts.CoSigners = append(opts.CoSigners, &CoSignerOpts{
+			Index:     share.Index,
+			URL:       srv.URL,
+			PublicKey: hexutil.Encode(share.Key.PublicKey().Marshal()),
+			AuthToken: testAuthToken,
+		})
+	}
+	return secretKey, &SetupConfig{Wallet: wallet, Opts: opts}, servers
+}
+
+func TestKeymanager_Sign(t *testing.T) {
+	ctx := context.Background()
+	secretKey, cfg, servers := setupThreshold(t, 3, 5)
+	km, err := NewKeymanager(ctx, cfg)
+	require.NoError(t, err)
+	pubKey := bytesutil.ToBytes48(secretKey.PublicKey().Marshal())
+
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][48]byte{pubKey}, keys)
+
+	req := blockRequest(t, pubKey, 10, 'a')
+	sig, err := km.Sign(ctx, req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, secretKey.Sign(req.SigningRoot).Marshal(), sig.Marshal())
+
+	// Any threshold of the shares can sign.
+	servers[0].Close()
+	servers[2].Close()
+	req = attestationRequest(t, pubKey, 1, 2, 'a')
+	sig, err = km.Sign(ctx, req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, secretKey.Sign(req.SigningRoot).Marshal(), sig.Marshal())
+
+	servers[1].Close()
+	_, err = km.Sign(ctx, attestationRequest(t, pubKey, 2, 3, 'a'))
+	assert.ErrorContains(t, ErrNotEnoughShares.Error(), err)
+	assert.ErrorContains(t, "got 1 of 2 partial signatures", err)
+}
+
+func TestKeymanager_Sign_SlashingProtection(t *testing.T) {
+	ctx := context.Background()
+	// Every co-signer is required, so that all of them record the first attestation.
+	secretKey, cfg, _ := setupThreshold(t, 3, 3)
+	km, err := NewKeymanager(ctx, cfg)
+	require.NoError(t, err)
+	pubKey := bytesutil.ToBytes48(secretKey.PublicKey().Marshal())
+
+	_, err = km.Sign(ctx, attestationRequest(t, pubKey, 1, 2, 'a'))
+	require.NoError(t, err)
+	// The co-signers refuse to sign a double vote.
+	_, err = km.Sign(ctx, attestationRequest(t, pubKey, 1, 2, 'b'))
+	assert.ErrorContains(t, ErrNotEnoughShares.Error(), err)
+	assert.ErrorContains(t, ErrSigningDenied.Error(), err)
+
+	// A signing root which does not match the signed object is rejected.
+	req := blockRequest(t, pubKey, 5, 'a')
+	req.SigningRoot = make([]byte, 32)
+	_, err = km.Sign(ctx, req)
+	assert.ErrorContains(t, "signing root does not match the signed object", err)
+}
+
+func TestKeymanager_Sign_Unauthorized(t *testing.T) {
+	ctx := context.Background()
+	secretKey, cfg, _ := setupThreshold(t, 2, 2)
+	cfg.Opts.CoSigners[0].AuthToken = "wrong"
+	km, err := NewKeymanager(ctx, cfg)
+	require.NoError(t, err)
+	pubKey := bytesutil.ToBytes48(secretKey.PublicKey().Marshal())
+
+	_, err = km.Sign(ctx, attestationRequest(t, pubKey, 1, 2, 'a'))
+	assert.ErrorContains(t, ErrNotEnoughShares.Error(), err)
+	assert.ErrorContains(t, "co-signer refused the authentication token", err)
+}
+
+func TestKeymanager_Sign_UnknownPublicKey(t *testing.T) {
+	ctx := context.Background()
+	_, cfg, _ := setupThreshold(t, 2, 2)
+	km, err := NewKeymanager(ctx, cfg)
+	require.NoError(t, err)
+	_, err = km.Sign(ctx, &validatorpb.SignRequest{PublicKey: make([]byte, 48)})
+	assert.ErrorContains(t, "no key share for public key", err)
+}
+
+func TestNewKeymanager_InvalidOpts(t *testing.T) {
+	ctx := context.Background()
+	_, cfg, _ := setupThreshold(t, 2, 3)
+	opts := cfg.Opts
+
+	_, err := NewKeymanager(ctx, &SetupConfig{Wallet: cfg.Wallet})
+	assert.ErrorContains(t, "keymanager options are required", err)
+
+	invalid := *opts
+	invalid.Threshold = 4
+	_, err = NewKeymanager(ctx, &SetupConfig{Wallet: cfg.Wallet, Opts: &invalid})
+	assert.ErrorContains(t, "threshold 4 is not reachable with 2 co-signers", err)
+
+	invalid = *opts
+	invalid.CoSigners = []*CoSignerOpts{opts.CoSigners[0], opts.CoSigners[0]}
+	_, err = NewKeymanager(ctx, &SetupConfig{Wallet: cfg.Wallet, Opts: &invalid})
+	assert.ErrorContains(t, "invalid or duplicate share index", err)
+
+	invalid = *opts
+	invalid.CoSigners = []*CoSignerOpts{{Index: 2, URL: opts.CoSigners[0].URL, PublicKey: opts.CoSigners[0].PublicKey}}
+	_, err = NewKeymanager(ctx, &SetupConfig{Wallet: cfg.Wallet, Opts: &invalid})
+	assert.ErrorContains(t, "has no auth token", err)
+
+	invalid = *opts
+	invalid.ShareIndex = 2
+	_, err = NewKeymanager(ctx, &SetupConfig{Wallet: cfg.Wallet, Opts: &invalid})
+	assert.ErrorContains(t, "key share has index 1, options expect 2", err)
+
+	otherKey, err := bls.RandKey()
+	require.NoError(t, err)
+	invalid = *opts
+	invalid.PublicKey = hexutil.Encode(otherKey.PublicKey().Marshal())
+	_, err = NewKeymanager(ctx, &SetupConfig{Wallet: cfg.Wallet, Opts: &invalid})
+	assert.ErrorContains(t, "key share was not split from the validator key of the options", err)
+
+	wrongPassword := &mock.Wallet{Files: cfg.Wallet.(*mock.Wallet).Files, WalletPassword: "wrong"}
+	_, err = NewKeymanager(ctx, &SetupConfig{Wallet: wrongPassword, Opts: opts})
+	assert.ErrorContains(t, "wrong password for key share keystore", err)
+}
+
+func TestOptionsFile_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	_, cfg, _ := setupThreshold(t, 2, 3)
+	enc, err := MarshalOptionsFile(ctx, cfg.Opts)
+	require.NoError(t, err)
+	decoded, err := UnmarshalOptionsFile(ioutil.NopCloser(bytes.NewReader(enc)))
+	require.NoError(t, err)
+	assert.DeepEqual(t, cfg.Opts, decoded)
+}
+
+func TestShareKeystore_RoundTrip(t *testing.T) {
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	shares, err := bls.SplitSecretKey(secretKey, 2, 3)
+	require.NoError(t, err)
+
+	keystore, err := EncryptShare(shares[1], secretKey.PublicKey(), walletPassword)
+	require.NoError(t, err)
+	assert.Equal(t, shares[1].Index, keystore.ShareIndex)
+	assert.Equal(t, hexutil.Encode(secretKey.PublicKey().Marshal()), keystore.ValidatorPublicKey)
+	// The keystore does not contain the share in plaintext.
+	enc, err := json.Marshal(keystore)
+	require.NoError(t, err)
+	assert.Equal(t, false, bytes.Contains(enc, []byte(hex.EncodeToString(shares[1].Key.Marshal()))))
+
+	share, err := DecryptShare(keystore, walletPassword)
+	require.NoError(t, err)
+	assert.Equal(t, shares[1].Index, share.Index)
+	assert.DeepEqual(t, shares[1].Key.Marshal(), share.Key.Marshal())
+
+	_, err = DecryptShare(keystore, "wrong")
+	assert.ErrorContains(t, "wrong password for key share keystore", err)
+	keystore.Pubkey = hex.EncodeToString(shares[0].Key.PublicKey().Marshal())
+	_, err = DecryptShare(keystore, walletPassword)
+	assert.ErrorContains(t, "key share does not match the public key of the keystore", err)
+}
@@ -0,0 +1,167 @@
+package threshold
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/validator/accounts/iface"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+const (
+	// AccountsPath where the key share of the keymanager is stored in the wallet.
+	AccountsPath = "accounts"
+	// ShareKeystoreFileName exposes the name of the keystore file of the key share.
+	ShareKeystoreFileName = "share-keystore.json"
+)
+
+// ShareKeystore is an EIP-2335 keystore of a key share, along with the index of the share
+// and the public key of the validator key it was split from.
+type ShareKeystore struct {
+	keymanager.Keystore
+	ShareIndex         uint64 `json:"share_index"`
+	ValidatorPublicKey string `json:"validator_public_key"`
+}
+
+// EncryptShare encrypts a key share of the validator key with the given public key into an
+// EIP-2335 keystore.
+func EncryptShare(share *bls.SecretKeyShare, validatorPubKey bls.PublicKey, password string) (*ShareKeystore, error) {
+	if share == nil || share.Key == nil || share.Index == 0 {
+		return nil, errors.New("secret key share is required")
+	}
+	encryptor := keystorev4.New()
+	cryptoFields, err := encryptor.Encrypt(share.Key.Marshal(), password)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encrypt key share")
+	}
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+	return &ShareKeystore{
+		Keystore: keymanager.Keystore{
+			Crypto:  cryptoFields,
+			ID:      id.String(),
+			Pubkey:  fmt.Sprintf("%x", share.Key.PublicKey().Marshal()),
+			Version: encryptor.Version(),
+			Name:    encryptor.Name(),
+		},
+		ShareIndex:         share.Index,
+		ValidatorPublicKey: fmt.Sprintf("%#x", validatorPubKey.Marshal()),
+	}, nil
+}
+
+// DecryptShare decrypts the key share of an EIP-2335 keystore and checks it against the
+// public key of the share recorded in the keystore.
+func DecryptShare(keystore *ShareKeystore, password string) (*bls.SecretKeyShare, error) {
+	if keystore.ShareIndex == 0 {
+		return nil, errors.New("share index must not be zero")
+	}
+	decryptor := keystorev4.New()
+	secret, err := decryptor.Decrypt(keystore.Crypto, password)
+	if err != nil && strings.Contains(err.Error(), "invalid checksum") {
+		return nil, errors.New("wrong password for key share keystore")
+	} else if err != nil {
+		return nil, errors.Wrap(err, "could not decrypt key share keystore")
+	}
+	secretKey, err := bls.SecretKeyFromBytes(secret)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create share secret key")
+	}
+	if keystore.Pubkey != "" {
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(keystore.Pubkey, "0x"))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not decode share public key from keystore")
+		}
+		if !bytes.Equal(secretKey.PublicKey().Marshal(), pubKey) {
+			return nil, errors.New("key share does not match the public key of the keystore")
+		}
+	}
+	return &bls.SecretKeyShare{Index: keystore.ShareIndex, Key: secretKey}, nil
+}
+
+// ReadShareKeystoreFile reads a key share keystore, such as written by the split-key command.
+func ReadShareKeystoreFile(path string) (*ShareKeystore, error) {
+	fullPath, err := fileutil.ExpandPath(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not determine absolute path for %s", path)
+	}
+	enc, err := ioutil.ReadFile(fullPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read key share keystore")
+	}
+	keystore := &ShareKeystore{}
+	if err := json.Unmarshal(enc, keystore); err != nil {
+		return nil, errors.Wrap(err, "could not decode key share keystore")
+	}
+	return keystore, nil
+}
+
+// ImportShareKeystore decrypts the keystore of the key share held by a threshold keymanager
+// with the given options, and stores the share in the wallet encrypted with the wallet password.
+func ImportShareKeystore(
+	ctx context.Context, wallet iface.Wallet, opts *KeymanagerOpts, keystore *ShareKeystore, password string,
+) error {
+	share, err := DecryptShare(keystore, password)
+	if err != nil {
+		return err
+	}
+	pubKey, err := checkShare(opts, keystore, share)
+	if err != nil {
+		return err
+	}
+	walletKeystore, err := EncryptShare(share, pubKey, wallet.Password())
+	if err != nil {
+		return err
+	}
+	enc, err := json.MarshalIndent(walletKeystore, "", "\t")
+	if err != nil {
+		return err
+	}
+	return wallet.WriteFileAtPath(ctx, AccountsPath, ShareKeystoreFileName, enc)
+}
+
+// readShareKeystore reads the key share of a threshold keymanager from the wallet and
+// decrypts it with the wallet password.
+func readShareKeystore(ctx context.Context, wallet iface.Wallet) (*ShareKeystore, *bls.SecretKeyShare, error) {
+	enc, err := wallet.ReadFileAtPath(ctx, AccountsPath, ShareKeystoreFileName)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not read key share keystore")
+	}
+	keystore := &ShareKeystore{}
+	if err := json.Unmarshal(enc, keystore); err != nil {
+		return nil, nil, errors.Wrap(err, "could not decode key share keystore")
+	}
+	share, err := DecryptShare(keystore, wallet.Password())
+	if err != nil {
+		return nil, nil, err
+	}
+	return keystore, share, nil
+}
+
+// checkShare checks that a key share is the one expected by the keymanager options, and
+// returns the validator public key of the options.
+func checkShare(opts *KeymanagerOpts, keystore *ShareKeystore, share *bls.SecretKeyShare) (bls.PublicKey, error) {
+	pubKey, err := decodePublicKey(opts.PublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode validator public key")
+	}
+	if share.Index != opts.ShareIndex {
+		return nil, fmt.Errorf("key share has index %d, options expect %d", share.Index, opts.ShareIndex)
+	}
+	keystorePubKey, err := decodePublicKey(keystore.ValidatorPublicKey)
+	if err != nil || !bytes.Equal(keystorePubKey.Marshal(), pubKey.Marshal()) {
+		return nil, errors.New("key share was not split from the validator key of the options")
+	}
+	return pubKey, nil
+}
@@ -0,0 +1,135 @@
+package threshold
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/slashutil"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+)
+
+// signingRootFromRequest computes the signing root of the object of a sign request, so that
+// a co-signer never signs a root it did not check the object of.
+func signingRootFromRequest(req *validatorpb.SignRequest) ([32]byte, error) {
+	domain := req.SignatureDomain
+	switch obj := req.Object.(type) {
+	case *validatorpb.SignRequest_Block:
+		if obj.Block == nil {
+			return [32]byte{}, errors.New("nil block in sign request")
+		}
+		return helpers.ComputeSigningRoot(obj.Block, domain)
+	case *validatorpb.SignRequest_BlockV2:
+		if obj.BlockV2 == nil {
+			return [32]byte{}, errors.New("nil block in sign request")
+		}
+		return helpers.ComputeSigningRoot(obj.BlockV2, domain)
+	case *validatorpb.SignRequest_AttestationData:
+		if obj.AttestationData == nil || obj.AttestationData.Source == nil || obj.AttestationData.Target == nil {
+			return [32]byte{}, errors.New("nil attestation data in sign request")
+		}
+		return helpers.ComputeSigningRoot(obj.AttestationData, domain)
+	case *validatorpb.SignRequest_AggregateAttestationAndProof:
+		if obj.AggregateAttestationAndProof == nil {
+			return [32]byte{}, errors.New("nil aggregate in sign request")
+		}
+		return helpers.ComputeSigningRoot(obj.AggregateAttestationAndProof, domain)
+	case *validatorpb.SignRequest_Exit:
+		if obj.Exit == nil {
+			return [32]byte{}, errors.New("nil voluntary exit in sign request")
+		}
+		return helpers.ComputeSigningRoot(obj.Exit, domain)
+	case *validatorpb.SignRequest_Slot:
+		slot := types.SSZUint64(obj.Slot)
+		return helpers.ComputeSigningRoot(&slot, domain)
+	case *validatorpb.SignRequest_Epoch:
+		epoch := types.SSZUint64(obj.Epoch)
+		return helpers.ComputeSigningRoot(&epoch, domain)
+	default:
+		return [32]byte{}, fmt.Errorf("unsupported sign request object %T", req.Object)
+	}
+}
+
+// slashingProtection checks that signing a block or an attestation is not slashable given the
+// signing history of the co-signer, following EIP-3076, and records it in the history.
+func (c *CoSigner) slashingProtection(ctx context.Context, req *validatorpb.SignRequest, signingRoot [32]byte) error {
+	switch obj := req.Object.(type) {
+	case *validatorpb.SignRequest_Block:
+		return c.proposalProtection(ctx, obj.Block.Slot, signingRoot)
+	case *validatorpb.SignRequest_BlockV2:
+		return c.proposalProtection(ctx, obj.BlockV2.Slot, signingRoot)
+	case *validatorpb.SignRequest_AttestationData:
+		return c.attestationProtection(ctx, obj.AttestationData, signingRoot)
+	default:
+		return nil
+	}
+}
+
+func (c *CoSigner) proposalProtection(ctx context.Context, slot types.Slot, signingRoot [32]byte) error {
+	prevSigningRoot, proposalAtSlotExists, err := c.db.ProposalHistoryForSlot(ctx, c.pubKey, slot)
+	if err != nil {
+		return errors.Wrap(err, "failed to get proposal history")
+	}
+	lowestSignedProposalSlot, lowestProposalExists, err := c.db.LowestSignedProposal(ctx, c.pubKey)
+	if err != nil {
+		return err
+	}
+	// A proposal with an empty signing root in the history is considered slashable.
+	signingRootsDiffer := slashutil.SigningRootsDiffer(prevSigningRoot, signingRoot)
+	if proposalAtSlotExists && signingRootsDiffer {
+		return errors.Wrapf(ErrSigningDenied, "double proposal at slot %d", slot)
+	}
+	if lowestProposalExists && signingRootsDiffer && lowestSignedProposalSlot >= slot {
+		return errors.Wrapf(
+			ErrSigningDenied,
+			"block slot %d <= lowest signed slot %d", slot, lowestSignedProposalSlot,
+		)
+	}
+	if err := c.db.SaveProposalHistoryForSlot(ctx, c.pubKey, slot, signingRoot[:]); err != nil {
+		return errors.Wrap(err, "failed to save updated proposal history")
+	}
+	return nil
+}
+
+func (c *CoSigner) attestationProtection(ctx context.Context, data *ethpb.AttestationData, signingRoot [32]byte) error {
+	lowestSourceEpoch, exists, err := c.db.LowestSignedSourceEpoch(ctx, c.pubKey)
+	if err != nil {
+		return err
+	}
+	if exists && data.Source.Epoch < lowestSourceEpoch {
+		return errors.Wrapf(
+			ErrSigningDenied,
+			"attestation source epoch %d < lowest signed source epoch %d", data.Source.Epoch, lowestSourceEpoch,
+		)
+	}
+	existingSigningRoot, err := c.db.SigningRootAtTargetEpoch(ctx, c.pubKey, data.Target.Epoch)
+	if err != nil {
+		return err
+	}
+	lowestTargetEpoch, exists, err := c.db.LowestSignedTargetEpoch(ctx, c.pubKey)
+	if err != nil {
+		return err
+	}
+	if slashutil.SigningRootsDiffer(existingSigningRoot, signingRoot) && exists && data.Target.Epoch <= lowestTargetEpoch {
+		return errors.Wrapf(
+			ErrSigningDenied,
+			"attestation target epoch %d <= lowest signed target epoch %d", data.Target.Epoch, lowestTargetEpoch,
+		)
+	}
+	indexedAtt := &ethpb.IndexedAttestation{Data: data}
+	slashingKind, err := c.db.CheckSlashableAttestation(ctx, c.pubKey, signingRoot, indexedAtt)
+	if err != nil {
+		if slashingKind != kv.NotSlashable {
+			return errors.Wrap(ErrSigningDenied, err.Error())
+		}
+		return err
+	}
+	if err := c.db.SaveAttestationForPubKey(ctx, c.pubKey, signingRoot, indexedAtt); err != nil {
+		return errors.Wrap(err, "could not save attestation history for validator public key")
+	}
+	return nil
+}
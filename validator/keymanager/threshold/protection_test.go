@@ -0,0 +1,188 @@
+package threshold
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+)
+
+var testDomain = make([]byte, 32)
+
+func blockRequest(t *testing.T, pubKey [48]byte, slot types.Slot, graffiti byte) *validatorpb.SignRequest {
+	blk := testutil.NewBeaconBlock().Block
+	blk.Slot = slot
+	blk.Body.Graffiti[0] = graffiti
+	root, err := helpers.ComputeSigningRoot(blk, testDomain)
+	require.NoError(t, err)
+	return &validatorpb.SignRequest{
+		PublicKey:       pubKey[:],
+		SigningRoot:     root[:],
+		SignatureDomain: testDomain,
+		Object:          &validatorpb.SignRequest_Block{Block: blk},
+	}
+}
+
+func attestationRequest(t *testing.T, pubKey [48]byte, source, target types.Epoch, head byte) *validatorpb.SignRequest {
+	data := testutil.HydrateAttestationData(&ethpb.AttestationData{
+		Source: &ethpb.Checkpoint{Epoch: source},
+		Target: &ethpb.Checkpoint{Epoch: target},
+	})
+	data.BeaconBlockRoot[0] = head
+	root, err := helpers.ComputeSigningRoot(data, testDomain)
+	require.NoError(t, err)
+	return &validatorpb.SignRequest{
+		PublicKey:       pubKey[:],
+		SigningRoot:     root[:],
+		SignatureDomain: testDomain,
+		Object:          &validatorpb.SignRequest_AttestationData{AttestationData: data},
+	}
+}
+
+func exitRequest(t *testing.T, pubKey [48]byte) *validatorpb.SignRequest {
+	exit := &ethpb.VoluntaryExit{Epoch: 5, ValidatorIndex: 3}
+	root, err := helpers.ComputeSigningRoot(exit, testDomain)
+	require.NoError(t, err)
+	return &validatorpb.SignRequest{
+		PublicKey:       pubKey[:],
+		SigningRoot:     root[:],
+		SignatureDomain: testDomain,
+		Object:          &validatorpb.SignRequest_Exit{Exit: exit},
+	}
+}
+
+func TestSigningRootFromRequest(t *testing.T) {
+	pubKey := [48]byte{1}
+	req := blockRequest(t, pubKey, 5, 'a')
+	root, err := signingRootFromRequest(req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, req.SigningRoot, root[:])
+
+	req = attestationRequest(t, pubKey, 1, 2, 'a')
+	root, err = signingRootFromRequest(req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, req.SigningRoot, root[:])
+
+	slot := types.SSZUint64(9)
+	want, err := helpers.ComputeSigningRoot(&slot, testDomain)
+	require.NoError(t, err)
+	root, err = signingRootFromRequest(&validatorpb.SignRequest{
+		SignatureDomain: testDomain,
+		Object:          &validatorpb.SignRequest_Slot{Slot: 9},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, want, root)
+
+	_, err = signingRootFromRequest(&validatorpb.SignRequest{})
+	assert.ErrorContains(t, "unsupported sign request object", err)
+	_, err = signingRootFromRequest(&validatorpb.SignRequest{
+		Object: &validatorpb.SignRequest_AttestationData{AttestationData: &ethpb.AttestationData{}},
+	})
+	assert.ErrorContains(t, "nil attestation data", err)
+}
+
+func TestCoSigner_ProposalProtection(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	c := &CoSigner{pubKey: pubKey, db: dbtest.SetupDB(t, [][48]byte{pubKey})}
+	sign := func(req *validatorpb.SignRequest) error {
+		return c.slashingProtection(ctx, req, bytesutil.ToBytes32(req.SigningRoot))
+	}
+
+	require.NoError(t, sign(blockRequest(t, pubKey, 10, 'a')))
+	// Signing the same block again is allowed.
+	require.NoError(t, sign(blockRequest(t, pubKey, 10, 'a')))
+	err := sign(blockRequest(t, pubKey, 10, 'b'))
+	assert.ErrorContains(t, ErrSigningDenied.Error(), err)
+	assert.ErrorContains(t, "double proposal at slot 10", err)
+	err = sign(blockRequest(t, pubKey, 9, 'a'))
+	assert.ErrorContains(t, "block slot 9 <= lowest signed slot 10", err)
+	require.NoError(t, sign(blockRequest(t, pubKey, 11, 'b')))
+}
+
+func TestCoSigner_AttestationProtection(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	c := &CoSigner{pubKey: pubKey, db: dbtest.SetupDB(t, [][48]byte{pubKey})}
+	sign := func(req *validatorpb.SignRequest) error {
+		return c.slashingProtection(ctx, req, bytesutil.ToBytes32(req.SigningRoot))
+	}
+
+	require.NoError(t, sign(attestationRequest(t, pubKey, 2, 3, 'a')))
+	require.NoError(t, sign(attestationRequest(t, pubKey, 2, 3, 'a')))
+	// Double vote.
+	err := sign(attestationRequest(t, pubKey, 2, 3, 'b'))
+	assert.ErrorContains(t, ErrSigningDenied.Error(), err)
+	// Source lower than the lowest signed source.
+	err = sign(attestationRequest(t, pubKey, 1, 4, 'a'))
+	assert.ErrorContains(t, "source epoch 1 < lowest signed source epoch 2", err)
+	require.NoError(t, sign(attestationRequest(t, pubKey, 3, 6, 'a')))
+	// Surrounded by the previous attestation.
+	err = sign(attestationRequest(t, pubKey, 4, 5, 'a'))
+	assert.ErrorContains(t, ErrSigningDenied.Error(), err)
+	// Surrounding the previous attestation.
+	err = sign(attestationRequest(t, pubKey, 2, 7, 'a'))
+	assert.ErrorContains(t, ErrSigningDenied.Error(), err)
+	require.NoError(t, sign(attestationRequest(t, pubKey, 6, 7, 'a')))
+}
+
+func TestCoSigner_Exits(t *testing.T) {
+	ctx := context.Background()
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	shares, err := bls.SplitSecretKey(secretKey, 2, 2)
+	require.NoError(t, err)
+	pubKey := bytesutil.ToBytes48(secretKey.PublicKey().Marshal())
+	cfg := &CoSignerConfig{
+		PublicKey: secretKey.PublicKey(),
+		Share:     shares[0],
+		DB:        dbtest.SetupDB(t, [][48]byte{pubKey}),
+	}
+	_, err = NewCoSigner(cfg)
+	assert.ErrorContains(t, "authentication token is required", err)
+
+	cfg.AuthToken = testAuthToken
+	c, err := NewCoSigner(cfg)
+	require.NoError(t, err)
+	_, err = c.Sign(ctx, exitRequest(t, pubKey))
+	assert.ErrorContains(t, ErrSigningDenied.Error(), err)
+	assert.ErrorContains(t, "signing voluntary exits is not allowed", err)
+
+	cfg.AllowExits = true
+	c, err = NewCoSigner(cfg)
+	require.NoError(t, err)
+	_, err = c.Sign(ctx, exitRequest(t, pubKey))
+	require.NoError(t, err)
+}
+
+func TestCoSigner_ServeHTTP_Unauthorized(t *testing.T) {
+	c := &CoSigner{authToken: testAuthToken}
+	for _, header := range []string{"", "Bearer wrong", testAuthToken} {
+		req := httptest.NewRequest(http.MethodPost, SignPath, strings.NewReader("{}"))
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+		rec := httptest.NewRecorder()
+		c.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Equal(t, "Bearer", rec.Header().Get("WWW-Authenticate"))
+	}
+
+	req := httptest.NewRequest(http.MethodPost, SignPath, strings.NewReader("{}"))
+	req.Header.Set("Authorization", bearerPrefix+testAuthToken)
+	rec := httptest.NewRecorder()
+	c.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,105 @@
+package web3signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+)
+
+const (
+	publicKeysPath = "/api/v1/eth2/publicKeys"
+	signPath       = "/api/v1/eth2/sign/"
+)
+
+// client performs requests against the HTTP API of a Web3Signer compatible remote signer.
+type client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// publicKeys lists the public keys available in the remote signer.
+func (c *client) publicKeys(ctx context.Context) ([][]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+publicKeysPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	body, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	var hexKeys []string
+	if err := json.Unmarshal(body, &hexKeys); err != nil {
+		return nil, errors.Wrap(err, "could not decode public keys response")
+	}
+	keys := make([][]byte, len(hexKeys))
+	for i, k := range hexKeys {
+		keys[i], err = hexutil.Decode(k)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode public key %s", k)
+		}
+	}
+	return keys, nil
+}
+
+// sign requests a signature for the given payload from the key with the given public key.
+func (c *client) sign(ctx context.Context, pubKey []byte, signReq *SignRequest) ([]byte, error) {
+	enc, err := json.Marshal(signReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode sign request")
+	}
+	url := c.baseURL + signPath + hexutil.Encode(pubKey)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(enc))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	body, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	// Older signers ignore the Accept header and answer with the bare hex signature.
+	sig := strings.TrimSpace(string(body))
+	if strings.HasPrefix(sig, "{") {
+		resp := &SignResponse{}
+		if err := json.Unmarshal(body, resp); err != nil {
+			return nil, errors.Wrap(err, "could not decode sign response")
+		}
+		sig = resp.Signature
+	}
+	return hexutil.Decode(sig)
+}
+
+func (c *client) do(req *http.Request) ([]byte, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not reach remote signer")
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read response of remote signer")
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return body, nil
+	case http.StatusNotFound:
+		return nil, ErrPublicKeyNotFound
+	case http.StatusPreconditionFailed:
+		return nil, ErrSigningDenied
+	default:
+		return nil, errors.Wrap(ErrSigningFailed, fmt.Sprintf("%s returned %d: %s", req.URL.Path, resp.StatusCode, strings.TrimSpace(string(body))))
+	}
+}
@@ -0,0 +1,24 @@
+/*
+Package web3signer defines a keymanager implementation which delegates signing to a
+remote signer implementing the Web3Signer HTTP signing API, such as Consensys Web3Signer.
+
+The validating public keys are listed via GET /api/v1/eth2/publicKeys and are
+periodically reloaded, so keys added to or removed from the remote signer are picked
+up while the validator is running.
+
+Signing requests are sent to POST /api/v1/eth2/sign/{pubkey} as typed JSON payloads
+for blocks, attestations, aggregates, aggregation slots, randao reveals and voluntary
+exits. Each payload carries the fork active at the epoch of the signed object together
+with the genesis validators root, which allows the remote signer to compute the
+signing domain and to apply its own slashing protection, along with the signing root
+computed by the validator client.
+
+The keymanager can be customized via a keymanageropts.json file
+which requires the following schema:
+
+ {
+   "base_url": "http://signer.example.com:9000", // Remote signer URL.
+   "genesis_validators_root": "0x4b36...", // Genesis validators root of the network.
+ }
+*/
+package web3signer
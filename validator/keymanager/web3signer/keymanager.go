@@ -0,0 +1,218 @@
+package web3signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/logrusorgru/aurora"
+	"github.com/pkg/errors"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+)
+
+var (
+	// ErrSigningFailed defines a failure from the remote signer
+	// when performing a signing operation.
+	ErrSigningFailed = errors.New("signing failed in the remote signer")
+	// ErrSigningDenied defines a signing request refused by the slashing
+	// protection of the remote signer.
+	ErrSigningDenied = errors.New("signing request was denied by remote signer")
+	// ErrPublicKeyNotFound defines a signing request for a key unknown to the remote signer.
+	ErrPublicKeyNotFound = errors.New("public key not found in remote signer")
+)
+
+var (
+	// requestTimeout bounds every request made to the remote signer.
+	requestTimeout = 10 * time.Second
+	// reloadInterval is the period at which public keys are reloaded from the remote signer.
+	reloadInterval = time.Minute
+)
+
+// KeymanagerOpts for a Web3Signer keymanager.
+type KeymanagerOpts struct {
+	BaseEndpoint          string `json:"base_url"`
+	GenesisValidatorsRoot string `json:"genesis_validators_root"`
+}
+
+// SetupConfig includes configuration values for initializing a Web3Signer keymanager.
+type SetupConfig struct {
+	Opts             *KeymanagerOpts
+	ListenForChanges bool
+}
+
+// Keymanager implementation delegating signing to a remote signer implementing the
+// Web3Signer HTTP signing API.
+type Keymanager struct {
+	opts                  *KeymanagerOpts
+	client                *client
+	genesisValidatorsRoot []byte
+	lock                  sync.RWMutex
+	orderedPubKeys        [][48]byte
+	pubKeysLoaded         bool
+	accountsChangedFeed   *event.Feed
+}
+
+// NewKeymanager instantiates a new Web3Signer keymanager from configuration options.
+func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	if cfg.Opts == nil || cfg.Opts.BaseEndpoint == "" {
+		return nil, errors.New("remote signer URL is required")
+	}
+	gvr, err := hexutil.Decode(cfg.Opts.GenesisValidatorsRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode genesis validators root")
+	}
+	if len(gvr) != 32 {
+		return nil, fmt.Errorf("genesis validators root has length %d, expected 32", len(gvr))
+	}
+	baseURL := strings.TrimSuffix(cfg.Opts.BaseEndpoint, "/")
+	if !strings.HasPrefix(baseURL, "http://") && !strings.HasPrefix(baseURL, "https://") {
+		baseURL = "http://" + baseURL
+	}
+	k := &Keymanager{
+		opts: cfg.Opts,
+		client: &client{
+			baseURL:    baseURL,
+			httpClient: &http.Client{Timeout: requestTimeout},
+		},
+		genesisValidatorsRoot: gvr,
+		orderedPubKeys:        make([][48]byte, 0),
+		accountsChangedFeed:   new(event.Feed),
+	}
+	if cfg.ListenForChanges {
+		// We periodically poll the remote signer for keys which
+		// were added or removed while the validator is running.
+		go k.listenForKeyChanges(ctx)
+	}
+	return k, nil
+}
+
+// UnmarshalOptionsFile attempts to JSON unmarshal a keymanager
+// options file into a struct.
+func UnmarshalOptionsFile(r io.ReadCloser) (*KeymanagerOpts, error) {
+	enc, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read config")
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.Errorf("Could not close keymanager config file: %v", err)
+		}
+	}()
+	opts := &KeymanagerOpts{}
+	if err := json.Unmarshal(enc, opts); err != nil {
+		return nil, errors.Wrap(err, "could not JSON unmarshal")
+	}
+	return opts, nil
+}
+
+// MarshalOptionsFile for the keymanager.
+func MarshalOptionsFile(_ context.Context, cfg *KeymanagerOpts) ([]byte, error) {
+	return json.MarshalIndent(cfg, "", "\t")
+}
+
+// String pretty-print of Web3Signer keymanager options.
+func (opts *KeymanagerOpts) String() string {
+	au := aurora.NewAurora(true)
+	var b strings.Builder
+	strURL := fmt.Sprintf("%s: %s\n", au.BrightMagenta("Remote signer URL"), opts.BaseEndpoint)
+	if _, err := b.WriteString(strURL); err != nil {
+		log.Error(err)
+		return ""
+	}
+	strRoot := fmt.Sprintf("%s: %s\n", au.BrightMagenta("Genesis validators root"), opts.GenesisValidatorsRoot)
+	if _, err := b.WriteString(strRoot); err != nil {
+		log.Error(err)
+		return ""
+	}
+	return b.String()
+}
+
+// KeymanagerOpts for the Web3Signer keymanager.
+func (km *Keymanager) KeymanagerOpts() *KeymanagerOpts {
+	return km.opts
+}
+
+// ReloadPublicKeys fetches the public keys of the remote signer and notifies
+// subscribers if they differ from the previously known keys.
+func (km *Keymanager) ReloadPublicKeys(ctx context.Context) ([][48]byte, error) {
+	keys, err := km.client.publicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not reload public keys")
+	}
+	pubKeys := make([][48]byte, len(keys))
+	for i := range keys {
+		pubKeys[i] = bytesutil.ToBytes48(keys[i])
+	}
+	sort.Slice(pubKeys, func(i, j int) bool { return bytes.Compare(pubKeys[i][:], pubKeys[j][:]) == -1 })
+
+	km.lock.Lock()
+	changed := km.pubKeysLoaded && !equalKeys(km.orderedPubKeys, pubKeys)
+	km.orderedPubKeys = pubKeys
+	km.pubKeysLoaded = true
+	km.lock.Unlock()
+
+	if changed {
+		log.Info(keymanager.KeysReloaded)
+		km.accountsChangedFeed.Send(pubKeys)
+	}
+	return pubKeys, nil
+}
+
+// FetchValidatingPublicKeys returns the public keys of the remote signer. Keys are
+// loaded on first use and kept up to date by the periodic reload.
+func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][48]byte, error) {
+	km.lock.RLock()
+	if km.pubKeysLoaded {
+		pubKeys := make([][48]byte, len(km.orderedPubKeys))
+		copy(pubKeys, km.orderedPubKeys)
+		km.lock.RUnlock()
+		return pubKeys, nil
+	}
+	km.lock.RUnlock()
+	return km.ReloadPublicKeys(ctx)
+}
+
+// Sign signs a message for a validator key via a request to the remote signer.
+func (km *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	signReq, err := signRequestFromProto(req, km.genesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := km.client.sign(ctx, req.PublicKey, signReq)
+	if err != nil {
+		return nil, err
+	}
+	return bls.SignatureFromBytes(sig)
+}
+
+// SubscribeAccountChanges creates an event subscription for a channel
+// to listen for public key changes at runtime, such as when keys are
+// added to or removed from the remote signer.
+func (km *Keymanager) SubscribeAccountChanges(pubKeysChan chan [][48]byte) event.Subscription {
+	return km.accountsChangedFeed.Subscribe(pubKeysChan)
+}
+
+func equalKeys(a, b [][48]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
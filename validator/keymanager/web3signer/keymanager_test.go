@@ -0,0 +1,294 @@
+package web3signer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+var testGenesisValidatorsRoot = hexutil.Encode(bytesutil.PadTo([]byte{0x4b}, 32))
+
+// mockSigner is a minimal Web3Signer stub holding a set of keys.
+type mockSigner struct {
+	t        *testing.T
+	lock     sync.Mutex
+	keys     map[string]bls.SecretKey
+	denied   bool
+	requests []*SignRequest
+	keyCalls int
+}
+
+func newMockSigner(t *testing.T, numKeys int) *mockSigner {
+	s := &mockSigner{t: t, keys: make(map[string]bls.SecretKey)}
+	for i := 0; i < numKeys; i++ {
+		s.addKey()
+	}
+	return s
+}
+
+func (s *mockSigner) addKey() bls.SecretKey {
+	sk, err := bls.RandKey()
+	require.NoError(s.t, err)
+	s.lock.Lock()
+	s.keys[hexutil.Encode(sk.PublicKey().Marshal())] = sk
+	s.lock.Unlock()
+	return sk
+}
+
+func (s *mockSigner) anyKey() bls.SecretKey {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, sk := range s.keys {
+		return sk
+	}
+	return nil
+}
+
+func (s *mockSigner) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(publicKeysPath, func(w http.ResponseWriter, _ *http.Request) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		s.keyCalls++
+		keys := make([]string, 0, len(s.keys))
+		for k := range s.keys {
+			keys = append(keys, k)
+		}
+		require.NoError(s.t, json.NewEncoder(w).Encode(keys))
+	})
+	mux.HandleFunc(signPath, func(w http.ResponseWriter, r *http.Request) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		sk, ok := s.keys[r.URL.Path[len(signPath):]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if s.denied {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		req := &SignRequest{}
+		require.NoError(s.t, json.NewDecoder(r.Body).Decode(req))
+		s.requests = append(s.requests, req)
+		root, err := hexutil.Decode(req.SigningRoot)
+		require.NoError(s.t, err)
+		sig := hexutil.Encode(sk.Sign(root).Marshal())
+		if r.Header.Get("Accept") == "application/json" {
+			w.Header().Set("Content-Type", "application/json")
+			require.NoError(s.t, json.NewEncoder(w).Encode(&SignResponse{Signature: sig}))
+			return
+		}
+		_, err = w.Write([]byte(sig))
+		require.NoError(s.t, err)
+	})
+	return mux
+}
+
+func setupKeymanager(t *testing.T, s *mockSigner, listenForChanges bool) *Keymanager {
+	srv := httptest.NewServer(s.handler())
+	t.Cleanup(srv.Close)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	km, err := NewKeymanager(ctx, &SetupConfig{
+		Opts: &KeymanagerOpts{
+			BaseEndpoint:          srv.URL,
+			GenesisValidatorsRoot: testGenesisValidatorsRoot,
+		},
+		ListenForChanges: listenForChanges,
+	})
+	require.NoError(t, err)
+	return km
+}
+
+func TestNewKeymanager_InvalidOpts(t *testing.T) {
+	_, err := NewKeymanager(context.Background(), &SetupConfig{Opts: &KeymanagerOpts{}})
+	assert.ErrorContains(t, "remote signer URL is required", err)
+	_, err = NewKeymanager(context.Background(), &SetupConfig{Opts: &KeymanagerOpts{
+		BaseEndpoint:          "localhost:9000",
+		GenesisValidatorsRoot: "0x01",
+	}})
+	assert.ErrorContains(t, "genesis validators root has length 1", err)
+
+	km, err := NewKeymanager(context.Background(), &SetupConfig{Opts: &KeymanagerOpts{
+		BaseEndpoint:          "localhost:9000/",
+		GenesisValidatorsRoot: testGenesisValidatorsRoot,
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:9000", km.client.baseURL)
+}
+
+func TestKeymanager_FetchValidatingPublicKeys(t *testing.T) {
+	s := newMockSigner(t, 3)
+	km := setupKeymanager(t, s, false)
+
+	for i := 0; i < 2; i++ {
+		keys, err := km.FetchValidatingPublicKeys(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 3, len(keys))
+		for _, k := range keys {
+			_, ok := s.keys[hexutil.Encode(k[:])]
+			assert.Equal(t, true, ok)
+		}
+	}
+	// Keys are cached after the first request.
+	assert.Equal(t, 1, s.keyCalls)
+}
+
+func TestKeymanager_ReloadPublicKeys(t *testing.T) {
+	hook := logTest.NewGlobal()
+	s := newMockSigner(t, 1)
+	km := setupKeymanager(t, s, false)
+	_, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+
+	keysChan := make(chan [][48]byte, 1)
+	sub := km.SubscribeAccountChanges(keysChan)
+	defer sub.Unsubscribe()
+
+	// Reloading unchanged keys does not notify subscribers.
+	_, err = km.ReloadPublicKeys(context.Background())
+	require.NoError(t, err)
+	assert.LogsDoNotContain(t, hook, "Reloaded validator keys")
+
+	s.addKey()
+	keys, err := km.ReloadPublicKeys(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, len(keys))
+	select {
+	case received := <-keysChan:
+		assert.DeepEqual(t, keys, received)
+	case <-time.After(time.Second):
+		t.Fatal("Did not receive key change notification")
+	}
+	assert.LogsContain(t, hook, "Reloaded validator keys")
+}
+
+func TestKeymanager_ListenForKeyChanges(t *testing.T) {
+	defaultInterval := reloadInterval
+	reloadInterval = 10 * time.Millisecond
+	defer func() {
+		reloadInterval = defaultInterval
+	}()
+	s := newMockSigner(t, 1)
+	km := setupKeymanager(t, s, true)
+	_, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+
+	keysChan := make(chan [][48]byte, 1)
+	sub := km.SubscribeAccountChanges(keysChan)
+	defer sub.Unsubscribe()
+	s.addKey()
+
+	select {
+	case received := <-keysChan:
+		assert.Equal(t, 2, len(received))
+	case <-time.After(5 * time.Second):
+		t.Fatal("Did not receive key change notification")
+	}
+}
+
+func TestKeymanager_Sign(t *testing.T) {
+	s := newMockSigner(t, 1)
+	km := setupKeymanager(t, s, false)
+	sk := s.anyKey()
+	root := bytesutil.PadTo([]byte{0x05}, 32)
+
+	sig, err := km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:   sk.PublicKey().Marshal(),
+		SigningRoot: root,
+		Object: &validatorpb.SignRequest_AttestationData{
+			AttestationData: testutil.HydrateAttestationData(&ethpb.AttestationData{Slot: 33}),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, true, sig.Verify(sk.PublicKey(), root))
+
+	require.Equal(t, 1, len(s.requests))
+	req := s.requests[0]
+	assert.Equal(t, AttestationType, req.Type)
+	assert.Equal(t, hexutil.Encode(root), req.SigningRoot)
+	assert.Equal(t, "33", req.Attestation.Slot)
+	assert.Equal(t, testGenesisValidatorsRoot, req.ForkInfo.GenesisValidatorsRoot)
+	assert.Equal(t, "0x00000000", req.ForkInfo.Fork.CurrentVersion)
+}
+
+func TestKeymanager_Sign_PlainTextResponse(t *testing.T) {
+	s := newMockSigner(t, 1)
+	sk := s.anyKey()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, err := w.Write([]byte(hexutil.Encode(sk.Sign([]byte("root")).Marshal())))
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+	km, err := NewKeymanager(context.Background(), &SetupConfig{Opts: &KeymanagerOpts{
+		BaseEndpoint:          srv.URL,
+		GenesisValidatorsRoot: testGenesisValidatorsRoot,
+	}})
+	require.NoError(t, err)
+
+	sig, err := km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:   sk.PublicKey().Marshal(),
+		SigningRoot: []byte("root"),
+		Object:      &validatorpb.SignRequest_Epoch{Epoch: 3},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, true, sig.Verify(sk.PublicKey(), []byte("root")))
+}
+
+func TestKeymanager_Sign_Errors(t *testing.T) {
+	s := newMockSigner(t, 1)
+	km := setupKeymanager(t, s, false)
+	req := &validatorpb.SignRequest{
+		PublicKey:   s.anyKey().PublicKey().Marshal(),
+		SigningRoot: make([]byte, 32),
+		Object:      &validatorpb.SignRequest_Slot{Slot: 1},
+	}
+
+	s.denied = true
+	_, err := km.Sign(context.Background(), req)
+	assert.ErrorContains(t, ErrSigningDenied.Error(), err)
+
+	req.PublicKey = bytesutil.PadTo([]byte{0x01}, 48)
+	_, err = km.Sign(context.Background(), req)
+	assert.ErrorContains(t, ErrPublicKeyNotFound.Error(), err)
+
+	req.Object = nil
+	_, err = km.Sign(context.Background(), req)
+	assert.ErrorContains(t, "unsupported sign request object", err)
+}
+
+func TestKeymanager_Sign_ServerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, err := w.Write([]byte("internal error"))
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+	km, err := NewKeymanager(context.Background(), &SetupConfig{Opts: &KeymanagerOpts{
+		BaseEndpoint:          srv.URL,
+		GenesisValidatorsRoot: testGenesisValidatorsRoot,
+	}})
+	require.NoError(t, err)
+
+	_, err = km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey: make([]byte, 48),
+		Object:    &validatorpb.SignRequest_Epoch{Epoch: 3},
+	})
+	assert.ErrorContains(t, ErrSigningFailed.Error(), err)
+	assert.ErrorContains(t, "returned 500: internal error", err)
+}
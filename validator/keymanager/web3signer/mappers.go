@@ -0,0 +1,288 @@
+package web3signer
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/p2putils"
+)
+
+// signRequestFromProto converts a keymanager sign request into a typed Web3Signer signing
+// payload. The fork of the payload is the one scheduled at the epoch of the signed object.
+func signRequestFromProto(req *validatorpb.SignRequest, genesisValidatorsRoot []byte) (*SignRequest, error) {
+	if req == nil {
+		return nil, errors.New("nil sign request")
+	}
+	signReq := &SignRequest{SigningRoot: hexutil.Encode(req.SigningRoot)}
+	var epoch types.Epoch
+	switch obj := req.Object.(type) {
+	case *validatorpb.SignRequest_Block:
+		if obj.Block == nil {
+			return nil, errors.New("nil block in sign request")
+		}
+		signReq.Type = BlockType
+		signReq.Block = blockFromProto(obj.Block)
+		epoch = helpers.SlotToEpoch(obj.Block.Slot)
+	case *validatorpb.SignRequest_BlockV2:
+		if obj.BlockV2 == nil {
+			return nil, errors.New("nil block in sign request")
+		}
+		signReq.Type = BlockV2Type
+		signReq.BeaconBlock = &BeaconBlockV2{
+			Version: "ALTAIR",
+			Block:   altairBlockFromProto(obj.BlockV2),
+		}
+		epoch = helpers.SlotToEpoch(obj.BlockV2.Slot)
+	case *validatorpb.SignRequest_AttestationData:
+		if obj.AttestationData == nil || obj.AttestationData.Target == nil {
+			return nil, errors.New("nil attestation data in sign request")
+		}
+		signReq.Type = AttestationType
+		signReq.Attestation = attestationDataFromProto(obj.AttestationData)
+		epoch = obj.AttestationData.Target.Epoch
+	case *validatorpb.SignRequest_AggregateAttestationAndProof:
+		agg := obj.AggregateAttestationAndProof
+		if agg == nil || agg.Aggregate == nil || agg.Aggregate.Data == nil {
+			return nil, errors.New("nil aggregate in sign request")
+		}
+		signReq.Type = AggregateAndProofType
+		signReq.AggregateAndProof = &AggregateAndProof{
+			AggregatorIndex: uintString(uint64(agg.AggregatorIndex)),
+			Aggregate:       attestationFromProto(agg.Aggregate),
+			SelectionProof:  hexutil.Encode(agg.SelectionProof),
+		}
+		epoch = helpers.SlotToEpoch(agg.Aggregate.Data.Slot)
+	case *validatorpb.SignRequest_Exit:
+		if obj.Exit == nil {
+			return nil, errors.New("nil voluntary exit in sign request")
+		}
+		signReq.Type = VoluntaryExitType
+		signReq.VoluntaryExit = voluntaryExitFromProto(obj.Exit)
+		epoch = obj.Exit.Epoch
+	case *validatorpb.SignRequest_Slot:
+		signReq.Type = AggregationSlotType
+		signReq.AggregationSlot = &AggregationSlot{Slot: uintString(uint64(obj.Slot))}
+		epoch = helpers.SlotToEpoch(obj.Slot)
+	case *validatorpb.SignRequest_Epoch:
+		signReq.Type = RandaoRevealType
+		signReq.RandaoReveal = &RandaoReveal{Epoch: uintString(uint64(obj.Epoch))}
+		epoch = obj.Epoch
+	default:
+		return nil, fmt.Errorf("unsupported sign request object %T", req.Object)
+	}
+	fork, err := p2putils.Fork(epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not determine fork")
+	}
+	signReq.ForkInfo = &ForkInfo{
+		Fork: &Fork{
+			PreviousVersion: hexutil.Encode(fork.PreviousVersion),
+			CurrentVersion:  hexutil.Encode(fork.CurrentVersion),
+			Epoch:           uintString(uint64(fork.Epoch)),
+		},
+		GenesisValidatorsRoot: hexutil.Encode(genesisValidatorsRoot),
+	}
+	return signReq, nil
+}
+
+func blockFromProto(b *ethpb.BeaconBlock) *BeaconBlock {
+	blk := &BeaconBlock{
+		Slot:          uintString(uint64(b.Slot)),
+		ProposerIndex: uintString(uint64(b.ProposerIndex)),
+		ParentRoot:    hexutil.Encode(b.ParentRoot),
+		StateRoot:     hexutil.Encode(b.StateRoot),
+	}
+	if b.Body != nil {
+		blk.Body = blockBodyFromProto(
+			b.Body.RandaoReveal,
+			b.Body.Eth1Data,
+			b.Body.Graffiti,
+			b.Body.ProposerSlashings,
+			b.Body.AttesterSlashings,
+			b.Body.Attestations,
+			b.Body.Deposits,
+			b.Body.VoluntaryExits,
+		)
+	}
+	return blk
+}
+
+func altairBlockFromProto(b *prysmv2.BeaconBlockAltair) *BeaconBlock {
+	blk := &BeaconBlock{
+		Slot:          uintString(uint64(b.Slot)),
+		ProposerIndex: uintString(uint64(b.ProposerIndex)),
+		ParentRoot:    hexutil.Encode(b.ParentRoot),
+		StateRoot:     hexutil.Encode(b.StateRoot),
+	}
+	if b.Body != nil {
+		blk.Body = blockBodyFromProto(
+			b.Body.RandaoReveal,
+			b.Body.Eth1Data,
+			b.Body.Graffiti,
+			b.Body.ProposerSlashings,
+			b.Body.AttesterSlashings,
+			b.Body.Attestations,
+			b.Body.Deposits,
+			b.Body.VoluntaryExits,
+		)
+		if b.Body.SyncAggregate != nil {
+			blk.Body.SyncAggregate = &SyncAggregate{
+				SyncCommitteeBits:      hexutil.Encode(b.Body.SyncAggregate.SyncCommitteeBits),
+				SyncCommitteeSignature: hexutil.Encode(b.Body.SyncAggregate.SyncCommitteeSignature),
+			}
+		}
+	}
+	return blk
+}
+
+func blockBodyFromProto(
+	randaoReveal []byte,
+	eth1Data *ethpb.Eth1Data,
+	graffiti []byte,
+	proposerSlashings []*ethpb.ProposerSlashing,
+	attesterSlashings []*ethpb.AttesterSlashing,
+	attestations []*ethpb.Attestation,
+	deposits []*ethpb.Deposit,
+	exits []*ethpb.SignedVoluntaryExit,
+) *BeaconBlockBody {
+	body := &BeaconBlockBody{
+		RandaoReveal:      hexutil.Encode(randaoReveal),
+		Graffiti:          hexutil.Encode(graffiti),
+		ProposerSlashings: make([]*ProposerSlashing, len(proposerSlashings)),
+		AttesterSlashings: make([]*AttesterSlashing, len(attesterSlashings)),
+		Attestations:      make([]*Attestation, len(attestations)),
+		Deposits:          make([]*Deposit, len(deposits)),
+		VoluntaryExits:    make([]*SignedVoluntaryExit, len(exits)),
+	}
+	if eth1Data != nil {
+		body.Eth1Data = &Eth1Data{
+			DepositRoot:  hexutil.Encode(eth1Data.DepositRoot),
+			DepositCount: uintString(eth1Data.DepositCount),
+			BlockHash:    hexutil.Encode(eth1Data.BlockHash),
+		}
+	}
+	for i, s := range proposerSlashings {
+		body.ProposerSlashings[i] = &ProposerSlashing{
+			SignedHeader1: signedHeaderFromProto(s.Header_1),
+			SignedHeader2: signedHeaderFromProto(s.Header_2),
+		}
+	}
+	for i, s := range attesterSlashings {
+		body.AttesterSlashings[i] = &AttesterSlashing{
+			Attestation1: indexedAttestationFromProto(s.Attestation_1),
+			Attestation2: indexedAttestationFromProto(s.Attestation_2),
+		}
+	}
+	for i, att := range attestations {
+		body.Attestations[i] = attestationFromProto(att)
+	}
+	for i, d := range deposits {
+		proof := make([]string, len(d.Proof))
+		for j := range d.Proof {
+			proof[j] = hexutil.Encode(d.Proof[j])
+		}
+		body.Deposits[i] = &Deposit{Proof: proof}
+		if d.Data != nil {
+			body.Deposits[i].Data = &DepositData{
+				Pubkey:                hexutil.Encode(d.Data.PublicKey),
+				WithdrawalCredentials: hexutil.Encode(d.Data.WithdrawalCredentials),
+				Amount:                uintString(d.Data.Amount),
+				Signature:             hexutil.Encode(d.Data.Signature),
+			}
+		}
+	}
+	for i, e := range exits {
+		body.VoluntaryExits[i] = &SignedVoluntaryExit{
+			Message:   voluntaryExitFromProto(e.Exit),
+			Signature: hexutil.Encode(e.Signature),
+		}
+	}
+	return body
+}
+
+func signedHeaderFromProto(h *ethpb.SignedBeaconBlockHeader) *SignedBeaconBlockHeader {
+	if h == nil {
+		return nil
+	}
+	signed := &SignedBeaconBlockHeader{Signature: hexutil.Encode(h.Signature)}
+	if h.Header != nil {
+		signed.Message = &BeaconBlockHeader{
+			Slot:          uintString(uint64(h.Header.Slot)),
+			ProposerIndex: uintString(uint64(h.Header.ProposerIndex)),
+			ParentRoot:    hexutil.Encode(h.Header.ParentRoot),
+			StateRoot:     hexutil.Encode(h.Header.StateRoot),
+			BodyRoot:      hexutil.Encode(h.Header.BodyRoot),
+		}
+	}
+	return signed
+}
+
+func indexedAttestationFromProto(att *ethpb.IndexedAttestation) *IndexedAttestation {
+	if att == nil {
+		return nil
+	}
+	indices := make([]string, len(att.AttestingIndices))
+	for i := range att.AttestingIndices {
+		indices[i] = uintString(att.AttestingIndices[i])
+	}
+	return &IndexedAttestation{
+		AttestingIndices: indices,
+		Data:             attestationDataFromProto(att.Data),
+		Signature:        hexutil.Encode(att.Signature),
+	}
+}
+
+func attestationFromProto(att *ethpb.Attestation) *Attestation {
+	if att == nil {
+		return nil
+	}
+	return &Attestation{
+		AggregationBits: hexutil.Encode(att.AggregationBits),
+		Data:            attestationDataFromProto(att.Data),
+		Signature:       hexutil.Encode(att.Signature),
+	}
+}
+
+func attestationDataFromProto(data *ethpb.AttestationData) *AttestationData {
+	if data == nil {
+		return nil
+	}
+	return &AttestationData{
+		Slot:            uintString(uint64(data.Slot)),
+		Index:           uintString(uint64(data.CommitteeIndex)),
+		BeaconBlockRoot: hexutil.Encode(data.BeaconBlockRoot),
+		Source:          checkpointFromProto(data.Source),
+		Target:          checkpointFromProto(data.Target),
+	}
+}
+
+func checkpointFromProto(c *ethpb.Checkpoint) *Checkpoint {
+	if c == nil {
+		return nil
+	}
+	return &Checkpoint{
+		Epoch: uintString(uint64(c.Epoch)),
+		Root:  hexutil.Encode(c.Root),
+	}
+}
+
+func voluntaryExitFromProto(e *ethpb.VoluntaryExit) *VoluntaryExit {
+	if e == nil {
+		return nil
+	}
+	return &VoluntaryExit{
+		Epoch:          uintString(uint64(e.Epoch)),
+		ValidatorIndex: uintString(uint64(e.ValidatorIndex)),
+	}
+}
+
+func uintString(i uint64) string {
+	return strconv.FormatUint(i, 10)
+}
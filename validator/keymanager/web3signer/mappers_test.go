@@ -0,0 +1,176 @@
+package web3signer
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSignRequestFromProto_Block(t *testing.T) {
+	blk := testutil.NewBeaconBlock().Block
+	blk.Slot = 65
+	blk.ProposerIndex = 7
+	blk.Body.Deposits = []*ethpb.Deposit{{
+		Proof: [][]byte{bytesutil.PadTo([]byte{0x01}, 32)},
+		Data: &ethpb.Deposit_Data{
+			PublicKey:             bytesutil.PadTo([]byte{0x02}, 48),
+			WithdrawalCredentials: make([]byte, 32),
+			Amount:                32000000000,
+			Signature:             make([]byte, 96),
+		},
+	}}
+	blk.Body.VoluntaryExits = []*ethpb.SignedVoluntaryExit{{
+		Exit:      &ethpb.VoluntaryExit{Epoch: 4, ValidatorIndex: 9},
+		Signature: make([]byte, 96),
+	}}
+	req, err := signRequestFromProto(&validatorpb.SignRequest{
+		SigningRoot: bytesutil.PadTo([]byte{0xaa}, 32),
+		Object:      &validatorpb.SignRequest_Block{Block: blk},
+	}, make([]byte, 32))
+	require.NoError(t, err)
+
+	assert.Equal(t, BlockType, req.Type)
+	assert.Equal(t, "65", req.Block.Slot)
+	assert.Equal(t, "7", req.Block.ProposerIndex)
+	assert.Equal(t, "0x00000000", req.ForkInfo.Fork.CurrentVersion)
+	require.Equal(t, 1, len(req.Block.Body.Deposits))
+	assert.Equal(t, "32000000000", req.Block.Body.Deposits[0].Data.Amount)
+	assert.Equal(t, "9", req.Block.Body.VoluntaryExits[0].Message.ValidatorIndex)
+	assert.Equal(t, 0, len(req.Block.Body.Attestations))
+	assert.Equal(t, (*SyncAggregate)(nil), req.Block.Body.SyncAggregate)
+}
+
+func TestSignRequestFromProto_BlockV2(t *testing.T) {
+	blk := &prysmv2.BeaconBlockAltair{
+		Slot:       10,
+		ParentRoot: make([]byte, 32),
+		StateRoot:  make([]byte, 32),
+		Body: &prysmv2.BeaconBlockBodyAltair{
+			RandaoReveal: make([]byte, 96),
+			Eth1Data:     &ethpb.Eth1Data{DepositRoot: make([]byte, 32), BlockHash: make([]byte, 32)},
+			Graffiti:     make([]byte, 32),
+			SyncAggregate: &prysmv2.SyncAggregate{
+				SyncCommitteeBits:      bitfield.NewBitvector512(),
+				SyncCommitteeSignature: make([]byte, 96),
+			},
+		},
+	}
+	req, err := signRequestFromProto(&validatorpb.SignRequest{
+		Object: &validatorpb.SignRequest_BlockV2{BlockV2: blk},
+	}, make([]byte, 32))
+	require.NoError(t, err)
+
+	assert.Equal(t, BlockV2Type, req.Type)
+	assert.Equal(t, "ALTAIR", req.BeaconBlock.Version)
+	assert.Equal(t, "10", req.BeaconBlock.Block.Slot)
+	require.NotNil(t, req.BeaconBlock.Block.Body.SyncAggregate)
+	assert.Equal(t, 2+2*64, len(req.BeaconBlock.Block.Body.SyncAggregate.SyncCommitteeBits))
+}
+
+func TestSignRequestFromProto_Objects(t *testing.T) {
+	agg := &ethpb.AggregateAttestationAndProof{
+		AggregatorIndex: 12,
+		Aggregate: testutil.HydrateAttestation(&ethpb.Attestation{
+			AggregationBits: bitfield.Bitlist{0b1101},
+			Data:            &ethpb.AttestationData{Slot: 70},
+		}),
+		SelectionProof: make([]byte, 96),
+	}
+	tests := []struct {
+		name  string
+		req   *validatorpb.SignRequest
+		check func(t *testing.T, req *SignRequest)
+	}{
+		{
+			name: "attestation",
+			req: &validatorpb.SignRequest{Object: &validatorpb.SignRequest_AttestationData{
+				AttestationData: testutil.HydrateAttestationData(&ethpb.AttestationData{CommitteeIndex: 3}),
+			}},
+			check: func(t *testing.T, req *SignRequest) {
+				assert.Equal(t, AttestationType, req.Type)
+				assert.Equal(t, "3", req.Attestation.Index)
+			},
+		},
+		{
+			name: "aggregate and proof",
+			req:  &validatorpb.SignRequest{Object: &validatorpb.SignRequest_AggregateAttestationAndProof{AggregateAttestationAndProof: agg}},
+			check: func(t *testing.T, req *SignRequest) {
+				assert.Equal(t, AggregateAndProofType, req.Type)
+				assert.Equal(t, "12", req.AggregateAndProof.AggregatorIndex)
+				assert.Equal(t, "0x0d", req.AggregateAndProof.Aggregate.AggregationBits)
+				assert.Equal(t, "70", req.AggregateAndProof.Aggregate.Data.Slot)
+			},
+		},
+		{
+			name: "voluntary exit",
+			req:  &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Exit{Exit: &ethpb.VoluntaryExit{Epoch: 5, ValidatorIndex: 2}}},
+			check: func(t *testing.T, req *SignRequest) {
+				assert.Equal(t, VoluntaryExitType, req.Type)
+				assert.Equal(t, "5", req.VoluntaryExit.Epoch)
+				assert.Equal(t, "2", req.VoluntaryExit.ValidatorIndex)
+			},
+		},
+		{
+			name: "aggregation slot",
+			req:  &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Slot{Slot: 40}},
+			check: func(t *testing.T, req *SignRequest) {
+				assert.Equal(t, AggregationSlotType, req.Type)
+				assert.Equal(t, "40", req.AggregationSlot.Slot)
+			},
+		},
+		{
+			name: "randao reveal",
+			req:  &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Epoch{Epoch: 6}},
+			check: func(t *testing.T, req *SignRequest) {
+				assert.Equal(t, RandaoRevealType, req.Type)
+				assert.Equal(t, "6", req.RandaoReveal.Epoch)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := signRequestFromProto(tt.req, make([]byte, 32))
+			require.NoError(t, err)
+			require.NotNil(t, req.ForkInfo)
+			tt.check(t, req)
+		})
+	}
+}
+
+func TestSignRequestFromProto_ForkSchedule(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.GenesisForkVersion = []byte{0, 0, 0, 0}
+	cfg.ForkVersionSchedule = map[types.Epoch][]byte{10: {1, 0, 0, 0}}
+	params.OverrideBeaconConfig(cfg)
+
+	req, err := signRequestFromProto(&validatorpb.SignRequest{
+		Object: &validatorpb.SignRequest_Epoch{Epoch: 9},
+	}, make([]byte, 32))
+	require.NoError(t, err)
+	assert.Equal(t, "0x00000000", req.ForkInfo.Fork.CurrentVersion)
+
+	req, err = signRequestFromProto(&validatorpb.SignRequest{
+		Object: &validatorpb.SignRequest_Exit{Exit: &ethpb.VoluntaryExit{Epoch: 11}},
+	}, make([]byte, 32))
+	require.NoError(t, err)
+	assert.Equal(t, "0x00000000", req.ForkInfo.Fork.PreviousVersion)
+	assert.Equal(t, "0x01000000", req.ForkInfo.Fork.CurrentVersion)
+	assert.Equal(t, "10", req.ForkInfo.Fork.Epoch)
+}
+
+func TestSignRequestFromProto_NilObject(t *testing.T) {
+	_, err := signRequestFromProto(&validatorpb.SignRequest{
+		Object: &validatorpb.SignRequest_Block{},
+	}, make([]byte, 32))
+	assert.ErrorContains(t, "nil block in sign request", err)
+}
@@ -0,0 +1,23 @@
+package web3signer
+
+import (
+	"context"
+	"time"
+)
+
+// Polls the remote signer every reloadInterval and reloads the validating public keys,
+// notifying subscribers when keys were added or removed.
+func (km *Keymanager) listenForKeyChanges(ctx context.Context) {
+	ticker := time.NewTicker(reloadInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := km.ReloadPublicKeys(ctx); err != nil {
+				log.WithError(err).Error("Could not reload public keys from remote signer")
+			}
+		}
+	}
+}
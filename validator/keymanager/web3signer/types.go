@@ -0,0 +1,185 @@
+package web3signer
+
+// Signing request types understood by the Web3Signer eth2 signing API.
+const (
+	BlockType             = "BLOCK"
+	BlockV2Type           = "BLOCK_V2"
+	AttestationType       = "ATTESTATION"
+	AggregateAndProofType = "AGGREGATE_AND_PROOF"
+	AggregationSlotType   = "AGGREGATION_SLOT"
+	RandaoRevealType      = "RANDAO_REVEAL"
+	VoluntaryExitType     = "VOLUNTARY_EXIT"
+)
+
+// SignRequest is the JSON body of a request to /api/v1/eth2/sign/{pubkey}. Exactly one
+// of the object fields is set, matching the request type.
+type SignRequest struct {
+	Type              string             `json:"type"`
+	ForkInfo          *ForkInfo          `json:"fork_info"`
+	SigningRoot       string             `json:"signingRoot,omitempty"`
+	Block             *BeaconBlock       `json:"block,omitempty"`
+	BeaconBlock       *BeaconBlockV2     `json:"beacon_block,omitempty"`
+	Attestation       *AttestationData   `json:"attestation,omitempty"`
+	AggregateAndProof *AggregateAndProof `json:"aggregate_and_proof,omitempty"`
+	AggregationSlot   *AggregationSlot   `json:"aggregation_slot,omitempty"`
+	RandaoReveal      *RandaoReveal      `json:"randao_reveal,omitempty"`
+	VoluntaryExit     *VoluntaryExit     `json:"voluntary_exit,omitempty"`
+}
+
+// SignResponse is the JSON response of a successful signing request.
+type SignResponse struct {
+	Signature string `json:"signature"`
+}
+
+// ForkInfo is used by the remote signer to compute the signing domain.
+type ForkInfo struct {
+	Fork                  *Fork  `json:"fork"`
+	GenesisValidatorsRoot string `json:"genesis_validators_root"`
+}
+
+// Fork describes the fork active at the epoch of the signed object.
+type Fork struct {
+	PreviousVersion string `json:"previous_version"`
+	CurrentVersion  string `json:"current_version"`
+	Epoch           string `json:"epoch"`
+}
+
+// BeaconBlockV2 wraps a block with the name of the fork it belongs to.
+type BeaconBlockV2 struct {
+	Version string       `json:"version"`
+	Block   *BeaconBlock `json:"block"`
+}
+
+// BeaconBlock of any fork. The sync aggregate is only set for Altair blocks.
+type BeaconBlock struct {
+	Slot          string           `json:"slot"`
+	ProposerIndex string           `json:"proposer_index"`
+	ParentRoot    string           `json:"parent_root"`
+	StateRoot     string           `json:"state_root"`
+	Body          *BeaconBlockBody `json:"body"`
+}
+
+// BeaconBlockBody of any fork.
+type BeaconBlockBody struct {
+	RandaoReveal      string                 `json:"randao_reveal"`
+	Eth1Data          *Eth1Data              `json:"eth1_data"`
+	Graffiti          string                 `json:"graffiti"`
+	ProposerSlashings []*ProposerSlashing    `json:"proposer_slashings"`
+	AttesterSlashings []*AttesterSlashing    `json:"attester_slashings"`
+	Attestations      []*Attestation         `json:"attestations"`
+	Deposits          []*Deposit             `json:"deposits"`
+	VoluntaryExits    []*SignedVoluntaryExit `json:"voluntary_exits"`
+	SyncAggregate     *SyncAggregate         `json:"sync_aggregate,omitempty"`
+}
+
+// Eth1Data of a block body.
+type Eth1Data struct {
+	DepositRoot  string `json:"deposit_root"`
+	DepositCount string `json:"deposit_count"`
+	BlockHash    string `json:"block_hash"`
+}
+
+// ProposerSlashing of a block body.
+type ProposerSlashing struct {
+	SignedHeader1 *SignedBeaconBlockHeader `json:"signed_header_1"`
+	SignedHeader2 *SignedBeaconBlockHeader `json:"signed_header_2"`
+}
+
+// SignedBeaconBlockHeader of a proposer slashing.
+type SignedBeaconBlockHeader struct {
+	Message   *BeaconBlockHeader `json:"message"`
+	Signature string             `json:"signature"`
+}
+
+// BeaconBlockHeader of a proposer slashing.
+type BeaconBlockHeader struct {
+	Slot          string `json:"slot"`
+	ProposerIndex string `json:"proposer_index"`
+	ParentRoot    string `json:"parent_root"`
+	StateRoot     string `json:"state_root"`
+	BodyRoot      string `json:"body_root"`
+}
+
+// AttesterSlashing of a block body.
+type AttesterSlashing struct {
+	Attestation1 *IndexedAttestation `json:"attestation_1"`
+	Attestation2 *IndexedAttestation `json:"attestation_2"`
+}
+
+// IndexedAttestation of an attester slashing.
+type IndexedAttestation struct {
+	AttestingIndices []string         `json:"attesting_indices"`
+	Data             *AttestationData `json:"data"`
+	Signature        string           `json:"signature"`
+}
+
+// Attestation of a block body or an aggregate.
+type Attestation struct {
+	AggregationBits string           `json:"aggregation_bits"`
+	Data            *AttestationData `json:"data"`
+	Signature       string           `json:"signature"`
+}
+
+// AttestationData is signed by attesters.
+type AttestationData struct {
+	Slot            string      `json:"slot"`
+	Index           string      `json:"index"`
+	BeaconBlockRoot string      `json:"beacon_block_root"`
+	Source          *Checkpoint `json:"source"`
+	Target          *Checkpoint `json:"target"`
+}
+
+// Checkpoint of an attestation.
+type Checkpoint struct {
+	Epoch string `json:"epoch"`
+	Root  string `json:"root"`
+}
+
+// Deposit of a block body.
+type Deposit struct {
+	Proof []string     `json:"proof"`
+	Data  *DepositData `json:"data"`
+}
+
+// DepositData of a deposit.
+type DepositData struct {
+	Pubkey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                string `json:"amount"`
+	Signature             string `json:"signature"`
+}
+
+// SignedVoluntaryExit of a block body.
+type SignedVoluntaryExit struct {
+	Message   *VoluntaryExit `json:"message"`
+	Signature string         `json:"signature"`
+}
+
+// VoluntaryExit is signed by an exiting validator.
+type VoluntaryExit struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
+
+// SyncAggregate of an Altair block body.
+type SyncAggregate struct {
+	SyncCommitteeBits      string `json:"sync_committee_bits"`
+	SyncCommitteeSignature string `json:"sync_committee_signature"`
+}
+
+// AggregateAndProof is signed by aggregators.
+type AggregateAndProof struct {
+	AggregatorIndex string       `json:"aggregator_index"`
+	Aggregate       *Attestation `json:"aggregate"`
+	SelectionProof  string       `json:"selection_proof"`
+}
+
+// AggregationSlot is signed to produce a selection proof.
+type AggregationSlot struct {
+	Slot string `json:"slot"`
+}
+
+// RandaoReveal is signed to produce the randao reveal of a block.
+type RandaoReveal struct {
+	Epoch string `json:"epoch"`
+}
@@ -0,0 +1,299 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	slashing "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format/format"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// keymanagerServer implements the standard keymanager API on top of the wallet of the
+// validator RPC server. It is a distinct type as its method names clash with the Prysm
+// wallet API.
+type keymanagerServer struct {
+	*Server
+	keystoresLock sync.Mutex
+}
+
+// ListKeystores lists all the validating keys known to the keymanager of the validator client.
+// Only keys of an imported wallet can be deleted through the keymanager API, all other keys are
+// reported as read only.
+func (s *keymanagerServer) ListKeystores(ctx context.Context, _ *empty.Empty) (*ethpbv1.ListKeystoresResponse, error) {
+	if !s.walletInitialized {
+		return nil, status.Error(codes.FailedPrecondition, "Wallet not yet initialized")
+	}
+	keys, err := s.keymanager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve validating public keys: %v", err)
+	}
+	kind := s.wallet.KeymanagerKind()
+	keystores := make([]*ethpbv1.ListKeystoresResponse_Keystore, len(keys))
+	for i := 0; i < len(keys); i++ {
+		keystores[i] = &ethpbv1.ListKeystoresResponse_Keystore{
+			ValidatingPubkey: hexutil.Encode(keys[i][:]),
+			Readonly:         kind != keymanager.Imported,
+		}
+		if kind == keymanager.Derived {
+			keystores[i].DerivationPath = fmt.Sprintf(derived.ValidatingKeyDerivationPathTemplate, i)
+		}
+	}
+	return &ethpbv1.ListKeystoresResponse{Data: keystores}, nil
+}
+
+// ImportKeystores imports EIP-2335 keystores into an imported wallet, each keystore
+// being decrypted with the password at the same index in the request.
+//
+// Steps:
+// 1. Import the EIP-3076 slashing protection history, if any. If it cannot be imported,
+//  none of the keystores are imported.
+// 2. Decrypt every keystore with its password, flagging keystores which cannot be
+//  decrypted as errors and keys already known to the keymanager as duplicates.
+// 3. Import the remaining keystores into the keymanager.
+func (s *keymanagerServer) ImportKeystores(
+	ctx context.Context, req *ethpbv1.ImportKeystoresRequest,
+) (*ethpbv1.ImportKeystoresResponse, error) {
+	km, err := s.importedKeymanager()
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Keystores) == 0 {
+		return &ethpbv1.ImportKeystoresResponse{}, nil
+	}
+	if len(req.Keystores) != len(req.Passwords) {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Number of keystores and passwords is not equal: %d != %d",
+			len(req.Keystores),
+			len(req.Passwords),
+		)
+	}
+	s.keystoresLock.Lock()
+	defer s.keystoresLock.Unlock()
+
+	statuses := make([]*ethpbv1.ImportedKeystoreStatus, len(req.Keystores))
+	if req.SlashingProtection != "" {
+		buf := bytes.NewBufferString(req.SlashingProtection)
+		if err := slashing.ImportStandardProtectionJSON(ctx, s.valDB, buf); err != nil {
+			for i := range statuses {
+				statuses[i] = &ethpbv1.ImportedKeystoreStatus{
+					Status:  ethpbv1.ImportedKeystoreStatus_error,
+					Message: fmt.Sprintf("could not import slashing protection: %v", err),
+				}
+			}
+			return &ethpbv1.ImportKeystoresResponse{Data: statuses}, nil
+		}
+	}
+
+	existingKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve validating public keys: %v", err)
+	}
+	seen := make(map[[48]byte]bool, len(existingKeys)+len(req.Keystores))
+	for _, k := range existingKeys {
+		seen[k] = true
+	}
+	// Keystores are grouped by password as the keymanager imports
+	// keystores sharing a single password at once.
+	keystoresByPassword := make(map[string][]*keymanager.Keystore)
+	passwords := make([]string, 0)
+	importedIndices := make(map[string][]int)
+	decryptor := keystorev4.New()
+	for i := 0; i < len(req.Keystores); i++ {
+		ks, pubKey, err := decryptKeystore(decryptor, req.Keystores[i], req.Passwords[i])
+		if err != nil {
+			statuses[i] = &ethpbv1.ImportedKeystoreStatus{
+				Status:  ethpbv1.ImportedKeystoreStatus_error,
+				Message: err.Error(),
+			}
+			continue
+		}
+		if seen[pubKey] {
+			statuses[i] = &ethpbv1.ImportedKeystoreStatus{
+				Status:  ethpbv1.ImportedKeystoreStatus_duplicate,
+				Message: fmt.Sprintf("duplicate key %#x", pubKey),
+			}
+			continue
+		}
+		seen[pubKey] = true
+		password := req.Passwords[i]
+		if _, ok := keystoresByPassword[password]; !ok {
+			passwords = append(passwords, password)
+		}
+		keystoresByPassword[password] = append(keystoresByPassword[password], ks)
+		importedIndices[password] = append(importedIndices[password], i)
+	}
+
+	for _, password := range passwords {
+		st := &ethpbv1.ImportedKeystoreStatus{Status: ethpbv1.ImportedKeystoreStatus_imported}
+		if err := km.ImportKeystores(ctx, keystoresByPassword[password], password); err != nil {
+			st = &ethpbv1.ImportedKeystoreStatus{
+				Status:  ethpbv1.ImportedKeystoreStatus_error,
+				Message: fmt.Sprintf("could not import keystore: %v", err),
+			}
+		}
+		for _, i := range importedIndices[password] {
+			statuses[i] = st
+		}
+	}
+	return &ethpbv1.ImportKeystoresResponse{Data: statuses}, nil
+}
+
+// DeleteKeystores deletes keys from an imported wallet and returns the EIP-3076 slashing
+// protection history of the deleted keys. Keys are deleted before their history is exported
+// so no further signatures can be produced after the export.
+//
+// Keys which are not in the wallet, but still have slashing protection history in the
+// validator database, are reported as not active and their history is exported as well.
+func (s *keymanagerServer) DeleteKeystores(
+	ctx context.Context, req *ethpbv1.DeleteKeystoresRequest,
+) (*ethpbv1.DeleteKeystoresResponse, error) {
+	km, err := s.importedKeymanager()
+	if err != nil {
+		return nil, err
+	}
+	pubKeys := make([][48]byte, len(req.Pubkeys))
+	for i, k := range req.Pubkeys {
+		pubKey, err := hexutil.Decode(k)
+		if err != nil || len(pubKey) != 48 {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid public key %s", k)
+		}
+		pubKeys[i] = bytesutil.ToBytes48(pubKey)
+	}
+	s.keystoresLock.Lock()
+	defer s.keystoresLock.Unlock()
+
+	existingKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve validating public keys: %v", err)
+	}
+	active := make(map[[48]byte]bool, len(existingKeys))
+	for _, k := range existingKeys {
+		active[k] = true
+	}
+	statuses := make([]*ethpbv1.DeletedKeystoreStatus, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		if !active[pubKey] {
+			continue
+		}
+		st := &ethpbv1.DeletedKeystoreStatus{Status: ethpbv1.DeletedKeystoreStatus_deleted}
+		if err := km.DeleteAccounts(ctx, [][]byte{pubKey[:]}); err != nil {
+			st = &ethpbv1.DeletedKeystoreStatus{
+				Status:  ethpbv1.DeletedKeystoreStatus_error,
+				Message: fmt.Sprintf("could not delete key: %v", err),
+			}
+		}
+		// Requesting the same key several times yields the same status.
+		for j := i; j < len(pubKeys); j++ {
+			if pubKeys[j] == pubKey {
+				statuses[j] = st
+			}
+		}
+		delete(active, pubKey)
+	}
+
+	interchange, err := slashing.ExportStandardProtectionJSON(ctx, s.valDB)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not export slashing protection history: %v", err)
+	}
+	requested := make(map[[48]byte]bool, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		requested[pubKey] = true
+	}
+	withHistory := make(map[[48]byte]bool)
+	data := make([]*format.ProtectionData, 0)
+	for _, item := range interchange.Data {
+		pubKey, err := hexutil.Decode(item.Pubkey)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not decode public key %s: %v", item.Pubkey, err)
+		}
+		if requested[bytesutil.ToBytes48(pubKey)] {
+			withHistory[bytesutil.ToBytes48(pubKey)] = true
+			data = append(data, item)
+		}
+	}
+	interchange.Data = data
+	for i, pubKey := range pubKeys {
+		if statuses[i] != nil {
+			continue
+		}
+		if withHistory[pubKey] {
+			statuses[i] = &ethpbv1.DeletedKeystoreStatus{Status: ethpbv1.DeletedKeystoreStatus_not_active}
+		} else {
+			statuses[i] = &ethpbv1.DeletedKeystoreStatus{Status: ethpbv1.DeletedKeystoreStatus_not_found}
+		}
+	}
+
+	encoded, err := json.Marshal(interchange)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not JSON marshal slashing protection history: %v", err)
+	}
+	return &ethpbv1.DeleteKeystoresResponse{
+		Data:               statuses,
+		SlashingProtection: string(encoded),
+	}, nil
+}
+
+// Keystores can only be imported into and deleted from imported wallets.
+func (s *keymanagerServer) importedKeymanager() (*imported.Keymanager, error) {
+	if s.wallet == nil || s.keymanager == nil {
+		return nil, status.Error(codes.FailedPrecondition, "No wallet found")
+	}
+	if s.wallet.KeymanagerKind() != keymanager.Imported {
+		return nil, status.Error(codes.FailedPrecondition, "Only imported wallets can manage keystores")
+	}
+	if s.valDB == nil {
+		return nil, status.Error(codes.FailedPrecondition, "No validator database found")
+	}
+	km, ok := s.keymanager.(*imported.Keymanager)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "Could not assert keymanager interface to concrete type")
+	}
+	return km, nil
+}
+
+// Decodes and decrypts an EIP-2335 keystore with its password. The password is verified
+// here as the keymanager interactively prompts for a new password when decryption fails.
+// The public key of the keystore is derived from its secret key.
+func decryptKeystore(
+	decryptor *keystorev4.Encryptor, encoded, password string,
+) (*keymanager.Keystore, [48]byte, error) {
+	ks := &keymanager.Keystore{}
+	if err := json.Unmarshal([]byte(encoded), ks); err != nil {
+		return nil, [48]byte{}, errors.Wrap(err, "could not decode keystore")
+	}
+	if ks.Crypto == nil {
+		return nil, [48]byte{}, errors.New("keystore is missing crypto fields")
+	}
+	privKeyBytes, err := decryptor.Decrypt(ks.Crypto, password)
+	if err != nil {
+		return nil, [48]byte{}, errors.Wrap(err, "could not decrypt keystore")
+	}
+	privKey, err := bls.SecretKeyFromBytes(privKeyBytes)
+	if err != nil {
+		return nil, [48]byte{}, errors.Wrap(err, "could not initialize private key from bytes")
+	}
+	pubKey := privKey.PublicKey().Marshal()
+	if ks.Pubkey != "" && strings.TrimPrefix(ks.Pubkey, "0x") != fmt.Sprintf("%x", pubKey) {
+		return nil, [48]byte{}, fmt.Errorf("keystore public key does not match secret key %#x", pubKey)
+	}
+	// The keymanager trusts the public key field of the keystore.
+	ks.Pubkey = fmt.Sprintf("%x", pubKey)
+	return ks, bytesutil.ToBytes48(pubKey), nil
+}
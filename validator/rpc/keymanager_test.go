@@ -0,0 +1,253 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/google/uuid"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/accounts"
+	"github.com/prysmaticlabs/prysm/validator/accounts/iface"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	slashing "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format/format"
+	mocks "github.com/prysmaticlabs/prysm/validator/testing"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+func TestServer_ListKeystores(t *testing.T) {
+	imported.ResetCaches()
+	ctx := context.Background()
+	s := &keymanagerServer{Server: &Server{}}
+	_, err := s.ListKeystores(ctx, &empty.Empty{})
+	require.ErrorContains(t, "Wallet not yet initialized", err)
+
+	ss, pubKeys := createImportedWalletWithAccounts(t, 2)
+	ss.walletInitialized = true
+	s = &keymanagerServer{Server: ss}
+	resp, err := s.ListKeystores(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, len(pubKeys), len(resp.Data))
+	listed := make(map[string]bool)
+	for _, ks := range resp.Data {
+		listed[ks.ValidatingPubkey] = true
+		assert.Equal(t, false, ks.Readonly)
+		assert.Equal(t, "", ks.DerivationPath)
+	}
+	for _, pubKey := range pubKeys {
+		assert.Equal(t, true, listed[hexutil.Encode(pubKey)])
+	}
+}
+
+func TestServer_ListKeystores_DerivedWallet(t *testing.T) {
+	localWalletDir := setupWalletDir(t)
+	defaultWalletPath = localWalletDir
+	ctx := context.Background()
+	w, err := accounts.CreateWalletWithKeymanager(ctx, &accounts.CreateWalletConfig{
+		WalletCfg: &wallet.Config{
+			WalletDir:      defaultWalletPath,
+			KeymanagerKind: keymanager.Derived,
+			WalletPassword: strongPass,
+		},
+		SkipMnemonicConfirm: true,
+		NumAccounts:         2,
+	})
+	require.NoError(t, err)
+	km, err := w.InitializeKeymanager(ctx, iface.InitKeymanagerConfig{ListenForChanges: false})
+	require.NoError(t, err)
+	s := &keymanagerServer{Server: &Server{
+		keymanager:        km,
+		wallet:            w,
+		walletInitialized: true,
+	}}
+	resp, err := s.ListKeystores(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Data))
+	for i, ks := range resp.Data {
+		assert.Equal(t, true, ks.Readonly)
+		assert.Equal(t, fmt.Sprintf("m/12381/3600/%d/0/0", i), ks.DerivationPath)
+	}
+
+	// Keys of a derived wallet cannot be managed through the keymanager API.
+	_, err = s.DeleteKeystores(ctx, &ethpbv1.DeleteKeystoresRequest{})
+	require.ErrorContains(t, "Only imported wallets can manage keystores", err)
+}
+
+func TestServer_ImportKeystores_Standard(t *testing.T) {
+	imported.ResetCaches()
+	ctx := context.Background()
+	ss, existingKeys := createImportedWalletWithAccounts(t, 1)
+	s := &keymanagerServer{Server: ss}
+
+	_, err := s.ImportKeystores(ctx, &ethpbv1.ImportKeystoresRequest{})
+	require.ErrorContains(t, "No validator database found", err)
+	s.valDB = dbtest.SetupDB(t, [][48]byte{})
+
+	_, err = s.ImportKeystores(ctx, &ethpbv1.ImportKeystoresRequest{
+		Keystores: []string{"{}"},
+	})
+	require.ErrorContains(t, "Number of keystores and passwords is not equal", err)
+
+	first, firstKey := createStandardKeystore(t, "first password")
+	second, secondKey := createStandardKeystore(t, "second password")
+	wrongPassword, _ := createStandardKeystore(t, "password")
+	existing, err := json.Marshal(&keymanager.Keystore{Pubkey: "00"})
+	require.NoError(t, err)
+
+	// The slashing protection history is imported along with the keys.
+	attestingHistory, proposalHistory := mocks.MockAttestingAndProposalHistories([][48]byte{firstKey})
+	interchange, err := mocks.MockSlashingProtectionJSON([][48]byte{firstKey}, attestingHistory, proposalHistory)
+	require.NoError(t, err)
+	encoded, err := json.Marshal(interchange)
+	require.NoError(t, err)
+
+	resp, err := s.ImportKeystores(ctx, &ethpbv1.ImportKeystoresRequest{
+		Keystores:          []string{first, second, first, wrongPassword, string(existing), "invalid"},
+		Passwords:          []string{"first password", "second password", "first password", "wrong", "", ""},
+		SlashingProtection: string(encoded),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 6, len(resp.Data))
+	assert.Equal(t, ethpbv1.ImportedKeystoreStatus_imported, resp.Data[0].Status)
+	assert.Equal(t, ethpbv1.ImportedKeystoreStatus_imported, resp.Data[1].Status)
+	assert.Equal(t, ethpbv1.ImportedKeystoreStatus_duplicate, resp.Data[2].Status)
+	assert.Equal(t, ethpbv1.ImportedKeystoreStatus_error, resp.Data[3].Status)
+	assert.Equal(t, ethpbv1.ImportedKeystoreStatus_error, resp.Data[4].Status)
+	assert.Equal(t, ethpbv1.ImportedKeystoreStatus_error, resp.Data[5].Status)
+
+	keys, err := s.keymanager.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(keys))
+	assert.DeepEqual(t, [][48]byte{bytesutil.ToBytes48(existingKeys[0]), firstKey, secondKey}, keys)
+
+	exported, err := slashing.ExportStandardProtectionJSON(ctx, s.valDB)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(exported.Data))
+	assert.Equal(t, hexutil.Encode(firstKey[:]), exported.Data[0].Pubkey)
+
+	// Importing a key which is already in the wallet reports a duplicate.
+	resp, err = s.ImportKeystores(ctx, &ethpbv1.ImportKeystoresRequest{
+		Keystores: []string{second},
+		Passwords: []string{"second password"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ethpbv1.ImportedKeystoreStatus_duplicate, resp.Data[0].Status)
+}
+
+func TestServer_ImportKeystores_InvalidSlashingProtection(t *testing.T) {
+	imported.ResetCaches()
+	ctx := context.Background()
+	ss, _ := createImportedWalletWithAccounts(t, 1)
+	ss.valDB = dbtest.SetupDB(t, [][48]byte{})
+	s := &keymanagerServer{Server: ss}
+
+	ks, _ := createStandardKeystore(t, strongPass)
+	resp, err := s.ImportKeystores(ctx, &ethpbv1.ImportKeystoresRequest{
+		Keystores:          []string{ks, ks},
+		Passwords:          []string{strongPass, strongPass},
+		SlashingProtection: "invalid",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Data))
+	for _, st := range resp.Data {
+		assert.Equal(t, ethpbv1.ImportedKeystoreStatus_error, st.Status)
+		assert.Equal(t, true, strings.Contains(st.Message, "could not import slashing protection"))
+	}
+
+	// None of the keys are imported without their slashing protection history.
+	keys, err := s.keymanager.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(keys))
+}
+
+func TestServer_DeleteKeystores(t *testing.T) {
+	imported.ResetCaches()
+	ctx := context.Background()
+	ss, pubKeys := createImportedWalletWithAccounts(t, 2)
+	ss.valDB = dbtest.SetupDB(t, [][48]byte{})
+	s := &keymanagerServer{Server: ss}
+
+	_, err := s.DeleteKeystores(ctx, &ethpbv1.DeleteKeystoresRequest{Pubkeys: []string{"0x01"}})
+	require.ErrorContains(t, "Invalid public key 0x01", err)
+
+	// Slashing protection history exists for a key in the wallet and a key
+	// which was removed from the wallet.
+	randomKeys, err := mocks.CreateRandomPubKeys(2)
+	require.NoError(t, err)
+	inactiveKey, unknownKey := randomKeys[0], randomKeys[1]
+	historyKeys := [][48]byte{bytesutil.ToBytes48(pubKeys[0]), inactiveKey}
+	attestingHistory, proposalHistory := mocks.MockAttestingAndProposalHistories(historyKeys)
+	interchange, err := mocks.MockSlashingProtectionJSON(historyKeys, attestingHistory, proposalHistory)
+	require.NoError(t, err)
+	encoded, err := json.Marshal(interchange)
+	require.NoError(t, err)
+	require.NoError(t, slashing.ImportStandardProtectionJSON(ctx, s.valDB, bytes.NewBuffer(encoded)))
+
+	resp, err := s.DeleteKeystores(ctx, &ethpbv1.DeleteKeystoresRequest{
+		Pubkeys: []string{
+			hexutil.Encode(pubKeys[0]),
+			hexutil.Encode(inactiveKey[:]),
+			hexutil.Encode(unknownKey[:]),
+			hexutil.Encode(pubKeys[0]),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 4, len(resp.Data))
+	assert.Equal(t, ethpbv1.DeletedKeystoreStatus_deleted, resp.Data[0].Status)
+	assert.Equal(t, ethpbv1.DeletedKeystoreStatus_not_active, resp.Data[1].Status)
+	assert.Equal(t, ethpbv1.DeletedKeystoreStatus_not_found, resp.Data[2].Status)
+	assert.Equal(t, ethpbv1.DeletedKeystoreStatus_deleted, resp.Data[3].Status)
+
+	keys, err := s.keymanager.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][48]byte{bytesutil.ToBytes48(pubKeys[1])}, keys)
+
+	// Only the history of the requested keys is exported.
+	exported := &format.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal([]byte(resp.SlashingProtection), exported))
+	assert.Equal(t, interchange.Metadata.GenesisValidatorsRoot, exported.Metadata.GenesisValidatorsRoot)
+	require.Equal(t, 2, len(exported.Data))
+	exportedKeys := map[string]bool{exported.Data[0].Pubkey: true, exported.Data[1].Pubkey: true}
+	assert.Equal(t, true, exportedKeys[hexutil.Encode(pubKeys[0])])
+	assert.Equal(t, true, exportedKeys[hexutil.Encode(inactiveKey[:])])
+
+	// Deleting the key again reports it as inactive as its history remains.
+	resp, err = s.DeleteKeystores(ctx, &ethpbv1.DeleteKeystoresRequest{
+		Pubkeys: []string{hexutil.Encode(pubKeys[0])},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ethpbv1.DeletedKeystoreStatus_not_active, resp.Data[0].Status)
+}
+
+func createStandardKeystore(t *testing.T, password string) (string, [48]byte) {
+	encryptor := keystorev4.New()
+	privKey, err := bls.RandKey()
+	require.NoError(t, err)
+	id, err := uuid.NewRandom()
+	require.NoError(t, err)
+	cryptoFields, err := encryptor.Encrypt(privKey.Marshal(), password)
+	require.NoError(t, err)
+	item := &keymanager.Keystore{
+		Crypto:  cryptoFields,
+		ID:      id.String(),
+		Version: encryptor.Version(),
+		Pubkey:  fmt.Sprintf("%x", privKey.PublicKey().Marshal()),
+		Name:    encryptor.Name(),
+	}
+	encoded, err := json.Marshal(item)
+	require.NoError(t, err)
+	return string(encoded), bytesutil.ToBytes48(privKey.PublicKey().Marshal())
+}
@@ -12,6 +12,7 @@ import (
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	healthpb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
 	"github.com/prysmaticlabs/prysm/shared/event"
@@ -189,6 +190,7 @@ func (s *Server) Start() {
 	pb.RegisterBeaconServer(s.grpcServer, s)
 	pb.RegisterAccountsServer(s.grpcServer, s)
 	pb.RegisterSlashingProtectionServer(s.grpcServer, s)
+	ethpbv1.RegisterKeyManagementServer(s.grpcServer, &keymanagerServer{Server: s})
 
 	go func() {
 		if s.listener != nil {